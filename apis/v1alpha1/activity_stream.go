@@ -0,0 +1,33 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// ActivityStream describes the Database Activity Stream of an Aurora DB
+// cluster, or of an RDS for Oracle or RDS for SQL Server DB instance, which
+// pushes the database activity to an Amazon Kinesis data stream reported in
+// Status.ActivityStreamKinesisStreamName. Changing the mode of a started
+// activity stream stops it and starts it again.
+type ActivityStream struct {
+	// Whether the activity stream is started.
+	Enabled *bool `json:"enabled"`
+	// The mode of the activity stream, async or sync. Only Aurora PostgreSQL
+	// supports the sync mode.
+	Mode *string `json:"mode,omitempty"`
+	// The Amazon Web Services KMS key identifier the database activity is
+	// encrypted with.
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	// Whether the activity stream includes the engine-native audit fields, for
+	// RDS for Oracle DB instances only.
+	EngineNativeAuditFieldsIncluded *bool `json:"engineNativeAuditFieldsIncluded,omitempty"`
+}
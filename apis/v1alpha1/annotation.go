@@ -13,7 +13,11 @@
 
 package v1alpha1
 
-import "fmt"
+import (
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
 
 var (
 	// LastAppliedConfigMapAnnotation is the annotation key used to store the namespaced name
@@ -27,4 +31,121 @@ var (
 	// compute the "reference" delta, and can result in the rds-controller making unnecessary password
 	// updates to the DBInstance or DBCluster.
 	LastAppliedSecretAnnotation = fmt.Sprintf("%s/last-applied-secret-reference", GroupVersion.Group)
+
+	// ResetAllParametersAnnotation is the annotation key users set on a DBParameterGroup or
+	// DBClusterParameterGroup to reset all the parameters of the parameter group to their
+	// default values before the parameter overrides are applied again. This is useful to
+	// discard parameters that were modified outside of the rds-controller, for instance from the
+	// console. Setting the annotation to a new value (for instance a timestamp) triggers another
+	// reset.
+	ResetAllParametersAnnotation = fmt.Sprintf("%s/reset-all-parameters", GroupVersion.Group)
+	// LastResetAllParametersAnnotation is the annotation key used to store the value of the
+	// ResetAllParametersAnnotation annotation the last time all the parameters of a
+	// DBParameterGroup or DBClusterParameterGroup were reset.
+	//
+	// This annotation is only applied by the rds-controller, and should not be modified by the user.
+	LastResetAllParametersAnnotation = fmt.Sprintf("%s/last-reset-all-parameters", GroupVersion.Group)
+
+	// RebootAnnotation is the annotation key users set to "true" on a DBInstance to reboot the
+	// DB instance once. The rds-controller removes the annotation after issuing the reboot and
+	// records it in Status.LastReboot.
+	RebootAnnotation = fmt.Sprintf("%s/reboot", GroupVersion.Group)
+	// RebootWithFailoverAnnotation is like RebootAnnotation, but the reboot is conducted through a
+	// Multi-AZ failover.
+	RebootWithFailoverAnnotation = fmt.Sprintf("%s/reboot-with-failover", GroupVersion.Group)
+
+	// PromoteReadReplicaAnnotation is the annotation key users set to "true" on a DBInstance or
+	// DBCluster created as a read replica, like a cross-Region Aurora read replica, to promote it
+	// to a standalone DB instance or DB cluster. The rds-controller removes the annotation,
+	// together with the Spec fields naming the source DB instance or DB cluster, once the
+	// promotion is issued.
+	PromoteReadReplicaAnnotation = fmt.Sprintf("%s/promote-read-replica", GroupVersion.Group)
+	// SwitchoverReadReplicaAnnotation is the annotation key users set to "true" on a DBInstance
+	// created as a read replica to switch it over to the primary, the current primary becoming
+	// a read replica of it. The rds-controller removes the annotation, together with the Spec
+	// fields naming the source DB instance, once the switchover is issued, and reports its
+	// progress in the Switchover condition.
+	SwitchoverReadReplicaAnnotation = fmt.Sprintf("%s/switchover-read-replica", GroupVersion.Group)
+
+	// DisableDeletionProtectionAnnotation is the annotation key users set to "true" on a
+	// DBInstance or DBCluster to let the rds-controller disable the deletion protection of the
+	// DB instance or DB cluster when the resource is deleted. Without it, the deletion of a
+	// resource with deletion protection enabled is blocked and reported in the DeletionBlocked
+	// condition.
+	DisableDeletionProtectionAnnotation = fmt.Sprintf("%s/disable-deletion-protection", GroupVersion.Group)
+
+	// ApplyPendingMaintenanceActionAnnotation is the annotation key users set on a DBInstance or
+	// DBCluster to the name of one of the pending maintenance actions listed in
+	// Status.PendingMaintenanceActions, like "system-update", to apply it immediately. The
+	// rds-controller removes the annotation once the maintenance action is applied.
+	ApplyPendingMaintenanceActionAnnotation = fmt.Sprintf("%s/apply-pending-maintenance-action", GroupVersion.Group)
+
+	// RotateCACertificateAnnotation is the annotation key users set on a DBInstance to rotate the
+	// CA certificate of the DB instance immediately, to Spec.CACertificateIdentifier when it
+	// differs from the current CA certificate, or else to the default CA certificate of the
+	// region. Set to "true", the DB instance is restarted so that the new CA certificate is used
+	// right away. Set to "no-restart", the DB instance is not restarted, which is only safe once
+	// the applications connecting over SSL/TLS trust the new CA certificate. The rds-controller
+	// removes the annotation once the rotation is issued.
+	RotateCACertificateAnnotation = fmt.Sprintf("%s/rotate-ca-certificate", GroupVersion.Group)
+
+	// PauseAutomationAnnotation is the annotation key users set on an RDS Custom DBInstance to a
+	// number of minutes between 60 and 1440, like "90", to pause the RDS Custom automation of the
+	// DB instance for that long, for instance while customizing its host. Set to "resume", the full
+	// automation resumes right away. The rds-controller removes the annotation once the automation
+	// mode is changed, and reports it in Status.AutomationMode and
+	// Status.ResumeFullAutomationModeTime.
+	PauseAutomationAnnotation = fmt.Sprintf("%s/pause-automation", GroupVersion.Group)
+
+	// BacktrackToAnnotation is the annotation key users set on an Aurora MySQL DBCluster with
+	// backtracking enabled through Spec.BacktrackWindow to a timestamp in RFC 3339 format, like
+	// "2024-03-05T14:30:00Z", to backtrack the DB cluster to that time. The rds-controller removes
+	// the annotation once the backtrack is issued, records it in Status.LastBacktrack and reports
+	// its progress in the Backtrack condition.
+	BacktrackToAnnotation = fmt.Sprintf("%s/backtrack-to", GroupVersion.Group)
+
+	// SwitchoverGlobalClusterAnnotation is the annotation key users set on a GlobalCluster to the
+	// ARN or identifier of one of its secondary DB clusters to switch the global database over to
+	// it, without data loss. FailoverGlobalClusterAnnotation is like it, but fails the global
+	// database over, allowing data loss, to recover from an outage of the primary cluster. The
+	// rds-controller removes the annotations once the switchover or failover is issued, records
+	// it in Status.LastFailover and reports its progress in the Switchover or Failover
+	// condition.
+	SwitchoverGlobalClusterAnnotation = fmt.Sprintf("%s/switchover-global-cluster", GroupVersion.Group)
+	FailoverGlobalClusterAnnotation   = fmt.Sprintf("%s/failover-global-cluster", GroupVersion.Group)
+
+	// FailoverDBClusterAnnotation is the annotation key users set on an Aurora DBCluster or a
+	// Multi-AZ DBCluster to fail the DB cluster over to one of its reader DB instances. Set to
+	// "true", Amazon RDS picks the reader DB instance promoted to the writer. Set to the name of a
+	// member DBInstance in the same namespace, or to the identifier of a reader DB instance of the
+	// DB cluster, that DB instance is promoted. The rds-controller removes the annotation once the
+	// failover is issued, records it in Status.LastFailover and reports its progress in the
+	// Failover condition.
+	FailoverDBClusterAnnotation = fmt.Sprintf("%s/failover-db-cluster", GroupVersion.Group)
+
+	// AdoptionPolicyAnnotation is the annotation key users set to "adopt" on a DBInstance to
+	// bring an existing DB instance under the management of the rds-controller instead of
+	// creating one. The DB instance is looked up by the identifiers of AdoptionFieldsAnnotation,
+	// or of the Spec, and its current configuration replaces the Spec, so that nothing is
+	// modified on adoption. The rds-controller then sets the services.k8s.aws/adopted annotation
+	// to "true", and manages the DB instance like any other from then on. The resource becomes
+	// terminal when there is no DB instance to adopt.
+	//
+	// Set to "adopt-or-create" on a resource of any kind but BlueGreenDeployment, whose
+	// identifier is chosen by RDS, the existing AWS resource with the identifiers of
+	// AdoptionFieldsAnnotation, or of the Spec, is adopted and converged to the Spec, and is
+	// created when there is none. This makes manifests portable between environments where some
+	// of the AWS resources already exist.
+	AdoptionPolicyAnnotation = ackv1alpha1.AnnotationPrefix + "adoption-policy"
+	// AdoptionFieldsAnnotation is the annotation key users set, along with
+	// AdoptionPolicyAnnotation, to a JSON object holding the identifiers of the AWS resource to
+	// adopt, like '{"dbInstanceIdentifier": "prod-db"}' for a DBInstance.
+	AdoptionFieldsAnnotation = ackv1alpha1.AnnotationPrefix + "adoption-fields"
+
+	// ResyncRequestedAnnotation is the annotation key the rds-controller sets, to the current
+	// time, on the DBClusters, DBInstances and DBParameterGroups to reconcile outside of their
+	// resync period: when one of their scheduled stops or starts is due, or when the Secret
+	// holding their master user password or their base DBParameterGroup changes. Users may
+	// also change it to reconcile a resource of these kinds immediately.
+	ResyncRequestedAnnotation = fmt.Sprintf("%s/resync-requested", GroupVersion.Group)
 )
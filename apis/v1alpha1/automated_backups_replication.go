@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// AutomatedBackupsReplication describes the replication of the automated
+// backups of a DB instance to another Amazon Web Services Region, for
+// disaster recovery. The replicated automated backups are reported in
+// Status.AutomatedBackupsReplicationARN. Only one destination region is
+// supported: the replication must be stopped before the automated backups are
+// replicated to another region.
+type AutomatedBackupsReplication struct {
+	// Whether the automated backups are replicated.
+	Enabled *bool `json:"enabled"`
+	// The Amazon Web Services Region the automated backups are replicated to,
+	// for example us-east-1.
+	DestinationRegion *string `json:"destinationRegion"`
+	// The retention period in days of the replicated automated backups, 7 when
+	// omitted. It is applied when the replication starts.
+	BackupRetentionPeriod *int64 `json:"backupRetentionPeriod,omitempty"`
+	// The Amazon Web Services KMS key identifier, in the destination region,
+	// the replicated automated backups are encrypted with. Required when the
+	// DB instance is encrypted. It is applied when the replication starts.
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
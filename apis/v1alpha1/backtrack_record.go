@@ -0,0 +1,32 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BacktrackRecord describes a backtrack of an Aurora MySQL DB cluster the
+// rds-controller issued because of the BacktrackToAnnotation annotation.
+type BacktrackRecord struct {
+	// The identifier of the backtrack.
+	BacktrackIdentifier *string `json:"backtrackIdentifier,omitempty"`
+	// The time to which the DB cluster is backtracked.
+	BacktrackTo *metav1.Time `json:"backtrackTo,omitempty"`
+	// The time at which the backtrack was issued.
+	BacktrackedAt *metav1.Time `json:"backtrackedAt,omitempty"`
+	// The status of the backtrack, either "applying", "completed", "failed"
+	// or "pending".
+	Status *string `json:"status,omitempty"`
+}
@@ -0,0 +1,34 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// DBClusterInstances describes the DB instances the controller creates in an
+// Aurora DB cluster, as DBInstance resources named after the DBCluster with
+// their index starting from 1, like my-cluster-1, and owned by it. Raising
+// the count adds DB instances, lowering it deletes the last ones. The member
+// DB instances are deleted before the DB cluster.
+type DBClusterInstances struct {
+	// The number of DB instances in the DB cluster.
+	Count *int64 `json:"count"`
+	// The compute and memory capacity of the DB instances, for example
+	// db.r6g.large, or db.serverless for Aurora Serverless v2.
+	DBInstanceClass *string `json:"dbInstanceClass"`
+	// The promotion tiers of the DB instances, by index. The DB instances
+	// past the last promotion tier get the default one.
+	PromotionTiers []*int64 `json:"promotionTiers,omitempty"`
+	// The Availability Zones the DB instances are spread across, in turn.
+	// Defaults to letting Amazon RDS pick the Availability Zone of each DB
+	// instance.
+	AvailabilityZones []*string `json:"availabilityZones,omitempty"`
+}
@@ -0,0 +1,37 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// ConnectionSecret describes the Kubernetes Secret the controller writes the
+// connection details of a DB instance or DB cluster to, so that applications
+// can connect to it. The controller sets the "host", "port", "dbname" and
+// "username" keys of the Secret, the "writerHost" and "readerHost" keys of a
+// DB cluster to its writer and reader endpoints, so that applications can
+// split read traffic, its "password" key when the master user password is set
+// from a Secret or managed in Secrets Manager, and its "ca.crt" key to the RDS
+// certificate bundle of the region. The controller creates the Secret in the
+// namespace of the DB instance or DB cluster, owned by it so that it is
+// deleted along with it, and refuses to write to an existing Secret it does not
+// own.
+type ConnectionSecret struct {
+	// The name of the Secret.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// Additional keys of the Secret, rendered from Go templates
+	// (https://pkg.go.dev/text/template) over the other keys of the Secret,
+	// like "postgresql://{{ .username }}:{{ .password | urlquery }}@{{ .host }}:{{ .port }}/{{ .dbname }}".
+	// Keys that are not known yet, like the host of a DB instance being
+	// created, render empty.
+	Templates map[string]*string `json:"templates,omitempty"`
+}
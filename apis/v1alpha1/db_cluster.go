@@ -42,6 +42,10 @@ import (
 // in the Amazon RDS User Guide.
 type DBClusterSpec struct {
 
+	// The Database Activity Stream of the DB cluster, started and stopped through
+	// StartActivityStream and StopActivityStream. When omitted, the activity
+	// stream is left in whatever state it is in.
+	ActivityStream *ActivityStream `json:"activityStream,omitempty"`
 	// The amount of storage in gibibytes (GiB) to allocate to each DB instance
 	// in the Multi-AZ DB cluster.
 	//
@@ -86,11 +90,17 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	BackupRetentionPeriod *int64 `json:"backupRetentionPeriod,omitempty"`
+	// The name of the ConfigMap, in the namespace of the DB cluster, the RDS
+	// certificate bundle of the region of the DB cluster is maintained in, under
+	// the ca.crt key, so that applications can verify the TLS connections to it.
+	CABundleConfigMap *string `json:"caBundleConfigMap,omitempty"`
 	// A value that indicates that the DB cluster should be associated with the
 	// specified CharacterSet.
 	//
 	// Valid for: Aurora DB clusters only
 	CharacterSetName *string `json:"characterSetName,omitempty"`
+	// The Kubernetes Secret the connection details of the DB cluster are written to.
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
 	// A value that indicates whether to copy all tags from the DB cluster to snapshots
 	// of the DB cluster. The default is not to copy them.
 	//
@@ -155,6 +165,13 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	DatabaseName *string `json:"databaseName,omitempty"`
+	// How the DB cluster is deleted when the resource is deleted, either "Delete"
+	// to delete it without a final snapshot or "Snapshot" to take a final DB
+	// cluster snapshot before deleting it. Defaults to "Delete". Set the
+	// services.k8s.aws/deletion-policy annotation to "retain" to leave the DB
+	// cluster running instead.
+	// +kubebuilder:validation:Enum=Delete;Snapshot
+	DeletionPolicy *string `json:"deletionPolicy,omitempty"`
 	// A value that indicates whether the DB cluster has deletion protection enabled.
 	// The database can't be deleted when deletion protection is enabled. By default,
 	// deletion protection isn't enabled.
@@ -232,6 +249,13 @@ type DBClusterSpec struct {
 	// in the Amazon Aurora User Guide.
 	//
 	// Valid for: Aurora DB clusters only
+	//
+	// The rds-controller only turns the Data API on and off on Aurora Serverless
+	// v1 DB clusters. Setting it to true on, or changing it for, an Aurora
+	// provisioned or Aurora Serverless v2 DB cluster is a terminal error, since
+	// their Data API is turned on and off with the EnableHttpEndpoint and
+	// DisableHttpEndpoint operations, which the controller does not support yet.
+	// Their effective Data API state is still reported in Status.HTTPEndpointEnabled.
 	EnableHTTPEndpoint *bool `json:"enableHTTPEndpoint,omitempty"`
 	// A value that indicates whether to enable mapping of Amazon Web Services Identity
 	// and Access Management (IAM) accounts to database accounts. By default, mapping
@@ -242,6 +266,12 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters only
 	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
+	// Specifies whether read replicas can forward write operations to the writer
+	// DB instance in the DB cluster. By default, write operations aren't allowed
+	// on reader DB instances.
+	//
+	// Valid for: Aurora DB clusters only
+	EnableLocalWriteForwarding *bool `json:"enableLocalWriteForwarding,omitempty"`
 	// A value that indicates whether to turn on Performance Insights for the DB
 	// cluster.
 	//
@@ -250,6 +280,13 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Multi-AZ DB clusters only
 	EnablePerformanceInsights *bool `json:"enablePerformanceInsights,omitempty"`
+	// Whether the controller writes the connection details of the DB cluster to a
+	// Secret following the Service Binding specification, referred to by
+	// Status.Binding. The Secret is deleted along with the DB cluster.
+	EnableServiceBinding *bool `json:"enableServiceBinding,omitempty"`
+	// The Kubernetes ExternalName Services pointed at the endpoint and reader
+	// endpoint of the DB cluster.
+	EndpointService *EndpointService `json:"endpointService,omitempty"`
 	// The name of the database engine to be used for this DB cluster.
 	//
 	// Valid Values:
@@ -330,11 +367,18 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	EngineVersion *string `json:"engineVersion,omitempty"`
+	// The template of the identifier of the final DB cluster snapshot taken when
+	// DeletionPolicy is "Snapshot". The {name}, {namespace}, {identifier} and
+	// {timestamp} placeholders are replaced with the name and namespace of the
+	// resource, the DB cluster identifier and the UTC time of the deletion (formatted
+	// as YYYYMMDDhhmmss). Defaults to "{identifier}-final-{timestamp}".
+	FinalSnapshotIdentifierTemplate *string `json:"finalSnapshotIdentifierTemplate,omitempty"`
 	// The global cluster ID of an Aurora cluster that becomes the primary cluster
 	// in the new global database cluster.
 	//
 	// Valid for: Aurora DB clusters only
-	GlobalClusterIdentifier *string `json:"globalClusterIdentifier,omitempty"`
+	GlobalClusterIdentifier *string                                  `json:"globalClusterIdentifier,omitempty"`
+	GlobalClusterRef        *ackv1alpha1.AWSResourceReferenceWrapper `json:"globalClusterRef,omitempty"`
 	// The amount of Provisioned IOPS (input/output operations per second) to be
 	// initially allocated for each DB instance in the Multi-AZ DB cluster.
 	//
@@ -348,6 +392,9 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Multi-AZ DB clusters only
 	IOPS *int64 `json:"iops,omitempty"`
+	// The Aurora DB instances the controller creates in the DB cluster and keeps
+	// in line with this description.
+	Instances *DBClusterInstances `json:"instances,omitempty"`
 	// The Amazon Web Services KMS key identifier for an encrypted DB cluster.
 	//
 	// The Amazon Web Services KMS key identifier is the key ARN, key ID, alias
@@ -402,6 +449,18 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	MasterUserPassword *ackv1alpha1.SecretKeyReference `json:"masterUserPassword,omitempty"`
+	// The ARN of an existing Amazon Web Services Secrets Manager secret holding
+	// the master user password, either as its value or under the password key of
+	// its JSON value. The controller reads the secret when it creates or modifies
+	// the database and needs the secretsmanager:GetSecretValue permission on it.
+	// Cannot be set along with MasterUserPassword or ManageMasterUserPassword.
+	MasterUserPasswordSecretARN *string `json:"masterUserPasswordSecretARN,omitempty"`
+	// The key of the Kubernetes Secret, in the namespace of the resource, the
+	// master user password is copied to when it is managed in Amazon Web Services
+	// Secrets Manager, so that applications can read it. The Secret must exist.
+	// The controller needs the secretsmanager:GetSecretValue permission on the
+	// secret managed by RDS.
+	MasterUserSecretDestination *MasterUserSecretDestination `json:"masterUserSecretDestination,omitempty"`
 	// The Amazon Web Services KMS key identifier to encrypt a secret that is automatically
 	// generated and managed in Amazon Web Services Secrets Manager.
 	//
@@ -424,6 +483,11 @@ type DBClusterSpec struct {
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	MasterUserSecretKMSKeyID  *string                                  `json:"masterUserSecretKMSKeyID,omitempty"`
 	MasterUserSecretKMSKeyRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"masterUserSecretKMSKeyRef,omitempty"`
+	// The rotation schedule of the master user password when it is managed in
+	// Amazon Web Services Secrets Manager. The controller needs the
+	// secretsmanager:DescribeSecret and secretsmanager:RotateSecret permissions
+	// on the secret managed by RDS.
+	MasterUserSecretRotation *MasterUserSecretRotation `json:"masterUserSecretRotation,omitempty"`
 	// The name of the master user for the DB cluster.
 	//
 	// Constraints:
@@ -652,11 +716,23 @@ type DBClusterSpec struct {
 	//
 	// Valid for: Aurora DB clusters and Multi-AZ DB clusters
 	ReplicationSourceIdentifier *string `json:"replicationSourceIdentifier,omitempty"`
+	// The DB cluster the DB cluster is restored from, at its latest restorable
+	// time, when it is created.
+	RestoreFrom *RestoreFrom `json:"restoreFrom,omitempty"`
+	// The DB cluster and point in time the DB cluster is restored from when it
+	// is created.
+	RestoreToPointInTime *DBClusterRestoreToPointInTime `json:"restoreToPointInTime,omitempty"`
+	// Whether a manual DB cluster snapshot is taken before modifications that
+	// are hard to roll back, namely major engine version upgrades and storage type
+	// changes. The modification is issued once the snapshot is available.
+	SafetySnapshot *bool `json:"safetySnapshot,omitempty"`
 	// For DB clusters in serverless DB engine mode, the scaling properties of the
 	// DB cluster.
 	//
 	// Valid for: Aurora DB clusters only
-	ScalingConfiguration             *ScalingConfiguration             `json:"scalingConfiguration,omitempty"`
+	ScalingConfiguration *ScalingConfiguration `json:"scalingConfiguration,omitempty"`
+	// When the controller stops and starts the DB cluster.
+	Schedule                         *StopStartSchedule                `json:"schedule,omitempty"`
 	ServerlessV2ScalingConfiguration *ServerlessV2ScalingConfiguration `json:"serverlessV2ScalingConfiguration,omitempty"`
 	// The identifier for the DB snapshot or DB cluster snapshot to restore from.
 	//
@@ -745,6 +821,10 @@ type DBClusterStatus struct {
 	// The number of change records stored for Backtrack.
 	// +kubebuilder:validation:Optional
 	BacktrackConsumedChangeRecords *int64 `json:"backtrackConsumedChangeRecords,omitempty"`
+	// The Secret holding the connection details following the Service Binding
+	// specification, when Spec.EnableServiceBinding is set.
+	// +kubebuilder:validation:Optional
+	Binding *ServiceBinding `json:"binding,omitempty"`
 	// The current capacity of an Aurora Serverless v1 DB cluster. The capacity
 	// is 0 (zero) when the cluster is paused.
 	//
@@ -760,6 +840,9 @@ type DBClusterStatus struct {
 	// Time (UTC).
 	// +kubebuilder:validation:Optional
 	ClusterCreateTime *metav1.Time `json:"clusterCreateTime,omitempty"`
+	// A hash of the connection details last written to Spec.ConnectionSecret.
+	// +kubebuilder:validation:Optional
+	ConnectionSecretHash *string `json:"connectionSecretHash,omitempty"`
 	// Specifies whether the DB cluster is a clone of a DB cluster owned by a different
 	// Amazon Web Services account.
 	// +kubebuilder:validation:Optional
@@ -806,6 +889,11 @@ type DBClusterStatus struct {
 	// Specifies the connection endpoint for the primary instance of the DB cluster.
 	// +kubebuilder:validation:Optional
 	Endpoint *string `json:"endpoint,omitempty"`
+	// The identifier of the global database (GlobalCluster) the DB cluster is a
+	// member of. Removing Spec.GlobalClusterIdentifier detaches the DB cluster
+	// from this global database.
+	// +kubebuilder:validation:Optional
+	GlobalClusterMembership *string `json:"globalClusterMembership,omitempty"`
 	// Specifies whether you have requested to enable write forwarding for a secondary
 	// cluster in an Aurora global database. Because write forwarding takes time
 	// to enable, check the value of GlobalWriteForwardingStatus to confirm that
@@ -835,10 +923,42 @@ type DBClusterStatus struct {
 	// and Access Management (IAM) accounts to database accounts is enabled.
 	// +kubebuilder:validation:Optional
 	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
+	// The next time you can modify the DB cluster to use the aurora-iopt1 storage
+	// type.
+	//
+	// This setting is only for Aurora DB clusters.
+	// +kubebuilder:validation:Optional
+	IOOptimizedNextAllowedModificationTime *metav1.Time `json:"ioOptimizedNextAllowedModificationTime,omitempty"`
+	// Whether the member DBInstances of the DB cluster match Spec.Instances. The
+	// controller creates, updates and deletes them when they don't.
+	// +kubebuilder:validation:Optional
+	InstancesSynced *bool `json:"instancesSynced,omitempty"`
+	// The last backtrack the controller issued because of the backtrack-to
+	// annotation.
+	// +kubebuilder:validation:Optional
+	LastBacktrack *BacktrackRecord `json:"lastBacktrack,omitempty"`
+	// The last failover the controller issued because of the failover-db-cluster
+	// annotation.
+	// +kubebuilder:validation:Optional
+	LastFailover *DBClusterFailoverRecord `json:"lastFailover,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	// +kubebuilder:validation:Optional
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
+	// Indicates whether an Aurora DB cluster has in-cluster write forwarding enabled,
+	// not enabled, requested, or is in the process of enabling it.
+	// +kubebuilder:validation:Optional
+	LocalWriteForwardingStatus *string `json:"localWriteForwardingStatus,omitempty"`
+	// A hash of the value of the Secret referenced by Spec.MasterUserPassword
+	// last applied to the DB cluster. The DB cluster is modified when the value of the
+	// Secret changes.
+	// +kubebuilder:validation:Optional
+	MasterUserPasswordHash *string `json:"masterUserPasswordHash,omitempty"`
+	// The version of the Secrets Manager secret set in Spec.MasterUserPasswordSecretARN
+	// that Status.MasterUserPasswordHash was read from. The value of the secret
+	// is only read again when it has a new version.
+	// +kubebuilder:validation:Optional
+	MasterUserPasswordSecretVersionID *string `json:"masterUserPasswordSecretVersionID,omitempty"`
 	// Contains the secret managed by RDS in Amazon Web Services Secrets Manager
 	// for the master user password.
 	//
@@ -849,9 +969,17 @@ type DBClusterStatus struct {
 	// in the Amazon Aurora User Guide.
 	// +kubebuilder:validation:Optional
 	MasterUserSecret *MasterUserSecret `json:"masterUserSecret,omitempty"`
+	// The last time the master user password managed in Secrets Manager was
+	// rotated, as reported by DescribeSecret.
+	// +kubebuilder:validation:Optional
+	MasterUserSecretLastRotatedDate *metav1.Time `json:"masterUserSecretLastRotatedDate,omitempty"`
 	// Specifies whether the DB cluster has instances in multiple Availability Zones.
 	// +kubebuilder:validation:Optional
 	MultiAZ *bool `json:"multiAZ,omitempty"`
+	// The maintenance actions pending for the DB cluster, as reported by
+	// DescribePendingMaintenanceActions.
+	// +kubebuilder:validation:Optional
+	PendingMaintenanceActions []*PendingMaintenanceAction `json:"pendingMaintenanceActions,omitempty"`
 	// A value that specifies that changes to the DB cluster are pending. This element
 	// is only included when changes are pending. Specific changes are identified
 	// by subelements.
@@ -883,9 +1011,23 @@ type DBClusterStatus struct {
 	// then reconnect to the reader endpoint.
 	// +kubebuilder:validation:Optional
 	ReaderEndpoint *string `json:"readerEndpoint,omitempty"`
+	// The identifier of the latest DB cluster snapshot taken before a modification
+	// that is hard to roll back.
+	// +kubebuilder:validation:Optional
+	SafetySnapshotIdentifier *string `json:"safetySnapshotIdentifier,omitempty"`
+	// The current capacity, in Aurora capacity units (ACUs), of an Aurora
+	// Serverless v2 DB cluster, from its latest ServerlessDatabaseCapacity
+	// metric in Amazon CloudWatch. The capacity is 0 (zero) when the DB cluster
+	// scaled to zero.
+	// +kubebuilder:validation:Optional
+	ServerlessV2Capacity *float64 `json:"serverlessV2Capacity,omitempty"`
 	// Specifies the current state of this DB cluster.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
+	// The network types supported by the DB subnet group of the DB cluster, as
+	// reported by DescribeDBSubnetGroups.
+	// +kubebuilder:validation:Optional
+	SupportedNetworkTypes []*string `json:"supportedNetworkTypes,omitempty"`
 	// +kubebuilder:validation:Optional
 	TagList []*Tag `json:"tagList,omitempty"`
 	// Provides a list of VPC security groups that the DB cluster belongs to.
@@ -896,6 +1038,9 @@ type DBClusterStatus struct {
 // DBCluster is the Schema for the DBClusters API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ENDPOINT",type=string,priority=0,JSONPath=`.status.endpoint`
+// +kubebuilder:printcolumn:name="PORT",type=integer,priority=0,JSONPath=`.spec.port`
+// +kubebuilder:printcolumn:name="READER-ENDPOINT",type=string,priority=0,JSONPath=`.status.readerEndpoint`
 type DBCluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -31,6 +31,13 @@ type DBClusterParameterGroupSpec struct {
 	// The description for the DB cluster parameter group.
 	// +kubebuilder:validation:Required
 	Description *string `json:"description"`
+	// What the controller does about parameters modified outside of the
+	// controller: "Correct" (the default) overwrites them with their desired
+	// values, "Ignore" leaves them untouched until the desired parameters
+	// change and "Alert" also lists them in Status.DriftedParameters and sets
+	// a Drifted condition.
+	// +kubebuilder:validation:Enum=Correct;Ignore;Alert
+	DriftPolicy *string `json:"driftPolicy,omitempty"`
 	// The DB cluster parameter group family name. A DB cluster parameter group
 	// can be associated with one and only one DB cluster parameter group family,
 	// and can be applied only to a DB cluster running a database engine and engine
@@ -80,6 +87,12 @@ type DBClusterParameterGroupSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	Family *string `json:"family"`
+	// The name of the DB parameter group used together with the DB cluster parameter group
+	// (for instance by the instances of an Aurora DB cluster). When set,
+	// parameters set in both groups are checked for conflicting values, which
+	// are reported in an ACK.Advisory condition.
+	InstanceParameterGroupName *string                                  `json:"instanceParameterGroupName,omitempty"`
+	InstanceParameterGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"instanceParameterGroupRef,omitempty"`
 	// The name of the DB cluster parameter group.
 	//
 	// Constraints:
@@ -90,6 +103,11 @@ type DBClusterParameterGroupSpec struct {
 	// +kubebuilder:validation:Required
 	Name               *string            `json:"name"`
 	ParameterOverrides map[string]*string `json:"parameterOverrides,omitempty"`
+	// The name of a curated set of parameters ("pgaudit-logging",
+	// "mysql-performance" or "strict-tls") to apply to the DB cluster parameter group
+	// for its engine family. ParameterOverrides take precedence over the
+	// parameters of the profile.
+	ParameterProfile *string `json:"parameterProfile,omitempty"`
 	// A list of parameters in the DB cluster parameter group to modify.
 	//
 	// Valid Values (for the application method): immediate | pending-reboot
@@ -120,9 +138,26 @@ type DBClusterParameterGroupStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The parameters the controller last applied to the DB cluster parameter group. Used to
+	// detect parameters modified outside of the controller.
+	// +kubebuilder:validation:Optional
+	AppliedParameterOverrides map[string]*string `json:"appliedParameterOverrides,omitempty"`
+	// The parameters that were modified outside of the controller, when the
+	// drift policy is "Alert".
+	// +kubebuilder:validation:Optional
+	DriftedParameters []*string `json:"driftedParameters,omitempty"`
+	// The most recent changes (up to 10) the controller made to the parameters
+	// of the DB cluster parameter group, oldest first.
+	// +kubebuilder:validation:Optional
+	ParameterApplyHistory []*ParameterApplyRecord `json:"parameterApplyHistory,omitempty"`
 	// Provides a list of parameters for the DB cluster parameter group.
 	// +kubebuilder:validation:Optional
 	ParameterOverrideStatuses []*Parameter `json:"parameterOverrideStatuses,omitempty"`
+	// The names of the parameters of the DB cluster parameter group whose value is not the
+	// engine default, keyed by the source of their value ("user" or "system").
+	// The other parameters have their engine default value.
+	// +kubebuilder:validation:Optional
+	ParameterSources map[string][]*string `json:"parameterSources,omitempty"`
 }
 
 // DBClusterParameterGroup is the Schema for the DBClusterParameterGroups API
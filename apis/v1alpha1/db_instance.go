@@ -30,6 +30,10 @@ import (
 // RestoreDBInstanceToPointInTime, StartDBInstance, and StopDBInstance.
 type DBInstanceSpec struct {
 
+	// The Database Activity Stream of the DB instance, started and stopped through
+	// StartActivityStream and StopActivityStream. When omitted, the activity
+	// stream is left in whatever state it is in.
+	ActivityStream *ActivityStream `json:"activityStream,omitempty"`
 	// The amount of storage in gibibytes (GiB) to allocate for the DB instance.
 	//
 	// Type: Integer
@@ -110,6 +114,11 @@ type DBInstanceSpec struct {
 	//     be an integer from 20 to 1024. Web and Express editions: Must be an integer
 	//     from 20 to 1024.
 	AllocatedStorage *int64 `json:"allocatedStorage,omitempty"`
+	// The replication of the automated backups of the DB instance to another
+	// region, started and stopped through StartDBInstanceAutomatedBackupsReplication
+	// and StopDBInstanceAutomatedBackupsReplication. When omitted, the replication
+	// is left in whatever state it is in.
+	AutomatedBackupsReplication *AutomatedBackupsReplication `json:"automatedBackupsReplication,omitempty"`
 	// A value that indicates whether minor engine upgrades are applied automatically
 	// to the DB instance during the maintenance window. By default, minor engine
 	// upgrades are applied automatically.
@@ -164,6 +173,10 @@ type DBInstanceSpec struct {
 	// Outposts (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/rds-on-outposts.html)
 	// in the Amazon RDS User Guide.
 	BackupTarget *string `json:"backupTarget,omitempty"`
+	// The name of the ConfigMap, in the namespace of the DB instance, the RDS
+	// certificate bundle of the region of the DB instance is maintained in, under
+	// the ca.crt key, so that applications can verify the TLS connections to it.
+	CABundleConfigMap *string `json:"caBundleConfigMap,omitempty"`
 	// Specifies the CA certificate identifier to use for the DB instance’s server
 	// certificate.
 	//
@@ -186,6 +199,8 @@ type DBInstanceSpec struct {
 	// Not applicable. The character set is managed by the DB cluster. For more
 	// information, see CreateDBCluster.
 	CharacterSetName *string `json:"characterSetName,omitempty"`
+	// The Kubernetes Secret the connection details of the DB instance are written to.
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
 	// A value that indicates whether to copy tags from the DB instance to snapshots
 	// of the DB instance. By default, tags are not copied.
 	//
@@ -244,8 +259,7 @@ type DBInstanceSpec struct {
 	// availability for your engine, see DB instance classes (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.DBInstanceClass.html)
 	// in the Amazon RDS User Guide or Aurora DB instance classes (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Concepts.DBInstanceClass.html)
 	// in the Amazon Aurora User Guide.
-	// +kubebuilder:validation:Required
-	DBInstanceClass *string `json:"dbInstanceClass"`
+	DBInstanceClass *string `json:"dbInstanceClass,omitempty"`
 	// The DB instance identifier. This parameter is stored as a lowercase string.
 	//
 	// Constraints:
@@ -257,8 +271,7 @@ type DBInstanceSpec struct {
 	//   - Can't end with a hyphen or contain two consecutive hyphens.
 	//
 	// Example: mydbinstance
-	// +kubebuilder:validation:Required
-	DBInstanceIdentifier *string `json:"dbInstanceIdentifier"`
+	DBInstanceIdentifier *string `json:"dbInstanceIdentifier,omitempty"`
 	// The meaning of this parameter differs according to the database engine you
 	// use.
 	//
@@ -404,6 +417,22 @@ type DBInstanceSpec struct {
 	// Example: mydbsubnetgroup
 	DBSubnetGroupName *string                                  `json:"dbSubnetGroupName,omitempty"`
 	DBSubnetGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"dbSubnetGroupRef,omitempty"`
+	// The Oracle system identifier (SID), which is the name of the Oracle database
+	// instance that manages your database files. In this context, the term "Oracle
+	// database instance" refers exclusively to the system global area (SGA) and
+	// Oracle background processes. If you don't specify a SID, the value defaults
+	// to RDSCDB. The Oracle SID is also the name of your CDB.
+	DBSystemID *string `json:"dbSystemID,omitempty"`
+	// Indicates whether the DB instance has a dedicated log volume (DLV) enabled.
+	DedicatedLogVolume *bool `json:"dedicatedLogVolume,omitempty"`
+	// How the DB instance is deleted when the resource is deleted, either
+	// "Delete" to delete it without a final snapshot or "Snapshot" to take a
+	// final DB snapshot before deleting it. Defaults to "Delete". DB instances of
+	// a DB cluster and read replicas are deleted without a final snapshot. Set
+	// the services.k8s.aws/deletion-policy annotation to "retain" to leave the
+	// DB instance running instead.
+	// +kubebuilder:validation:Enum=Delete;Snapshot
+	DeletionPolicy *string `json:"deletionPolicy,omitempty"`
 	// A value that indicates whether the DB instance has deletion protection enabled.
 	// The database can't be deleted when deletion protection is enabled. By default,
 	// deletion protection isn't enabled. For more information, see Deleting a DB
@@ -416,6 +445,10 @@ type DBInstanceSpec struct {
 	// cluster can be deleted even when deletion protection is enabled for the DB
 	// cluster.
 	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+	// The state the DB instance should be in, either "available" or "stopped".
+	// The controller stops or starts the DB instance accordingly. When
+	// omitted, the DB instance is left in whatever state it is in.
+	DesiredState *string `json:"desiredState,omitempty"`
 	// DestinationRegion is used for presigning the request to a given region.
 	DestinationRegion *string `json:"destinationRegion,omitempty"`
 	// The Active Directory directory ID to create the DB instance in. Currently,
@@ -431,6 +464,30 @@ type DBInstanceSpec struct {
 	//
 	// Not applicable. The domain is managed by the DB cluster.
 	Domain *string `json:"domain,omitempty"`
+	// The ARN for the Secrets Manager secret with the credentials for the user
+	// joining the domain.
+	//
+	// Example: arn:aws:secretsmanager:region:account-number:secret:myselfmanagedADtestsecret-123456
+	DomainAuthSecretARN *string `json:"domainAuthSecretARN,omitempty"`
+	// The IPv4 DNS IP addresses of your primary and secondary Active Directory
+	// domain controllers.
+	//
+	// Constraints:
+	//
+	//   - Two IP addresses must be provided. If there isn't a secondary domain
+	//     controller, use the IP address of the primary domain controller for both
+	//     entries in the list.
+	//
+	// Example: 123.124.125.126,234.235.236.237
+	DomainDNSIPs []*string `json:"domainDNSIPs,omitempty"`
+	// The fully qualified domain name (FQDN) of an Active Directory domain.
+	//
+	// Constraints:
+	//
+	//   - Can't be longer than 64 characters.
+	//
+	// Example: mymanagedADtest.mymanagedAD.mydomain
+	DomainFqdn *string `json:"domainFqdn,omitempty"`
 	// Specify the name of the IAM role to be used when making API calls to the
 	// Directory Service.
 	//
@@ -440,6 +497,16 @@ type DBInstanceSpec struct {
 	//
 	// Not applicable. The domain is managed by the DB cluster.
 	DomainIAMRoleName *string `json:"domainIAMRoleName,omitempty"`
+	// The Active Directory organizational unit for your DB instance to join.
+	//
+	// Constraints:
+	//
+	//   - Must be in the distinguished name format.
+	//
+	//   - Can't be longer than 64 characters.
+	//
+	// Example: OU=mymanagedADtestOU,DC=mymanagedADtest,DC=mymanagedAD,DC=mydomain
+	DomainOu *string `json:"domainOu,omitempty"`
 	// The list of log types that need to be enabled for exporting to CloudWatch
 	// Logs. The values in the list depend on the DB engine. For more information,
 	// see Publishing Database Logs to Amazon CloudWatch Logs (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_LogAccess.html#USER_LogAccess.Procedural.UploadtoCloudWatch)
@@ -503,6 +570,12 @@ type DBInstanceSpec struct {
 	// Not applicable. Mapping Amazon Web Services IAM accounts to database accounts
 	// is managed by the DB cluster.
 	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
+	// Whether the controller writes the connection details of the DB instance to a
+	// Secret following the Service Binding specification, referred to by
+	// Status.Binding. The Secret is deleted along with the DB instance.
+	EnableServiceBinding *bool `json:"enableServiceBinding,omitempty"`
+	// The Kubernetes ExternalName Service pointed at the endpoint of the DB instance.
+	EndpointService *EndpointService `json:"endpointService,omitempty"`
 	// The name of the database engine to be used for this instance.
 	//
 	// Not every database engine is available for every Amazon Web Services Region.
@@ -545,8 +618,7 @@ type DBInstanceSpec struct {
 	//
 	//   - sqlserver-web
 	//
-	// +kubebuilder:validation:Required
-	Engine *string `json:"engine"`
+	Engine *string `json:"engine,omitempty"`
 	// The version number of the database engine to use.
 	//
 	// For a list of valid engine versions, use the DescribeDBEngineVersions operation.
@@ -598,6 +670,12 @@ type DBInstanceSpec struct {
 	// For information, see Amazon RDS for PostgreSQL versions and extensions (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/CHAP_PostgreSQL.html#PostgreSQL.Concepts)
 	// in the Amazon RDS User Guide.
 	EngineVersion *string `json:"engineVersion,omitempty"`
+	// The template of the identifier of the final DB snapshot taken when
+	// DeletionPolicy is "Snapshot". The {name}, {namespace}, {identifier} and
+	// {timestamp} placeholders are replaced with the name and namespace of the
+	// resource, the DB instance identifier and the UTC time of the deletion (formatted
+	// as YYYYMMDDhhmmss). Defaults to "{identifier}-final-{timestamp}".
+	FinalSnapshotIdentifierTemplate *string `json:"finalSnapshotIdentifierTemplate,omitempty"`
 	// The amount of Provisioned IOPS (input/output operations per second) to be
 	// initially allocated for the DB instance. For information about valid IOPS
 	// values, see Amazon RDS DB instance storage (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/CHAP_Storage.html)
@@ -687,6 +765,18 @@ type DBInstanceSpec struct {
 	//
 	// Constraints: Must contain from 8 to 128 characters.
 	MasterUserPassword *ackv1alpha1.SecretKeyReference `json:"masterUserPassword,omitempty"`
+	// The ARN of an existing Amazon Web Services Secrets Manager secret holding
+	// the master user password, either as its value or under the password key of
+	// its JSON value. The controller reads the secret when it creates or modifies
+	// the database and needs the secretsmanager:GetSecretValue permission on it.
+	// Cannot be set along with MasterUserPassword or ManageMasterUserPassword.
+	MasterUserPasswordSecretARN *string `json:"masterUserPasswordSecretARN,omitempty"`
+	// The key of the Kubernetes Secret, in the namespace of the resource, the
+	// master user password is copied to when it is managed in Amazon Web Services
+	// Secrets Manager, so that applications can read it. The Secret must exist.
+	// The controller needs the secretsmanager:GetSecretValue permission on the
+	// secret managed by RDS.
+	MasterUserSecretDestination *MasterUserSecretDestination `json:"masterUserSecretDestination,omitempty"`
 	// The Amazon Web Services KMS key identifier to encrypt a secret that is automatically
 	// generated and managed in Amazon Web Services Secrets Manager.
 	//
@@ -707,6 +797,11 @@ type DBInstanceSpec struct {
 	// Services Region.
 	MasterUserSecretKMSKeyID  *string                                  `json:"masterUserSecretKMSKeyID,omitempty"`
 	MasterUserSecretKMSKeyRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"masterUserSecretKMSKeyRef,omitempty"`
+	// The rotation schedule of the master user password when it is managed in
+	// Amazon Web Services Secrets Manager. The controller needs the
+	// secretsmanager:DescribeSecret and secretsmanager:RotateSecret permissions
+	// on the secret managed by RDS.
+	MasterUserSecretRotation *MasterUserSecretRotation `json:"masterUserSecretRotation,omitempty"`
 	// The name for the master user.
 	//
 	// # Amazon Aurora
@@ -761,6 +856,9 @@ type DBInstanceSpec struct {
 	//
 	// This setting doesn't apply to RDS Custom.
 	MonitoringRoleARN *string `json:"monitoringRoleARN,omitempty"`
+	// When the controller applies the modifications of the DB instance, per
+	// category of modifications.
+	ModificationTiming *ModificationTiming `json:"modificationTiming,omitempty"`
 	// A value that indicates whether the DB instance is a Multi-AZ deployment.
 	// You can't set the AvailabilityZone parameter if the DB instance is a Multi-AZ
 	// deployment.
@@ -803,7 +901,8 @@ type DBInstanceSpec struct {
 	// # Amazon Aurora
 	//
 	// Not applicable.
-	OptionGroupName *string `json:"optionGroupName,omitempty"`
+	OptionGroupName *string                                  `json:"optionGroupName,omitempty"`
+	OptionGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"optionGroupRef,omitempty"`
 	// A value that indicates whether to enable Performance Insights for the DB
 	// instance. For more information, see Using Amazon Performance Insights (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_PerfInsights.html)
 	// in the Amazon RDS User Guide.
@@ -1067,6 +1166,19 @@ type DBInstanceSpec struct {
 	// value won't be set by default. After replica creation, you can manage the
 	// open mode manually.
 	ReplicaMode *string `json:"replicaMode,omitempty"`
+	// The DB instance and point in time the DB instance is restored from when
+	// it is created.
+	RestoreToPointInTime *RestoreToPointInTime `json:"restoreToPointInTime,omitempty"`
+	// The Amazon S3 backup the DB instance is restored from when it is
+	// created.
+	S3Restore *S3Restore `json:"s3Restore,omitempty"`
+	// Whether a manual DB snapshot is taken before modifications that are hard
+	// to roll back, namely major engine version upgrades and storage type changes.
+	// The modification is issued once the snapshot is available.
+	SafetySnapshot *bool `json:"safetySnapshot,omitempty"`
+	// When the controller stops and starts the DB instance. Ignored when
+	// DesiredState is set.
+	Schedule *StopStartSchedule `json:"schedule,omitempty"`
 	// The identifier of the DB instance that will act as the source for the read
 	// replica. Each DB instance can have up to 15 read replicas, with the exception
 	// of Oracle and SQL Server, which can have up to five.
@@ -1098,7 +1210,8 @@ type DBInstanceSpec struct {
 	//     see Constructing an ARN for Amazon RDS (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.ARN.html#USER_Tagging.ARN.Constructing)
 	//     in the Amazon RDS User Guide. This doesn't apply to SQL Server or RDS
 	//     Custom, which don't support cross-Region replicas.
-	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+	SourceDBInstanceIdentifier *string                                  `json:"sourceDBInstanceIdentifier,omitempty"`
+	SourceDBInstanceRef        *ackv1alpha1.AWSResourceReferenceWrapper `json:"sourceDBInstanceRef,omitempty"`
 	// SourceRegion is the source region where the resource exists. This is not
 	// sent over the wire and is only used for presigning. This value should always
 	// have the same region as the source ARN.
@@ -1207,6 +1320,18 @@ type DBInstanceStatus struct {
 	// with the DB instance.
 	// +kubebuilder:validation:Optional
 	AssociatedRoles []*DBInstanceRole `json:"associatedRoles,omitempty"`
+	// The ARN of the automated backups replicated to the destination region of
+	// Spec.AutomatedBackupsReplication.
+	// +kubebuilder:validation:Optional
+	AutomatedBackupsReplicationARN *string `json:"automatedBackupsReplicationARN,omitempty"`
+	// The retention period in days of the automated backups replicated to the
+	// destination region of Spec.AutomatedBackupsReplication.
+	// +kubebuilder:validation:Optional
+	AutomatedBackupsReplicationRetentionPeriod *int64 `json:"automatedBackupsReplicationRetentionPeriod,omitempty"`
+	// The status of the automated backups replicated to the destination region
+	// of Spec.AutomatedBackupsReplication.
+	// +kubebuilder:validation:Optional
+	AutomatedBackupsReplicationStatus *string `json:"automatedBackupsReplicationStatus,omitempty"`
 	// The time when a stopped DB instance is restarted automatically.
 	// +kubebuilder:validation:Optional
 	AutomaticRestartTime *metav1.Time `json:"automaticRestartTime,omitempty"`
@@ -1219,9 +1344,21 @@ type DBInstanceStatus struct {
 	// Backup.
 	// +kubebuilder:validation:Optional
 	AWSBackupRecoveryPointARN *string `json:"awsBackupRecoveryPointARN,omitempty"`
+	// The Secret holding the connection details following the Service Binding
+	// specification, when Spec.EnableServiceBinding is set.
+	// +kubebuilder:validation:Optional
+	Binding *ServiceBinding `json:"binding,omitempty"`
 	// The details of the DB instance's server certificate.
 	// +kubebuilder:validation:Optional
 	CertificateDetails *CertificateDetails `json:"certificateDetails,omitempty"`
+	// A hash of the connection details last written to Spec.ConnectionSecret.
+	// +kubebuilder:validation:Optional
+	ConnectionSecretHash *string `json:"connectionSecretHash,omitempty"`
+	// The storage, in gibibytes, currently allocated to the DB instance. It can
+	// be larger than Spec.AllocatedStorage when storage autoscaling is enabled
+	// through Spec.MaxAllocatedStorage.
+	// +kubebuilder:validation:Optional
+	CurrentAllocatedStorage *int64 `json:"currentAllocatedStorage,omitempty"`
 	// Specifies whether a customer-owned IP address (CoIP) is enabled for an RDS
 	// on Outposts DB instance.
 	//
@@ -1255,10 +1392,6 @@ type DBInstanceStatus struct {
 	// including the name, description, and subnets in the subnet group.
 	// +kubebuilder:validation:Optional
 	DBSubnetGroup *DBSubnetGroup_SDK `json:"dbSubnetGroup,omitempty"`
-	// The Oracle system ID (Oracle SID) for a container database (CDB). The Oracle
-	// SID is also the name of the CDB. This setting is valid for RDS Custom only.
-	// +kubebuilder:validation:Optional
-	DBSystemID *string `json:"dbSystemID,omitempty"`
 	// Specifies the port that the DB instance listens on. If the DB instance is
 	// part of a DB cluster, this can be a different port than the DB cluster port.
 	// +kubebuilder:validation:Optional
@@ -1304,6 +1437,10 @@ type DBInstanceStatus struct {
 	// Provides the date and time the DB instance was created.
 	// +kubebuilder:validation:Optional
 	InstanceCreateTime *metav1.Time `json:"instanceCreateTime,omitempty"`
+	// The last reboot the controller issued because of the reboot or
+	// reboot-with-failover annotations.
+	// +kubebuilder:validation:Optional
+	LastReboot *RebootRecord `json:"lastReboot,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	// +kubebuilder:validation:Optional
@@ -1311,6 +1448,16 @@ type DBInstanceStatus struct {
 	// Specifies the listener connection endpoint for SQL Server Always On.
 	// +kubebuilder:validation:Optional
 	ListenerEndpoint *Endpoint `json:"listenerEndpoint,omitempty"`
+	// A hash of the value of the Secret referenced by Spec.MasterUserPassword
+	// last applied to the DB instance. The DB instance is modified when the value of the
+	// Secret changes.
+	// +kubebuilder:validation:Optional
+	MasterUserPasswordHash *string `json:"masterUserPasswordHash,omitempty"`
+	// The version of the Secrets Manager secret set in Spec.MasterUserPasswordSecretARN
+	// that Status.MasterUserPasswordHash was read from. The value of the secret
+	// is only read again when it has a new version.
+	// +kubebuilder:validation:Optional
+	MasterUserPasswordSecretVersionID *string `json:"masterUserPasswordSecretVersionID,omitempty"`
 	// Contains the secret managed by RDS in Amazon Web Services Secrets Manager
 	// for the master user password.
 	//
@@ -1319,9 +1466,17 @@ type DBInstanceStatus struct {
 	// in the Amazon RDS User Guide.
 	// +kubebuilder:validation:Optional
 	MasterUserSecret *MasterUserSecret `json:"masterUserSecret,omitempty"`
+	// The last time the master user password managed in Secrets Manager was
+	// rotated, as reported by DescribeSecret.
+	// +kubebuilder:validation:Optional
+	MasterUserSecretLastRotatedDate *metav1.Time `json:"masterUserSecretLastRotatedDate,omitempty"`
 	// Provides the list of option group memberships for this DB instance.
 	// +kubebuilder:validation:Optional
 	OptionGroupMemberships []*OptionGroupMembership `json:"optionGroupMemberships,omitempty"`
+	// The maintenance actions pending for the DB instance, as reported by
+	// DescribePendingMaintenanceActions.
+	// +kubebuilder:validation:Optional
+	PendingMaintenanceActions []*PendingMaintenanceAction `json:"pendingMaintenanceActions,omitempty"`
 	// A value that specifies that changes to the DB instance are pending. This
 	// element is only included when changes are pending. Specific changes are identified
 	// by subelements.
@@ -1353,6 +1508,10 @@ type DBInstanceStatus struct {
 	// maximum value is 1,440.
 	// +kubebuilder:validation:Optional
 	ResumeFullAutomationModeTime *metav1.Time `json:"resumeFullAutomationModeTime,omitempty"`
+	// The identifier of the latest DB snapshot taken before a modification that
+	// is hard to roll back.
+	// +kubebuilder:validation:Optional
+	SafetySnapshotIdentifier *string `json:"safetySnapshotIdentifier,omitempty"`
 	// If present, specifies the name of the secondary Availability Zone for a DB
 	// instance with multi-AZ support.
 	// +kubebuilder:validation:Optional
@@ -28,9 +28,35 @@ import (
 // action.
 type DBParameterGroupSpec struct {
 
+	// The name of a DB parameter group whose user-defined parameters the
+	// ParameterOverrides are layered on top of. Changes to a base parameter
+	// group managed by a DBParameterGroup resource in the same namespace are
+	// picked up as soon as that resource is synced, changes to other base
+	// parameter groups at the next resync.
+	BaseParameterGroupName *string                                  `json:"baseParameterGroupName,omitempty"`
+	BaseParameterGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"baseParameterGroupRef,omitempty"`
+	// The name of the DB cluster parameter group used together with the DB parameter group
+	// (for instance by the instances of an Aurora DB cluster). When set,
+	// parameters set in both groups are checked for conflicting values, which
+	// are reported in an ACK.Advisory condition.
+	ClusterParameterGroupName *string                                  `json:"clusterParameterGroupName,omitempty"`
+	ClusterParameterGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"clusterParameterGroupRef,omitempty"`
 	// The description for the DB parameter group.
 	// +kubebuilder:validation:Required
 	Description *string `json:"description"`
+	// What the controller does about parameters modified outside of the
+	// controller: "Correct" (the default) overwrites them with their desired
+	// values, "Ignore" leaves them untouched until the desired parameters
+	// change and "Alert" also lists them in Status.DriftedParameters and sets
+	// a Drifted condition.
+	// +kubebuilder:validation:Enum=Correct;Ignore;Alert
+	DriftPolicy *string `json:"driftPolicy,omitempty"`
+	// The name of the database engine (for instance "postgres" or
+	// "aurora-mysql") used to derive Family when it is omitted.
+	Engine *string `json:"engine,omitempty"`
+	// The version of the database engine used to derive Family when it is
+	// omitted. When omitted, the default version of Engine is used.
+	EngineVersion *string `json:"engineVersion,omitempty"`
 	// The DB parameter group family name. A DB parameter group can be associated
 	// with one and only one DB parameter group family, and can be applied only
 	// to a DB instance running a database engine and engine version compatible
@@ -80,8 +106,8 @@ type DBParameterGroupSpec struct {
 	//
 	//   - sqlserver-web
 	//
-	// +kubebuilder:validation:Required
-	Family *string `json:"family"`
+	// When omitted, the family is derived from Engine and EngineVersion.
+	Family *string `json:"family,omitempty"`
 	// The name of the DB parameter group.
 	//
 	// Constraints:
@@ -96,6 +122,17 @@ type DBParameterGroupSpec struct {
 	// +kubebuilder:validation:Required
 	Name               *string            `json:"name"`
 	ParameterOverrides map[string]*string `json:"parameterOverrides,omitempty"`
+	// The name of a curated set of parameters ("pgaudit-logging",
+	// "mysql-performance" or "strict-tls") to apply to the DB parameter group
+	// for its engine family. ParameterOverrides take precedence over the
+	// parameters of the profile.
+	ParameterProfile *string `json:"parameterProfile,omitempty"`
+	// The name or ARN of an existing DB parameter group to copy the parameters
+	// of when the DB parameter group is created. ParameterOverrides are applied
+	// on top of the copied parameters, which are kept in
+	// Status.SourceParameterOverrides. Only used when the DB parameter group is
+	// created.
+	SourceParameterGroupName *string `json:"sourceParameterGroupName,omitempty"`
 	// Tags to assign to the DB parameter group.
 	Tags []*Tag `json:"tags,omitempty"`
 }
@@ -113,9 +150,35 @@ type DBParameterGroupStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The user-defined parameters of the base parameter group that the parameter
+	// overrides are layered on top of.
+	// +kubebuilder:validation:Optional
+	BaseParameterOverrides map[string]*string `json:"baseParameterOverrides,omitempty"`
+	// The parameters the controller last applied to the DB parameter group. Used to
+	// detect parameters modified outside of the controller.
+	// +kubebuilder:validation:Optional
+	AppliedParameterOverrides map[string]*string `json:"appliedParameterOverrides,omitempty"`
+	// The parameters that were modified outside of the controller, when the
+	// drift policy is "Alert".
+	// +kubebuilder:validation:Optional
+	DriftedParameters []*string `json:"driftedParameters,omitempty"`
+	// The most recent changes (up to 10) the controller made to the parameters
+	// of the DB parameter group, oldest first.
+	// +kubebuilder:validation:Optional
+	ParameterApplyHistory []*ParameterApplyRecord `json:"parameterApplyHistory,omitempty"`
 	// A list of Parameter values.
 	// +kubebuilder:validation:Optional
 	ParameterOverrideStatuses []*Parameter `json:"parameterOverrideStatuses,omitempty"`
+	// The names of the parameters of the DB parameter group whose value is not the
+	// engine default, keyed by the source of their value ("user" or "system").
+	// The other parameters have their engine default value.
+	// +kubebuilder:validation:Optional
+	ParameterSources map[string][]*string `json:"parameterSources,omitempty"`
+	// The user-defined parameters copied from the source parameter group when
+	// the DB parameter group was created. The parameter overrides are layered
+	// on top of them.
+	// +kubebuilder:validation:Optional
+	SourceParameterOverrides map[string]*string `json:"sourceParameterOverrides,omitempty"`
 }
 
 // DBParameterGroup is the Schema for the DBParameterGroups API
@@ -28,8 +28,19 @@ import (
 type DBProxySpec struct {
 
 	// The authorization mechanism that the proxy uses.
-	// +kubebuilder:validation:Required
-	Auth []*UserAuthConfig `json:"auth"`
+	Auth []*UserAuthConfig `json:"auth,omitempty"`
+	// Database users the proxy authenticates as with passwords held by Kubernetes
+	// Secrets. The controller copies them to Secrets Manager secrets named
+	// rds-proxy/<proxy name>/<user name> and tagged rds.services.k8s.aws/db-proxy
+	// with the name of the proxy, and adds the secrets to the authorization
+	// mechanism of the proxy, along with the entries of Auth. An existing secret
+	// with the same name that is not tagged for the proxy is never overwritten. The
+	// secrets of the removed users are deleted with a recovery window of 7 days.
+	// The controller needs the secretsmanager:CreateSecret,
+	// secretsmanager:TagResource, secretsmanager:PutSecretValue,
+	// secretsmanager:DescribeSecret, secretsmanager:RestoreSecret and
+	// secretsmanager:DeleteSecret permissions on the rds-proxy/* secrets.
+	AuthSecrets []*DBProxyAuthSecret `json:"authSecrets,omitempty"`
 	// Whether the proxy includes detailed information about SQL statements in its
 	// logs. This information helps you to debug issues involving SQL behavior or
 	// the performance and scalability of the proxy connections. The debug information
@@ -69,10 +80,11 @@ type DBProxySpec struct {
 	// with the proxy.
 	Tags []*Tag `json:"tags,omitempty"`
 	// One or more VPC security group IDs to associate with the new proxy.
-	VPCSecurityGroupIDs []*string `json:"vpcSecurityGroupIDs,omitempty"`
+	VPCSecurityGroupIDs  []*string                                  `json:"vpcSecurityGroupIDs,omitempty"`
+	VPCSecurityGroupRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"vpcSecurityGroupRefs,omitempty"`
 	// One or more VPC subnet IDs to associate with the new proxy.
-	// +kubebuilder:validation:Required
-	VPCSubnetIDs []*string `json:"vpcSubnetIDs"`
+	VPCSubnetIDs  []*string                                  `json:"vpcSubnetIDs,omitempty"`
+	VPCSubnetRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"vpcSubnetRefs,omitempty"`
 }
 
 // DBProxyStatus defines the observed state of DBProxy
@@ -95,6 +107,9 @@ type DBProxyStatus struct {
 	// endpoint value in the connection string for a database client application.
 	// +kubebuilder:validation:Optional
 	Endpoint *string `json:"endpoint,omitempty"`
+	// The Secrets Manager secrets the controller manages for Spec.AuthSecrets.
+	// +kubebuilder:validation:Optional
+	ManagedAuthSecrets []*DBProxyAuthSecretStatus `json:"managedAuthSecrets,omitempty"`
 	// The current status of this proxy. A status of available means the proxy is
 	// ready to handle requests. Other values indicate that you must wait for the
 	// proxy to be ready, or take some action to resolve an issue.
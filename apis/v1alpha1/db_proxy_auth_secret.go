@@ -0,0 +1,56 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
+
+// DBProxyAuthSecret describes a database user a DB proxy authenticates as with
+// a password held by a Kubernetes Secret. The controller copies the user name
+// and password to a Secrets Manager secret named rds-proxy/<proxy name>/<user
+// name>, which the proxy reads, and adds the secret to the authentication
+// settings of the proxy. The Secrets Manager secret is updated when the
+// Kubernetes Secret changes and deleted along with the proxy or the entry.
+type DBProxyAuthSecret struct {
+	// The name of the database user.
+	// +kubebuilder:validation:Required
+	UserName *string `json:"userName"`
+	// The Kubernetes Secret key holding the password of the database user.
+	// +kubebuilder:validation:Required
+	Password *ackv1alpha1.SecretKeyReference `json:"password"`
+	// The ID of the KMS key Secrets Manager encrypts the secret with. Defaults
+	// to the aws/secretsmanager key. The IAM role of the proxy needs the
+	// kms:Decrypt permission on the key.
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	// The type of authentication the proxy uses for connections from clients,
+	// like MYSQL_NATIVE_PASSWORD or POSTGRES_SCRAM_SHA_256.
+	ClientPasswordAuthType *string `json:"clientPasswordAuthType,omitempty"`
+	// A description of the authentication settings, shown by RDS.
+	Description *string `json:"description,omitempty"`
+	// Whether the proxy requires or disallows IAM authentication for the
+	// database user: REQUIRED, ENABLED or DISABLED.
+	IAMAuth *string `json:"iamAuth,omitempty"`
+}
+
+// DBProxyAuthSecretStatus describes the Secrets Manager secret the controller
+// manages for a DBProxyAuthSecret.
+type DBProxyAuthSecretStatus struct {
+	// The name of the database user.
+	UserName *string `json:"userName,omitempty"`
+	// The ARN of the Secrets Manager secret holding the password.
+	SecretARN *string `json:"secretARN,omitempty"`
+	// A hash of the password last copied to the Secrets Manager secret.
+	PasswordHash *string `json:"passwordHash,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// EndpointService describes the Kubernetes ExternalName Service the controller
+// points at the endpoint of a DB instance or DB cluster, so that applications
+// in the Kubernetes cluster get a stable DNS name decoupled from the AWS
+// hostname. For a DB cluster, the controller also points a second Service,
+// named after the first one with a "-reader" suffix, at its reader endpoint.
+// The Services are created in the namespace of the DB instance or DB cluster
+// and deleted along with it.
+type EndpointService struct {
+	// The name of the Service. Defaults to the name of the DB instance or DB
+	// cluster.
+	Name *string `json:"name,omitempty"`
+}
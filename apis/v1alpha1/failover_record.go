@@ -0,0 +1,45 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FailoverRecord describes a failover or switchover of an Aurora global
+// database the rds-controller issued because of the FailoverGlobalClusterAnnotation
+// or SwitchoverGlobalClusterAnnotation annotations.
+type FailoverRecord struct {
+	// The Amazon Resource Name (ARN) of the secondary DB cluster promoted to
+	// the primary cluster.
+	TargetDBClusterARN *string `json:"targetDBClusterARN,omitempty"`
+	// Whether the global database was switched over, without data loss,
+	// rather than failed over.
+	Switchover *bool `json:"switchover,omitempty"`
+	// The time at which the failover or switchover was issued.
+	FailedOverAt *metav1.Time `json:"failedOverAt,omitempty"`
+}
+
+// DBClusterFailoverRecord describes a failover of a DB cluster the
+// rds-controller issued because of the FailoverDBClusterAnnotation annotation.
+type DBClusterFailoverRecord struct {
+	// The identifier of the writer DB instance of the DB cluster when the
+	// failover was issued.
+	FromDBInstanceIdentifier *string `json:"fromDBInstanceIdentifier,omitempty"`
+	// The identifier of the reader DB instance promoted to the writer, unset
+	// when Amazon RDS picks it.
+	TargetDBInstanceIdentifier *string `json:"targetDBInstanceIdentifier,omitempty"`
+	// The time at which the failover was issued.
+	FailedOverAt *metav1.Time `json:"failedOverAt,omitempty"`
+}
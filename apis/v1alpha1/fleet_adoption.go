@@ -0,0 +1,114 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FleetAdoptionLabel is the label the controller sets on the AdoptedResources
+// it creates for a FleetAdoption, and on the DBInstance and DBCluster
+// resources they adopt, holding the name of the FleetAdoption.
+const FleetAdoptionLabel = "rds.services.k8s.aws/fleet-adoption"
+
+// FleetAdoptionSpec defines the desired state of FleetAdoption.
+//
+// A FleetAdoption onboards the existing DB instances and DB clusters of the
+// AWS account and region of its target namespace: it periodically lists the
+// ones whose tags match its tag filters and creates an AdoptedResource in its
+// target namespace for each of them, which in turn creates the DBInstance or
+// DBCluster resource managing it. The DB instances and DB clusters already
+// managed by an ACK controller, whose tags hold the Kubernetes namespace of
+// their resource, are skipped. Deleting the FleetAdoption keeps the resources
+// it adopted.
+type FleetAdoptionSpec struct {
+	// How often the DB instances and DB clusters are listed, for instance "1h".
+	// Must be at least one minute. Defaults to one hour.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// The kinds of resources adopted, DBInstance or DBCluster. Defaults to
+	// both.
+	Kinds []*string `json:"kinds,omitempty"`
+	// The tag filters a DB instance or DB cluster must all match to be
+	// adopted.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	TagFilters []*FleetAdoptionTagFilter `json:"tagFilters"`
+	// The namespace the AdoptedResources and adopted resources are created
+	// in. The DB instances and DB clusters are listed in the AWS account,
+	// region and endpoint of the namespace, as set by its owner account ID,
+	// default region and endpoint URL annotations, and otherwise in those of
+	// the controller.
+	// +kubebuilder:validation:Required
+	TargetNamespace *string `json:"targetNamespace"`
+}
+
+// FleetAdoptionTagFilter matches the DB instances and DB clusters with a tag
+// of the supplied key and, when values are supplied, one of them as value.
+type FleetAdoptionTagFilter struct {
+	// +kubebuilder:validation:Required
+	Key *string `json:"key"`
+	// The values the tag may have. Any value matches when unset.
+	Values []*string `json:"values,omitempty"`
+}
+
+// FleetAdoptionStatus defines the observed state of FleetAdoption
+type FleetAdoptionStatus struct {
+	// All CRS managed by ACK have a common `Status.Conditions` member that
+	// contains a collection of `ackv1alpha1.Condition` objects that describe
+	// the various terminal states of the CR
+	// +kubebuilder:validation:Optional
+	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The number of AdoptedResources created by the last discovery.
+	// +kubebuilder:validation:Optional
+	CreatedAdoptedResources *int64 `json:"createdAdoptedResources,omitempty"`
+	// The time of the last discovery.
+	// +kubebuilder:validation:Optional
+	LastDiscoveryTime *metav1.Time `json:"lastDiscoveryTime,omitempty"`
+	// The number of DB clusters matching the tag filters at the last
+	// discovery, including the ones adopted before.
+	// +kubebuilder:validation:Optional
+	MatchedDBClusters *int64 `json:"matchedDBClusters,omitempty"`
+	// The number of DB instances matching the tag filters at the last
+	// discovery, including the ones adopted before.
+	// +kubebuilder:validation:Optional
+	MatchedDBInstances *int64 `json:"matchedDBInstances,omitempty"`
+}
+
+// FleetAdoption is the Schema for the FleetAdoptions API
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="NAMESPACE",type=string,priority=0,JSONPath=`.spec.targetNamespace`
+// +kubebuilder:printcolumn:name="INSTANCES",type=integer,priority=0,JSONPath=`.status.matchedDBInstances`
+// +kubebuilder:printcolumn:name="CLUSTERS",type=integer,priority=0,JSONPath=`.status.matchedDBClusters`
+// +kubebuilder:printcolumn:name="LAST-DISCOVERY",type=date,priority=1,JSONPath=`.status.lastDiscoveryTime`
+type FleetAdoption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              FleetAdoptionSpec   `json:"spec,omitempty"`
+	Status            FleetAdoptionStatus `json:"status,omitempty"`
+}
+
+// FleetAdoptionList contains a list of FleetAdoption
+// +kubebuilder:object:root=true
+type FleetAdoptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetAdoption `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FleetAdoption{}, &FleetAdoptionList{})
+}
@@ -40,7 +40,8 @@ type GlobalClusterSpec struct {
 	GlobalClusterIdentifier *string `json:"globalClusterIdentifier,omitempty"`
 	// The Amazon Resource Name (ARN) to use as the primary cluster of the global
 	// database. This parameter is optional.
-	SourceDBClusterIdentifier *string `json:"sourceDBClusterIdentifier,omitempty"`
+	SourceDBClusterIdentifier *string                                  `json:"sourceDBClusterIdentifier,omitempty"`
+	SourceDBClusterRef        *ackv1alpha1.AWSResourceReferenceWrapper `json:"sourceDBClusterRef,omitempty"`
 	// The storage encryption setting for the new global database cluster.
 	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
 }
@@ -73,6 +74,10 @@ type GlobalClusterStatus struct {
 	// accessed.
 	// +kubebuilder:validation:Optional
 	GlobalClusterResourceID *string `json:"globalClusterResourceID,omitempty"`
+	// The last failover or switchover the controller issued because of the
+	// failover-global-cluster or switchover-global-cluster annotations.
+	// +kubebuilder:validation:Optional
+	LastFailover *FailoverRecord `json:"lastFailover,omitempty"`
 	// Specifies the current state of this global database cluster.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
@@ -0,0 +1,26 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// MasterUserSecretDestination selects the key of the Kubernetes Secret, in the
+// namespace of the DB instance or DB cluster, the master user password managed
+// by RDS in Secrets Manager is copied to.
+type MasterUserSecretDestination struct {
+	// The name of the Secret.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// The key of the Secret the password is copied to.
+	// +kubebuilder:validation:Required
+	Key *string `json:"key"`
+}
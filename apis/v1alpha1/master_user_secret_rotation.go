@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// MasterUserSecretRotation describes the rotation of the master user password
+// of a DB instance or DB cluster managed by RDS in Secrets Manager, when
+// Spec.ManageMasterUserPassword is set. The rotation schedule is applied to the
+// secret through Secrets Manager, and is either a number of days between
+// rotations or a schedule expression. Secrets Manager rotates the secrets RDS
+// manages every 7 days by default.
+type MasterUserSecretRotation struct {
+	// The number of days between rotations of the password, from 1 to 1000.
+	AutomaticallyAfterDays *int64 `json:"automaticallyAfterDays,omitempty"`
+	// A cron() or rate() expression scheduling the rotations of the password,
+	// like "cron(0 4 ? * SUN *)" or "rate(10 days)".
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+	// The length of the window the password is rotated within, in hours, like
+	// "3h". Defaults to the window Secrets Manager picks.
+	Duration *string `json:"duration,omitempty"`
+	// Whether the password is also rotated immediately, through
+	// ModifyDBInstance or ModifyDBCluster, when the rotation schedule is
+	// applied.
+	RotateImmediately *bool `json:"rotateImmediately,omitempty"`
+}
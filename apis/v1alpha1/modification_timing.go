@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// The timings of the modifications of a DB instance
+const (
+	// ModificationTimingImmediate applies the modifications right away. This
+	// is the default.
+	ModificationTimingImmediate = "Immediate"
+	// ModificationTimingMaintenanceWindow defers the modifications to the
+	// next maintenance window of the DB instance.
+	ModificationTimingMaintenanceWindow = "MaintenanceWindow"
+)
+
+// ModificationTiming describes when the controller applies the modifications
+// of a DB instance, per category of modifications. Each category is either
+// "Immediate" or "MaintenanceWindow". Categories left unset are applied
+// immediately.
+//
+// Note that RDS applies every pending modification, including the deferred
+// ones, as soon as another modification is applied immediately.
+type ModificationTiming struct {
+	// When an upgrade of the engine version, through Spec.EngineVersion, is
+	// applied.
+	// +kubebuilder:validation:Enum=Immediate;MaintenanceWindow
+	EngineVersion *string `json:"engineVersion,omitempty"`
+	// When a change of the DB instance class, through Spec.DBInstanceClass, is
+	// applied.
+	// +kubebuilder:validation:Enum=Immediate;MaintenanceWindow
+	InstanceClass *string `json:"instanceClass,omitempty"`
+	// When a conversion to or from a Multi-AZ deployment, through
+	// Spec.MultiAZ, is applied.
+	// +kubebuilder:validation:Enum=Immediate;MaintenanceWindow
+	MultiAZ *string `json:"multiAZ,omitempty"`
+	// When a change of the storage, through Spec.AllocatedStorage,
+	// Spec.StorageType, Spec.IOPS or Spec.StorageThroughput, is applied.
+	// +kubebuilder:validation:Enum=Immediate;MaintenanceWindow
+	Storage *string `json:"storage,omitempty"`
+}
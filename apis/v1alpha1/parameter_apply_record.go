@@ -0,0 +1,31 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ParameterApplyRecord describes a change the rds-controller made to the
+// parameters of a DB parameter group or DB cluster parameter group.
+type ParameterApplyRecord struct {
+	// The operation that changed the parameters: "modify", "reset" or
+	// "reset-all".
+	Operation *string `json:"operation,omitempty"`
+	// The time at which the parameters were changed.
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty"`
+	// The names of the parameters that were changed. Empty for "reset-all"
+	// operations, which change all the parameters.
+	ParameterNames []*string `json:"parameterNames,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RebootRecord describes a reboot of a DB instance the rds-controller issued
+// because of the RebootAnnotation or RebootWithFailoverAnnotation annotations.
+type RebootRecord struct {
+	// The time at which the reboot was issued.
+	RebootedAt *metav1.Time `json:"rebootedAt,omitempty"`
+	// Whether the reboot was conducted through a Multi-AZ failover.
+	ForceFailover *bool `json:"forceFailover,omitempty"`
+}
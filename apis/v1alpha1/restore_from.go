@@ -0,0 +1,28 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// RestoreFrom describes the DB cluster a DBCluster is restored from, at its
+// latest restorable time, when it is created. With the copy-on-write restore
+// type, the default, the DB cluster is a clone of the source DB cluster that
+// shares its storage until either of them changes the data.
+type RestoreFrom struct {
+	// The identifier of the source DB cluster from which to restore.
+	SourceDBClusterIdentifier *string `json:"sourceDBClusterIdentifier,omitempty"`
+	// The type of restore to be performed, either copy-on-write, which
+	// restores the DB cluster as a clone of the source DB cluster, or
+	// full-copy, which restores it as a full copy of the source DB cluster.
+	// Defaults to copy-on-write.
+	RestoreType *string `json:"restoreType,omitempty"`
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreToPointInTime describes the DB instance, and the point in time, a
+// DBInstance is restored from when it is created. Exactly one of
+// SourceDBInstanceIdentifier, SourceDBInstanceAutomatedBackupsARN and
+// SourceDBIResourceID must be set, and exactly one of RestoreTime and
+// UseLatestRestorableTime. A deleted DB instance whose automated backups were
+// retained is restored from SourceDBInstanceAutomatedBackupsARN or
+// SourceDBIResourceID.
+type RestoreToPointInTime struct {
+	// The identifier of the source DB instance from which to restore.
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+	// The Amazon Resource Name (ARN) of the automated backups from which to
+	// restore, either the retained automated backups of a deleted DB instance
+	// or replicated automated backups, for example,
+	// arn:aws:rds:us-east-1:123456789012:auto-backup:ab-L2IJCEXJP7XQ7HOJ4SIEXAMPLE.
+	SourceDBInstanceAutomatedBackupsARN *string `json:"sourceDBInstanceAutomatedBackupsARN,omitempty"`
+	// The resource ID of the source DB instance from which to restore, which
+	// can be a deleted DB instance whose automated backups were retained.
+	SourceDBIResourceID *string `json:"sourceDBIResourceID,omitempty"`
+	// The date and time to restore from.
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+	// Whether the DB instance is restored from the latest backup time.
+	UseLatestRestorableTime *bool `json:"useLatestRestorableTime,omitempty"`
+}
+
+// DBClusterRestoreToPointInTime describes the DB cluster, and the point in
+// time, a DBCluster is restored from when it is created. Exactly one of
+// SourceDBClusterIdentifier and SourceDBClusterResourceID must be set, and
+// exactly one of RestoreTime and UseLatestRestorableTime.
+type DBClusterRestoreToPointInTime struct {
+	// The identifier of the source DB cluster from which to restore.
+	SourceDBClusterIdentifier *string `json:"sourceDBClusterIdentifier,omitempty"`
+	// The resource ID of the source DB cluster from which to restore.
+	SourceDBClusterResourceID *string `json:"sourceDBClusterResourceID,omitempty"`
+	// The date and time to restore the DB cluster to.
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+	// Whether the DB cluster is restored from the latest backup time.
+	UseLatestRestorableTime *bool `json:"useLatestRestorableTime,omitempty"`
+	// The type of restore to be performed, either full-copy, which restores
+	// the DB cluster as a full copy of the source DB cluster, or
+	// copy-on-write, which restores it as a clone. Defaults to full-copy.
+	RestoreType *string `json:"restoreType,omitempty"`
+}
@@ -0,0 +1,158 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreVerificationLabel is the label the controller sets on the DBInstance
+// resources and health check Jobs it creates for a RestoreVerification,
+// holding the name of the RestoreVerification.
+const RestoreVerificationLabel = "rds.services.k8s.aws/restore-verification"
+
+// RestoreVerificationSpec defines the desired state of RestoreVerification.
+//
+// A RestoreVerification periodically proves that DB snapshots can be
+// restored: at the times of a cron expression, it restores the most recent
+// available DBSnapshot it selects into a short-lived DBInstance resource,
+// waits for the DB instance to be available, optionally runs a health check
+// Job against it, records the outcome in its status and deletes the DB
+// instance.
+type RestoreVerificationSpec struct {
+	// The DB instance class of the restored DB instances, for instance
+	// "db.t3.micro".
+	// +kubebuilder:validation:Required
+	DBInstanceClass *string `json:"dbInstanceClass"`
+	// The DB subnet group of the restored DB instances. Defaults to the default
+	// DB subnet group of the default VPC.
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+	// The health check run against the restored DB instance once it is
+	// available. When unset, the restore is verified as soon as the DB
+	// instance is available.
+	HealthCheck *RestoreHealthCheck `json:"healthCheck,omitempty"`
+	// The cron expression, made of the five standard fields (minute, hour, day
+	// of month, month and day of week), of the times at which restores are
+	// verified, for instance "0 6 * * 0" for every Sunday at 6am.
+	// +kubebuilder:validation:Required
+	Schedule *string `json:"schedule"`
+	// The labels of the DBSnapshot resources, in the namespace of the
+	// RestoreVerification, whose most recent available snapshot is restored.
+	// For instance, the snapshots taken by a SnapshotSchedule are selected by
+	// the rds.services.k8s.aws/snapshot-schedule label.
+	// +kubebuilder:validation:Required
+	SnapshotSelector *metav1.LabelSelector `json:"snapshotSelector"`
+	// How long a verification may take, from the restore to the end of the
+	// health check, before it is failed and its DB instance deleted. Defaults
+	// to 2 hours.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// The IANA time zone (for instance "Europe/Paris") in which Schedule is
+	// evaluated. Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+	// The VPC security groups of the restored DB instances, which must let the
+	// health check connect. Defaults to the default security group of the VPC.
+	VPCSecurityGroupIDs []*string `json:"vpcSecurityGroupIDs,omitempty"`
+}
+
+// RestoreHealthCheck describes the container of the Job run against a
+// restored DB instance. The restore is verified when the Job succeeds. The
+// container gets the DB_HOST, DB_PORT, DB_ENGINE and DB_USER environment
+// variables describing the restored DB instance, and DB_PASSWORD when
+// PasswordSecretRef is set.
+type RestoreHealthCheck struct {
+	// The arguments of the entrypoint of the image.
+	Args []*string `json:"args,omitempty"`
+	// The entrypoint of the image.
+	Command []*string `json:"command,omitempty"`
+	// The container image of the health check, for instance "postgres:16".
+	// +kubebuilder:validation:Required
+	Image *string `json:"image"`
+	// The key of a Secret, in the namespace of the RestoreVerification,
+	// holding the master user password of the snapshotted DB instance.
+	PasswordSecretRef *ackv1alpha1.SecretKeyReference `json:"passwordSecretRef,omitempty"`
+}
+
+// RestoreVerificationRun describes a verification in progress.
+type RestoreVerificationRun struct {
+	// The name of the DBInstance resource the snapshot is restored into,
+	// which is also the name of the health check Job.
+	DBInstance *string `json:"dbInstance,omitempty"`
+	// The name of the DBSnapshot resource being restored.
+	DBSnapshot *string `json:"dbSnapshot,omitempty"`
+	// When the verification started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// RestoreVerificationResult describes the outcome of a verification.
+type RestoreVerificationResult struct {
+	// When the verification ended.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// The name of the DBSnapshot resource that was restored.
+	DBSnapshot *string `json:"dbSnapshot,omitempty"`
+	// Explains the outcome of the verification.
+	Message *string `json:"message,omitempty"`
+	// When the verification started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Whether the snapshot was restored and passed the health check.
+	Succeeded *bool `json:"succeeded,omitempty"`
+}
+
+// RestoreVerificationStatus defines the observed state of RestoreVerification
+type RestoreVerificationStatus struct {
+	// All CRS managed by ACK have a common `Status.Conditions` member that
+	// contains a collection of `ackv1alpha1.Condition` objects that describe
+	// the various terminal states of the CR. The RestoreVerified condition
+	// reports the outcome of the last verification.
+	// +kubebuilder:validation:Optional
+	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The verification in progress, if any.
+	// +kubebuilder:validation:Optional
+	CurrentRun *RestoreVerificationRun `json:"currentRun,omitempty"`
+	// The outcome of the last verification.
+	// +kubebuilder:validation:Optional
+	LastResult *RestoreVerificationResult `json:"lastResult,omitempty"`
+	// The most recent time of the schedule a verification was started for.
+	// +kubebuilder:validation:Optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// The next time of the schedule a verification will be started at.
+	// +kubebuilder:validation:Optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// RestoreVerification is the Schema for the RestoreVerifications API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,priority=0,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="SUCCEEDED",type=boolean,priority=0,JSONPath=`.status.lastResult.succeeded`
+// +kubebuilder:printcolumn:name="LAST-SCHEDULE",type=date,priority=0,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="NEXT-SCHEDULE",type=date,priority=1,JSONPath=`.status.nextScheduleTime`
+type RestoreVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RestoreVerificationSpec   `json:"spec,omitempty"`
+	Status            RestoreVerificationStatus `json:"status,omitempty"`
+}
+
+// RestoreVerificationList contains a list of RestoreVerification
+// +kubebuilder:object:root=true
+type RestoreVerificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RestoreVerification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RestoreVerification{}, &RestoreVerificationList{})
+}
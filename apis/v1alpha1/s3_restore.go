@@ -0,0 +1,37 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// S3Restore describes the Amazon S3 bucket holding the backup (for instance a
+// Percona XtraBackup backup of a MySQL database) a DBInstance is restored
+// from when it is created.
+type S3Restore struct {
+	// The name of your Amazon S3 bucket that contains your database backup
+	// file.
+	// +kubebuilder:validation:Required
+	S3BucketName *string `json:"s3BucketName"`
+	// The prefix of your Amazon S3 bucket.
+	S3Prefix *string `json:"s3Prefix,omitempty"`
+	// An Amazon Web Services Identity and Access Management (IAM) role to allow
+	// Amazon RDS to access your Amazon S3 bucket.
+	// +kubebuilder:validation:Required
+	S3IngestionRoleARN *string `json:"s3IngestionRoleARN"`
+	// The name of the engine of your source database. Defaults to "mysql",
+	// the only supported value.
+	SourceEngine *string `json:"sourceEngine,omitempty"`
+	// The version of the database that the backup files were created from.
+	// MySQL versions 5.6 and 5.7 are supported.
+	// +kubebuilder:validation:Required
+	SourceEngineVersion *string `json:"sourceEngineVersion"`
+}
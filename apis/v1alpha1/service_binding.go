@@ -0,0 +1,25 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// ServiceBinding refers to the Kubernetes Secret holding the connection
+// details of a DB instance or DB cluster under the well-known keys of the
+// Service Binding specification (https://servicebinding.io/spec/core/1.0.0/#well-known-secret-entries),
+// so that it can be bound by ServiceBinding-aware tooling as a provisioned
+// service.
+type ServiceBinding struct {
+	// The name of the Secret, in the namespace of the DB instance or DB
+	// cluster.
+	Name *string `json:"name"`
+}
@@ -0,0 +1,137 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotScheduleLabel is the label the controller sets on the DBSnapshot and
+// DBClusterSnapshot resources it creates for a SnapshotSchedule, holding the
+// name of the SnapshotSchedule. Only the snapshots with this label are pruned.
+const SnapshotScheduleLabel = "rds.services.k8s.aws/snapshot-schedule"
+
+// SnapshotScheduleSpec defines the desired state of SnapshotSchedule.
+//
+// A SnapshotSchedule takes manual snapshots of a DB instance or a DB cluster
+// at the times of a cron expression, by creating DBSnapshot or
+// DBClusterSnapshot resources in its namespace, and deletes the snapshots it
+// took once they are past their retention. Deleting the SnapshotSchedule keeps
+// the snapshots it took.
+type SnapshotScheduleSpec struct {
+	// The DBCluster resource, in the namespace of the SnapshotSchedule, whose
+	// snapshots are taken. Exactly one of DBClusterRef and DBInstanceRef is
+	// required.
+	DBClusterRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"dbClusterRef,omitempty"`
+	// The DBInstance resource, in the namespace of the SnapshotSchedule, whose
+	// snapshots are taken. Exactly one of DBClusterRef and DBInstanceRef is
+	// required.
+	DBInstanceRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"dbInstanceRef,omitempty"`
+	// How long the snapshots are kept and how many of them.
+	Retention *SnapshotRetention `json:"retention,omitempty"`
+	// The cron expression, made of the five standard fields (minute, hour, day
+	// of month, month and day of week), of the times at which snapshots are
+	// taken, for instance "0 3 * * *" for every day at 3am. When the
+	// controller misses several times, a single snapshot is taken.
+	// +kubebuilder:validation:Required
+	Schedule *string `json:"schedule"`
+	// The IANA time zone (for instance "Europe/Paris") in which Schedule is
+	// evaluated. Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// SnapshotRetention describes which of the snapshots taken by a
+// SnapshotSchedule are kept. A snapshot is kept when any of Count, Daily,
+// Weekly and Monthly keeps it, and is deleted once it is older than MaxAge.
+// All snapshots are kept when they are all unset.
+type SnapshotRetention struct {
+	// The number of most recent snapshots kept. Must be at least 1.
+	Count *int64 `json:"count,omitempty"`
+	// The number of most recent days, in the time zone of the schedule, whose
+	// last snapshot is kept. Must be at least 1.
+	Daily *int64 `json:"daily,omitempty"`
+	// The maximum age of the snapshots kept, for instance "168h" for a week.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// The number of most recent months, in the time zone of the schedule,
+	// whose last snapshot is kept. Must be at least 1.
+	Monthly *int64 `json:"monthly,omitempty"`
+	// The number of most recent ISO weeks, starting on Monday in the time zone
+	// of the schedule, whose last snapshot is kept. Must be at least 1.
+	Weekly *int64 `json:"weekly,omitempty"`
+}
+
+// SnapshotRetentionCounts reports the number of snapshots kept by each rule
+// of a SnapshotRetention. A snapshot kept by several rules is counted by each
+// of them.
+type SnapshotRetentionCounts struct {
+	// The number of snapshots kept as the most recent ones.
+	Count *int64 `json:"count,omitempty"`
+	// The number of snapshots kept as the last snapshot of their day.
+	Daily *int64 `json:"daily,omitempty"`
+	// The number of snapshots kept as the last snapshot of their month.
+	Monthly *int64 `json:"monthly,omitempty"`
+	// The number of snapshots kept as the last snapshot of their week.
+	Weekly *int64 `json:"weekly,omitempty"`
+}
+
+// SnapshotScheduleStatus defines the observed state of SnapshotSchedule
+type SnapshotScheduleStatus struct {
+	// All CRS managed by ACK have a common `Status.Conditions` member that
+	// contains a collection of `ackv1alpha1.Condition` objects that describe
+	// the various terminal states of the CR
+	// +kubebuilder:validation:Optional
+	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The name of the most recent snapshot resource taken by the schedule.
+	// +kubebuilder:validation:Optional
+	LastSnapshot *string `json:"lastSnapshot,omitempty"`
+	// The most recent time of the schedule a snapshot was taken for.
+	// +kubebuilder:validation:Optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// The next time of the schedule a snapshot will be taken at.
+	// +kubebuilder:validation:Optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// The number of snapshots taken by the schedule that are currently kept.
+	// +kubebuilder:validation:Optional
+	RetainedSnapshots *int64 `json:"retainedSnapshots,omitempty"`
+	// The number of snapshots kept by each rule of the retention.
+	// +kubebuilder:validation:Optional
+	RetentionCounts *SnapshotRetentionCounts `json:"retentionCounts,omitempty"`
+}
+
+// SnapshotSchedule is the Schema for the SnapshotSchedules API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,priority=0,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="LAST-SCHEDULE",type=date,priority=0,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="NEXT-SCHEDULE",type=date,priority=1,JSONPath=`.status.nextScheduleTime`
+// +kubebuilder:printcolumn:name="RETAINED",type=integer,priority=0,JSONPath=`.status.retainedSnapshots`
+type SnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SnapshotScheduleSpec   `json:"spec,omitempty"`
+	Status            SnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// SnapshotScheduleList contains a list of SnapshotSchedule
+// +kubebuilder:object:root=true
+type SnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnapshotSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SnapshotSchedule{}, &SnapshotScheduleList{})
+}
@@ -0,0 +1,33 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// StopStartSchedule describes when the controller stops and starts a DB
+// instance or DB cluster. Start and Stop are cron expressions made of the five
+// standard fields (minute, hour, day of month, month and day of week), for
+// instance "0 20 * * 1-5" for 8pm on weekdays. The DB instance or DB cluster
+// is kept in the state of whichever of the two happened last: it is stopped
+// or started at the times of Stop and Start, and stopped again at the next
+// resync after RDS automatically starts it 7 days after it was stopped.
+type StopStartSchedule struct {
+	// The cron expression of the times at which the DB instance or DB cluster
+	// is started.
+	Start *string `json:"start,omitempty"`
+	// The cron expression of the times at which the DB instance or DB cluster
+	// is stopped.
+	Stop *string `json:"stop,omitempty"`
+	// The IANA time zone (for instance "Europe/Paris") in which Start and Stop
+	// are evaluated. Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
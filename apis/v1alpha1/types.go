@@ -142,12 +142,29 @@ type AvailableProcessorFeature struct {
 // in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
 // for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
 // in the Amazon Aurora User Guide.
-type BlueGreenDeployment struct {
-	CreateTime *metav1.Time `json:"createTime,omitempty"`
-	DeleteTime *metav1.Time `json:"deleteTime,omitempty"`
-	// A list of tags. For more information, see Tagging Amazon RDS Resources (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
-	// in the Amazon RDS User Guide.
-	TagList []*Tag `json:"tagList,omitempty"`
+type BlueGreenDeploymentTask struct {
+	Name   *string `json:"name,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+// Contains the details about a blue/green deployment.
+//
+// For more information, see Using Amazon RDS Blue/Green Deployments for database
+// updates (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/blue-green-deployments.html)
+// in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
+// for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
+// in the Amazon Aurora User Guide.
+type BlueGreenDeployment_SDK struct {
+	BlueGreenDeploymentIdentifier *string                    `json:"blueGreenDeploymentIdentifier,omitempty"`
+	BlueGreenDeploymentName       *string                    `json:"blueGreenDeploymentName,omitempty"`
+	CreateTime                    *metav1.Time               `json:"createTime,omitempty"`
+	DeleteTime                    *metav1.Time               `json:"deleteTime,omitempty"`
+	Source                        *string                    `json:"source,omitempty"`
+	Status                        *string                    `json:"status,omitempty"`
+	StatusDetails                 *string                    `json:"statusDetails,omitempty"`
+	SwitchoverDetails             []*SwitchoverDetail        `json:"switchoverDetails,omitempty"`
+	Target                        *string                    `json:"target,omitempty"`
+	Tasks                         []*BlueGreenDeploymentTask `json:"tasks,omitempty"`
 }
 
 // A CA certificate for an Amazon Web Services account.
@@ -260,14 +277,13 @@ type CustomDBEngineVersionAMI struct {
 //
 // For the data structure that represents Amazon RDS DB instance endpoints,
 // see Endpoint.
-type DBClusterEndpoint struct {
+type DBClusterEndpoint_SDK struct {
 	CustomEndpointType                  *string   `json:"customEndpointType,omitempty"`
 	DBClusterEndpointARN                *string   `json:"dbClusterEndpointARN,omitempty"`
 	DBClusterEndpointIdentifier         *string   `json:"dbClusterEndpointIdentifier,omitempty"`
 	DBClusterEndpointResourceIdentifier *string   `json:"dbClusterEndpointResourceIdentifier,omitempty"`
 	DBClusterIdentifier                 *string   `json:"dbClusterIdentifier,omitempty"`
 	Endpoint                            *string   `json:"endpoint,omitempty"`
-	EndpointType                        *string   `json:"endpointType,omitempty"`
 	ExcludedMembers                     []*string `json:"excludedMembers,omitempty"`
 	StaticMembers                       []*string `json:"staticMembers,omitempty"`
 	Status                              *string   `json:"status,omitempty"`
@@ -310,7 +326,7 @@ type DBClusterRole struct {
 //
 // This data type is used as a response element in the DescribeDBClusterSnapshots
 // action.
-type DBClusterSnapshot struct {
+type DBClusterSnapshot_SDK struct {
 	AllocatedStorage                 *int64       `json:"allocatedStorage,omitempty"`
 	AvailabilityZones                []*string    `json:"availabilityZones,omitempty"`
 	ClusterCreateTime                *metav1.Time `json:"clusterCreateTime,omitempty"`
@@ -318,6 +334,7 @@ type DBClusterSnapshot struct {
 	DBClusterSnapshotARN             *string      `json:"dbClusterSnapshotARN,omitempty"`
 	DBClusterSnapshotIdentifier      *string      `json:"dbClusterSnapshotIdentifier,omitempty"`
 	DBSystemID                       *string      `json:"dbSystemID,omitempty"`
+	DBClusterResourceID              *string      `json:"dbClusterResourceID,omitempty"`
 	Engine                           *string      `json:"engine,omitempty"`
 	EngineMode                       *string      `json:"engineMode,omitempty"`
 	EngineVersion                    *string      `json:"engineVersion,omitempty"`
@@ -332,6 +349,7 @@ type DBClusterSnapshot struct {
 	SourceDBClusterSnapshotARN       *string      `json:"sourceDBClusterSnapshotARN,omitempty"`
 	Status                           *string      `json:"status,omitempty"`
 	StorageEncrypted                 *bool        `json:"storageEncrypted,omitempty"`
+	StorageType                      *string      `json:"storageType,omitempty"`
 	// A list of tags. For more information, see Tagging Amazon RDS Resources (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
 	// in the Amazon RDS User Guide.
 	TagList []*Tag  `json:"tagList,omitempty"`
@@ -605,6 +623,7 @@ type DBInstance_SDK struct {
 	DBSystemID                   *string             `json:"dbSystemID,omitempty"`
 	DBInstancePort               *int64              `json:"dbInstancePort,omitempty"`
 	DBIResourceID                *string             `json:"dbiResourceID,omitempty"`
+	DedicatedLogVolume           *bool               `json:"dedicatedLogVolume,omitempty"`
 	DeletionProtection           *bool               `json:"deletionProtection,omitempty"`
 	DomainMemberships            []*DomainMembership `json:"domainMemberships,omitempty"`
 	EnabledCloudwatchLogsExports []*string           `json:"enabledCloudwatchLogsExports,omitempty"`
@@ -726,13 +745,15 @@ type DBParameterGroup_SDK struct {
 //
 // This data type is used as a response element in the DescribeDBProxyEndpoints
 // operation.
-type DBProxyEndpoint struct {
+type DBProxyEndpoint_SDK struct {
 	CreatedDate         *metav1.Time `json:"createdDate,omitempty"`
 	DBProxyEndpointARN  *string      `json:"dbProxyEndpointARN,omitempty"`
 	DBProxyEndpointName *string      `json:"dbProxyEndpointName,omitempty"`
 	DBProxyName         *string      `json:"dbProxyName,omitempty"`
 	Endpoint            *string      `json:"endpoint,omitempty"`
 	IsDefault           *bool        `json:"isDefault,omitempty"`
+	Status              *string      `json:"status,omitempty"`
+	TargetRole          *string      `json:"targetRole,omitempty"`
 	VPCID               *string      `json:"vpcID,omitempty"`
 	VPCSecurityGroupIDs []*string    `json:"vpcSecurityGroupIDs,omitempty"`
 	VPCSubnetIDs        []*string    `json:"vpcSubnetIDs,omitempty"`
@@ -745,11 +766,15 @@ type DBProxyEndpoint struct {
 // This data type is used as a response element in the DescribeDBProxyTargets
 // action.
 type DBProxyTarget struct {
-	Endpoint         *string `json:"endpoint,omitempty"`
-	Port             *int64  `json:"port,omitempty"`
-	RdsResourceID    *string `json:"rdsResourceID,omitempty"`
-	TargetARN        *string `json:"targetARN,omitempty"`
-	TrackedClusterID *string `json:"trackedClusterID,omitempty"`
+	Endpoint      *string `json:"endpoint,omitempty"`
+	Port          *int64  `json:"port,omitempty"`
+	RdsResourceID *string `json:"rdsResourceID,omitempty"`
+	Role          *string `json:"role,omitempty"`
+	TargetARN     *string `json:"targetARN,omitempty"`
+	// Information about the connection health of an RDS Proxy target.
+	TargetHealth     *TargetHealth `json:"targetHealth,omitempty"`
+	TrackedClusterID *string       `json:"trackedClusterID,omitempty"`
+	Type             *string       `json:"type_,omitempty"`
 }
 
 // Represents a set of RDS DB instances, Aurora DB clusters, or both that a
@@ -758,14 +783,17 @@ type DBProxyTarget struct {
 //
 // This data type is used as a response element in the DescribeDBProxyTargetGroups
 // action.
-type DBProxyTargetGroup struct {
-	CreatedDate     *metav1.Time `json:"createdDate,omitempty"`
-	DBProxyName     *string      `json:"dbProxyName,omitempty"`
-	IsDefault       *bool        `json:"isDefault,omitempty"`
-	Status          *string      `json:"status,omitempty"`
-	TargetGroupARN  *string      `json:"targetGroupARN,omitempty"`
-	TargetGroupName *string      `json:"targetGroupName,omitempty"`
-	UpdatedDate     *metav1.Time `json:"updatedDate,omitempty"`
+type DBProxyTargetGroup_SDK struct {
+	// Displays the settings that control the size and behavior of the connection
+	// pool associated with a DBProxyTarget.
+	ConnectionPoolConfig *ConnectionPoolConfigurationInfo `json:"connectionPoolConfig,omitempty"`
+	CreatedDate          *metav1.Time                     `json:"createdDate,omitempty"`
+	DBProxyName          *string                          `json:"dbProxyName,omitempty"`
+	IsDefault            *bool                            `json:"isDefault,omitempty"`
+	Status               *string                          `json:"status,omitempty"`
+	TargetGroupARN       *string                          `json:"targetGroupARN,omitempty"`
+	TargetGroupName      *string                          `json:"targetGroupName,omitempty"`
+	UpdatedDate          *metav1.Time                     `json:"updatedDate,omitempty"`
 }
 
 // The data structure representing a proxy managed by the RDS Proxy.
@@ -818,13 +846,15 @@ type DBSecurityGroupMembership struct {
 // Contains the details of an Amazon RDS DB snapshot.
 //
 // This data type is used as a response element in the DescribeDBSnapshots action.
-type DBSnapshot struct {
+type DBSnapshot_SDK struct {
 	AllocatedStorage                 *int64              `json:"allocatedStorage,omitempty"`
 	AvailabilityZone                 *string             `json:"availabilityZone,omitempty"`
 	DBInstanceIdentifier             *string             `json:"dbInstanceIdentifier,omitempty"`
 	DBSnapshotARN                    *string             `json:"dbSnapshotARN,omitempty"`
 	DBSnapshotIdentifier             *string             `json:"dbSnapshotIdentifier,omitempty"`
+	DBSystemID                       *string             `json:"dbSystemID,omitempty"`
 	DBIResourceID                    *string             `json:"dbiResourceID,omitempty"`
+	DedicatedLogVolume               *bool               `json:"dedicatedLogVolume,omitempty"`
 	Encrypted                        *bool               `json:"encrypted,omitempty"`
 	Engine                           *string             `json:"engine,omitempty"`
 	EngineVersion                    *string             `json:"engineVersion,omitempty"`
@@ -834,6 +864,7 @@ type DBSnapshot struct {
 	KMSKeyID                         *string             `json:"kmsKeyID,omitempty"`
 	LicenseModel                     *string             `json:"licenseModel,omitempty"`
 	MasterUsername                   *string             `json:"masterUsername,omitempty"`
+	MultiTenant                      *bool               `json:"multiTenant,omitempty"`
 	OptionGroupName                  *string             `json:"optionGroupName,omitempty"`
 	OriginalSnapshotCreateTime       *metav1.Time        `json:"originalSnapshotCreateTime,omitempty"`
 	PercentProgress                  *int64              `json:"percentProgress,omitempty"`
@@ -897,10 +928,13 @@ type DescribeDBLogFilesDetails struct {
 // An Active Directory Domain membership record associated with the DB instance
 // or cluster.
 type DomainMembership struct {
-	Domain      *string `json:"domain,omitempty"`
-	FQDN        *string `json:"fQDN,omitempty"`
-	IAMRoleName *string `json:"iamRoleName,omitempty"`
-	Status      *string `json:"status,omitempty"`
+	AuthSecretARN *string   `json:"authSecretARN,omitempty"`
+	DNSIPs        []*string `json:"dnsIPs,omitempty"`
+	Domain        *string   `json:"domain,omitempty"`
+	FQDN          *string   `json:"fQDN,omitempty"`
+	IAMRoleName   *string   `json:"iamRoleName,omitempty"`
+	OU            *string   `json:"oU,omitempty"`
+	Status        *string   `json:"status,omitempty"`
 }
 
 // This data type is used as a response element in the following actions:
@@ -961,7 +995,7 @@ type EventCategoriesMap struct {
 
 // Contains the results of a successful invocation of the DescribeEventSubscriptions
 // action.
-type EventSubscription struct {
+type EventSubscription_SDK struct {
 	CustSubscriptionID       *string `json:"custSubscriptionID,omitempty"`
 	CustomerAWSID            *string `json:"customerAWSID,omitempty"`
 	Enabled                  *bool   `json:"enabled,omitempty"`
@@ -975,7 +1009,7 @@ type EventSubscription struct {
 // Contains the details of a snapshot or cluster export to Amazon S3.
 //
 // This data type is used as a response element in the DescribeExportTasks action.
-type ExportTask struct {
+type ExportTask_SDK struct {
 	ExportOnly             []*string    `json:"exportOnly,omitempty"`
 	ExportTaskIdentifier   *string      `json:"exportTaskIdentifier,omitempty"`
 	FailureCause           *string      `json:"failureCause,omitempty"`
@@ -986,6 +1020,7 @@ type ExportTask struct {
 	S3Prefix               *string      `json:"s3Prefix,omitempty"`
 	SnapshotTime           *metav1.Time `json:"snapshotTime,omitempty"`
 	SourceARN              *string      `json:"sourceARN,omitempty"`
+	SourceType             *string      `json:"sourceType,omitempty"`
 	Status                 *string      `json:"status,omitempty"`
 	TaskEndTime            *metav1.Time `json:"taskEndTime,omitempty"`
 	TaskStartTime          *metav1.Time `json:"taskStartTime,omitempty"`
@@ -1084,6 +1119,7 @@ type MinimumEngineVersionPerAllowedValue struct {
 type Option struct {
 	OptionDescription           *string                       `json:"optionDescription,omitempty"`
 	OptionName                  *string                       `json:"optionName,omitempty"`
+	OptionSettings              []*OptionSetting              `json:"optionSettings,omitempty"`
 	OptionVersion               *string                       `json:"optionVersion,omitempty"`
 	Permanent                   *bool                         `json:"permanent,omitempty"`
 	Persistent                  *bool                         `json:"persistent,omitempty"`
@@ -1093,14 +1129,15 @@ type Option struct {
 
 // A list of all available options
 type OptionConfiguration struct {
-	DBSecurityGroupMemberships  []*string `json:"dbSecurityGroupMemberships,omitempty"`
-	OptionName                  *string   `json:"optionName,omitempty"`
-	OptionVersion               *string   `json:"optionVersion,omitempty"`
-	Port                        *int64    `json:"port,omitempty"`
-	VPCSecurityGroupMemberships []*string `json:"vpcSecurityGroupMemberships,omitempty"`
+	DBSecurityGroupMemberships  []*string        `json:"dbSecurityGroupMemberships,omitempty"`
+	OptionName                  *string          `json:"optionName,omitempty"`
+	OptionSettings              []*OptionSetting `json:"optionSettings,omitempty"`
+	OptionVersion               *string          `json:"optionVersion,omitempty"`
+	Port                        *int64           `json:"port,omitempty"`
+	VPCSecurityGroupMemberships []*string        `json:"vpcSecurityGroupMemberships,omitempty"`
 }
 
-type OptionGroup struct {
+type OptionGroup_SDK struct {
 	AllowsVPCAndNonVPCInstanceMemberships *bool        `json:"allowsVPCAndNonVPCInstanceMemberships,omitempty"`
 	CopyTimestamp                         *metav1.Time `json:"copyTimestamp,omitempty"`
 	EngineName                            *string      `json:"engineName,omitempty"`
@@ -1199,6 +1236,7 @@ type OrderableDBInstanceOption struct {
 	SupportedEngineModes              []*string `json:"supportedEngineModes,omitempty"`
 	SupportedNetworkTypes             []*string `json:"supportedNetworkTypes,omitempty"`
 	SupportsClusters                  *bool     `json:"supportsClusters,omitempty"`
+	SupportsDedicatedLogVolume        *bool     `json:"supportsDedicatedLogVolume,omitempty"`
 	SupportsEnhancedMonitoring        *bool     `json:"supportsEnhancedMonitoring,omitempty"`
 	SupportsGlobalDatabases           *bool     `json:"supportsGlobalDatabases,omitempty"`
 	SupportsIAMDatabaseAuthentication *bool     `json:"supportsIAMDatabaseAuthentication,omitempty"`
@@ -1266,6 +1304,7 @@ type PendingModifiedValues struct {
 	DBInstanceClass                  *string `json:"dbInstanceClass,omitempty"`
 	DBInstanceIdentifier             *string `json:"dbInstanceIdentifier,omitempty"`
 	DBSubnetGroupName                *string `json:"dbSubnetGroupName,omitempty"`
+	DedicatedLogVolume               *bool   `json:"dedicatedLogVolume,omitempty"`
 	EngineVersion                    *string `json:"engineVersion,omitempty"`
 	IAMDatabaseAuthenticationEnabled *bool   `json:"iamDatabaseAuthenticationEnabled,omitempty"`
 	IOPS                             *int64  `json:"iops,omitempty"`
@@ -1347,7 +1386,7 @@ type RecurringCharge struct {
 
 // This data type is used as a response element in the DescribeReservedDBInstances
 // and PurchaseReservedDBInstancesOffering actions.
-type ReservedDBInstance struct {
+type ReservedDBInstance_SDK struct {
 	CurrencyCode                  *string      `json:"currencyCode,omitempty"`
 	DBInstanceClass               *string      `json:"dbInstanceClass,omitempty"`
 	DBInstanceCount               *int64       `json:"dbInstanceCount,omitempty"`
@@ -1458,6 +1497,19 @@ type Subnet struct {
 	SubnetStatus  *string  `json:"subnetStatus,omitempty"`
 }
 
+// Contains the details about a blue/green deployment.
+//
+// For more information, see Using Amazon RDS Blue/Green Deployments for database
+// updates (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/blue-green-deployments.html)
+// in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
+// for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
+// in the Amazon Aurora User Guide.
+type SwitchoverDetail struct {
+	SourceMember *string `json:"sourceMember,omitempty"`
+	Status       *string `json:"status,omitempty"`
+	TargetMember *string `json:"targetMember,omitempty"`
+}
+
 // Metadata assigned to an Amazon RDS resource consisting of a key-value pair.
 //
 // For more information, see Tagging Amazon RDS Resources (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
@@ -1470,6 +1522,24 @@ type Tag struct {
 // Information about the connection health of an RDS Proxy target.
 type TargetHealth struct {
 	Description *string `json:"description,omitempty"`
+	Reason      *string `json:"reason,omitempty"`
+	State       *string `json:"state,omitempty"`
+}
+
+// A tenant database in the DB instance. This data type is an element in the
+// response to the DescribeTenantDatabases action.
+type TenantDatabase_SDK struct {
+	CharacterSetName         *string      `json:"characterSetName,omitempty"`
+	DBInstanceIdentifier     *string      `json:"dbInstanceIdentifier,omitempty"`
+	DBIResourceID            *string      `json:"dbiResourceID,omitempty"`
+	DeletionProtection       *bool        `json:"deletionProtection,omitempty"`
+	MasterUsername           *string      `json:"masterUsername,omitempty"`
+	NcharCharacterSetName    *string      `json:"ncharCharacterSetName,omitempty"`
+	Status                   *string      `json:"status,omitempty"`
+	TenantDBName             *string      `json:"tenantDBName,omitempty"`
+	TenantDatabaseARN        *string      `json:"tenantDatabaseARN,omitempty"`
+	TenantDatabaseCreateTime *metav1.Time `json:"tenantDatabaseCreateTime,omitempty"`
+	TenantDatabaseResourceID *string      `json:"tenantDatabaseResourceID,omitempty"`
 }
 
 // A time zone associated with a DBInstance or a DBSnapshot. This data type
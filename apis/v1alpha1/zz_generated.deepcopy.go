@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,379 +46,379 @@ func (in *AccountQuota) DeepCopy() *AccountQuota {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
+func (in *ActivityStream) DeepCopyInto(out *ActivityStream) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineNativeAuditFieldsIncluded != nil {
+		in, out := &in.EngineNativeAuditFieldsIncluded, &out.EngineNativeAuditFieldsIncluded
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZone.
-func (in *AvailabilityZone) DeepCopy() *AvailabilityZone {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityStream.
+func (in *ActivityStream) DeepCopy() *ActivityStream {
 	if in == nil {
 		return nil
 	}
-	out := new(AvailabilityZone)
+	out := new(ActivityStream)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AvailableProcessorFeature) DeepCopyInto(out *AvailableProcessorFeature) {
+func (in *AutomatedBackupsReplication) DeepCopyInto(out *AutomatedBackupsReplication) {
 	*out = *in
-	if in.AllowedValues != nil {
-		in, out := &in.AllowedValues, &out.AllowedValues
-		*out = new(string)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DefaultValue != nil {
-		in, out := &in.DefaultValue, &out.DefaultValue
+	if in.DestinationRegion != nil {
+		in, out := &in.DestinationRegion, &out.DestinationRegion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableProcessorFeature.
-func (in *AvailableProcessorFeature) DeepCopy() *AvailableProcessorFeature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedBackupsReplication.
+func (in *AutomatedBackupsReplication) DeepCopy() *AutomatedBackupsReplication {
 	if in == nil {
 		return nil
 	}
-	out := new(AvailableProcessorFeature)
+	out := new(AutomatedBackupsReplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BlueGreenDeployment) DeepCopyInto(out *BlueGreenDeployment) {
+func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.DeleteTime != nil {
-		in, out := &in.DeleteTime, &out.DeleteTime
-		*out = (*in).DeepCopy()
-	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment.
-func (in *BlueGreenDeployment) DeepCopy() *BlueGreenDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZone.
+func (in *AvailabilityZone) DeepCopy() *AvailabilityZone {
 	if in == nil {
 		return nil
 	}
-	out := new(BlueGreenDeployment)
+	out := new(AvailabilityZone)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Certificate) DeepCopyInto(out *Certificate) {
+func (in *AvailableProcessorFeature) DeepCopyInto(out *AvailableProcessorFeature) {
 	*out = *in
-	if in.CertificateARN != nil {
-		in, out := &in.CertificateARN, &out.CertificateARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CertificateIdentifier != nil {
-		in, out := &in.CertificateIdentifier, &out.CertificateIdentifier
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
 		*out = new(string)
 		**out = **in
 	}
-	if in.CertificateType != nil {
-		in, out := &in.CertificateType, &out.CertificateType
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
 		*out = new(string)
 		**out = **in
 	}
-	if in.CustomerOverride != nil {
-		in, out := &in.CustomerOverride, &out.CustomerOverride
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CustomerOverrideValidTill != nil {
-		in, out := &in.CustomerOverrideValidTill, &out.CustomerOverrideValidTill
-		*out = (*in).DeepCopy()
-	}
-	if in.Thumbprint != nil {
-		in, out := &in.Thumbprint, &out.Thumbprint
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.ValidFrom != nil {
-		in, out := &in.ValidFrom, &out.ValidFrom
-		*out = (*in).DeepCopy()
-	}
-	if in.ValidTill != nil {
-		in, out := &in.ValidTill, &out.ValidTill
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
-func (in *Certificate) DeepCopy() *Certificate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableProcessorFeature.
+func (in *AvailableProcessorFeature) DeepCopy() *AvailableProcessorFeature {
 	if in == nil {
 		return nil
 	}
-	out := new(Certificate)
+	out := new(AvailableProcessorFeature)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateDetails) DeepCopyInto(out *CertificateDetails) {
+func (in *BacktrackRecord) DeepCopyInto(out *BacktrackRecord) {
 	*out = *in
-	if in.CAIdentifier != nil {
-		in, out := &in.CAIdentifier, &out.CAIdentifier
+	if in.BacktrackIdentifier != nil {
+		in, out := &in.BacktrackIdentifier, &out.BacktrackIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.ValidTill != nil {
-		in, out := &in.ValidTill, &out.ValidTill
+	if in.BacktrackTo != nil {
+		in, out := &in.BacktrackTo, &out.BacktrackTo
+		*out = (*in).DeepCopy()
+	}
+	if in.BacktrackedAt != nil {
+		in, out := &in.BacktrackedAt, &out.BacktrackedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDetails.
-func (in *CertificateDetails) DeepCopy() *CertificateDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BacktrackRecord.
+func (in *BacktrackRecord) DeepCopy() *BacktrackRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateDetails)
+	out := new(BacktrackRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CharacterSet) DeepCopyInto(out *CharacterSet) {
+func (in *BlueGreenDeployment) DeepCopyInto(out *BlueGreenDeployment) {
 	*out = *in
-	if in.CharacterSetDescription != nil {
-		in, out := &in.CharacterSetDescription, &out.CharacterSetDescription
-		*out = new(string)
-		**out = **in
-	}
-	if in.CharacterSetName != nil {
-		in, out := &in.CharacterSetName, &out.CharacterSetName
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CharacterSet.
-func (in *CharacterSet) DeepCopy() *CharacterSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment.
+func (in *BlueGreenDeployment) DeepCopy() *BlueGreenDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(CharacterSet)
+	out := new(BlueGreenDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueGreenDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudwatchLogsExportConfiguration) DeepCopyInto(out *CloudwatchLogsExportConfiguration) {
+func (in *BlueGreenDeploymentList) DeepCopyInto(out *BlueGreenDeploymentList) {
 	*out = *in
-	if in.DisableLogTypes != nil {
-		in, out := &in.DisableLogTypes, &out.DisableLogTypes
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.EnableLogTypes != nil {
-		in, out := &in.EnableLogTypes, &out.EnableLogTypes
-		*out = make([]*string, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BlueGreenDeployment, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudwatchLogsExportConfiguration.
-func (in *CloudwatchLogsExportConfiguration) DeepCopy() *CloudwatchLogsExportConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentList.
+func (in *BlueGreenDeploymentList) DeepCopy() *BlueGreenDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudwatchLogsExportConfiguration)
+	out := new(BlueGreenDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueGreenDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterPendingModifiedValues) DeepCopyInto(out *ClusterPendingModifiedValues) {
+func (in *BlueGreenDeploymentSpec) DeepCopyInto(out *BlueGreenDeploymentSpec) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
+	if in.BlueGreenDeploymentName != nil {
+		in, out := &in.BlueGreenDeploymentName, &out.BlueGreenDeploymentName
+		*out = new(string)
 		**out = **in
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
-		*out = new(int64)
+	if in.DeleteTarget != nil {
+		in, out := &in.DeleteTarget, &out.DeleteTarget
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SwitchoverTimeout != nil {
+		in, out := &in.SwitchoverTimeout, &out.SwitchoverTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TargetDBClusterParameterGroupName != nil {
+		in, out := &in.TargetDBClusterParameterGroupName, &out.TargetDBClusterParameterGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
+	if in.TargetDBInstanceClass != nil {
+		in, out := &in.TargetDBInstanceClass, &out.TargetDBInstanceClass
+		*out = new(string)
 		**out = **in
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
-		*out = new(int64)
+	if in.TargetDBParameterGroupName != nil {
+		in, out := &in.TargetDBParameterGroupName, &out.TargetDBParameterGroupName
+		*out = new(string)
 		**out = **in
 	}
-	if in.MasterUserPassword != nil {
-		in, out := &in.MasterUserPassword, &out.MasterUserPassword
+	if in.TargetEngineVersion != nil {
+		in, out := &in.TargetEngineVersion, &out.TargetEngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.PendingCloudwatchLogsExports != nil {
-		in, out := &in.PendingCloudwatchLogsExports, &out.PendingCloudwatchLogsExports
-		*out = new(PendingCloudwatchLogsExports)
-		(*in).DeepCopyInto(*out)
+	if in.UpgradeTargetStorageConfig != nil {
+		in, out := &in.UpgradeTargetStorageConfig, &out.UpgradeTargetStorageConfig
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPendingModifiedValues.
-func (in *ClusterPendingModifiedValues) DeepCopy() *ClusterPendingModifiedValues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentSpec.
+func (in *BlueGreenDeploymentSpec) DeepCopy() *BlueGreenDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterPendingModifiedValues)
+	out := new(BlueGreenDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolConfiguration) DeepCopyInto(out *ConnectionPoolConfiguration) {
+func (in *BlueGreenDeploymentStatus) DeepCopyInto(out *BlueGreenDeploymentStatus) {
 	*out = *in
-	if in.ConnectionBorrowTimeout != nil {
-		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
-		*out = new(int64)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.InitQuery != nil {
-		in, out := &in.InitQuery, &out.InitQuery
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxConnectionsPercent != nil {
-		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MaxIdleConnectionsPercent != nil {
-		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SessionPinningFilters != nil {
-		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
-		*out = make([]*string, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfiguration.
-func (in *ConnectionPoolConfiguration) DeepCopy() *ConnectionPoolConfiguration {
-	if in == nil {
-		return nil
-	}
-	out := new(ConnectionPoolConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolConfigurationInfo) DeepCopyInto(out *ConnectionPoolConfigurationInfo) {
-	*out = *in
-	if in.ConnectionBorrowTimeout != nil {
-		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
-		*out = new(int64)
+	if in.BlueGreenDeploymentIdentifier != nil {
+		in, out := &in.BlueGreenDeploymentIdentifier, &out.BlueGreenDeploymentIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.InitQuery != nil {
-		in, out := &in.InitQuery, &out.InitQuery
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteTime != nil {
+		in, out := &in.DeleteTime, &out.DeleteTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxConnectionsPercent != nil {
-		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
-		*out = new(int64)
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxIdleConnectionsPercent != nil {
-		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
-		*out = new(int64)
+	if in.SwitchoverDetails != nil {
+		in, out := &in.SwitchoverDetails, &out.SwitchoverDetails
+		*out = make([]*SwitchoverDetail, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SwitchoverDetail)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
 		**out = **in
 	}
-	if in.SessionPinningFilters != nil {
-		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
-		*out = make([]*string, len(*in))
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]*BlueGreenDeploymentTask, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(BlueGreenDeploymentTask)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfigurationInfo.
-func (in *ConnectionPoolConfigurationInfo) DeepCopy() *ConnectionPoolConfigurationInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentStatus.
+func (in *BlueGreenDeploymentStatus) DeepCopy() *BlueGreenDeploymentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolConfigurationInfo)
+	out := new(BlueGreenDeploymentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomDBEngineVersionAMI) DeepCopyInto(out *CustomDBEngineVersionAMI) {
+func (in *BlueGreenDeploymentTask) DeepCopyInto(out *BlueGreenDeploymentTask) {
 	*out = *in
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
@@ -428,486 +429,511 @@ func (in *CustomDBEngineVersionAMI) DeepCopyInto(out *CustomDBEngineVersionAMI)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersionAMI.
-func (in *CustomDBEngineVersionAMI) DeepCopy() *CustomDBEngineVersionAMI {
-	if in == nil {
-		return nil
-	}
-	out := new(CustomDBEngineVersionAMI)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBCluster) DeepCopyInto(out *DBCluster) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster.
-func (in *DBCluster) DeepCopy() *DBCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentTask.
+func (in *BlueGreenDeploymentTask) DeepCopy() *BlueGreenDeploymentTask {
 	if in == nil {
 		return nil
 	}
-	out := new(DBCluster)
+	out := new(BlueGreenDeploymentTask)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterEndpoint) DeepCopyInto(out *DBClusterEndpoint) {
+func (in *BlueGreenDeployment_SDK) DeepCopyInto(out *BlueGreenDeployment_SDK) {
 	*out = *in
-	if in.CustomEndpointType != nil {
-		in, out := &in.CustomEndpointType, &out.CustomEndpointType
+	if in.BlueGreenDeploymentIdentifier != nil {
+		in, out := &in.BlueGreenDeploymentIdentifier, &out.BlueGreenDeploymentIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterEndpointARN != nil {
-		in, out := &in.DBClusterEndpointARN, &out.DBClusterEndpointARN
+	if in.BlueGreenDeploymentName != nil {
+		in, out := &in.BlueGreenDeploymentName, &out.BlueGreenDeploymentName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterEndpointIdentifier != nil {
-		in, out := &in.DBClusterEndpointIdentifier, &out.DBClusterEndpointIdentifier
-		*out = new(string)
-		**out = **in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
 	}
-	if in.DBClusterEndpointResourceIdentifier != nil {
-		in, out := &in.DBClusterEndpointResourceIdentifier, &out.DBClusterEndpointResourceIdentifier
-		*out = new(string)
-		**out = **in
+	if in.DeleteTime != nil {
+		in, out := &in.DeleteTime, &out.DeleteTime
+		*out = (*in).DeepCopy()
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
 		*out = new(string)
 		**out = **in
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.EndpointType != nil {
-		in, out := &in.EndpointType, &out.EndpointType
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExcludedMembers != nil {
-		in, out := &in.ExcludedMembers, &out.ExcludedMembers
-		*out = make([]*string, len(*in))
+	if in.SwitchoverDetails != nil {
+		in, out := &in.SwitchoverDetails, &out.SwitchoverDetails
+		*out = make([]*SwitchoverDetail, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(SwitchoverDetail)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.StaticMembers != nil {
-		in, out := &in.StaticMembers, &out.StaticMembers
-		*out = make([]*string, len(*in))
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]*BlueGreenDeploymentTask, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(BlueGreenDeploymentTask)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpoint.
-func (in *DBClusterEndpoint) DeepCopy() *DBClusterEndpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment_SDK.
+func (in *BlueGreenDeployment_SDK) DeepCopy() *BlueGreenDeployment_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterEndpoint)
+	out := new(BlueGreenDeployment_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterList) DeepCopyInto(out *DBClusterList) {
+func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DBCluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterList.
-func (in *DBClusterList) DeepCopy() *DBClusterList {
-	if in == nil {
-		return nil
-	}
-	out := new(DBClusterList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBClusterList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.CertificateARN != nil {
+		in, out := &in.CertificateARN, &out.CertificateARN
+		*out = new(string)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterMember) DeepCopyInto(out *DBClusterMember) {
-	*out = *in
-	if in.DBClusterParameterGroupStatus != nil {
-		in, out := &in.DBClusterParameterGroupStatus, &out.DBClusterParameterGroupStatus
+	if in.CertificateIdentifier != nil {
+		in, out := &in.CertificateIdentifier, &out.CertificateIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+	if in.CertificateType != nil {
+		in, out := &in.CertificateType, &out.CertificateType
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsClusterWriter != nil {
-		in, out := &in.IsClusterWriter, &out.IsClusterWriter
+	if in.CustomerOverride != nil {
+		in, out := &in.CustomerOverride, &out.CustomerOverride
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PromotionTier != nil {
-		in, out := &in.PromotionTier, &out.PromotionTier
-		*out = new(int64)
+	if in.CustomerOverrideValidTill != nil {
+		in, out := &in.CustomerOverrideValidTill, &out.CustomerOverrideValidTill
+		*out = (*in).DeepCopy()
+	}
+	if in.Thumbprint != nil {
+		in, out := &in.Thumbprint, &out.Thumbprint
+		*out = new(string)
 		**out = **in
 	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidTill != nil {
+		in, out := &in.ValidTill, &out.ValidTill
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterMember.
-func (in *DBClusterMember) DeepCopy() *DBClusterMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterMember)
+	out := new(Certificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterOptionGroupStatus) DeepCopyInto(out *DBClusterOptionGroupStatus) {
+func (in *CertificateDetails) DeepCopyInto(out *CertificateDetails) {
 	*out = *in
-	if in.DBClusterOptionGroupName != nil {
-		in, out := &in.DBClusterOptionGroupName, &out.DBClusterOptionGroupName
+	if in.CAIdentifier != nil {
+		in, out := &in.CAIdentifier, &out.CAIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
+	if in.ValidTill != nil {
+		in, out := &in.ValidTill, &out.ValidTill
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterOptionGroupStatus.
-func (in *DBClusterOptionGroupStatus) DeepCopy() *DBClusterOptionGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDetails.
+func (in *CertificateDetails) DeepCopy() *CertificateDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterOptionGroupStatus)
+	out := new(CertificateDetails)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterParameterGroup) DeepCopyInto(out *DBClusterParameterGroup) {
+func (in *CharacterSet) DeepCopyInto(out *CharacterSet) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.CharacterSetDescription != nil {
+		in, out := &in.CharacterSetDescription, &out.CharacterSetDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroup.
-func (in *DBClusterParameterGroup) DeepCopy() *DBClusterParameterGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CharacterSet.
+func (in *CharacterSet) DeepCopy() *CharacterSet {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterParameterGroup)
+	out := new(CharacterSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBClusterParameterGroup) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterParameterGroupList) DeepCopyInto(out *DBClusterParameterGroupList) {
+func (in *CloudwatchLogsExportConfiguration) DeepCopyInto(out *CloudwatchLogsExportConfiguration) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DBClusterParameterGroup, len(*in))
+	if in.DisableLogTypes != nil {
+		in, out := &in.DisableLogTypes, &out.DisableLogTypes
+		*out = make([]*string, len(*in))
 		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.EnableLogTypes != nil {
+		in, out := &in.EnableLogTypes, &out.EnableLogTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupList.
-func (in *DBClusterParameterGroupList) DeepCopy() *DBClusterParameterGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudwatchLogsExportConfiguration.
+func (in *CloudwatchLogsExportConfiguration) DeepCopy() *CloudwatchLogsExportConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterParameterGroupList)
+	out := new(CloudwatchLogsExportConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBClusterParameterGroupList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterParameterGroupSpec) DeepCopyInto(out *DBClusterParameterGroupSpec) {
+func (in *ClusterPendingModifiedValues) DeepCopyInto(out *ClusterPendingModifiedValues) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Family != nil {
-		in, out := &in.Family, &out.Family
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.ParameterOverrides != nil {
-		in, out := &in.ParameterOverrides, &out.ParameterOverrides
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]*Parameter, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Parameter)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.MasterUserPassword != nil {
+		in, out := &in.MasterUserPassword, &out.MasterUserPassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingCloudwatchLogsExports != nil {
+		in, out := &in.PendingCloudwatchLogsExports, &out.PendingCloudwatchLogsExports
+		*out = new(PendingCloudwatchLogsExports)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupSpec.
-func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPendingModifiedValues.
+func (in *ClusterPendingModifiedValues) DeepCopy() *ClusterPendingModifiedValues {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterParameterGroupSpec)
+	out := new(ClusterPendingModifiedValues)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
+func (in *ConnectionPoolConfiguration) DeepCopyInto(out *ConnectionPoolConfiguration) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
+	if in.ConnectionBorrowTimeout != nil {
+		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
+		*out = new(int64)
+		**out = **in
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]*corev1alpha1.Condition, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.Condition)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.InitQuery != nil {
+		in, out := &in.InitQuery, &out.InitQuery
+		*out = new(string)
+		**out = **in
 	}
-	if in.ParameterOverrideStatuses != nil {
-		in, out := &in.ParameterOverrideStatuses, &out.ParameterOverrideStatuses
-		*out = make([]*Parameter, len(*in))
+	if in.MaxConnectionsPercent != nil {
+		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxIdleConnectionsPercent != nil {
+		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SessionPinningFilters != nil {
+		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Parameter)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupStatus.
-func (in *DBClusterParameterGroupStatus) DeepCopy() *DBClusterParameterGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfiguration.
+func (in *ConnectionPoolConfiguration) DeepCopy() *ConnectionPoolConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterParameterGroupStatus)
+	out := new(ConnectionPoolConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterParameterGroup_SDK) DeepCopyInto(out *DBClusterParameterGroup_SDK) {
+func (in *ConnectionPoolConfigurationInfo) DeepCopyInto(out *ConnectionPoolConfigurationInfo) {
 	*out = *in
-	if in.DBClusterParameterGroupARN != nil {
-		in, out := &in.DBClusterParameterGroupARN, &out.DBClusterParameterGroupARN
-		*out = new(string)
+	if in.ConnectionBorrowTimeout != nil {
+		in, out := &in.ConnectionBorrowTimeout, &out.ConnectionBorrowTimeout
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DBClusterParameterGroupName != nil {
-		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+	if in.InitQuery != nil {
+		in, out := &in.InitQuery, &out.InitQuery
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroupFamily != nil {
-		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
-		*out = new(string)
+	if in.MaxConnectionsPercent != nil {
+		in, out := &in.MaxConnectionsPercent, &out.MaxConnectionsPercent
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.MaxIdleConnectionsPercent != nil {
+		in, out := &in.MaxIdleConnectionsPercent, &out.MaxIdleConnectionsPercent
+		*out = new(int64)
 		**out = **in
 	}
+	if in.SessionPinningFilters != nil {
+		in, out := &in.SessionPinningFilters, &out.SessionPinningFilters
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroup_SDK.
-func (in *DBClusterParameterGroup_SDK) DeepCopy() *DBClusterParameterGroup_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolConfigurationInfo.
+func (in *ConnectionPoolConfigurationInfo) DeepCopy() *ConnectionPoolConfigurationInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterParameterGroup_SDK)
+	out := new(ConnectionPoolConfigurationInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterRole) DeepCopyInto(out *DBClusterRole) {
+func (in *ConnectionSecret) DeepCopyInto(out *ConnectionSecret) {
 	*out = *in
-	if in.FeatureName != nil {
-		in, out := &in.FeatureName, &out.FeatureName
-		*out = new(string)
-		**out = **in
-	}
-	if in.RoleARN != nil {
-		in, out := &in.RoleARN, &out.RoleARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterRole.
-func (in *DBClusterRole) DeepCopy() *DBClusterRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecret.
+func (in *ConnectionSecret) DeepCopy() *ConnectionSecret {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterRole)
+	out := new(ConnectionSecret)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
+func (in *CustomDBEngineVersion) DeepCopyInto(out *CustomDBEngineVersion) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersion.
+func (in *CustomDBEngineVersion) DeepCopy() *CustomDBEngineVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDBEngineVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomDBEngineVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDBEngineVersionAMI) DeepCopyInto(out *CustomDBEngineVersionAMI) {
+	*out = *in
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
 		**out = **in
 	}
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
-		*out = make([]*string, len(*in))
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersionAMI.
+func (in *CustomDBEngineVersionAMI) DeepCopy() *CustomDBEngineVersionAMI {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDBEngineVersionAMI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDBEngineVersionList) DeepCopyInto(out *CustomDBEngineVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomDBEngineVersion, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ClusterCreateTime != nil {
-		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersionList.
+func (in *CustomDBEngineVersionList) DeepCopy() *CustomDBEngineVersionList {
+	if in == nil {
+		return nil
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
-		*out = new(string)
-		**out = **in
+	out := new(CustomDBEngineVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomDBEngineVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.DBClusterSnapshotARN != nil {
-		in, out := &in.DBClusterSnapshotARN, &out.DBClusterSnapshotARN
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDBEngineVersionSpec) DeepCopyInto(out *CustomDBEngineVersionSpec) {
+	*out = *in
+	if in.DatabaseInstallationFilesS3BucketName != nil {
+		in, out := &in.DatabaseInstallationFilesS3BucketName, &out.DatabaseInstallationFilesS3BucketName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterSnapshotIdentifier != nil {
-		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+	if in.DatabaseInstallationFilesS3Prefix != nil {
+		in, out := &in.DatabaseInstallationFilesS3Prefix, &out.DatabaseInstallationFilesS3Prefix
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSystemID != nil {
-		in, out := &in.DBSystemID, &out.DBSystemID
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
@@ -916,19 +942,14 @@ func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineMode != nil {
-		in, out := &in.EngineMode, &out.EngineMode
-		*out = new(string)
-		**out = **in
-	}
 	if in.EngineVersion != nil {
 		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
 		**out = **in
 	}
 	if in.KMSKeyID != nil {
@@ -936,52 +957,23 @@ func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
-		*out = new(string)
-		**out = **in
-	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
-		*out = new(string)
-		**out = **in
-	}
-	if in.PercentProgress != nil {
-		in, out := &in.PercentProgress, &out.PercentProgress
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SnapshotCreateTime != nil {
-		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.SnapshotType != nil {
-		in, out := &in.SnapshotType, &out.SnapshotType
-		*out = new(string)
-		**out = **in
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SourceDBClusterSnapshotARN != nil {
-		in, out := &in.SourceDBClusterSnapshotARN, &out.SourceDBClusterSnapshotARN
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.SourceCustomDBEngineVersionIdentifier != nil {
+		in, out := &in.SourceCustomDBEngineVersionIdentifier, &out.SourceCustomDBEngineVersionIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -991,78 +983,204 @@ func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
 			}
 		}
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
+	if in.UseAWSProvidedLatestImage != nil {
+		in, out := &in.UseAWSProvidedLatestImage, &out.UseAWSProvidedLatestImage
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshot.
-func (in *DBClusterSnapshot) DeepCopy() *DBClusterSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersionSpec.
+func (in *CustomDBEngineVersionSpec) DeepCopy() *CustomDBEngineVersionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterSnapshot)
+	out := new(CustomDBEngineVersionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterSnapshotAttribute) DeepCopyInto(out *DBClusterSnapshotAttribute) {
+func (in *CustomDBEngineVersionStatus) DeepCopyInto(out *CustomDBEngineVersionStatus) {
 	*out = *in
-	if in.AttributeName != nil {
-		in, out := &in.AttributeName, &out.AttributeName
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomDBEngineVersionManifest != nil {
+		in, out := &in.CustomDBEngineVersionManifest, &out.CustomDBEngineVersionManifest
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBEngineDescription != nil {
+		in, out := &in.DBEngineDescription, &out.DBEngineDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBEngineMediaType != nil {
+		in, out := &in.DBEngineMediaType, &out.DBEngineMediaType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupFamily != nil {
+		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(CustomDBEngineVersionAMI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotAttribute.
-func (in *DBClusterSnapshotAttribute) DeepCopy() *DBClusterSnapshotAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDBEngineVersionStatus.
+func (in *CustomDBEngineVersionStatus) DeepCopy() *CustomDBEngineVersionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterSnapshotAttribute)
+	out := new(CustomDBEngineVersionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterSnapshotAttributesResult) DeepCopyInto(out *DBClusterSnapshotAttributesResult) {
+func (in *DBCluster) DeepCopyInto(out *DBCluster) {
 	*out = *in
-	if in.DBClusterSnapshotIdentifier != nil {
-		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotAttributesResult.
-func (in *DBClusterSnapshotAttributesResult) DeepCopy() *DBClusterSnapshotAttributesResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster.
+func (in *DBCluster) DeepCopy() *DBCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterSnapshotAttributesResult)
+	out := new(DBCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
+func (in *DBClusterEndpoint) DeepCopyInto(out *DBClusterEndpoint) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpoint.
+func (in *DBClusterEndpoint) DeepCopy() *DBClusterEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterEndpointList) DeepCopyInto(out *DBClusterEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBClusterEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpointList.
+func (in *DBClusterEndpointList) DeepCopy() *DBClusterEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterEndpointSpec) DeepCopyInto(out *DBClusterEndpointSpec) {
+	*out = *in
+	if in.DBClusterEndpointIdentifier != nil {
+		in, out := &in.DBClusterEndpointIdentifier, &out.DBClusterEndpointIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.AutoMinorVersionUpgrade != nil {
-		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
-		*out = new(bool)
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+	if in.DBClusterRef != nil {
+		in, out := &in.DBClusterRef, &out.DBClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EndpointType != nil {
+		in, out := &in.EndpointType, &out.EndpointType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludedMembers != nil {
+		in, out := &in.ExcludedMembers, &out.ExcludedMembers
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -1072,88 +1190,148 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 			}
 		}
 	}
-	if in.BacktrackWindow != nil {
-		in, out := &in.BacktrackWindow, &out.BacktrackWindow
-		*out = new(int64)
-		**out = **in
+	if in.ExcludedMemberRefs != nil {
+		in, out := &in.ExcludedMemberRefs, &out.ExcludedMemberRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
-		*out = new(int64)
-		**out = **in
+	if in.StaticMembers != nil {
+		in, out := &in.StaticMembers, &out.StaticMembers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.CharacterSetName != nil {
-		in, out := &in.CharacterSetName, &out.CharacterSetName
-		*out = new(string)
-		**out = **in
+	if in.StaticMemberRefs != nil {
+		in, out := &in.StaticMemberRefs, &out.StaticMemberRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.CopyTagsToSnapshot != nil {
-		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
-		*out = new(bool)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpointSpec.
+func (in *DBClusterEndpointSpec) DeepCopy() *DBClusterEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterEndpointStatus) DeepCopyInto(out *DBClusterEndpointStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CustomEndpointType != nil {
+		in, out := &in.CustomEndpointType, &out.CustomEndpointType
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterInstanceClass != nil {
-		in, out := &in.DBClusterInstanceClass, &out.DBClusterInstanceClass
+	if in.DBClusterEndpointResourceIdentifier != nil {
+		in, out := &in.DBClusterEndpointResourceIdentifier, &out.DBClusterEndpointResourceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterParameterGroupName != nil {
-		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterParameterGroupRef != nil {
-		in, out := &in.DBClusterParameterGroupRef, &out.DBClusterParameterGroupRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DBSubnetGroupName != nil {
-		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupRef != nil {
-		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpointStatus.
+func (in *DBClusterEndpointStatus) DeepCopy() *DBClusterEndpointStatus {
+	if in == nil {
+		return nil
 	}
-	if in.DBSystemID != nil {
-		in, out := &in.DBSystemID, &out.DBSystemID
+	out := new(DBClusterEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterEndpoint_SDK) DeepCopyInto(out *DBClusterEndpoint_SDK) {
+	*out = *in
+	if in.CustomEndpointType != nil {
+		in, out := &in.CustomEndpointType, &out.CustomEndpointType
 		*out = new(string)
 		**out = **in
 	}
-	if in.DatabaseName != nil {
-		in, out := &in.DatabaseName, &out.DatabaseName
+	if in.DBClusterEndpointARN != nil {
+		in, out := &in.DBClusterEndpointARN, &out.DBClusterEndpointARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
-		*out = new(bool)
+	if in.DBClusterEndpointIdentifier != nil {
+		in, out := &in.DBClusterEndpointIdentifier, &out.DBClusterEndpointIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.DestinationRegion != nil {
-		in, out := &in.DestinationRegion, &out.DestinationRegion
+	if in.DBClusterEndpointResourceIdentifier != nil {
+		in, out := &in.DBClusterEndpointResourceIdentifier, &out.DBClusterEndpointResourceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.DomainIAMRoleName != nil {
-		in, out := &in.DomainIAMRoleName, &out.DomainIAMRoleName
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(string)
 		**out = **in
 	}
-	if in.EnableCloudwatchLogsExports != nil {
-		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
+	if in.ExcludedMembers != nil {
+		in, out := &in.ExcludedMembers, &out.ExcludedMembers
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -1163,19 +1341,1149 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 			}
 		}
 	}
-	if in.EnableGlobalWriteForwarding != nil {
-		in, out := &in.EnableGlobalWriteForwarding, &out.EnableGlobalWriteForwarding
-		*out = new(bool)
+	if in.StaticMembers != nil {
+		in, out := &in.StaticMembers, &out.StaticMembers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.EnableHTTPEndpoint != nil {
-		in, out := &in.EnableHTTPEndpoint, &out.EnableHTTPEndpoint
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterEndpoint_SDK.
+func (in *DBClusterEndpoint_SDK) DeepCopy() *DBClusterEndpoint_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterEndpoint_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterFailoverRecord) DeepCopyInto(out *DBClusterFailoverRecord) {
+	*out = *in
+	if in.FromDBInstanceIdentifier != nil {
+		in, out := &in.FromDBInstanceIdentifier, &out.FromDBInstanceIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.EnableIAMDatabaseAuthentication != nil {
-		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
-		*out = new(bool)
+	if in.TargetDBInstanceIdentifier != nil {
+		in, out := &in.TargetDBInstanceIdentifier, &out.TargetDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailedOverAt != nil {
+		in, out := &in.FailedOverAt, &out.FailedOverAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterFailoverRecord.
+func (in *DBClusterFailoverRecord) DeepCopy() *DBClusterFailoverRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterFailoverRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterInstances) DeepCopyInto(out *DBClusterInstances) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.PromotionTiers != nil {
+		in, out := &in.PromotionTiers, &out.PromotionTiers
+		*out = make([]*int64, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(int64)
+				**out = **in
+			}
+		}
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterInstances.
+func (in *DBClusterInstances) DeepCopy() *DBClusterInstances {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterInstances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterList) DeepCopyInto(out *DBClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterList.
+func (in *DBClusterList) DeepCopy() *DBClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterMember) DeepCopyInto(out *DBClusterMember) {
+	*out = *in
+	if in.DBClusterParameterGroupStatus != nil {
+		in, out := &in.DBClusterParameterGroupStatus, &out.DBClusterParameterGroupStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsClusterWriter != nil {
+		in, out := &in.IsClusterWriter, &out.IsClusterWriter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PromotionTier != nil {
+		in, out := &in.PromotionTier, &out.PromotionTier
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterMember.
+func (in *DBClusterMember) DeepCopy() *DBClusterMember {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterOptionGroupStatus) DeepCopyInto(out *DBClusterOptionGroupStatus) {
+	*out = *in
+	if in.DBClusterOptionGroupName != nil {
+		in, out := &in.DBClusterOptionGroupName, &out.DBClusterOptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterOptionGroupStatus.
+func (in *DBClusterOptionGroupStatus) DeepCopy() *DBClusterOptionGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterOptionGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroup) DeepCopyInto(out *DBClusterParameterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroup.
+func (in *DBClusterParameterGroup) DeepCopy() *DBClusterParameterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupList) DeepCopyInto(out *DBClusterParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBClusterParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupList.
+func (in *DBClusterParameterGroupList) DeepCopy() *DBClusterParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupSpec) DeepCopyInto(out *DBClusterParameterGroupSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceParameterGroupName != nil {
+		in, out := &in.InstanceParameterGroupName, &out.InstanceParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceParameterGroupRef != nil {
+		in, out := &in.InstanceParameterGroupRef, &out.InstanceParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParameterOverrides != nil {
+		in, out := &in.ParameterOverrides, &out.ParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ParameterProfile != nil {
+		in, out := &in.ParameterProfile, &out.ParameterProfile
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]*Parameter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Parameter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupSpec.
+func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AppliedParameterOverrides != nil {
+		in, out := &in.AppliedParameterOverrides, &out.AppliedParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.DriftedParameters != nil {
+		in, out := &in.DriftedParameters, &out.DriftedParameters
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ParameterApplyHistory != nil {
+		in, out := &in.ParameterApplyHistory, &out.ParameterApplyHistory
+		*out = make([]*ParameterApplyRecord, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ParameterApplyRecord)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ParameterOverrideStatuses != nil {
+		in, out := &in.ParameterOverrideStatuses, &out.ParameterOverrideStatuses
+		*out = make([]*Parameter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Parameter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ParameterSources != nil {
+		in, out := &in.ParameterSources, &out.ParameterSources
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupStatus.
+func (in *DBClusterParameterGroupStatus) DeepCopy() *DBClusterParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterParameterGroup_SDK) DeepCopyInto(out *DBClusterParameterGroup_SDK) {
+	*out = *in
+	if in.DBClusterParameterGroupARN != nil {
+		in, out := &in.DBClusterParameterGroupARN, &out.DBClusterParameterGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupName != nil {
+		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupFamily != nil {
+		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroup_SDK.
+func (in *DBClusterParameterGroup_SDK) DeepCopy() *DBClusterParameterGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterParameterGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterRestoreToPointInTime) DeepCopyInto(out *DBClusterRestoreToPointInTime) {
+	*out = *in
+	if in.SourceDBClusterIdentifier != nil {
+		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBClusterResourceID != nil {
+		in, out := &in.SourceDBClusterResourceID, &out.SourceDBClusterResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreType != nil {
+		in, out := &in.RestoreType, &out.RestoreType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterRestoreToPointInTime.
+func (in *DBClusterRestoreToPointInTime) DeepCopy() *DBClusterRestoreToPointInTime {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterRestoreToPointInTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterRole) DeepCopyInto(out *DBClusterRole) {
+	*out = *in
+	if in.FeatureName != nil {
+		in, out := &in.FeatureName, &out.FeatureName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterRole.
+func (in *DBClusterRole) DeepCopy() *DBClusterRole {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshot) DeepCopyInto(out *DBClusterSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshot.
+func (in *DBClusterSnapshot) DeepCopy() *DBClusterSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshotAttribute) DeepCopyInto(out *DBClusterSnapshotAttribute) {
+	*out = *in
+	if in.AttributeName != nil {
+		in, out := &in.AttributeName, &out.AttributeName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotAttribute.
+func (in *DBClusterSnapshotAttribute) DeepCopy() *DBClusterSnapshotAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshotAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshotAttributesResult) DeepCopyInto(out *DBClusterSnapshotAttributesResult) {
+	*out = *in
+	if in.DBClusterSnapshotIdentifier != nil {
+		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotAttributesResult.
+func (in *DBClusterSnapshotAttributesResult) DeepCopy() *DBClusterSnapshotAttributesResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshotAttributesResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshotList) DeepCopyInto(out *DBClusterSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBClusterSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotList.
+func (in *DBClusterSnapshotList) DeepCopy() *DBClusterSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBClusterSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshotSpec) DeepCopyInto(out *DBClusterSnapshotSpec) {
+	*out = *in
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterRef != nil {
+		in, out := &in.DBClusterRef, &out.DBClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBClusterSnapshotIdentifier != nil {
+		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedAccountIDs != nil {
+		in, out := &in.SharedAccountIDs, &out.SharedAccountIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotSpec.
+func (in *DBClusterSnapshotSpec) DeepCopy() *DBClusterSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshotStatus) DeepCopyInto(out *DBClusterSnapshotStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ClusterCreateTime != nil {
+		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterResourceID != nil {
+		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotType != nil {
+		in, out := &in.SnapshotType, &out.SnapshotType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBClusterSnapshotARN != nil {
+		in, out := &in.SourceDBClusterSnapshotARN, &out.SourceDBClusterSnapshotARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshotStatus.
+func (in *DBClusterSnapshotStatus) DeepCopy() *DBClusterSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSnapshot_SDK) DeepCopyInto(out *DBClusterSnapshot_SDK) {
+	*out = *in
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ClusterCreateTime != nil {
+		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterSnapshotARN != nil {
+		in, out := &in.DBClusterSnapshotARN, &out.DBClusterSnapshotARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterSnapshotIdentifier != nil {
+		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterResourceID != nil {
+		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotType != nil {
+		in, out := &in.SnapshotType, &out.SnapshotType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBClusterSnapshotARN != nil {
+		in, out := &in.SourceDBClusterSnapshotARN, &out.SourceDBClusterSnapshotARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSnapshot_SDK.
+func (in *DBClusterSnapshot_SDK) DeepCopy() *DBClusterSnapshot_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSnapshot_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
+	*out = *in
+	if in.ActivityStream != nil {
+		in, out := &in.ActivityStream, &out.ActivityStream
+		*out = new(ActivityStream)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.BacktrackWindow != nil {
+		in, out := &in.BacktrackWindow, &out.BacktrackWindow
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CABundleConfigMap != nil {
+		in, out := &in.CABundleConfigMap, &out.CABundleConfigMap
+		*out = new(string)
+		**out = **in
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterInstanceClass != nil {
+		in, out := &in.DBClusterInstanceClass, &out.DBClusterInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupName != nil {
+		in, out := &in.DBClusterParameterGroupName, &out.DBClusterParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterParameterGroupRef != nil {
+		in, out := &in.DBClusterParameterGroupRef, &out.DBClusterParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupRef != nil {
+		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DestinationRegion != nil {
+		in, out := &in.DestinationRegion, &out.DestinationRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainIAMRoleName != nil {
+		in, out := &in.DomainIAMRoleName, &out.DomainIAMRoleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableCloudwatchLogsExports != nil {
+		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.EnableGlobalWriteForwarding != nil {
+		in, out := &in.EnableGlobalWriteForwarding, &out.EnableGlobalWriteForwarding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTPEndpoint != nil {
+		in, out := &in.EnableHTTPEndpoint, &out.EnableHTTPEndpoint
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIAMDatabaseAuthentication != nil {
+		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableLocalWriteForwarding != nil {
+		in, out := &in.EnableLocalWriteForwarding, &out.EnableLocalWriteForwarding
+		*out = new(bool)
 		**out = **in
 	}
 	if in.EnablePerformanceInsights != nil {
@@ -1183,59 +2491,2603 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
+	if in.EnableServiceBinding != nil {
+		in, out := &in.EnableServiceBinding, &out.EnableServiceBinding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EndpointService != nil {
+		in, out := &in.EndpointService, &out.EndpointService
+		*out = new(EndpointService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FinalSnapshotIdentifierTemplate != nil {
+		in, out := &in.FinalSnapshotIdentifierTemplate, &out.FinalSnapshotIdentifierTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalClusterIdentifier != nil {
+		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalClusterRef != nil {
+		in, out := &in.GlobalClusterRef, &out.GlobalClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = new(DBClusterInstances)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManageMasterUserPassword != nil {
+		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserPassword != nil {
+		in, out := &in.MasterUserPassword, &out.MasterUserPassword
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.MasterUserPasswordSecretARN != nil {
+		in, out := &in.MasterUserPasswordSecretARN, &out.MasterUserPasswordSecretARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecretDestination != nil {
+		in, out := &in.MasterUserSecretDestination, &out.MasterUserSecretDestination
+		*out = new(MasterUserSecretDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretKMSKeyID != nil {
+		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecretKMSKeyRef != nil {
+		in, out := &in.MasterUserSecretKMSKeyRef, &out.MasterUserSecretKMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretRotation != nil {
+		in, out := &in.MasterUserSecretRotation, &out.MasterUserSecretRotation
+		*out = new(MasterUserSecretRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitoringInterval != nil {
+		in, out := &in.MonitoringInterval, &out.MonitoringInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitoringRoleARN != nil {
+		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkType != nil {
+		in, out := &in.NetworkType, &out.NetworkType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsKMSKeyID != nil {
+		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsRetentionPeriod != nil {
+		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreSignedURL != nil {
+		in, out := &in.PreSignedURL, &out.PreSignedURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredBackupWindow != nil {
+		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PubliclyAccessible != nil {
+		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicationSourceIdentifier != nil {
+		in, out := &in.ReplicationSourceIdentifier, &out.ReplicationSourceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreToPointInTime != nil {
+		in, out := &in.RestoreToPointInTime, &out.RestoreToPointInTime
+		*out = new(DBClusterRestoreToPointInTime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SafetySnapshot != nil {
+		in, out := &in.SafetySnapshot, &out.SafetySnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScalingConfiguration != nil {
+		in, out := &in.ScalingConfiguration, &out.ScalingConfiguration
+		*out = new(ScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(StopStartSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerlessV2ScalingConfiguration != nil {
+		in, out := &in.ServerlessV2ScalingConfiguration, &out.ServerlessV2ScalingConfiguration
+		*out = new(ServerlessV2ScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotIdentifier != nil {
+		in, out := &in.SnapshotIdentifier, &out.SnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRegion != nil {
+		in, out := &in.SourceRegion, &out.SourceRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSecurityGroupRefs != nil {
+		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSpec.
+func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ActivityStreamKinesisStreamName != nil {
+		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamKMSKeyID != nil {
+		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamMode != nil {
+		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamStatus != nil {
+		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssociatedRoles != nil {
+		in, out := &in.AssociatedRoles, &out.AssociatedRoles
+		*out = make([]*DBClusterRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutomaticRestartTime != nil {
+		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BacktrackConsumedChangeRecords != nil {
+		in, out := &in.BacktrackConsumedChangeRecords, &out.BacktrackConsumedChangeRecords
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(ServiceBinding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CloneGroupID != nil {
+		in, out := &in.CloneGroupID, &out.CloneGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterCreateTime != nil {
+		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ConnectionSecretHash != nil {
+		in, out := &in.ConnectionSecretHash, &out.ConnectionSecretHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.CrossAccountClone != nil {
+		in, out := &in.CrossAccountClone, &out.CrossAccountClone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomEndpoints != nil {
+		in, out := &in.CustomEndpoints, &out.CustomEndpoints
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.DBClusterMembers != nil {
+		in, out := &in.DBClusterMembers, &out.DBClusterMembers
+		*out = make([]*DBClusterMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBClusterOptionGroupMemberships != nil {
+		in, out := &in.DBClusterOptionGroupMemberships, &out.DBClusterOptionGroupMemberships
+		*out = make([]*DBClusterOptionGroupStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterOptionGroupStatus)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBClusterParameterGroup != nil {
+		in, out := &in.DBClusterParameterGroup, &out.DBClusterParameterGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroup != nil {
+		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterResourceID != nil {
+		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainMemberships != nil {
+		in, out := &in.DomainMemberships, &out.DomainMemberships
+		*out = make([]*DomainMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DomainMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EarliestBacktrackTime != nil {
+		in, out := &in.EarliestBacktrackTime, &out.EarliestBacktrackTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EarliestRestorableTime != nil {
+		in, out := &in.EarliestRestorableTime, &out.EarliestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EnabledCloudwatchLogsExports != nil {
+		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalClusterMembership != nil {
+		in, out := &in.GlobalClusterMembership, &out.GlobalClusterMembership
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalWriteForwardingRequested != nil {
+		in, out := &in.GlobalWriteForwardingRequested, &out.GlobalWriteForwardingRequested
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GlobalWriteForwardingStatus != nil {
+		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPEndpointEnabled != nil {
+		in, out := &in.HTTPEndpointEnabled, &out.HTTPEndpointEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOOptimizedNextAllowedModificationTime != nil {
+		in, out := &in.IOOptimizedNextAllowedModificationTime, &out.IOOptimizedNextAllowedModificationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.InstancesSynced != nil {
+		in, out := &in.InstancesSynced, &out.InstancesSynced
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastBacktrack != nil {
+		in, out := &in.LastBacktrack, &out.LastBacktrack
+		*out = new(BacktrackRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastFailover != nil {
+		in, out := &in.LastFailover, &out.LastFailover
+		*out = new(DBClusterFailoverRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatestRestorableTime != nil {
+		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LocalWriteForwardingStatus != nil {
+		in, out := &in.LocalWriteForwardingStatus, &out.LocalWriteForwardingStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserPasswordHash != nil {
+		in, out := &in.MasterUserPasswordHash, &out.MasterUserPasswordHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserPasswordSecretVersionID != nil {
+		in, out := &in.MasterUserPasswordSecretVersionID, &out.MasterUserPasswordSecretVersionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretLastRotatedDate != nil {
+		in, out := &in.MasterUserSecretLastRotatedDate, &out.MasterUserSecretLastRotatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PendingMaintenanceActions != nil {
+		in, out := &in.PendingMaintenanceActions, &out.PendingMaintenanceActions
+		*out = make([]*PendingMaintenanceAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PendingMaintenanceAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PendingModifiedValues != nil {
+		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
+		*out = new(ClusterPendingModifiedValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsEnabled != nil {
+		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadReplicaIdentifiers != nil {
+		in, out := &in.ReadReplicaIdentifiers, &out.ReadReplicaIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReaderEndpoint != nil {
+		in, out := &in.ReaderEndpoint, &out.ReaderEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.SafetySnapshotIdentifier != nil {
+		in, out := &in.SafetySnapshotIdentifier, &out.SafetySnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerlessV2Capacity != nil {
+		in, out := &in.ServerlessV2Capacity, &out.ServerlessV2Capacity
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportedNetworkTypes != nil {
+		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCSecurityGroups != nil {
+		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
+		*out = make([]*VPCSecurityGroupMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCSecurityGroupMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterStatus.
+func (in *DBClusterStatus) DeepCopy() *DBClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBCluster_SDK) DeepCopyInto(out *DBCluster_SDK) {
+	*out = *in
+	if in.ActivityStreamKinesisStreamName != nil {
+		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamKMSKeyID != nil {
+		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamMode != nil {
+		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamStatus != nil {
+		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AssociatedRoles != nil {
+		in, out := &in.AssociatedRoles, &out.AssociatedRoles
+		*out = make([]*DBClusterRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutomaticRestartTime != nil {
+		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.BacktrackConsumedChangeRecords != nil {
+		in, out := &in.BacktrackConsumedChangeRecords, &out.BacktrackConsumedChangeRecords
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BacktrackWindow != nil {
+		in, out := &in.BacktrackWindow, &out.BacktrackWindow
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CloneGroupID != nil {
+		in, out := &in.CloneGroupID, &out.CloneGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterCreateTime != nil {
+		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CrossAccountClone != nil {
+		in, out := &in.CrossAccountClone, &out.CrossAccountClone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomEndpoints != nil {
+		in, out := &in.CustomEndpoints, &out.CustomEndpoints
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.DBClusterARN != nil {
+		in, out := &in.DBClusterARN, &out.DBClusterARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterInstanceClass != nil {
+		in, out := &in.DBClusterInstanceClass, &out.DBClusterInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterMembers != nil {
+		in, out := &in.DBClusterMembers, &out.DBClusterMembers
+		*out = make([]*DBClusterMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBClusterOptionGroupMemberships != nil {
+		in, out := &in.DBClusterOptionGroupMemberships, &out.DBClusterOptionGroupMemberships
+		*out = make([]*DBClusterOptionGroupStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBClusterOptionGroupStatus)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBClusterParameterGroup != nil {
+		in, out := &in.DBClusterParameterGroup, &out.DBClusterParameterGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroup != nil {
+		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterResourceID != nil {
+		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DomainMemberships != nil {
+		in, out := &in.DomainMemberships, &out.DomainMemberships
+		*out = make([]*DomainMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DomainMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EarliestBacktrackTime != nil {
+		in, out := &in.EarliestBacktrackTime, &out.EarliestBacktrackTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EarliestRestorableTime != nil {
+		in, out := &in.EarliestRestorableTime, &out.EarliestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EnabledCloudwatchLogsExports != nil {
+		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineMode != nil {
+		in, out := &in.EngineMode, &out.EngineMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalWriteForwardingRequested != nil {
+		in, out := &in.GlobalWriteForwardingRequested, &out.GlobalWriteForwardingRequested
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GlobalWriteForwardingStatus != nil {
+		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPEndpointEnabled != nil {
+		in, out := &in.HTTPEndpointEnabled, &out.HTTPEndpointEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LatestRestorableTime != nil {
+		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitoringInterval != nil {
+		in, out := &in.MonitoringInterval, &out.MonitoringInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitoringRoleARN != nil {
+		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkType != nil {
+		in, out := &in.NetworkType, &out.NetworkType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingModifiedValues != nil {
+		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
+		*out = new(ClusterPendingModifiedValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsEnabled != nil {
+		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PerformanceInsightsKMSKeyID != nil {
+		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsRetentionPeriod != nil {
+		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreferredBackupWindow != nil {
+		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PubliclyAccessible != nil {
+		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadReplicaIdentifiers != nil {
+		in, out := &in.ReadReplicaIdentifiers, &out.ReadReplicaIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReaderEndpoint != nil {
+		in, out := &in.ReaderEndpoint, &out.ReaderEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationSourceIdentifier != nil {
+		in, out := &in.ReplicationSourceIdentifier, &out.ReplicationSourceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalingConfigurationInfo != nil {
+		in, out := &in.ScalingConfigurationInfo, &out.ScalingConfigurationInfo
+		*out = new(ScalingConfigurationInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerlessV2ScalingConfiguration != nil {
+		in, out := &in.ServerlessV2ScalingConfiguration, &out.ServerlessV2ScalingConfiguration
+		*out = new(ServerlessV2ScalingConfigurationInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCSecurityGroups != nil {
+		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
+		*out = make([]*VPCSecurityGroupMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCSecurityGroupMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster_SDK.
+func (in *DBCluster_SDK) DeepCopy() *DBCluster_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBCluster_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBEngineVersion) DeepCopyInto(out *DBEngineVersion) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DBEngineDescription != nil {
+		in, out := &in.DBEngineDescription, &out.DBEngineDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBEngineMediaType != nil {
+		in, out := &in.DBEngineMediaType, &out.DBEngineMediaType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBEngineVersionARN != nil {
+		in, out := &in.DBEngineVersionARN, &out.DBEngineVersionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBEngineVersionDescription != nil {
+		in, out := &in.DBEngineVersionDescription, &out.DBEngineVersionDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupFamily != nil {
+		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseInstallationFilesS3BucketName != nil {
+		in, out := &in.DatabaseInstallationFilesS3BucketName, &out.DatabaseInstallationFilesS3BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseInstallationFilesS3Prefix != nil {
+		in, out := &in.DatabaseInstallationFilesS3Prefix, &out.DatabaseInstallationFilesS3Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExportableLogTypes != nil {
+		in, out := &in.ExportableLogTypes, &out.ExportableLogTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportedEngineModes != nil {
+		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SupportsBabelfish != nil {
+		in, out := &in.SupportsBabelfish, &out.SupportsBabelfish
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsCertificateRotationWithoutRestart != nil {
+		in, out := &in.SupportsCertificateRotationWithoutRestart, &out.SupportsCertificateRotationWithoutRestart
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsGlobalDatabases != nil {
+		in, out := &in.SupportsGlobalDatabases, &out.SupportsGlobalDatabases
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsLogExportsToCloudwatchLogs != nil {
+		in, out := &in.SupportsLogExportsToCloudwatchLogs, &out.SupportsLogExportsToCloudwatchLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsParallelQuery != nil {
+		in, out := &in.SupportsParallelQuery, &out.SupportsParallelQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsReadReplica != nil {
+		in, out := &in.SupportsReadReplica, &out.SupportsReadReplica
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBEngineVersion.
+func (in *DBEngineVersion) DeepCopy() *DBEngineVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(DBEngineVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstance) DeepCopyInto(out *DBInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstance.
+func (in *DBInstance) DeepCopy() *DBInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceAutomatedBackup) DeepCopyInto(out *DBInstanceAutomatedBackup) {
+	*out = *in
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackupTarget != nil {
+		in, out := &in.BackupTarget, &out.BackupTarget
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceARN != nil {
+		in, out := &in.DBInstanceARN, &out.DBInstanceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceAutomatedBackupsARN != nil {
+		in, out := &in.DBInstanceAutomatedBackupsARN, &out.DBInstanceAutomatedBackupsARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceAutomatedBackupsReplications != nil {
+		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
+		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceAutomatedBackupsReplication)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageThroughput != nil {
+		in, out := &in.StorageThroughput, &out.StorageThroughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TDECredentialARN != nil {
+		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceAutomatedBackup.
+func (in *DBInstanceAutomatedBackup) DeepCopy() *DBInstanceAutomatedBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceAutomatedBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceAutomatedBackupsReplication) DeepCopyInto(out *DBInstanceAutomatedBackupsReplication) {
+	*out = *in
+	if in.DBInstanceAutomatedBackupsARN != nil {
+		in, out := &in.DBInstanceAutomatedBackupsARN, &out.DBInstanceAutomatedBackupsARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceAutomatedBackupsReplication.
+func (in *DBInstanceAutomatedBackupsReplication) DeepCopy() *DBInstanceAutomatedBackupsReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceAutomatedBackupsReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceList) DeepCopyInto(out *DBInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceList.
+func (in *DBInstanceList) DeepCopy() *DBInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceRole) DeepCopyInto(out *DBInstanceRole) {
+	*out = *in
+	if in.FeatureName != nil {
+		in, out := &in.FeatureName, &out.FeatureName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceRole.
+func (in *DBInstanceRole) DeepCopy() *DBInstanceRole {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
+	*out = *in
+	if in.ActivityStream != nil {
+		in, out := &in.ActivityStream, &out.ActivityStream
+		*out = new(ActivityStream)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutomatedBackupsReplication != nil {
+		in, out := &in.AutomatedBackupsReplication, &out.AutomatedBackupsReplication
+		*out = new(AutomatedBackupsReplication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackupTarget != nil {
+		in, out := &in.BackupTarget, &out.BackupTarget
+		*out = new(string)
+		**out = **in
+	}
+	if in.CABundleConfigMap != nil {
+		in, out := &in.CABundleConfigMap, &out.CABundleConfigMap
+		*out = new(string)
+		**out = **in
+	}
+	if in.CACertificateIdentifier != nil {
+		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomIAMInstanceProfile != nil {
+		in, out := &in.CustomIAMInstanceProfile, &out.CustomIAMInstanceProfile
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBClusterSnapshotIdentifier != nil {
+		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBName != nil {
+		in, out := &in.DBName, &out.DBName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupName != nil {
+		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroupRef != nil {
+		in, out := &in.DBParameterGroupRef, &out.DBParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSnapshotIdentifier != nil {
+		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBSubnetGroupRef != nil {
+		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DedicatedLogVolume != nil {
+		in, out := &in.DedicatedLogVolume, &out.DedicatedLogVolume
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DesiredState != nil {
+		in, out := &in.DesiredState, &out.DesiredState
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationRegion != nil {
+		in, out := &in.DestinationRegion, &out.DestinationRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainAuthSecretARN != nil {
+		in, out := &in.DomainAuthSecretARN, &out.DomainAuthSecretARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainDNSIPs != nil {
+		in, out := &in.DomainDNSIPs, &out.DomainDNSIPs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.DomainFqdn != nil {
+		in, out := &in.DomainFqdn, &out.DomainFqdn
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainIAMRoleName != nil {
+		in, out := &in.DomainIAMRoleName, &out.DomainIAMRoleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainOu != nil {
+		in, out := &in.DomainOu, &out.DomainOu
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableCloudwatchLogsExports != nil {
+		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.EnableCustomerOwnedIP != nil {
+		in, out := &in.EnableCustomerOwnedIP, &out.EnableCustomerOwnedIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIAMDatabaseAuthentication != nil {
+		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableServiceBinding != nil {
+		in, out := &in.EnableServiceBinding, &out.EnableServiceBinding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EndpointService != nil {
+		in, out := &in.EndpointService, &out.EndpointService
+		*out = new(EndpointService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FinalSnapshotIdentifierTemplate != nil {
+		in, out := &in.FinalSnapshotIdentifierTemplate, &out.FinalSnapshotIdentifierTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManageMasterUserPassword != nil {
+		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserPassword != nil {
+		in, out := &in.MasterUserPassword, &out.MasterUserPassword
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.MasterUserPasswordSecretARN != nil {
+		in, out := &in.MasterUserPasswordSecretARN, &out.MasterUserPasswordSecretARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecretDestination != nil {
+		in, out := &in.MasterUserSecretDestination, &out.MasterUserSecretDestination
+		*out = new(MasterUserSecretDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretKMSKeyID != nil {
+		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecretKMSKeyRef != nil {
+		in, out := &in.MasterUserSecretKMSKeyRef, &out.MasterUserSecretKMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretRotation != nil {
+		in, out := &in.MasterUserSecretRotation, &out.MasterUserSecretRotation
+		*out = new(MasterUserSecretRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAllocatedStorage != nil {
+		in, out := &in.MaxAllocatedStorage, &out.MaxAllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitoringInterval != nil {
+		in, out := &in.MonitoringInterval, &out.MonitoringInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitoringRoleARN != nil {
+		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTiming != nil {
+		in, out := &in.ModificationTiming, &out.ModificationTiming
+		*out = new(ModificationTiming)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NcharCharacterSetName != nil {
+		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkType != nil {
+		in, out := &in.NetworkType, &out.NetworkType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionGroupRef != nil {
+		in, out := &in.OptionGroupRef, &out.OptionGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerformanceInsightsEnabled != nil {
+		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PerformanceInsightsKMSKeyID != nil {
+		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceInsightsRetentionPeriod != nil {
+		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreSignedURL != nil {
+		in, out := &in.PreSignedURL, &out.PreSignedURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredBackupWindow != nil {
+		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProcessorFeatures != nil {
+		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
+		*out = make([]*ProcessorFeature, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProcessorFeature)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PromotionTier != nil {
+		in, out := &in.PromotionTier, &out.PromotionTier
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PubliclyAccessible != nil {
+		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicaMode != nil {
+		in, out := &in.ReplicaMode, &out.ReplicaMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreToPointInTime != nil {
+		in, out := &in.RestoreToPointInTime, &out.RestoreToPointInTime
+		*out = new(RestoreToPointInTime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3Restore != nil {
+		in, out := &in.S3Restore, &out.S3Restore
+		*out = new(S3Restore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SafetySnapshot != nil {
+		in, out := &in.SafetySnapshot, &out.SafetySnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(StopStartSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDBInstanceIdentifier != nil {
+		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBInstanceRef != nil {
+		in, out := &in.SourceDBInstanceRef, &out.SourceDBInstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceRegion != nil {
+		in, out := &in.SourceRegion, &out.SourceRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageThroughput != nil {
+		in, out := &in.StorageThroughput, &out.StorageThroughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TDECredentialARN != nil {
+		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.TDECredentialPassword != nil {
+		in, out := &in.TDECredentialPassword, &out.TDECredentialPassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseDefaultProcessorFeatures != nil {
+		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSecurityGroupRefs != nil {
+		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceSpec.
+func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
+		in, out := &in.ActivityStreamEngineNativeAuditFieldsIncluded, &out.ActivityStreamEngineNativeAuditFieldsIncluded
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActivityStreamKinesisStreamName != nil {
+		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamKMSKeyID != nil {
+		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamMode != nil {
+		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamPolicyStatus != nil {
+		in, out := &in.ActivityStreamPolicyStatus, &out.ActivityStreamPolicyStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamStatus != nil {
+		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssociatedRoles != nil {
+		in, out := &in.AssociatedRoles, &out.AssociatedRoles
+		*out = make([]*DBInstanceRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutomatedBackupsReplicationARN != nil {
+		in, out := &in.AutomatedBackupsReplicationARN, &out.AutomatedBackupsReplicationARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutomatedBackupsReplicationRetentionPeriod != nil {
+		in, out := &in.AutomatedBackupsReplicationRetentionPeriod, &out.AutomatedBackupsReplicationRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutomatedBackupsReplicationStatus != nil {
+		in, out := &in.AutomatedBackupsReplicationStatus, &out.AutomatedBackupsReplicationStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutomaticRestartTime != nil {
+		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AutomationMode != nil {
+		in, out := &in.AutomationMode, &out.AutomationMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AWSBackupRecoveryPointARN != nil {
+		in, out := &in.AWSBackupRecoveryPointARN, &out.AWSBackupRecoveryPointARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(ServiceBinding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateDetails != nil {
+		in, out := &in.CertificateDetails, &out.CertificateDetails
+		*out = new(CertificateDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionSecretHash != nil {
+		in, out := &in.ConnectionSecretHash, &out.ConnectionSecretHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.CurrentAllocatedStorage != nil {
+		in, out := &in.CurrentAllocatedStorage, &out.CurrentAllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CustomerOwnedIPEnabled != nil {
+		in, out := &in.CustomerOwnedIPEnabled, &out.CustomerOwnedIPEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DBInstanceAutomatedBackupsReplications != nil {
+		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
+		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceAutomatedBackupsReplication)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBInstanceStatus != nil {
+		in, out := &in.DBInstanceStatus, &out.DBInstanceStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroups != nil {
+		in, out := &in.DBParameterGroups, &out.DBParameterGroups
+		*out = make([]*DBParameterGroupStatus_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBParameterGroupStatus_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBSubnetGroup != nil {
+		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
+		*out = new(DBSubnetGroup_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBInstancePort != nil {
+		in, out := &in.DBInstancePort, &out.DBInstancePort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainMemberships != nil {
+		in, out := &in.DomainMemberships, &out.DomainMemberships
+		*out = make([]*DomainMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DomainMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EnabledCloudwatchLogsExports != nil {
+		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnhancedMonitoringResourceARN != nil {
+		in, out := &in.EnhancedMonitoringResourceARN, &out.EnhancedMonitoringResourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReboot != nil {
+		in, out := &in.LastReboot, &out.LastReboot
+		*out = new(RebootRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatestRestorableTime != nil {
+		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ListenerEndpoint != nil {
+		in, out := &in.ListenerEndpoint, &out.ListenerEndpoint
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserPasswordHash != nil {
+		in, out := &in.MasterUserPasswordHash, &out.MasterUserPasswordHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserPasswordSecretVersionID != nil {
+		in, out := &in.MasterUserPasswordSecretVersionID, &out.MasterUserPasswordSecretVersionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecretLastRotatedDate != nil {
+		in, out := &in.MasterUserSecretLastRotatedDate, &out.MasterUserSecretLastRotatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.OptionGroupMemberships != nil {
+		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
+		*out = make([]*OptionGroupMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OptionGroupMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PendingMaintenanceActions != nil {
+		in, out := &in.PendingMaintenanceActions, &out.PendingMaintenanceActions
+		*out = make([]*PendingMaintenanceAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PendingMaintenanceAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PendingModifiedValues != nil {
+		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
+		*out = new(PendingModifiedValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadReplicaDBClusterIdentifiers != nil {
+		in, out := &in.ReadReplicaDBClusterIdentifiers, &out.ReadReplicaDBClusterIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReadReplicaDBInstanceIdentifiers != nil {
+		in, out := &in.ReadReplicaDBInstanceIdentifiers, &out.ReadReplicaDBInstanceIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReadReplicaSourceDBClusterIdentifier != nil {
+		in, out := &in.ReadReplicaSourceDBClusterIdentifier, &out.ReadReplicaSourceDBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadReplicaSourceDBInstanceIdentifier != nil {
+		in, out := &in.ReadReplicaSourceDBInstanceIdentifier, &out.ReadReplicaSourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResumeFullAutomationModeTime != nil {
+		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SafetySnapshotIdentifier != nil {
+		in, out := &in.SafetySnapshotIdentifier, &out.SafetySnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryAvailabilityZone != nil {
+		in, out := &in.SecondaryAvailabilityZone, &out.SecondaryAvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusInfos != nil {
+		in, out := &in.StatusInfos, &out.StatusInfos
+		*out = make([]*DBInstanceStatusInfo, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceStatusInfo)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCSecurityGroups != nil {
+		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
+		*out = make([]*VPCSecurityGroupMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCSecurityGroupMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatus.
+func (in *DBInstanceStatus) DeepCopy() *DBInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceStatusInfo) DeepCopyInto(out *DBInstanceStatusInfo) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Normal != nil {
+		in, out := &in.Normal, &out.Normal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusType != nil {
+		in, out := &in.StatusType, &out.StatusType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatusInfo.
+func (in *DBInstanceStatusInfo) DeepCopy() *DBInstanceStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstance_SDK) DeepCopyInto(out *DBInstance_SDK) {
+	*out = *in
+	if in.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
+		in, out := &in.ActivityStreamEngineNativeAuditFieldsIncluded, &out.ActivityStreamEngineNativeAuditFieldsIncluded
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActivityStreamKinesisStreamName != nil {
+		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamKMSKeyID != nil {
+		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamMode != nil {
+		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamPolicyStatus != nil {
+		in, out := &in.ActivityStreamPolicyStatus, &out.ActivityStreamPolicyStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivityStreamStatus != nil {
+		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AssociatedRoles != nil {
+		in, out := &in.AssociatedRoles, &out.AssociatedRoles
+		*out = make([]*DBInstanceRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutomaticRestartTime != nil {
+		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AutomationMode != nil {
+		in, out := &in.AutomationMode, &out.AutomationMode
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineMode != nil {
-		in, out := &in.EngineMode, &out.EngineMode
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.AWSBackupRecoveryPointARN != nil {
+		in, out := &in.AWSBackupRecoveryPointARN, &out.AWSBackupRecoveryPointARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalClusterIdentifier != nil {
-		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackupTarget != nil {
+		in, out := &in.BackupTarget, &out.BackupTarget
 		*out = new(string)
 		**out = **in
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
+	if in.CACertificateIdentifier != nil {
+		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateDetails != nil {
+		in, out := &in.CertificateDetails, &out.CertificateDetails
+		*out = new(CertificateDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CopyTagsToSnapshot != nil {
+		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomIAMInstanceProfile != nil {
+		in, out := &in.CustomIAMInstanceProfile, &out.CustomIAMInstanceProfile
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomerOwnedIPEnabled != nil {
+		in, out := &in.CustomerOwnedIPEnabled, &out.CustomerOwnedIPEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DBClusterIdentifier != nil {
+		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceARN != nil {
+		in, out := &in.DBInstanceARN, &out.DBInstanceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceAutomatedBackupsReplications != nil {
+		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
+		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceAutomatedBackupsReplication)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstanceStatus != nil {
+		in, out := &in.DBInstanceStatus, &out.DBInstanceStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBName != nil {
+		in, out := &in.DBName, &out.DBName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBParameterGroups != nil {
+		in, out := &in.DBParameterGroups, &out.DBParameterGroups
+		*out = make([]*DBParameterGroupStatus_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBParameterGroupStatus_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBSubnetGroup != nil {
+		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
+		*out = new(DBSubnetGroup_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBInstancePort != nil {
+		in, out := &in.DBInstancePort, &out.DBInstancePort
 		*out = new(int64)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyRef != nil {
-		in, out := &in.KMSKeyRef, &out.KMSKeyRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+	if in.DedicatedLogVolume != nil {
+		in, out := &in.DedicatedLogVolume, &out.DedicatedLogVolume
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DomainMemberships != nil {
+		in, out := &in.DomainMemberships, &out.DomainMemberships
+		*out = make([]*DomainMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DomainMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EnabledCloudwatchLogsExports != nil {
+		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(Endpoint)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ManageMasterUserPassword != nil {
-		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnhancedMonitoringResourceARN != nil {
+		in, out := &in.EnhancedMonitoringResourceARN, &out.EnhancedMonitoringResourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MasterUserPassword != nil {
-		in, out := &in.MasterUserPassword, &out.MasterUserPassword
-		*out = new(corev1alpha1.SecretKeyReference)
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
 		**out = **in
 	}
-	if in.MasterUserSecretKMSKeyID != nil {
-		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
 		**out = **in
 	}
-	if in.MasterUserSecretKMSKeyRef != nil {
-		in, out := &in.MasterUserSecretKMSKeyRef, &out.MasterUserSecretKMSKeyRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+	if in.LatestRestorableTime != nil {
+		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListenerEndpoint != nil {
+		in, out := &in.ListenerEndpoint, &out.ListenerEndpoint
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.MasterUsername != nil {
@@ -1243,6 +5095,11 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaxAllocatedStorage != nil {
+		in, out := &in.MaxAllocatedStorage, &out.MaxAllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
 	if in.MonitoringInterval != nil {
 		in, out := &in.MonitoringInterval, &out.MonitoringInterval
 		*out = new(int64)
@@ -1253,14 +5110,40 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkType != nil {
-		in, out := &in.NetworkType, &out.NetworkType
-		*out = new(string)
-		**out = **in
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NcharCharacterSetName != nil {
+		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkType != nil {
+		in, out := &in.NetworkType, &out.NetworkType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionGroupMemberships != nil {
+		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
+		*out = make([]*OptionGroupMembership, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OptionGroupMembership)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PendingModifiedValues != nil {
+		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
+		*out = new(PendingModifiedValues)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
-		*out = new(string)
+	if in.PerformanceInsightsEnabled != nil {
+		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+		*out = new(bool)
 		**out = **in
 	}
 	if in.PerformanceInsightsKMSKeyID != nil {
@@ -1273,16 +5156,6 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
-		**out = **in
-	}
-	if in.PreSignedURL != nil {
-		in, out := &in.PreSignedURL, &out.PreSignedURL
-		*out = new(string)
-		**out = **in
-	}
 	if in.PreferredBackupWindow != nil {
 		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
 		*out = new(string)
@@ -1293,308 +5166,346 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProcessorFeatures != nil {
+		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
+		*out = make([]*ProcessorFeature, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProcessorFeature)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PromotionTier != nil {
+		in, out := &in.PromotionTier, &out.PromotionTier
+		*out = new(int64)
+		**out = **in
+	}
 	if in.PubliclyAccessible != nil {
 		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ReplicationSourceIdentifier != nil {
-		in, out := &in.ReplicationSourceIdentifier, &out.ReplicationSourceIdentifier
+	if in.ReadReplicaDBClusterIdentifiers != nil {
+		in, out := &in.ReadReplicaDBClusterIdentifiers, &out.ReadReplicaDBClusterIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReadReplicaDBInstanceIdentifiers != nil {
+		in, out := &in.ReadReplicaDBInstanceIdentifiers, &out.ReadReplicaDBInstanceIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ReadReplicaSourceDBClusterIdentifier != nil {
+		in, out := &in.ReadReplicaSourceDBClusterIdentifier, &out.ReadReplicaSourceDBClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.ScalingConfiguration != nil {
-		in, out := &in.ScalingConfiguration, &out.ScalingConfiguration
-		*out = new(ScalingConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServerlessV2ScalingConfiguration != nil {
-		in, out := &in.ServerlessV2ScalingConfiguration, &out.ServerlessV2ScalingConfiguration
-		*out = new(ServerlessV2ScalingConfiguration)
-		(*in).DeepCopyInto(*out)
+	if in.ReadReplicaSourceDBInstanceIdentifier != nil {
+		in, out := &in.ReadReplicaSourceDBInstanceIdentifier, &out.ReadReplicaSourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
 	}
-	if in.SnapshotIdentifier != nil {
-		in, out := &in.SnapshotIdentifier, &out.SnapshotIdentifier
+	if in.ReplicaMode != nil {
+		in, out := &in.ReplicaMode, &out.ReplicaMode
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceRegion != nil {
-		in, out := &in.SourceRegion, &out.SourceRegion
+	if in.ResumeFullAutomationModeTime != nil {
+		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SecondaryAvailabilityZone != nil {
+		in, out := &in.SecondaryAvailabilityZone, &out.SecondaryAvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
+	if in.StatusInfos != nil {
+		in, out := &in.StatusInfos, &out.StatusInfos
+		*out = make([]*DBInstanceStatusInfo, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBInstanceStatusInfo)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.StorageEncrypted != nil {
 		in, out := &in.StorageEncrypted, &out.StorageEncrypted
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StorageThroughput != nil {
+		in, out := &in.StorageThroughput, &out.StorageThroughput
+		*out = new(int64)
+		**out = **in
+	}
 	if in.StorageType != nil {
 		in, out := &in.StorageType, &out.StorageType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.TDECredentialARN != nil {
+		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+		*out = new(string)
+		**out = **in
 	}
-	if in.VPCSecurityGroupIDs != nil {
-		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
 	}
-	if in.VPCSecurityGroupRefs != nil {
-		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
-		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+	if in.VPCSecurityGroups != nil {
+		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
+		*out = make([]*VPCSecurityGroupMembership, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				*out = new(VPCSecurityGroupMembership)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterSpec.
-func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstance_SDK.
+func (in *DBInstance_SDK) DeepCopy() *DBInstance_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterSpec)
+	out := new(DBInstance_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
+func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup.
+func (in *DBParameterGroup) DeepCopy() *DBParameterGroup {
+	if in == nil {
+		return nil
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]*corev1alpha1.Condition, len(*in))
+	out := new(DBParameterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupList) DeepCopyInto(out *DBParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBParameterGroup, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.Condition)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ActivityStreamKinesisStreamName != nil {
-		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupList.
+func (in *DBParameterGroupList) DeepCopy() *DBParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupSpec) DeepCopyInto(out *DBParameterGroupSpec) {
+	*out = *in
+	if in.BaseParameterGroupName != nil {
+		in, out := &in.BaseParameterGroupName, &out.BaseParameterGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamKMSKeyID != nil {
-		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+	if in.BaseParameterGroupRef != nil {
+		in, out := &in.BaseParameterGroupRef, &out.BaseParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterParameterGroupName != nil {
+		in, out := &in.ClusterParameterGroupName, &out.ClusterParameterGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamMode != nil {
-		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+	if in.ClusterParameterGroupRef != nil {
+		in, out := &in.ClusterParameterGroupRef, &out.ClusterParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamStatus != nil {
-		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.AssociatedRoles != nil {
-		in, out := &in.AssociatedRoles, &out.AssociatedRoles
-		*out = make([]*DBClusterRole, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterRole)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AutomaticRestartTime != nil {
-		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.BacktrackConsumedChangeRecords != nil {
-		in, out := &in.BacktrackConsumedChangeRecords, &out.BacktrackConsumedChangeRecords
-		*out = new(int64)
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
 		**out = **in
 	}
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = new(int64)
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
 		**out = **in
 	}
-	if in.CloneGroupID != nil {
-		in, out := &in.CloneGroupID, &out.CloneGroupID
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClusterCreateTime != nil {
-		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.CrossAccountClone != nil {
-		in, out := &in.CrossAccountClone, &out.CrossAccountClone
-		*out = new(bool)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.CustomEndpoints != nil {
-		in, out := &in.CustomEndpoints, &out.CustomEndpoints
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
+	if in.ParameterOverrides != nil {
+		in, out := &in.ParameterOverrides, &out.ParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(string)
 				**out = **in
 			}
+			(*out)[key] = outVal
 		}
 	}
-	if in.DBClusterMembers != nil {
-		in, out := &in.DBClusterMembers, &out.DBClusterMembers
-		*out = make([]*DBClusterMember, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterMember)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.DBClusterOptionGroupMemberships != nil {
-		in, out := &in.DBClusterOptionGroupMemberships, &out.DBClusterOptionGroupMemberships
-		*out = make([]*DBClusterOptionGroupStatus, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterOptionGroupStatus)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.DBClusterParameterGroup != nil {
-		in, out := &in.DBClusterParameterGroup, &out.DBClusterParameterGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBSubnetGroup != nil {
-		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
+	if in.ParameterProfile != nil {
+		in, out := &in.ParameterProfile, &out.ParameterProfile
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterResourceID != nil {
-		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
+	if in.SourceParameterGroupName != nil {
+		in, out := &in.SourceParameterGroupName, &out.SourceParameterGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DomainMemberships != nil {
-		in, out := &in.DomainMemberships, &out.DomainMemberships
-		*out = make([]*DomainMembership, len(*in))
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DomainMembership)
+				*out = new(Tag)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.EarliestBacktrackTime != nil {
-		in, out := &in.EarliestBacktrackTime, &out.EarliestBacktrackTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupSpec.
+func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
+	if in == nil {
+		return nil
 	}
-	if in.EarliestRestorableTime != nil {
-		in, out := &in.EarliestRestorableTime, &out.EarliestRestorableTime
-		*out = (*in).DeepCopy()
+	out := new(DBParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.EnabledCloudwatchLogsExports != nil {
-		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
-		*out = make([]*string, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BaseParameterOverrides != nil {
+		in, out := &in.BaseParameterOverrides, &out.BaseParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(string)
 				**out = **in
 			}
+			(*out)[key] = outVal
 		}
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalWriteForwardingRequested != nil {
-		in, out := &in.GlobalWriteForwardingRequested, &out.GlobalWriteForwardingRequested
-		*out = new(bool)
-		**out = **in
-	}
-	if in.GlobalWriteForwardingStatus != nil {
-		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostedZoneID != nil {
-		in, out := &in.HostedZoneID, &out.HostedZoneID
-		*out = new(string)
-		**out = **in
-	}
-	if in.HTTPEndpointEnabled != nil {
-		in, out := &in.HTTPEndpointEnabled, &out.HTTPEndpointEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LatestRestorableTime != nil {
-		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
-		*out = (*in).DeepCopy()
-	}
-	if in.MasterUserSecret != nil {
-		in, out := &in.MasterUserSecret, &out.MasterUserSecret
-		*out = new(MasterUserSecret)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PendingModifiedValues != nil {
-		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
-		*out = new(ClusterPendingModifiedValues)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PercentProgress != nil {
-		in, out := &in.PercentProgress, &out.PercentProgress
-		*out = new(string)
-		**out = **in
-	}
-	if in.PerformanceInsightsEnabled != nil {
-		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
-		*out = new(bool)
-		**out = **in
+	if in.AppliedParameterOverrides != nil {
+		in, out := &in.AppliedParameterOverrides, &out.AppliedParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
 	}
-	if in.ReadReplicaIdentifiers != nil {
-		in, out := &in.ReadReplicaIdentifiers, &out.ReadReplicaIdentifiers
+	if in.DriftedParameters != nil {
+		in, out := &in.DriftedParameters, &out.DriftedParameters
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -1604,397 +5515,625 @@ func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 			}
 		}
 	}
-	if in.ReaderEndpoint != nil {
-		in, out := &in.ReaderEndpoint, &out.ReaderEndpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]*Tag, len(*in))
+	if in.ParameterApplyHistory != nil {
+		in, out := &in.ParameterApplyHistory, &out.ParameterApplyHistory
+		*out = make([]*ParameterApplyRecord, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
+				*out = new(ParameterApplyRecord)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.VPCSecurityGroups != nil {
-		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
-		*out = make([]*VPCSecurityGroupMembership, len(*in))
+	if in.ParameterOverrideStatuses != nil {
+		in, out := &in.ParameterOverrideStatuses, &out.ParameterOverrideStatuses
+		*out = make([]*Parameter, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VPCSecurityGroupMembership)
+				*out = new(Parameter)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.ParameterSources != nil {
+		in, out := &in.ParameterSources, &out.ParameterSources
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.SourceParameterOverrides != nil {
+		in, out := &in.SourceParameterOverrides, &out.SourceParameterOverrides
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
+func (in *DBParameterGroupStatus) DeepCopy() *DBParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupStatus_SDK) DeepCopyInto(out *DBParameterGroupStatus_SDK) {
+	*out = *in
+	if in.DBParameterGroupName != nil {
+		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParameterApplyStatus != nil {
+		in, out := &in.ParameterApplyStatus, &out.ParameterApplyStatus
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterStatus.
-func (in *DBClusterStatus) DeepCopy() *DBClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus_SDK.
+func (in *DBParameterGroupStatus_SDK) DeepCopy() *DBParameterGroupStatus_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBClusterStatus)
+	out := new(DBParameterGroupStatus_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBCluster_SDK) DeepCopyInto(out *DBCluster_SDK) {
+func (in *DBParameterGroup_SDK) DeepCopyInto(out *DBParameterGroup_SDK) {
 	*out = *in
-	if in.ActivityStreamKinesisStreamName != nil {
-		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
+	if in.DBParameterGroupARN != nil {
+		in, out := &in.DBParameterGroupARN, &out.DBParameterGroupARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamKMSKeyID != nil {
-		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
+	if in.DBParameterGroupFamily != nil {
+		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamMode != nil {
-		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
+	if in.DBParameterGroupName != nil {
+		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ActivityStreamStatus != nil {
-		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup_SDK.
+func (in *DBParameterGroup_SDK) DeepCopy() *DBParameterGroup_SDK {
+	if in == nil {
+		return nil
 	}
-	if in.AssociatedRoles != nil {
-		in, out := &in.AssociatedRoles, &out.AssociatedRoles
-		*out = make([]*DBClusterRole, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterRole)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	out := new(DBParameterGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxy) DeepCopyInto(out *DBProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy.
+func (in *DBProxy) DeepCopy() *DBProxy {
+	if in == nil {
+		return nil
 	}
-	if in.AutoMinorVersionUpgrade != nil {
-		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
-		*out = new(bool)
+	out := new(DBProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyAuthSecret) DeepCopyInto(out *DBProxyAuthSecret) {
+	*out = *in
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
 		**out = **in
 	}
-	if in.AutomaticRestartTime != nil {
-		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
-		*out = (*in).DeepCopy()
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
 	}
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
 	}
-	if in.BacktrackConsumedChangeRecords != nil {
-		in, out := &in.BacktrackConsumedChangeRecords, &out.BacktrackConsumedChangeRecords
-		*out = new(int64)
+	if in.ClientPasswordAuthType != nil {
+		in, out := &in.ClientPasswordAuthType, &out.ClientPasswordAuthType
+		*out = new(string)
 		**out = **in
 	}
-	if in.BacktrackWindow != nil {
-		in, out := &in.BacktrackWindow, &out.BacktrackWindow
-		*out = new(int64)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
-		*out = new(int64)
+	if in.IAMAuth != nil {
+		in, out := &in.IAMAuth, &out.IAMAuth
+		*out = new(string)
 		**out = **in
 	}
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = new(int64)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyAuthSecret.
+func (in *DBProxyAuthSecret) DeepCopy() *DBProxyAuthSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyAuthSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyAuthSecretStatus) DeepCopyInto(out *DBProxyAuthSecretStatus) {
+	*out = *in
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
 		**out = **in
 	}
-	if in.CharacterSetName != nil {
-		in, out := &in.CharacterSetName, &out.CharacterSetName
+	if in.SecretARN != nil {
+		in, out := &in.SecretARN, &out.SecretARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.CloneGroupID != nil {
-		in, out := &in.CloneGroupID, &out.CloneGroupID
+	if in.PasswordHash != nil {
+		in, out := &in.PasswordHash, &out.PasswordHash
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClusterCreateTime != nil {
-		in, out := &in.ClusterCreateTime, &out.ClusterCreateTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyAuthSecretStatus.
+func (in *DBProxyAuthSecretStatus) DeepCopy() *DBProxyAuthSecretStatus {
+	if in == nil {
+		return nil
 	}
-	if in.CopyTagsToSnapshot != nil {
-		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
-		*out = new(bool)
-		**out = **in
+	out := new(DBProxyAuthSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint.
+func (in *DBProxyEndpoint) DeepCopy() *DBProxyEndpoint {
+	if in == nil {
+		return nil
 	}
-	if in.CrossAccountClone != nil {
-		in, out := &in.CrossAccountClone, &out.CrossAccountClone
-		*out = new(bool)
-		**out = **in
+	out := new(DBProxyEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.CustomEndpoints != nil {
-		in, out := &in.CustomEndpoints, &out.CustomEndpoints
-		*out = make([]*string, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointList) DeepCopyInto(out *DBProxyEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxyEndpoint, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DBClusterARN != nil {
-		in, out := &in.DBClusterARN, &out.DBClusterARN
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointList.
+func (in *DBProxyEndpointList) DeepCopy() *DBProxyEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointSpec) DeepCopyInto(out *DBProxyEndpointSpec) {
+	*out = *in
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+	if in.DBProxyRef != nil {
+		in, out := &in.DBProxyRef, &out.DBProxyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterInstanceClass != nil {
-		in, out := &in.DBClusterInstanceClass, &out.DBClusterInstanceClass
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TargetRole != nil {
+		in, out := &in.TargetRole, &out.TargetRole
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterMembers != nil {
-		in, out := &in.DBClusterMembers, &out.DBClusterMembers
-		*out = make([]*DBClusterMember, len(*in))
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterMember)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-	if in.DBClusterOptionGroupMemberships != nil {
-		in, out := &in.DBClusterOptionGroupMemberships, &out.DBClusterOptionGroupMemberships
-		*out = make([]*DBClusterOptionGroupStatus, len(*in))
+	if in.VPCSecurityGroupRefs != nil {
+		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBClusterOptionGroupStatus)
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.DBClusterParameterGroup != nil {
-		in, out := &in.DBClusterParameterGroup, &out.DBClusterParameterGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBSubnetGroup != nil {
-		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBSystemID != nil {
-		in, out := &in.DBSystemID, &out.DBSystemID
-		*out = new(string)
-		**out = **in
-	}
-	if in.DatabaseName != nil {
-		in, out := &in.DatabaseName, &out.DatabaseName
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBClusterResourceID != nil {
-		in, out := &in.DBClusterResourceID, &out.DBClusterResourceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
-		*out = new(bool)
-		**out = **in
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.DomainMemberships != nil {
-		in, out := &in.DomainMemberships, &out.DomainMemberships
-		*out = make([]*DomainMembership, len(*in))
+	if in.VPCSubnetRefs != nil {
+		in, out := &in.VPCSubnetRefs, &out.VPCSubnetRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DomainMembership)
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.EarliestBacktrackTime != nil {
-		in, out := &in.EarliestBacktrackTime, &out.EarliestBacktrackTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointSpec.
+func (in *DBProxyEndpointSpec) DeepCopy() *DBProxyEndpointSpec {
+	if in == nil {
+		return nil
 	}
-	if in.EarliestRestorableTime != nil {
-		in, out := &in.EarliestRestorableTime, &out.EarliestRestorableTime
-		*out = (*in).DeepCopy()
+	out := new(DBProxyEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointStatus) DeepCopyInto(out *DBProxyEndpointStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.EnabledCloudwatchLogsExports != nil {
-		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
-		*out = make([]*string, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(string)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
 		**out = **in
 	}
-	if in.EngineMode != nil {
-		in, out := &in.EngineMode, &out.EngineMode
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalWriteForwardingRequested != nil {
-		in, out := &in.GlobalWriteForwardingRequested, &out.GlobalWriteForwardingRequested
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointStatus.
+func (in *DBProxyEndpointStatus) DeepCopy() *DBProxyEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpoint_SDK) DeepCopyInto(out *DBProxyEndpoint_SDK) {
+	*out = *in
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.DBProxyEndpointARN != nil {
+		in, out := &in.DBProxyEndpointARN, &out.DBProxyEndpointARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalWriteForwardingStatus != nil {
-		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
+	if in.DBProxyEndpointName != nil {
+		in, out := &in.DBProxyEndpointName, &out.DBProxyEndpointName
 		*out = new(string)
 		**out = **in
 	}
-	if in.HostedZoneID != nil {
-		in, out := &in.HostedZoneID, &out.HostedZoneID
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
 		*out = new(string)
 		**out = **in
 	}
-	if in.HTTPEndpointEnabled != nil {
-		in, out := &in.HTTPEndpointEnabled, &out.HTTPEndpointEnabled
-		*out = new(bool)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
-		*out = new(int64)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
+	if in.TargetRole != nil {
+		in, out := &in.TargetRole, &out.TargetRole
 		*out = new(string)
 		**out = **in
 	}
-	if in.LatestRestorableTime != nil {
-		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
-		*out = (*in).DeepCopy()
-	}
-	if in.MasterUserSecret != nil {
-		in, out := &in.MasterUserSecret, &out.MasterUserSecret
-		*out = new(MasterUserSecret)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.MonitoringInterval != nil {
-		in, out := &in.MonitoringInterval, &out.MonitoringInterval
-		*out = new(int64)
-		**out = **in
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.MonitoringRoleARN != nil {
-		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
-		*out = new(string)
-		**out = **in
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint_SDK.
+func (in *DBProxyEndpoint_SDK) DeepCopy() *DBProxyEndpoint_SDK {
+	if in == nil {
+		return nil
 	}
-	if in.NetworkType != nil {
-		in, out := &in.NetworkType, &out.NetworkType
-		*out = new(string)
-		**out = **in
+	out := new(DBProxyEndpoint_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyList) DeepCopyInto(out *DBProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.PendingModifiedValues != nil {
-		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
-		*out = new(ClusterPendingModifiedValues)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyList.
+func (in *DBProxyList) DeepCopy() *DBProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make([]*UserAuthConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserAuthConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.PercentProgress != nil {
-		in, out := &in.PercentProgress, &out.PercentProgress
-		*out = new(string)
-		**out = **in
+	if in.AuthSecrets != nil {
+		in, out := &in.AuthSecrets, &out.AuthSecrets
+		*out = make([]*DBProxyAuthSecret, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBProxyAuthSecret)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.PerformanceInsightsEnabled != nil {
-		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+	if in.DebugLogging != nil {
+		in, out := &in.DebugLogging, &out.DebugLogging
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PerformanceInsightsKMSKeyID != nil {
-		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+	if in.EngineFamily != nil {
+		in, out := &in.EngineFamily, &out.EngineFamily
 		*out = new(string)
 		**out = **in
 	}
-	if in.PerformanceInsightsRetentionPeriod != nil {
-		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
+	if in.IdleClientTimeout != nil {
+		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.PreferredBackupWindow != nil {
-		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
-		*out = new(string)
+	if in.RequireTLS != nil {
+		in, out := &in.RequireTLS, &out.RequireTLS
+		*out = new(bool)
 		**out = **in
 	}
-	if in.PreferredMaintenanceWindow != nil {
-		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.PubliclyAccessible != nil {
-		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
-		*out = new(bool)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.ReadReplicaIdentifiers != nil {
-		in, out := &in.ReadReplicaIdentifiers, &out.ReadReplicaIdentifiers
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -2004,129 +6143,262 @@ func (in *DBCluster_SDK) DeepCopyInto(out *DBCluster_SDK) {
 			}
 		}
 	}
-	if in.ReaderEndpoint != nil {
-		in, out := &in.ReaderEndpoint, &out.ReaderEndpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.ReplicationSourceIdentifier != nil {
-		in, out := &in.ReplicationSourceIdentifier, &out.ReplicationSourceIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.ScalingConfigurationInfo != nil {
-		in, out := &in.ScalingConfigurationInfo, &out.ScalingConfigurationInfo
-		*out = new(ScalingConfigurationInfo)
-		(*in).DeepCopyInto(*out)
+	if in.VPCSecurityGroupRefs != nil {
+		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.ServerlessV2ScalingConfiguration != nil {
-		in, out := &in.ServerlessV2ScalingConfiguration, &out.ServerlessV2ScalingConfiguration
-		*out = new(ServerlessV2ScalingConfigurationInfo)
-		(*in).DeepCopyInto(*out)
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
+	if in.VPCSubnetRefs != nil {
+		in, out := &in.VPCSubnetRefs, &out.VPCSubnetRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxySpec.
+func (in *DBProxySpec) DeepCopy() *DBProxySpec {
+	if in == nil {
+		return nil
 	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
-		*out = new(string)
-		**out = **in
+	out := new(DBProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]*Tag, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
+				*out = new(corev1alpha1.Condition)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.VPCSecurityGroups != nil {
-		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
-		*out = make([]*VPCSecurityGroupMembership, len(*in))
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedAuthSecrets != nil {
+		in, out := &in.ManagedAuthSecrets, &out.ManagedAuthSecrets
+		*out = make([]*DBProxyAuthSecretStatus, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VPCSecurityGroupMembership)
+				*out = new(DBProxyAuthSecretStatus)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedDate != nil {
+		in, out := &in.UpdatedDate, &out.UpdatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCluster_SDK.
-func (in *DBCluster_SDK) DeepCopy() *DBCluster_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyStatus.
+func (in *DBProxyStatus) DeepCopy() *DBProxyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBCluster_SDK)
+	out := new(DBProxyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBEngineVersion) DeepCopyInto(out *DBEngineVersion) {
+func (in *DBProxyTarget) DeepCopyInto(out *DBProxyTarget) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
 	}
-	if in.DBEngineDescription != nil {
-		in, out := &in.DBEngineDescription, &out.DBEngineDescription
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RdsResourceID != nil {
+		in, out := &in.RdsResourceID, &out.RdsResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBEngineMediaType != nil {
-		in, out := &in.DBEngineMediaType, &out.DBEngineMediaType
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBEngineVersionARN != nil {
-		in, out := &in.DBEngineVersionARN, &out.DBEngineVersionARN
+	if in.TargetARN != nil {
+		in, out := &in.TargetARN, &out.TargetARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBEngineVersionDescription != nil {
-		in, out := &in.DBEngineVersionDescription, &out.DBEngineVersionDescription
+	if in.TargetHealth != nil {
+		in, out := &in.TargetHealth, &out.TargetHealth
+		*out = new(TargetHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrackedClusterID != nil {
+		in, out := &in.TrackedClusterID, &out.TrackedClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroupFamily != nil {
-		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTarget.
+func (in *DBProxyTarget) DeepCopy() *DBProxyTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroup) DeepCopyInto(out *DBProxyTargetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroup.
+func (in *DBProxyTargetGroup) DeepCopy() *DBProxyTargetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupList) DeepCopyInto(out *DBProxyTargetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxyTargetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupList.
+func (in *DBProxyTargetGroupList) DeepCopy() *DBProxyTargetGroupList {
+	if in == nil {
+		return nil
 	}
-	if in.DatabaseInstallationFilesS3BucketName != nil {
-		in, out := &in.DatabaseInstallationFilesS3BucketName, &out.DatabaseInstallationFilesS3BucketName
-		*out = new(string)
-		**out = **in
+	out := new(DBProxyTargetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyTargetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.DatabaseInstallationFilesS3Prefix != nil {
-		in, out := &in.DatabaseInstallationFilesS3Prefix, &out.DatabaseInstallationFilesS3Prefix
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupSpec) DeepCopyInto(out *DBProxyTargetGroupSpec) {
+	*out = *in
+	if in.ConnectionPoolConfig != nil {
+		in, out := &in.ConnectionPoolConfig, &out.ConnectionPoolConfig
+		*out = new(ConnectionPoolConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
-		**out = **in
+	if in.DBClusterIdentifiers != nil {
+		in, out := &in.DBClusterIdentifiers, &out.DBClusterIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
-		**out = **in
+	if in.DBClusterRefs != nil {
+		in, out := &in.DBClusterRefs, &out.DBClusterRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.ExportableLogTypes != nil {
-		in, out := &in.ExportableLogTypes, &out.ExportableLogTypes
+	if in.DBInstanceIdentifiers != nil {
+		in, out := &in.DBInstanceIdentifiers, &out.DBInstanceIdentifiers
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -2136,222 +6408,313 @@ func (in *DBEngineVersion) DeepCopyInto(out *DBEngineVersion) {
 			}
 		}
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
-		**out = **in
+	if in.DBInstanceRefs != nil {
+		in, out := &in.DBInstanceRefs, &out.DBInstanceRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.MajorEngineVersion != nil {
-		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.DBProxyRef != nil {
+		in, out := &in.DBProxyRef, &out.DBProxyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.SupportedEngineModes != nil {
-		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
-		*out = make([]*string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupSpec.
+func (in *DBProxyTargetGroupSpec) DeepCopy() *DBProxyTargetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyTargetGroupStatus) DeepCopyInto(out *DBProxyTargetGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.SupportsBabelfish != nil {
-		in, out := &in.SupportsBabelfish, &out.SupportsBabelfish
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SupportsCertificateRotationWithoutRestart != nil {
-		in, out := &in.SupportsCertificateRotationWithoutRestart, &out.SupportsCertificateRotationWithoutRestart
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SupportsGlobalDatabases != nil {
-		in, out := &in.SupportsGlobalDatabases, &out.SupportsGlobalDatabases
-		*out = new(bool)
-		**out = **in
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
 	}
-	if in.SupportsLogExportsToCloudwatchLogs != nil {
-		in, out := &in.SupportsLogExportsToCloudwatchLogs, &out.SupportsLogExportsToCloudwatchLogs
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SupportsParallelQuery != nil {
-		in, out := &in.SupportsParallelQuery, &out.SupportsParallelQuery
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsReadReplica != nil {
-		in, out := &in.SupportsReadReplica, &out.SupportsReadReplica
-		*out = new(bool)
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]*Tag, len(*in))
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]*DBProxyTarget, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
+				*out = new(DBProxyTarget)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBEngineVersion.
-func (in *DBEngineVersion) DeepCopy() *DBEngineVersion {
-	if in == nil {
-		return nil
+	if in.UpdatedDate != nil {
+		in, out := &in.UpdatedDate, &out.UpdatedDate
+		*out = (*in).DeepCopy()
 	}
-	out := new(DBEngineVersion)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstance) DeepCopyInto(out *DBInstance) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstance.
-func (in *DBInstance) DeepCopy() *DBInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroupStatus.
+func (in *DBProxyTargetGroupStatus) DeepCopy() *DBProxyTargetGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstance)
+	out := new(DBProxyTargetGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBInstance) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceAutomatedBackup) DeepCopyInto(out *DBInstanceAutomatedBackup) {
+func (in *DBProxyTargetGroup_SDK) DeepCopyInto(out *DBProxyTargetGroup_SDK) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
-		**out = **in
+	if in.ConnectionPoolConfig != nil {
+		in, out := &in.ConnectionPoolConfig, &out.ConnectionPoolConfig
+		*out = new(ConnectionPoolConfigurationInfo)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
 		*out = new(string)
 		**out = **in
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
-		*out = new(int64)
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
 		**out = **in
 	}
-	if in.BackupTarget != nil {
-		in, out := &in.BackupTarget, &out.BackupTarget
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceARN != nil {
-		in, out := &in.DBInstanceARN, &out.DBInstanceARN
+	if in.TargetGroupARN != nil {
+		in, out := &in.TargetGroupARN, &out.TargetGroupARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceAutomatedBackupsARN != nil {
-		in, out := &in.DBInstanceAutomatedBackupsARN, &out.DBInstanceAutomatedBackupsARN
+	if in.TargetGroupName != nil {
+		in, out := &in.TargetGroupName, &out.TargetGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceAutomatedBackupsReplications != nil {
-		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
-		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+	if in.UpdatedDate != nil {
+		in, out := &in.UpdatedDate, &out.UpdatedDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroup_SDK.
+func (in *DBProxyTargetGroup_SDK) DeepCopy() *DBProxyTargetGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyTargetGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxy_SDK) DeepCopyInto(out *DBProxy_SDK) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make([]*UserAuthConfigInfo, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceAutomatedBackupsReplication)
+				*out = new(UserAuthConfigInfo)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.DBProxyARN != nil {
+		in, out := &in.DBProxyARN, &out.DBProxyARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBIResourceID != nil {
-		in, out := &in.DBIResourceID, &out.DBIResourceID
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DebugLogging != nil {
+		in, out := &in.DebugLogging, &out.DebugLogging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineFamily != nil {
+		in, out := &in.EngineFamily, &out.EngineFamily
 		*out = new(string)
 		**out = **in
 	}
-	if in.Encrypted != nil {
-		in, out := &in.Encrypted, &out.Encrypted
-		*out = new(bool)
+	if in.IdleClientTimeout != nil {
+		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
+	if in.RequireTLS != nil {
+		in, out := &in.RequireTLS, &out.RequireTLS
+		*out = new(bool)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceCreateTime != nil {
-		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+	if in.UpdatedDate != nil {
+		in, out := &in.UpdatedDate, &out.UpdatedDate
 		*out = (*in).DeepCopy()
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
-		*out = new(int64)
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy_SDK.
+func (in *DBProxy_SDK) DeepCopy() *DBProxy_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxy_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSecurityGroup) DeepCopyInto(out *DBSecurityGroup) {
+	*out = *in
+	if in.DBSecurityGroupARN != nil {
+		in, out := &in.DBSecurityGroupARN, &out.DBSecurityGroupARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
+	if in.DBSecurityGroupDescription != nil {
+		in, out := &in.DBSecurityGroupDescription, &out.DBSecurityGroupDescription
 		*out = new(string)
 		**out = **in
 	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
+	if in.DBSecurityGroupName != nil {
+		in, out := &in.DBSecurityGroupName, &out.DBSecurityGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
 		**out = **in
 	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroup.
+func (in *DBSecurityGroup) DeepCopy() *DBSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSecurityGroupMembership) DeepCopyInto(out *DBSecurityGroupMembership) {
+	*out = *in
+	if in.DBSecurityGroupName != nil {
+		in, out := &in.DBSecurityGroupName, &out.DBSecurityGroupName
 		*out = new(string)
 		**out = **in
 	}
@@ -2360,89 +6723,111 @@ func (in *DBInstanceAutomatedBackup) DeepCopyInto(out *DBInstanceAutomatedBackup
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageThroughput != nil {
-		in, out := &in.StorageThroughput, &out.StorageThroughput
-		*out = new(int64)
-		**out = **in
-	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroupMembership.
+func (in *DBSecurityGroupMembership) DeepCopy() *DBSecurityGroupMembership {
+	if in == nil {
+		return nil
 	}
-	if in.TDECredentialARN != nil {
-		in, out := &in.TDECredentialARN, &out.TDECredentialARN
-		*out = new(string)
-		**out = **in
+	out := new(DBSecurityGroupMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshot.
+func (in *DBSnapshot) DeepCopy() *DBSnapshot {
+	if in == nil {
+		return nil
 	}
-	if in.Timezone != nil {
-		in, out := &in.Timezone, &out.Timezone
-		*out = new(string)
-		**out = **in
+	out := new(DBSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshotAttribute) DeepCopyInto(out *DBSnapshotAttribute) {
+	*out = *in
+	if in.AttributeName != nil {
+		in, out := &in.AttributeName, &out.AttributeName
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceAutomatedBackup.
-func (in *DBInstanceAutomatedBackup) DeepCopy() *DBInstanceAutomatedBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotAttribute.
+func (in *DBSnapshotAttribute) DeepCopy() *DBSnapshotAttribute {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceAutomatedBackup)
+	out := new(DBSnapshotAttribute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceAutomatedBackupsReplication) DeepCopyInto(out *DBInstanceAutomatedBackupsReplication) {
+func (in *DBSnapshotAttributesResult) DeepCopyInto(out *DBSnapshotAttributesResult) {
 	*out = *in
-	if in.DBInstanceAutomatedBackupsARN != nil {
-		in, out := &in.DBInstanceAutomatedBackupsARN, &out.DBInstanceAutomatedBackupsARN
+	if in.DBSnapshotIdentifier != nil {
+		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceAutomatedBackupsReplication.
-func (in *DBInstanceAutomatedBackupsReplication) DeepCopy() *DBInstanceAutomatedBackupsReplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotAttributesResult.
+func (in *DBSnapshotAttributesResult) DeepCopy() *DBSnapshotAttributesResult {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceAutomatedBackupsReplication)
+	out := new(DBSnapshotAttributesResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceList) DeepCopyInto(out *DBInstanceList) {
+func (in *DBSnapshotList) DeepCopyInto(out *DBSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DBInstance, len(*in))
+		*out = make([]DBSnapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceList.
-func (in *DBInstanceList) DeepCopy() *DBInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotList.
+func (in *DBSnapshotList) DeepCopy() *DBSnapshotList {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceList)
+	out := new(DBSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBInstanceList) DeepCopyObject() runtime.Object {
+func (in *DBSnapshotList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2450,276 +6835,353 @@ func (in *DBInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceRole) DeepCopyInto(out *DBInstanceRole) {
+func (in *DBSnapshotSpec) DeepCopyInto(out *DBSnapshotSpec) {
 	*out = *in
-	if in.FeatureName != nil {
-		in, out := &in.FeatureName, &out.FeatureName
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.RoleARN != nil {
-		in, out := &in.RoleARN, &out.RoleARN
-		*out = new(string)
-		**out = **in
+	if in.DBInstanceRef != nil {
+		in, out := &in.DBInstanceRef, &out.DBInstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.DBSnapshotIdentifier != nil {
+		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
 		*out = new(string)
 		**out = **in
 	}
+	if in.SharedAccountIDs != nil {
+		in, out := &in.SharedAccountIDs, &out.SharedAccountIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceRole.
-func (in *DBInstanceRole) DeepCopy() *DBInstanceRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotSpec.
+func (in *DBSnapshotSpec) DeepCopy() *DBSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceRole)
+	out := new(DBSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
+func (in *DBSnapshotStatus) DeepCopyInto(out *DBSnapshotStatus) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
-		**out = **in
-	}
-	if in.AutoMinorVersionUpgrade != nil {
-		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
-		*out = new(bool)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
 		*out = new(int64)
 		**out = **in
 	}
-	if in.BackupTarget != nil {
-		in, out := &in.BackupTarget, &out.BackupTarget
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
-	if in.CACertificateIdentifier != nil {
-		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CharacterSetName != nil {
-		in, out := &in.CharacterSetName, &out.CharacterSetName
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CopyTagsToSnapshot != nil {
-		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
+	if in.DedicatedLogVolume != nil {
+		in, out := &in.DedicatedLogVolume, &out.DedicatedLogVolume
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CustomIAMInstanceProfile != nil {
-		in, out := &in.CustomIAMInstanceProfile, &out.CustomIAMInstanceProfile
-		*out = new(string)
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBClusterSnapshotIdentifier != nil {
-		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceClass != nil {
-		in, out := &in.DBInstanceClass, &out.DBInstanceClass
-		*out = new(string)
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
-		*out = new(string)
-		**out = **in
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
 	}
-	if in.DBName != nil {
-		in, out := &in.DBName, &out.DBName
-		*out = new(string)
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DBParameterGroupName != nil {
-		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroupRef != nil {
-		in, out := &in.DBParameterGroupRef, &out.DBParameterGroupRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DBSnapshotIdentifier != nil {
-		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupName != nil {
-		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupRef != nil {
-		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
+	if in.MultiTenant != nil {
+		in, out := &in.MultiTenant, &out.MultiTenant
 		*out = new(bool)
 		**out = **in
 	}
-	if in.DestinationRegion != nil {
-		in, out := &in.DestinationRegion, &out.DestinationRegion
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
-		*out = new(string)
+	if in.OriginalSnapshotCreateTime != nil {
+		in, out := &in.OriginalSnapshotCreateTime, &out.OriginalSnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DomainIAMRoleName != nil {
-		in, out := &in.DomainIAMRoleName, &out.DomainIAMRoleName
-		*out = new(string)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
 		**out = **in
 	}
-	if in.EnableCloudwatchLogsExports != nil {
-		in, out := &in.EnableCloudwatchLogsExports, &out.EnableCloudwatchLogsExports
-		*out = make([]*string, len(*in))
+	if in.ProcessorFeatures != nil {
+		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
+		*out = make([]*ProcessorFeature, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(ProcessorFeature)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.EnableCustomerOwnedIP != nil {
-		in, out := &in.EnableCustomerOwnedIP, &out.EnableCustomerOwnedIP
-		*out = new(bool)
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotDatabaseTime != nil {
+		in, out := &in.SnapshotDatabaseTime, &out.SnapshotDatabaseTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotTarget != nil {
+		in, out := &in.SnapshotTarget, &out.SnapshotTarget
+		*out = new(string)
 		**out = **in
 	}
-	if in.EnableIAMDatabaseAuthentication != nil {
-		in, out := &in.EnableIAMDatabaseAuthentication, &out.EnableIAMDatabaseAuthentication
-		*out = new(bool)
+	if in.SnapshotType != nil {
+		in, out := &in.SnapshotType, &out.SnapshotType
+		*out = new(string)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
+	if in.SourceDBSnapshotIdentifier != nil {
+		in, out := &in.SourceDBSnapshotIdentifier, &out.SourceDBSnapshotIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.SourceRegion != nil {
+		in, out := &in.SourceRegion, &out.SourceRegion
 		*out = new(string)
 		**out = **in
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageThroughput != nil {
+		in, out := &in.StorageThroughput, &out.StorageThroughput
 		*out = new(int64)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
 		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyRef != nil {
-		in, out := &in.KMSKeyRef, &out.KMSKeyRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
+	if in.TDECredentialARN != nil {
+		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+		*out = new(string)
+		**out = **in
 	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
 		*out = new(string)
 		**out = **in
 	}
-	if in.ManageMasterUserPassword != nil {
-		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
-		*out = new(bool)
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
 		**out = **in
 	}
-	if in.MasterUserPassword != nil {
-		in, out := &in.MasterUserPassword, &out.MasterUserPassword
-		*out = new(corev1alpha1.SecretKeyReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotStatus.
+func (in *DBSnapshotStatus) DeepCopy() *DBSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSnapshot_SDK) DeepCopyInto(out *DBSnapshot_SDK) {
+	*out = *in
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
 		**out = **in
 	}
-	if in.MasterUserSecretKMSKeyID != nil {
-		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
-	if in.MasterUserSecretKMSKeyRef != nil {
-		in, out := &in.MasterUserSecretKMSKeyRef, &out.MasterUserSecretKMSKeyRef
-		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-		(*in).DeepCopyInto(*out)
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+		*out = new(string)
+		**out = **in
 	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
+	if in.DBSnapshotARN != nil {
+		in, out := &in.DBSnapshotARN, &out.DBSnapshotARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxAllocatedStorage != nil {
-		in, out := &in.MaxAllocatedStorage, &out.MaxAllocatedStorage
-		*out = new(int64)
+	if in.DBSnapshotIdentifier != nil {
+		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.MonitoringInterval != nil {
-		in, out := &in.MonitoringInterval, &out.MonitoringInterval
-		*out = new(int64)
+	if in.DBSystemID != nil {
+		in, out := &in.DBSystemID, &out.DBSystemID
+		*out = new(string)
 		**out = **in
 	}
-	if in.MonitoringRoleARN != nil {
-		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
+	if in.DedicatedLogVolume != nil {
+		in, out := &in.DedicatedLogVolume, &out.DedicatedLogVolume
 		*out = new(bool)
 		**out = **in
 	}
-	if in.NcharCharacterSetName != nil {
-		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkType != nil {
-		in, out := &in.NetworkType, &out.NetworkType
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceCreateTime != nil {
+		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
 		*out = new(string)
 		**out = **in
 	}
-	if in.PerformanceInsightsEnabled != nil {
-		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
+	if in.MultiTenant != nil {
+		in, out := &in.MultiTenant, &out.MultiTenant
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PerformanceInsightsKMSKeyID != nil {
-		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.PerformanceInsightsRetentionPeriod != nil {
-		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
+	if in.OriginalSnapshotCreateTime != nil {
+		in, out := &in.OriginalSnapshotCreateTime, &out.OriginalSnapshotCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
 		*out = new(int64)
 		**out = **in
 	}
@@ -2728,21 +7190,6 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.PreSignedURL != nil {
-		in, out := &in.PreSignedURL, &out.PreSignedURL
-		*out = new(string)
-		**out = **in
-	}
-	if in.PreferredBackupWindow != nil {
-		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
-		*out = new(string)
-		**out = **in
-	}
-	if in.PreferredMaintenanceWindow != nil {
-		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
-		*out = new(string)
-		**out = **in
-	}
 	if in.ProcessorFeatures != nil {
 		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
 		*out = make([]*ProcessorFeature, len(*in))
@@ -2754,23 +7201,26 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 			}
 		}
 	}
-	if in.PromotionTier != nil {
-		in, out := &in.PromotionTier, &out.PromotionTier
-		*out = new(int64)
-		**out = **in
+	if in.SnapshotCreateTime != nil {
+		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
+		*out = (*in).DeepCopy()
 	}
-	if in.PubliclyAccessible != nil {
-		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
-		*out = new(bool)
+	if in.SnapshotDatabaseTime != nil {
+		in, out := &in.SnapshotDatabaseTime, &out.SnapshotDatabaseTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotTarget != nil {
+		in, out := &in.SnapshotTarget, &out.SnapshotTarget
+		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicaMode != nil {
-		in, out := &in.ReplicaMode, &out.ReplicaMode
+	if in.SnapshotType != nil {
+		in, out := &in.SnapshotType, &out.SnapshotType
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceDBInstanceIdentifier != nil {
-		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+	if in.SourceDBSnapshotIdentifier != nil {
+		in, out := &in.SourceDBSnapshotIdentifier, &out.SourceDBSnapshotIdentifier
 		*out = new(string)
 		**out = **in
 	}
@@ -2779,9 +7229,9 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
 	if in.StorageThroughput != nil {
@@ -2794,8 +7244,8 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
 		*out = make([]*Tag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -2810,199 +7260,102 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.TDECredentialPassword != nil {
-		in, out := &in.TDECredentialPassword, &out.TDECredentialPassword
-		*out = new(string)
-		**out = **in
-	}
 	if in.Timezone != nil {
 		in, out := &in.Timezone, &out.Timezone
 		*out = new(string)
 		**out = **in
 	}
-	if in.UseDefaultProcessorFeatures != nil {
-		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
-		*out = new(bool)
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
 		**out = **in
 	}
-	if in.VPCSecurityGroupIDs != nil {
-		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.VPCSecurityGroupRefs != nil {
-		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
-		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceSpec.
-func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshot_SDK.
+func (in *DBSnapshot_SDK) DeepCopy() *DBSnapshot_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceSpec)
+	out := new(DBSnapshot_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
+func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]*corev1alpha1.Condition, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.Condition)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
-		in, out := &in.ActivityStreamEngineNativeAuditFieldsIncluded, &out.ActivityStreamEngineNativeAuditFieldsIncluded
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ActivityStreamKinesisStreamName != nil {
-		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamKMSKeyID != nil {
-		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamMode != nil {
-		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamPolicyStatus != nil {
-		in, out := &in.ActivityStreamPolicyStatus, &out.ActivityStreamPolicyStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamStatus != nil {
-		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.AssociatedRoles != nil {
-		in, out := &in.AssociatedRoles, &out.AssociatedRoles
-		*out = make([]*DBInstanceRole, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceRole)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AutomaticRestartTime != nil {
-		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AutomationMode != nil {
-		in, out := &in.AutomationMode, &out.AutomationMode
-		*out = new(string)
-		**out = **in
-	}
-	if in.AWSBackupRecoveryPointARN != nil {
-		in, out := &in.AWSBackupRecoveryPointARN, &out.AWSBackupRecoveryPointARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CertificateDetails != nil {
-		in, out := &in.CertificateDetails, &out.CertificateDetails
-		*out = new(CertificateDetails)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup.
+func (in *DBSubnetGroup) DeepCopy() *DBSubnetGroup {
+	if in == nil {
+		return nil
 	}
-	if in.CustomerOwnedIPEnabled != nil {
-		in, out := &in.CustomerOwnedIPEnabled, &out.CustomerOwnedIPEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(DBSubnetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.DBInstanceAutomatedBackupsReplications != nil {
-		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
-		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupList) DeepCopyInto(out *DBSubnetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBSubnetGroup, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceAutomatedBackupsReplication)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DBInstanceStatus != nil {
-		in, out := &in.DBInstanceStatus, &out.DBInstanceStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBParameterGroups != nil {
-		in, out := &in.DBParameterGroups, &out.DBParameterGroups
-		*out = make([]*DBParameterGroupStatus_SDK, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBParameterGroupStatus_SDK)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupList.
+func (in *DBSubnetGroupList) DeepCopy() *DBSubnetGroupList {
+	if in == nil {
+		return nil
 	}
-	if in.DBSubnetGroup != nil {
-		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
-		*out = new(DBSubnetGroup_SDK)
-		(*in).DeepCopyInto(*out)
+	out := new(DBSubnetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.DBSystemID != nil {
-		in, out := &in.DBSystemID, &out.DBSystemID
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupSpec) DeepCopyInto(out *DBSubnetGroupSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstancePort != nil {
-		in, out := &in.DBInstancePort, &out.DBInstancePort
-		*out = new(int64)
-		**out = **in
-	}
-	if in.DBIResourceID != nil {
-		in, out := &in.DBIResourceID, &out.DBIResourceID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.DomainMemberships != nil {
-		in, out := &in.DomainMemberships, &out.DomainMemberships
-		*out = make([]*DomainMembership, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DomainMembership)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.EnabledCloudwatchLogsExports != nil {
-		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -3012,534 +7365,533 @@ func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 			}
 		}
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(Endpoint)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnhancedMonitoringResourceARN != nil {
-		in, out := &in.EnhancedMonitoringResourceARN, &out.EnhancedMonitoringResourceARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.InstanceCreateTime != nil {
-		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LatestRestorableTime != nil {
-		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ListenerEndpoint != nil {
-		in, out := &in.ListenerEndpoint, &out.ListenerEndpoint
-		*out = new(Endpoint)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MasterUserSecret != nil {
-		in, out := &in.MasterUserSecret, &out.MasterUserSecret
-		*out = new(MasterUserSecret)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.OptionGroupMemberships != nil {
-		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
-		*out = make([]*OptionGroupMembership, len(*in))
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(OptionGroupMembership)
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.PendingModifiedValues != nil {
-		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
-		*out = new(PendingModifiedValues)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReadReplicaDBClusterIdentifiers != nil {
-		in, out := &in.ReadReplicaDBClusterIdentifiers, &out.ReadReplicaDBClusterIdentifiers
-		*out = make([]*string, len(*in))
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.ReadReplicaDBInstanceIdentifiers != nil {
-		in, out := &in.ReadReplicaDBInstanceIdentifiers, &out.ReadReplicaDBInstanceIdentifiers
-		*out = make([]*string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupSpec.
+func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.ReadReplicaSourceDBClusterIdentifier != nil {
-		in, out := &in.ReadReplicaSourceDBClusterIdentifier, &out.ReadReplicaSourceDBClusterIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.ReadReplicaSourceDBInstanceIdentifier != nil {
-		in, out := &in.ReadReplicaSourceDBInstanceIdentifier, &out.ReadReplicaSourceDBInstanceIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResumeFullAutomationModeTime != nil {
-		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
-		*out = (*in).DeepCopy()
-	}
-	if in.SecondaryAvailabilityZone != nil {
-		in, out := &in.SecondaryAvailabilityZone, &out.SecondaryAvailabilityZone
+	if in.SubnetGroupStatus != nil {
+		in, out := &in.SubnetGroupStatus, &out.SubnetGroupStatus
 		*out = new(string)
 		**out = **in
 	}
-	if in.StatusInfos != nil {
-		in, out := &in.StatusInfos, &out.StatusInfos
-		*out = make([]*DBInstanceStatusInfo, len(*in))
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]*Subnet, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceStatusInfo)
+				*out = new(Subnet)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.VPCSecurityGroups != nil {
-		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
-		*out = make([]*VPCSecurityGroupMembership, len(*in))
+	if in.SupportedNetworkTypes != nil {
+		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VPCSecurityGroupMembership)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatus.
-func (in *DBInstanceStatus) DeepCopy() *DBInstanceStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(DBInstanceStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstanceStatusInfo) DeepCopyInto(out *DBInstanceStatusInfo) {
-	*out = *in
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
-		*out = new(string)
-		**out = **in
-	}
-	if in.Normal != nil {
-		in, out := &in.Normal, &out.Normal
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.StatusType != nil {
-		in, out := &in.StatusType, &out.StatusType
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceStatusInfo.
-func (in *DBInstanceStatusInfo) DeepCopy() *DBInstanceStatusInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupStatus.
+func (in *DBSubnetGroupStatus) DeepCopy() *DBSubnetGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstanceStatusInfo)
+	out := new(DBSubnetGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBInstance_SDK) DeepCopyInto(out *DBInstance_SDK) {
+func (in *DBSubnetGroup_SDK) DeepCopyInto(out *DBSubnetGroup_SDK) {
 	*out = *in
-	if in.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
-		in, out := &in.ActivityStreamEngineNativeAuditFieldsIncluded, &out.ActivityStreamEngineNativeAuditFieldsIncluded
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ActivityStreamKinesisStreamName != nil {
-		in, out := &in.ActivityStreamKinesisStreamName, &out.ActivityStreamKinesisStreamName
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamKMSKeyID != nil {
-		in, out := &in.ActivityStreamKMSKeyID, &out.ActivityStreamKMSKeyID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamMode != nil {
-		in, out := &in.ActivityStreamMode, &out.ActivityStreamMode
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamPolicyStatus != nil {
-		in, out := &in.ActivityStreamPolicyStatus, &out.ActivityStreamPolicyStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.ActivityStreamStatus != nil {
-		in, out := &in.ActivityStreamStatus, &out.ActivityStreamStatus
-		*out = new(string)
-		**out = **in
-	}
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
-		**out = **in
-	}
-	if in.AssociatedRoles != nil {
-		in, out := &in.AssociatedRoles, &out.AssociatedRoles
-		*out = make([]*DBInstanceRole, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceRole)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AutoMinorVersionUpgrade != nil {
-		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AutomaticRestartTime != nil {
-		in, out := &in.AutomaticRestartTime, &out.AutomaticRestartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AutomationMode != nil {
-		in, out := &in.AutomationMode, &out.AutomationMode
+	if in.DBSubnetGroupARN != nil {
+		in, out := &in.DBSubnetGroupARN, &out.DBSubnetGroupARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+	if in.DBSubnetGroupDescription != nil {
+		in, out := &in.DBSubnetGroupDescription, &out.DBSubnetGroupDescription
 		*out = new(string)
 		**out = **in
 	}
-	if in.AWSBackupRecoveryPointARN != nil {
-		in, out := &in.AWSBackupRecoveryPointARN, &out.AWSBackupRecoveryPointARN
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
-		*out = new(int64)
-		**out = **in
-	}
-	if in.BackupTarget != nil {
-		in, out := &in.BackupTarget, &out.BackupTarget
+	if in.SubnetGroupStatus != nil {
+		in, out := &in.SubnetGroupStatus, &out.SubnetGroupStatus
 		*out = new(string)
 		**out = **in
 	}
-	if in.CACertificateIdentifier != nil {
-		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
-		*out = new(string)
-		**out = **in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]*Subnet, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Subnet)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.CertificateDetails != nil {
-		in, out := &in.CertificateDetails, &out.CertificateDetails
-		*out = new(CertificateDetails)
-		(*in).DeepCopyInto(*out)
+	if in.SupportedNetworkTypes != nil {
+		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.CharacterSetName != nil {
-		in, out := &in.CharacterSetName, &out.CharacterSetName
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CopyTagsToSnapshot != nil {
-		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup_SDK.
+func (in *DBSubnetGroup_SDK) DeepCopy() *DBSubnetGroup_SDK {
+	if in == nil {
+		return nil
 	}
-	if in.CustomIAMInstanceProfile != nil {
-		in, out := &in.CustomIAMInstanceProfile, &out.CustomIAMInstanceProfile
+	out := new(DBSubnetGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescribeDBLogFilesDetails) DeepCopyInto(out *DescribeDBLogFilesDetails) {
+	*out = *in
+	if in.LogFileName != nil {
+		in, out := &in.LogFileName, &out.LogFileName
 		*out = new(string)
 		**out = **in
 	}
-	if in.CustomerOwnedIPEnabled != nil {
-		in, out := &in.CustomerOwnedIPEnabled, &out.CustomerOwnedIPEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DBClusterIdentifier != nil {
-		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeDBLogFilesDetails.
+func (in *DescribeDBLogFilesDetails) DeepCopy() *DescribeDBLogFilesDetails {
+	if in == nil {
+		return nil
 	}
-	if in.DBInstanceARN != nil {
-		in, out := &in.DBInstanceARN, &out.DBInstanceARN
+	out := new(DescribeDBLogFilesDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMembership) DeepCopyInto(out *DomainMembership) {
+	*out = *in
+	if in.AuthSecretARN != nil {
+		in, out := &in.AuthSecretARN, &out.AuthSecretARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceAutomatedBackupsReplications != nil {
-		in, out := &in.DBInstanceAutomatedBackupsReplications, &out.DBInstanceAutomatedBackupsReplications
-		*out = make([]*DBInstanceAutomatedBackupsReplication, len(*in))
+	if in.DNSIPs != nil {
+		in, out := &in.DNSIPs, &out.DNSIPs
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceAutomatedBackupsReplication)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-	if in.DBInstanceClass != nil {
-		in, out := &in.DBInstanceClass, &out.DBInstanceClass
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+	if in.FQDN != nil {
+		in, out := &in.FQDN, &out.FQDN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstanceStatus != nil {
-		in, out := &in.DBInstanceStatus, &out.DBInstanceStatus
+	if in.IAMRoleName != nil {
+		in, out := &in.IAMRoleName, &out.IAMRoleName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBName != nil {
-		in, out := &in.DBName, &out.DBName
+	if in.OU != nil {
+		in, out := &in.OU, &out.OU
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroups != nil {
-		in, out := &in.DBParameterGroups, &out.DBParameterGroups
-		*out = make([]*DBParameterGroupStatus_SDK, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBParameterGroupStatus_SDK)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
 	}
-	if in.DBSubnetGroup != nil {
-		in, out := &in.DBSubnetGroup, &out.DBSubnetGroup
-		*out = new(DBSubnetGroup_SDK)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMembership.
+func (in *DomainMembership) DeepCopy() *DomainMembership {
+	if in == nil {
+		return nil
 	}
-	if in.DBSystemID != nil {
-		in, out := &in.DBSystemID, &out.DBSystemID
+	out := new(DomainMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2SecurityGroup) DeepCopyInto(out *EC2SecurityGroup) {
+	*out = *in
+	if in.EC2SecurityGroupID != nil {
+		in, out := &in.EC2SecurityGroupID, &out.EC2SecurityGroupID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBInstancePort != nil {
-		in, out := &in.DBInstancePort, &out.DBInstancePort
-		*out = new(int64)
+	if in.EC2SecurityGroupName != nil {
+		in, out := &in.EC2SecurityGroupName, &out.EC2SecurityGroupName
+		*out = new(string)
 		**out = **in
 	}
-	if in.DBIResourceID != nil {
-		in, out := &in.DBIResourceID, &out.DBIResourceID
+	if in.EC2SecurityGroupOwnerID != nil {
+		in, out := &in.EC2SecurityGroupOwnerID, &out.EC2SecurityGroupOwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
-		*out = new(bool)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.DomainMemberships != nil {
-		in, out := &in.DomainMemberships, &out.DomainMemberships
-		*out = make([]*DomainMembership, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DomainMembership)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2SecurityGroup.
+func (in *EC2SecurityGroup) DeepCopy() *EC2SecurityGroup {
+	if in == nil {
+		return nil
 	}
-	if in.EnabledCloudwatchLogsExports != nil {
-		in, out := &in.EnabledCloudwatchLogsExports, &out.EnabledCloudwatchLogsExports
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	out := new(EC2SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(Endpoint)
-		(*in).DeepCopyInto(*out)
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointService) DeepCopyInto(out *EndpointService) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointService.
+func (in *EndpointService) DeepCopy() *EndpointService {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineDefaults) DeepCopyInto(out *EngineDefaults) {
+	*out = *in
+	if in.DBParameterGroupFamily != nil {
+		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
 		*out = new(string)
 		**out = **in
 	}
-	if in.EnhancedMonitoringResourceARN != nil {
-		in, out := &in.EnhancedMonitoringResourceARN, &out.EnhancedMonitoringResourceARN
+	if in.Marker != nil {
+		in, out := &in.Marker, &out.Marker
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
-		**out = **in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]*Parameter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Parameter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.InstanceCreateTime != nil {
-		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineDefaults.
+func (in *EngineDefaults) DeepCopy() *EngineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Event) DeepCopyInto(out *Event) {
+	*out = *in
+	if in.Date != nil {
+		in, out := &in.Date, &out.Date
 		*out = (*in).DeepCopy()
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
-		*out = new(int64)
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.LatestRestorableTime != nil {
-		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
+	if in.SourceIdentifier != nil {
+		in, out := &in.SourceIdentifier, &out.SourceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.ListenerEndpoint != nil {
-		in, out := &in.ListenerEndpoint, &out.ListenerEndpoint
-		*out = new(Endpoint)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MasterUserSecret != nil {
-		in, out := &in.MasterUserSecret, &out.MasterUserSecret
-		*out = new(MasterUserSecret)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
+func (in *Event) DeepCopy() *Event {
+	if in == nil {
+		return nil
 	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
+	out := new(Event)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventCategoriesMap) DeepCopyInto(out *EventCategoriesMap) {
+	*out = *in
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxAllocatedStorage != nil {
-		in, out := &in.MaxAllocatedStorage, &out.MaxAllocatedStorage
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCategoriesMap.
+func (in *EventCategoriesMap) DeepCopy() *EventCategoriesMap {
+	if in == nil {
+		return nil
 	}
-	if in.MonitoringInterval != nil {
-		in, out := &in.MonitoringInterval, &out.MonitoringInterval
-		*out = new(int64)
-		**out = **in
+	out := new(EventCategoriesMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription.
+func (in *EventSubscription) DeepCopy() *EventSubscription {
+	if in == nil {
+		return nil
 	}
-	if in.MonitoringRoleARN != nil {
-		in, out := &in.MonitoringRoleARN, &out.MonitoringRoleARN
-		*out = new(string)
-		**out = **in
+	out := new(EventSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionList) DeepCopyInto(out *EventSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NcharCharacterSetName != nil {
-		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionList.
+func (in *EventSubscriptionList) DeepCopy() *EventSubscriptionList {
+	if in == nil {
+		return nil
 	}
-	if in.NetworkType != nil {
-		in, out := &in.NetworkType, &out.NetworkType
-		*out = new(string)
+	out := new(EventSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionSpec) DeepCopyInto(out *EventSubscriptionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.OptionGroupMemberships != nil {
-		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
-		*out = make([]*OptionGroupMembership, len(*in))
+	if in.EventCategories != nil {
+		in, out := &in.EventCategories, &out.EventCategories
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(OptionGroupMembership)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-	if in.PendingModifiedValues != nil {
-		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
-		*out = new(PendingModifiedValues)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PerformanceInsightsEnabled != nil {
-		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PerformanceInsightsKMSKeyID != nil {
-		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.PerformanceInsightsRetentionPeriod != nil {
-		in, out := &in.PerformanceInsightsRetentionPeriod, &out.PerformanceInsightsRetentionPeriod
-		*out = new(int64)
-		**out = **in
-	}
-	if in.PreferredBackupWindow != nil {
-		in, out := &in.PreferredBackupWindow, &out.PreferredBackupWindow
+	if in.SNSTopicARN != nil {
+		in, out := &in.SNSTopicARN, &out.SNSTopicARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.PreferredMaintenanceWindow != nil {
-		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
-		*out = new(string)
-		**out = **in
+	if in.SNSTopicRef != nil {
+		in, out := &in.SNSTopicRef, &out.SNSTopicRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ProcessorFeatures != nil {
-		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
-		*out = make([]*ProcessorFeature, len(*in))
+	if in.SourceDBClusterRefs != nil {
+		in, out := &in.SourceDBClusterRefs, &out.SourceDBClusterRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProcessorFeature)
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.PromotionTier != nil {
-		in, out := &in.PromotionTier, &out.PromotionTier
-		*out = new(int64)
-		**out = **in
-	}
-	if in.PubliclyAccessible != nil {
-		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
-		*out = new(bool)
-		**out = **in
+	if in.SourceDBInstanceRefs != nil {
+		in, out := &in.SourceDBInstanceRefs, &out.SourceDBInstanceRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.ReadReplicaDBClusterIdentifiers != nil {
-		in, out := &in.ReadReplicaDBClusterIdentifiers, &out.ReadReplicaDBClusterIdentifiers
+	if in.SourceIDs != nil {
+		in, out := &in.SourceIDs, &out.SourceIDs
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -3549,102 +7901,142 @@ func (in *DBInstance_SDK) DeepCopyInto(out *DBInstance_SDK) {
 			}
 		}
 	}
-	if in.ReadReplicaDBInstanceIdentifiers != nil {
-		in, out := &in.ReadReplicaDBInstanceIdentifiers, &out.ReadReplicaDBInstanceIdentifiers
-		*out = make([]*string, len(*in))
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionSpec.
+func (in *EventSubscriptionSpec) DeepCopy() *EventSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionStatus) DeepCopyInto(out *EventSubscriptionStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.ReadReplicaSourceDBClusterIdentifier != nil {
-		in, out := &in.ReadReplicaSourceDBClusterIdentifier, &out.ReadReplicaSourceDBClusterIdentifier
+	if in.CustSubscriptionID != nil {
+		in, out := &in.CustSubscriptionID, &out.CustSubscriptionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReadReplicaSourceDBInstanceIdentifier != nil {
-		in, out := &in.ReadReplicaSourceDBInstanceIdentifier, &out.ReadReplicaSourceDBInstanceIdentifier
+	if in.CustomerAWSID != nil {
+		in, out := &in.CustomerAWSID, &out.CustomerAWSID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicaMode != nil {
-		in, out := &in.ReplicaMode, &out.ReplicaMode
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResumeFullAutomationModeTime != nil {
-		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
-		*out = (*in).DeepCopy()
+	if in.SubscriptionCreationTime != nil {
+		in, out := &in.SubscriptionCreationTime, &out.SubscriptionCreationTime
+		*out = new(string)
+		**out = **in
 	}
-	if in.SecondaryAvailabilityZone != nil {
-		in, out := &in.SecondaryAvailabilityZone, &out.SecondaryAvailabilityZone
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionStatus.
+func (in *EventSubscriptionStatus) DeepCopy() *EventSubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscription_SDK) DeepCopyInto(out *EventSubscription_SDK) {
+	*out = *in
+	if in.CustSubscriptionID != nil {
+		in, out := &in.CustSubscriptionID, &out.CustSubscriptionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.StatusInfos != nil {
-		in, out := &in.StatusInfos, &out.StatusInfos
-		*out = make([]*DBInstanceStatusInfo, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DBInstanceStatusInfo)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.CustomerAWSID != nil {
+		in, out := &in.CustomerAWSID, &out.CustomerAWSID
+		*out = new(string)
+		**out = **in
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.StorageThroughput != nil {
-		in, out := &in.StorageThroughput, &out.StorageThroughput
-		*out = new(int64)
+	if in.EventSubscriptionARN != nil {
+		in, out := &in.EventSubscriptionARN, &out.EventSubscriptionARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
+	if in.SNSTopicARN != nil {
+		in, out := &in.SNSTopicARN, &out.SNSTopicARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.TDECredentialARN != nil {
-		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Timezone != nil {
-		in, out := &in.Timezone, &out.Timezone
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCSecurityGroups != nil {
-		in, out := &in.VPCSecurityGroups, &out.VPCSecurityGroups
-		*out = make([]*VPCSecurityGroupMembership, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VPCSecurityGroupMembership)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.SubscriptionCreationTime != nil {
+		in, out := &in.SubscriptionCreationTime, &out.SubscriptionCreationTime
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstance_SDK.
-func (in *DBInstance_SDK) DeepCopy() *DBInstance_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription_SDK.
+func (in *EventSubscription_SDK) DeepCopy() *EventSubscription_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBInstance_SDK)
+	out := new(EventSubscription_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
+func (in *ExportTask) DeepCopyInto(out *ExportTask) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3652,18 +8044,18 @@ func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup.
-func (in *DBParameterGroup) DeepCopy() *DBParameterGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTask.
+func (in *ExportTask) DeepCopy() *ExportTask {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroup)
+	out := new(ExportTask)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
+func (in *ExportTask) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3671,31 +8063,31 @@ func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroupList) DeepCopyInto(out *DBParameterGroupList) {
+func (in *ExportTaskList) DeepCopyInto(out *ExportTaskList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DBParameterGroup, len(*in))
+		*out = make([]ExportTask, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupList.
-func (in *DBParameterGroupList) DeepCopy() *DBParameterGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTaskList.
+func (in *ExportTaskList) DeepCopy() *ExportTaskList {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroupList)
+	out := new(ExportTaskList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
+func (in *ExportTaskList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3703,64 +8095,73 @@ func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroupSpec) DeepCopyInto(out *DBParameterGroupSpec) {
+func (in *ExportTaskSpec) DeepCopyInto(out *ExportTaskSpec) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.ExportOnly != nil {
+		in, out := &in.ExportOnly, &out.ExportOnly
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ExportTaskIdentifier != nil {
+		in, out := &in.ExportTaskIdentifier, &out.ExportTaskIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.Family != nil {
-		in, out := &in.Family, &out.Family
+	if in.IAMRoleARN != nil {
+		in, out := &in.IAMRoleARN, &out.IAMRoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ParameterOverrides != nil {
-		in, out := &in.ParameterOverrides, &out.ParameterOverrides
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupSpec.
-func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTaskSpec.
+func (in *ExportTaskSpec) DeepCopy() *ExportTaskSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroupSpec)
+	out := new(ExportTaskSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
+func (in *ExportTaskStatus) DeepCopyInto(out *ExportTaskStatus) {
 	*out = *in
 	if in.ACKResourceMetadata != nil {
 		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
@@ -3778,166 +8179,227 @@ func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
 			}
 		}
 	}
-	if in.ParameterOverrideStatuses != nil {
-		in, out := &in.ParameterOverrideStatuses, &out.ParameterOverrideStatuses
-		*out = make([]*Parameter, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Parameter)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.FailureCause != nil {
+		in, out := &in.FailureCause, &out.FailureCause
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
-func (in *DBParameterGroupStatus) DeepCopy() *DBParameterGroupStatus {
-	if in == nil {
-		return nil
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(int64)
+		**out = **in
 	}
-	out := new(DBParameterGroupStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroupStatus_SDK) DeepCopyInto(out *DBParameterGroupStatus_SDK) {
-	*out = *in
-	if in.DBParameterGroupName != nil {
-		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+	if in.SnapshotTime != nil {
+		in, out := &in.SnapshotTime, &out.SnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
 		*out = new(string)
 		**out = **in
 	}
-	if in.ParameterApplyStatus != nil {
-		in, out := &in.ParameterApplyStatus, &out.ParameterApplyStatus
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaskEndTime != nil {
+		in, out := &in.TaskEndTime, &out.TaskEndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TaskStartTime != nil {
+		in, out := &in.TaskStartTime, &out.TaskStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TotalExtractedDataInGB != nil {
+		in, out := &in.TotalExtractedDataInGB, &out.TotalExtractedDataInGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WarningMessage != nil {
+		in, out := &in.WarningMessage, &out.WarningMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus_SDK.
-func (in *DBParameterGroupStatus_SDK) DeepCopy() *DBParameterGroupStatus_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTaskStatus.
+func (in *ExportTaskStatus) DeepCopy() *ExportTaskStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroupStatus_SDK)
+	out := new(ExportTaskStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBParameterGroup_SDK) DeepCopyInto(out *DBParameterGroup_SDK) {
+func (in *ExportTask_SDK) DeepCopyInto(out *ExportTask_SDK) {
 	*out = *in
-	if in.DBParameterGroupARN != nil {
-		in, out := &in.DBParameterGroupARN, &out.DBParameterGroupARN
+	if in.ExportOnly != nil {
+		in, out := &in.ExportOnly, &out.ExportOnly
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ExportTaskIdentifier != nil {
+		in, out := &in.ExportTaskIdentifier, &out.ExportTaskIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroupFamily != nil {
-		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
+	if in.FailureCause != nil {
+		in, out := &in.FailureCause, &out.FailureCause
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBParameterGroupName != nil {
-		in, out := &in.DBParameterGroupName, &out.DBParameterGroupName
+	if in.IAMRoleARN != nil {
+		in, out := &in.IAMRoleARN, &out.IAMRoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PercentProgress != nil {
+		in, out := &in.PercentProgress, &out.PercentProgress
+		*out = new(int64)
+		**out = **in
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotTime != nil {
+		in, out := &in.SnapshotTime, &out.SnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaskEndTime != nil {
+		in, out := &in.TaskEndTime, &out.TaskEndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TaskStartTime != nil {
+		in, out := &in.TaskStartTime, &out.TaskStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TotalExtractedDataInGB != nil {
+		in, out := &in.TotalExtractedDataInGB, &out.TotalExtractedDataInGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WarningMessage != nil {
+		in, out := &in.WarningMessage, &out.WarningMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup_SDK.
-func (in *DBParameterGroup_SDK) DeepCopy() *DBParameterGroup_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTask_SDK.
+func (in *ExportTask_SDK) DeepCopy() *ExportTask_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBParameterGroup_SDK)
+	out := new(ExportTask_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxy) DeepCopyInto(out *DBProxy) {
+func (in *FailoverRecord) DeepCopyInto(out *FailoverRecord) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.TargetDBClusterARN != nil {
+		in, out := &in.TargetDBClusterARN, &out.TargetDBClusterARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FailedOverAt != nil {
+		in, out := &in.FailedOverAt, &out.FailedOverAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy.
-func (in *DBProxy) DeepCopy() *DBProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverRecord.
+func (in *FailoverRecord) DeepCopy() *FailoverRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxy)
+	out := new(FailoverRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBProxy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
+func (in *FailoverState) DeepCopyInto(out *FailoverState) {
 	*out = *in
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = (*in).DeepCopy()
-	}
-	if in.DBProxyEndpointARN != nil {
-		in, out := &in.DBProxyEndpointARN, &out.DBProxyEndpointARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBProxyEndpointName != nil {
-		in, out := &in.DBProxyEndpointName, &out.DBProxyEndpointName
+	if in.FromDBClusterARN != nil {
+		in, out := &in.FromDBClusterARN, &out.FromDBClusterARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBProxyName != nil {
-		in, out := &in.DBProxyName, &out.DBProxyName
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
+	if in.ToDBClusterARN != nil {
+		in, out := &in.ToDBClusterARN, &out.ToDBClusterARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverState.
+func (in *FailoverState) DeepCopy() *FailoverState {
+	if in == nil {
+		return nil
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	out := new(FailoverState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCSecurityGroupIDs != nil {
-		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.VPCSubnetIDs != nil {
-		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -3949,42 +8411,37 @@ func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint.
-func (in *DBProxyEndpoint) DeepCopy() *DBProxyEndpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyEndpoint)
+	out := new(Filter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxyList) DeepCopyInto(out *DBProxyList) {
+func (in *FleetAdoption) DeepCopyInto(out *FleetAdoption) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DBProxy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyList.
-func (in *DBProxyList) DeepCopy() *DBProxyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetAdoption.
+func (in *FleetAdoption) DeepCopy() *FleetAdoption {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyList)
+	out := new(FleetAdoption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBProxyList) DeepCopyObject() runtime.Object {
+func (in *FleetAdoption) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3992,62 +8449,47 @@ func (in *DBProxyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
+func (in *FleetAdoptionList) DeepCopyInto(out *FleetAdoptionList) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = make([]*UserAuthConfig, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetAdoption, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(UserAuthConfig)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DebugLogging != nil {
-		in, out := &in.DebugLogging, &out.DebugLogging
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EngineFamily != nil {
-		in, out := &in.EngineFamily, &out.EngineFamily
-		*out = new(string)
-		**out = **in
-	}
-	if in.IdleClientTimeout != nil {
-		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.RequireTLS != nil {
-		in, out := &in.RequireTLS, &out.RequireTLS
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetAdoptionList.
+func (in *FleetAdoptionList) DeepCopy() *FleetAdoptionList {
+	if in == nil {
+		return nil
 	}
-	if in.RoleARN != nil {
-		in, out := &in.RoleARN, &out.RoleARN
-		*out = new(string)
-		**out = **in
+	out := new(FleetAdoptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetAdoptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetAdoptionSpec) DeepCopyInto(out *FleetAdoptionSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.VPCSecurityGroupIDs != nil {
-		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -4057,37 +8499,37 @@ func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
 			}
 		}
 	}
-	if in.VPCSubnetIDs != nil {
-		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
-		*out = make([]*string, len(*in))
+	if in.TagFilters != nil {
+		in, out := &in.TagFilters, &out.TagFilters
+		*out = make([]*FleetAdoptionTagFilter, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(FleetAdoptionTagFilter)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.TargetNamespace != nil {
+		in, out := &in.TargetNamespace, &out.TargetNamespace
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxySpec.
-func (in *DBProxySpec) DeepCopy() *DBProxySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetAdoptionSpec.
+func (in *FleetAdoptionSpec) DeepCopy() *FleetAdoptionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxySpec)
+	out := new(FleetAdoptionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
+func (in *FleetAdoptionStatus) DeepCopyInto(out *FleetAdoptionStatus) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]*corev1alpha1.Condition, len(*in))
@@ -4099,214 +8541,147 @@ func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
 			}
 		}
 	}
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = (*in).DeepCopy()
-	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
+	if in.CreatedAdoptedResources != nil {
+		in, out := &in.CreatedAdoptedResources, &out.CreatedAdoptedResources
+		*out = new(int64)
 		**out = **in
 	}
-	if in.UpdatedDate != nil {
-		in, out := &in.UpdatedDate, &out.UpdatedDate
+	if in.LastDiscoveryTime != nil {
+		in, out := &in.LastDiscoveryTime, &out.LastDiscoveryTime
 		*out = (*in).DeepCopy()
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
+	if in.MatchedDBClusters != nil {
+		in, out := &in.MatchedDBClusters, &out.MatchedDBClusters
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MatchedDBInstances != nil {
+		in, out := &in.MatchedDBInstances, &out.MatchedDBInstances
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyStatus.
-func (in *DBProxyStatus) DeepCopy() *DBProxyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetAdoptionStatus.
+func (in *FleetAdoptionStatus) DeepCopy() *FleetAdoptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyStatus)
+	out := new(FleetAdoptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxyTarget) DeepCopyInto(out *DBProxyTarget) {
+func (in *FleetAdoptionTagFilter) DeepCopyInto(out *FleetAdoptionTagFilter) {
 	*out = *in
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
-		**out = **in
-	}
-	if in.RdsResourceID != nil {
-		in, out := &in.RdsResourceID, &out.RdsResourceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.TargetARN != nil {
-		in, out := &in.TargetARN, &out.TargetARN
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
-	if in.TrackedClusterID != nil {
-		in, out := &in.TrackedClusterID, &out.TrackedClusterID
-		*out = new(string)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTarget.
-func (in *DBProxyTarget) DeepCopy() *DBProxyTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetAdoptionTagFilter.
+func (in *FleetAdoptionTagFilter) DeepCopy() *FleetAdoptionTagFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyTarget)
+	out := new(FleetAdoptionTagFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxyTargetGroup) DeepCopyInto(out *DBProxyTargetGroup) {
+func (in *GlobalCluster) DeepCopyInto(out *GlobalCluster) {
 	*out = *in
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = (*in).DeepCopy()
-	}
-	if in.DBProxyName != nil {
-		in, out := &in.DBProxyName, &out.DBProxyName
-		*out = new(string)
-		**out = **in
-	}
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.TargetGroupARN != nil {
-		in, out := &in.TargetGroupARN, &out.TargetGroupARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.TargetGroupName != nil {
-		in, out := &in.TargetGroupName, &out.TargetGroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.UpdatedDate != nil {
-		in, out := &in.UpdatedDate, &out.UpdatedDate
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTargetGroup.
-func (in *DBProxyTargetGroup) DeepCopy() *DBProxyTargetGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalCluster.
+func (in *GlobalCluster) DeepCopy() *GlobalCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyTargetGroup)
+	out := new(GlobalCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxy_SDK) DeepCopyInto(out *DBProxy_SDK) {
+func (in *GlobalClusterList) DeepCopyInto(out *GlobalClusterList) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = make([]*UserAuthConfigInfo, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalCluster, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(UserAuthConfigInfo)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = (*in).DeepCopy()
-	}
-	if in.DBProxyARN != nil {
-		in, out := &in.DBProxyARN, &out.DBProxyARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBProxyName != nil {
-		in, out := &in.DBProxyName, &out.DBProxyName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterList.
+func (in *GlobalClusterList) DeepCopy() *GlobalClusterList {
+	if in == nil {
+		return nil
 	}
-	if in.DebugLogging != nil {
-		in, out := &in.DebugLogging, &out.DebugLogging
-		*out = new(bool)
-		**out = **in
+	out := new(GlobalClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterMember) DeepCopyInto(out *GlobalClusterMember) {
+	*out = *in
+	if in.DBClusterARN != nil {
+		in, out := &in.DBClusterARN, &out.DBClusterARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineFamily != nil {
-		in, out := &in.EngineFamily, &out.EngineFamily
+	if in.GlobalWriteForwardingStatus != nil {
+		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
 		*out = new(string)
 		**out = **in
 	}
-	if in.IdleClientTimeout != nil {
-		in, out := &in.IdleClientTimeout, &out.IdleClientTimeout
-		*out = new(int64)
-		**out = **in
-	}
-	if in.RequireTLS != nil {
-		in, out := &in.RequireTLS, &out.RequireTLS
+	if in.IsWriter != nil {
+		in, out := &in.IsWriter, &out.IsWriter
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RoleARN != nil {
-		in, out := &in.RoleARN, &out.RoleARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.UpdatedDate != nil {
-		in, out := &in.UpdatedDate, &out.UpdatedDate
-		*out = (*in).DeepCopy()
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPCSecurityGroupIDs != nil {
-		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.VPCSubnetIDs != nil {
-		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+	if in.Readers != nil {
+		in, out := &in.Readers, &out.Readers
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -4318,64 +8693,116 @@ func (in *DBProxy_SDK) DeepCopyInto(out *DBProxy_SDK) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxy_SDK.
-func (in *DBProxy_SDK) DeepCopy() *DBProxy_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterMember.
+func (in *GlobalClusterMember) DeepCopy() *GlobalClusterMember {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxy_SDK)
+	out := new(GlobalClusterMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSecurityGroup) DeepCopyInto(out *DBSecurityGroup) {
+func (in *GlobalClusterSpec) DeepCopyInto(out *GlobalClusterSpec) {
 	*out = *in
-	if in.DBSecurityGroupARN != nil {
-		in, out := &in.DBSecurityGroupARN, &out.DBSecurityGroupARN
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSecurityGroupDescription != nil {
-		in, out := &in.DBSecurityGroupDescription, &out.DBSecurityGroupDescription
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSecurityGroupName != nil {
-		in, out := &in.DBSecurityGroupName, &out.DBSecurityGroupName
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
+	if in.GlobalClusterIdentifier != nil {
+		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	if in.SourceDBClusterIdentifier != nil {
+		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceDBClusterRef != nil {
+		in, out := &in.SourceDBClusterRef, &out.SourceDBClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroup.
-func (in *DBSecurityGroup) DeepCopy() *DBSecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterSpec.
+func (in *GlobalClusterSpec) DeepCopy() *GlobalClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSecurityGroup)
+	out := new(GlobalClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSecurityGroupMembership) DeepCopyInto(out *DBSecurityGroupMembership) {
+func (in *GlobalClusterStatus) DeepCopyInto(out *GlobalClusterStatus) {
 	*out = *in
-	if in.DBSecurityGroupName != nil {
-		in, out := &in.DBSecurityGroupName, &out.DBSecurityGroupName
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FailoverState != nil {
+		in, out := &in.FailoverState, &out.FailoverState
+		*out = new(FailoverState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalClusterMembers != nil {
+		in, out := &in.GlobalClusterMembers, &out.GlobalClusterMembers
+		*out = make([]*GlobalClusterMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalClusterMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalClusterResourceID != nil {
+		in, out := &in.GlobalClusterResourceID, &out.GlobalClusterResourceID
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastFailover != nil {
+		in, out := &in.LastFailover, &out.LastFailover
+		*out = new(FailoverRecord)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -4383,51 +8810,26 @@ func (in *DBSecurityGroupMembership) DeepCopyInto(out *DBSecurityGroupMembership
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSecurityGroupMembership.
-func (in *DBSecurityGroupMembership) DeepCopy() *DBSecurityGroupMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterStatus.
+func (in *GlobalClusterStatus) DeepCopy() *GlobalClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSecurityGroupMembership)
+	out := new(GlobalClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
+func (in *GlobalCluster_SDK) DeepCopyInto(out *GlobalCluster_SDK) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
-		**out = **in
-	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBSnapshotARN != nil {
-		in, out := &in.DBSnapshotARN, &out.DBSnapshotARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBSnapshotIdentifier != nil {
-		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBIResourceID != nil {
-		in, out := &in.DBIResourceID, &out.DBIResourceID
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Encrypted != nil {
-		in, out := &in.Encrypted, &out.Encrypted
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
 		*out = new(bool)
 		**out = **in
 	}
@@ -4441,90 +8843,64 @@ func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.InstanceCreateTime != nil {
-		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
-		*out = new(int64)
-		**out = **in
-	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
-		*out = new(string)
-		**out = **in
+	if in.FailoverState != nil {
+		in, out := &in.FailoverState, &out.FailoverState
+		*out = new(FailoverState)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MasterUsername != nil {
-		in, out := &in.MasterUsername, &out.MasterUsername
+	if in.GlobalClusterARN != nil {
+		in, out := &in.GlobalClusterARN, &out.GlobalClusterARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
+	if in.GlobalClusterIdentifier != nil {
+		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.OriginalSnapshotCreateTime != nil {
-		in, out := &in.OriginalSnapshotCreateTime, &out.OriginalSnapshotCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.PercentProgress != nil {
-		in, out := &in.PercentProgress, &out.PercentProgress
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ProcessorFeatures != nil {
-		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
-		*out = make([]*ProcessorFeature, len(*in))
+	if in.GlobalClusterMembers != nil {
+		in, out := &in.GlobalClusterMembers, &out.GlobalClusterMembers
+		*out = make([]*GlobalClusterMember, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProcessorFeature)
+				*out = new(GlobalClusterMember)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.SnapshotCreateTime != nil {
-		in, out := &in.SnapshotCreateTime, &out.SnapshotCreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.SnapshotDatabaseTime != nil {
-		in, out := &in.SnapshotDatabaseTime, &out.SnapshotDatabaseTime
-		*out = (*in).DeepCopy()
-	}
-	if in.SnapshotTarget != nil {
-		in, out := &in.SnapshotTarget, &out.SnapshotTarget
+	if in.GlobalClusterResourceID != nil {
+		in, out := &in.GlobalClusterResourceID, &out.GlobalClusterResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SnapshotType != nil {
-		in, out := &in.SnapshotType, &out.SnapshotType
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceDBSnapshotIdentifier != nil {
-		in, out := &in.SourceDBSnapshotIdentifier, &out.SourceDBSnapshotIdentifier
-		*out = new(string)
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
 		**out = **in
 	}
-	if in.SourceRegion != nil {
-		in, out := &in.SourceRegion, &out.SourceRegion
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalCluster_SDK.
+func (in *GlobalCluster_SDK) DeepCopy() *GlobalCluster_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalCluster_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+	if in.CIDRIP != nil {
+		in, out := &in.CIDRIP, &out.CIDRIP
 		*out = new(string)
 		**out = **in
 	}
@@ -4533,606 +8909,895 @@ func (in *DBSnapshot) DeepCopyInto(out *DBSnapshot) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageThroughput != nil {
-		in, out := &in.StorageThroughput, &out.StorageThroughput
-		*out = new(int64)
-		**out = **in
-	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
-		*out = new(string)
-		**out = **in
-	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
 	}
-	if in.TDECredentialARN != nil {
-		in, out := &in.TDECredentialARN, &out.TDECredentialARN
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasterUserSecret) DeepCopyInto(out *MasterUserSecret) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Timezone != nil {
-		in, out := &in.Timezone, &out.Timezone
+	if in.SecretARN != nil {
+		in, out := &in.SecretARN, &out.SecretARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	if in.SecretStatus != nil {
+		in, out := &in.SecretStatus, &out.SecretStatus
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshot.
-func (in *DBSnapshot) DeepCopy() *DBSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecret.
+func (in *MasterUserSecret) DeepCopy() *MasterUserSecret {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSnapshot)
+	out := new(MasterUserSecret)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSnapshotAttribute) DeepCopyInto(out *DBSnapshotAttribute) {
+func (in *MasterUserSecretDestination) DeepCopyInto(out *MasterUserSecretDestination) {
 	*out = *in
-	if in.AttributeName != nil {
-		in, out := &in.AttributeName, &out.AttributeName
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotAttribute.
-func (in *DBSnapshotAttribute) DeepCopy() *DBSnapshotAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecretDestination.
+func (in *MasterUserSecretDestination) DeepCopy() *MasterUserSecretDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSnapshotAttribute)
+	out := new(MasterUserSecretDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSnapshotAttributesResult) DeepCopyInto(out *DBSnapshotAttributesResult) {
+func (in *MasterUserSecretRotation) DeepCopyInto(out *MasterUserSecretRotation) {
 	*out = *in
-	if in.DBSnapshotIdentifier != nil {
-		in, out := &in.DBSnapshotIdentifier, &out.DBSnapshotIdentifier
+	if in.AutomaticallyAfterDays != nil {
+		in, out := &in.AutomaticallyAfterDays, &out.AutomaticallyAfterDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
 		*out = new(string)
 		**out = **in
 	}
+	if in.RotateImmediately != nil {
+		in, out := &in.RotateImmediately, &out.RotateImmediately
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSnapshotAttributesResult.
-func (in *DBSnapshotAttributesResult) DeepCopy() *DBSnapshotAttributesResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecretRotation.
+func (in *MasterUserSecretRotation) DeepCopy() *MasterUserSecretRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSnapshotAttributesResult)
+	out := new(MasterUserSecretRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
+func (in *MinimumEngineVersionPerAllowedValue) DeepCopyInto(out *MinimumEngineVersionPerAllowedValue) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.AllowedValue != nil {
+		in, out := &in.AllowedValue, &out.AllowedValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup.
-func (in *DBSubnetGroup) DeepCopy() *DBSubnetGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinimumEngineVersionPerAllowedValue.
+func (in *MinimumEngineVersionPerAllowedValue) DeepCopy() *MinimumEngineVersionPerAllowedValue {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroup)
+	out := new(MinimumEngineVersionPerAllowedValue)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupList) DeepCopyInto(out *DBSubnetGroupList) {
+func (in *ModificationTiming) DeepCopyInto(out *ModificationTiming) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DBSubnetGroup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceClass != nil {
+		in, out := &in.InstanceClass, &out.InstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(string)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupList.
-func (in *DBSubnetGroupList) DeepCopy() *DBSubnetGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModificationTiming.
+func (in *ModificationTiming) DeepCopy() *ModificationTiming {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupList)
+	out := new(ModificationTiming)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupSpec) DeepCopyInto(out *DBSubnetGroupSpec) {
+func (in *Option) DeepCopyInto(out *Option) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.OptionDescription != nil {
+		in, out := &in.OptionDescription, &out.OptionDescription
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.OptionName != nil {
+		in, out := &in.OptionName, &out.OptionName
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetIDs != nil {
-		in, out := &in.SubnetIDs, &out.SubnetIDs
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.SubnetRefs != nil {
-		in, out := &in.SubnetRefs, &out.SubnetRefs
-		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]*OptionSetting, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				*out = new(OptionSetting)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
+	if in.OptionVersion != nil {
+		in, out := &in.OptionVersion, &out.OptionVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Permanent != nil {
+		in, out := &in.Permanent, &out.Permanent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VPCSecurityGroupMemberships != nil {
+		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
+		*out = make([]*VPCSecurityGroupMembership, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
+				*out = new(VPCSecurityGroupMembership)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupSpec.
-func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Option.
+func (in *Option) DeepCopy() *Option {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupSpec)
+	out := new(Option)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
+func (in *OptionConfiguration) DeepCopyInto(out *OptionConfiguration) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
+	if in.DBSecurityGroupMemberships != nil {
+		in, out := &in.DBSecurityGroupMemberships, &out.DBSecurityGroupMemberships
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]*corev1alpha1.Condition, len(*in))
+	if in.OptionName != nil {
+		in, out := &in.OptionName, &out.OptionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]*OptionSetting, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.Condition)
+				*out = new(OptionSetting)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.SubnetGroupStatus != nil {
-		in, out := &in.SubnetGroupStatus, &out.SubnetGroupStatus
+	if in.OptionVersion != nil {
+		in, out := &in.OptionVersion, &out.OptionVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]*Subnet, len(*in))
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VPCSecurityGroupMemberships != nil {
+		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionConfiguration.
+func (in *OptionConfiguration) DeepCopy() *OptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
+func (in *OptionGroup) DeepCopy() *OptionGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupList) DeepCopyInto(out *OptionGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OptionGroup, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Subnet)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SupportedNetworkTypes != nil {
-		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupList.
+func (in *OptionGroupList) DeepCopy() *OptionGroupList {
+	if in == nil {
+		return nil
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	out := new(OptionGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
+	*out = *in
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupStatus.
-func (in *DBSubnetGroupStatus) DeepCopy() *DBSubnetGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupMembership.
+func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroupStatus)
+	out := new(OptionGroupMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBSubnetGroup_SDK) DeepCopyInto(out *DBSubnetGroup_SDK) {
+func (in *OptionGroupOption) DeepCopyInto(out *OptionGroupOption) {
 	*out = *in
-	if in.DBSubnetGroupARN != nil {
-		in, out := &in.DBSubnetGroupARN, &out.DBSubnetGroupARN
+	if in.CopyableCrossAccount != nil {
+		in, out := &in.CopyableCrossAccount, &out.CopyableCrossAccount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultPort != nil {
+		in, out := &in.DefaultPort, &out.DefaultPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupDescription != nil {
-		in, out := &in.DBSubnetGroupDescription, &out.DBSubnetGroupDescription
+	if in.EngineName != nil {
+		in, out := &in.EngineName, &out.EngineName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupName != nil {
-		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetGroupStatus != nil {
-		in, out := &in.SubnetGroupStatus, &out.SubnetGroupStatus
+	if in.MinimumRequiredMinorEngineVersion != nil {
+		in, out := &in.MinimumRequiredMinorEngineVersion, &out.MinimumRequiredMinorEngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]*Subnet, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Subnet)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
-	if in.SupportedNetworkTypes != nil {
-		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.Permanent != nil {
+		in, out := &in.Permanent, &out.Permanent
+		*out = new(bool)
+		**out = **in
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PortRequired != nil {
+		in, out := &in.PortRequired, &out.PortRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiresAutoMinorEngineVersionUpgrade != nil {
+		in, out := &in.RequiresAutoMinorEngineVersionUpgrade, &out.RequiresAutoMinorEngineVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportsOptionVersionDowngrade != nil {
+		in, out := &in.SupportsOptionVersionDowngrade, &out.SupportsOptionVersionDowngrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCOnly != nil {
+		in, out := &in.VPCOnly, &out.VPCOnly
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup_SDK.
-func (in *DBSubnetGroup_SDK) DeepCopy() *DBSubnetGroup_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupOption.
+func (in *OptionGroupOption) DeepCopy() *OptionGroupOption {
 	if in == nil {
 		return nil
 	}
-	out := new(DBSubnetGroup_SDK)
+	out := new(OptionGroupOption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DescribeDBLogFilesDetails) DeepCopyInto(out *DescribeDBLogFilesDetails) {
+func (in *OptionGroupOptionSetting) DeepCopyInto(out *OptionGroupOptionSetting) {
 	*out = *in
-	if in.LogFileName != nil {
-		in, out := &in.LogFileName, &out.LogFileName
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyType != nil {
+		in, out := &in.ApplyType, &out.ApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsModifiable != nil {
+		in, out := &in.IsModifiable, &out.IsModifiable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsRequired != nil {
+		in, out := &in.IsRequired, &out.IsRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SettingDescription != nil {
+		in, out := &in.SettingDescription, &out.SettingDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.SettingName != nil {
+		in, out := &in.SettingName, &out.SettingName
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescribeDBLogFilesDetails.
-func (in *DescribeDBLogFilesDetails) DeepCopy() *DescribeDBLogFilesDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupOptionSetting.
+func (in *OptionGroupOptionSetting) DeepCopy() *OptionGroupOptionSetting {
 	if in == nil {
 		return nil
 	}
-	out := new(DescribeDBLogFilesDetails)
+	out := new(OptionGroupOptionSetting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainMembership) DeepCopyInto(out *DomainMembership) {
+func (in *OptionGroupSpec) DeepCopyInto(out *OptionGroupSpec) {
 	*out = *in
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.FQDN != nil {
-		in, out := &in.FQDN, &out.FQDN
+	if in.EngineName != nil {
+		in, out := &in.EngineName, &out.EngineName
 		*out = new(string)
 		**out = **in
 	}
-	if in.IAMRoleName != nil {
-		in, out := &in.IAMRoleName, &out.IAMRoleName
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]*OptionConfiguration, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OptionConfiguration)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMembership.
-func (in *DomainMembership) DeepCopy() *DomainMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupSpec.
+func (in *OptionGroupSpec) DeepCopy() *OptionGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainMembership)
+	out := new(OptionGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EC2SecurityGroup) DeepCopyInto(out *EC2SecurityGroup) {
+func (in *OptionGroupStatus) DeepCopyInto(out *OptionGroupStatus) {
 	*out = *in
-	if in.EC2SecurityGroupID != nil {
-		in, out := &in.EC2SecurityGroupID, &out.EC2SecurityGroupID
-		*out = new(string)
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AllowsVPCAndNonVPCInstanceMemberships != nil {
+		in, out := &in.AllowsVPCAndNonVPCInstanceMemberships, &out.AllowsVPCAndNonVPCInstanceMemberships
+		*out = new(bool)
 		**out = **in
 	}
-	if in.EC2SecurityGroupName != nil {
-		in, out := &in.EC2SecurityGroupName, &out.EC2SecurityGroupName
+	if in.AppliedOptions != nil {
+		in, out := &in.AppliedOptions, &out.AppliedOptions
+		*out = make([]*Option, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Option)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CopyTimestamp != nil {
+		in, out := &in.CopyTimestamp, &out.CopyTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceAccountID != nil {
+		in, out := &in.SourceAccountID, &out.SourceAccountID
 		*out = new(string)
 		**out = **in
 	}
-	if in.EC2SecurityGroupOwnerID != nil {
-		in, out := &in.EC2SecurityGroupOwnerID, &out.EC2SecurityGroupOwnerID
+	if in.SourceOptionGroup != nil {
+		in, out := &in.SourceOptionGroup, &out.SourceOptionGroup
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2SecurityGroup.
-func (in *EC2SecurityGroup) DeepCopy() *EC2SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupStatus.
+func (in *OptionGroupStatus) DeepCopy() *OptionGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EC2SecurityGroup)
+	out := new(OptionGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *OptionGroup_SDK) DeepCopyInto(out *OptionGroup_SDK) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
+	if in.AllowsVPCAndNonVPCInstanceMemberships != nil {
+		in, out := &in.AllowsVPCAndNonVPCInstanceMemberships, &out.AllowsVPCAndNonVPCInstanceMemberships
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyTimestamp != nil {
+		in, out := &in.CopyTimestamp, &out.CopyTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EngineName != nil {
+		in, out := &in.EngineName, &out.EngineName
 		*out = new(string)
 		**out = **in
 	}
-	if in.HostedZoneID != nil {
-		in, out := &in.HostedZoneID, &out.HostedZoneID
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
+	if in.OptionGroupARN != nil {
+		in, out := &in.OptionGroupARN, &out.OptionGroupARN
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
-	if in == nil {
-		return nil
+	if in.OptionGroupDescription != nil {
+		in, out := &in.OptionGroupDescription, &out.OptionGroupDescription
+		*out = new(string)
+		**out = **in
 	}
-	out := new(Endpoint)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineDefaults) DeepCopyInto(out *EngineDefaults) {
-	*out = *in
-	if in.DBParameterGroupFamily != nil {
-		in, out := &in.DBParameterGroupFamily, &out.DBParameterGroupFamily
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Marker != nil {
-		in, out := &in.Marker, &out.Marker
+	if in.SourceAccountID != nil {
+		in, out := &in.SourceAccountID, &out.SourceAccountID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]*Parameter, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Parameter)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.SourceOptionGroup != nil {
+		in, out := &in.SourceOptionGroup, &out.SourceOptionGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineDefaults.
-func (in *EngineDefaults) DeepCopy() *EngineDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup_SDK.
+func (in *OptionGroup_SDK) DeepCopy() *OptionGroup_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineDefaults)
+	out := new(OptionGroup_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Event) DeepCopyInto(out *Event) {
+func (in *OptionSetting) DeepCopyInto(out *OptionSetting) {
 	*out = *in
-	if in.Date != nil {
-		in, out := &in.Date, &out.Date
-		*out = (*in).DeepCopy()
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = new(string)
+		**out = **in
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.ApplyType != nil {
+		in, out := &in.ApplyType, &out.ApplyType
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceARN != nil {
-		in, out := &in.SourceARN, &out.SourceARN
+	if in.DataType != nil {
+		in, out := &in.DataType, &out.DataType
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceIdentifier != nil {
-		in, out := &in.SourceIdentifier, &out.SourceIdentifier
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsCollection != nil {
+		in, out := &in.IsCollection, &out.IsCollection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsModifiable != nil {
+		in, out := &in.IsModifiable, &out.IsModifiable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
-func (in *Event) DeepCopy() *Event {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionSetting.
+func (in *OptionSetting) DeepCopy() *OptionSetting {
 	if in == nil {
 		return nil
 	}
-	out := new(Event)
+	out := new(OptionSetting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventCategoriesMap) DeepCopyInto(out *EventCategoriesMap) {
+func (in *OptionVersion) DeepCopyInto(out *OptionVersion) {
 	*out = *in
-	if in.SourceType != nil {
-		in, out := &in.SourceType, &out.SourceType
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCategoriesMap.
-func (in *EventCategoriesMap) DeepCopy() *EventCategoriesMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionVersion.
+func (in *OptionVersion) DeepCopy() *OptionVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(EventCategoriesMap)
+	out := new(OptionVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
+func (in *OrderableDBInstanceOption) DeepCopyInto(out *OrderableDBInstanceOption) {
 	*out = *in
-	if in.CustSubscriptionID != nil {
-		in, out := &in.CustSubscriptionID, &out.CustSubscriptionID
+	if in.AvailabilityZoneGroup != nil {
+		in, out := &in.AvailabilityZoneGroup, &out.AvailabilityZoneGroup
 		*out = new(string)
 		**out = **in
 	}
-	if in.CustomerAWSID != nil {
-		in, out := &in.CustomerAWSID, &out.CustomerAWSID
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
 		**out = **in
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
 		**out = **in
 	}
-	if in.EventSubscriptionARN != nil {
-		in, out := &in.EventSubscriptionARN, &out.EventSubscriptionARN
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.SNSTopicARN != nil {
-		in, out := &in.SNSTopicARN, &out.SNSTopicARN
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceType != nil {
-		in, out := &in.SourceType, &out.SourceType
-		*out = new(string)
+	if in.MaxIOPSPerDBInstance != nil {
+		in, out := &in.MaxIOPSPerDBInstance, &out.MaxIOPSPerDBInstance
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
+	if in.MaxIOPSPerGib != nil {
+		in, out := &in.MaxIOPSPerGib, &out.MaxIOPSPerGib
+		*out = new(float64)
 		**out = **in
 	}
-	if in.SubscriptionCreationTime != nil {
-		in, out := &in.SubscriptionCreationTime, &out.SubscriptionCreationTime
+	if in.MaxStorageSize != nil {
+		in, out := &in.MaxStorageSize, &out.MaxStorageSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxStorageThroughputPerDBInstance != nil {
+		in, out := &in.MaxStorageThroughputPerDBInstance, &out.MaxStorageThroughputPerDBInstance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxStorageThroughputPerIOPS != nil {
+		in, out := &in.MaxStorageThroughputPerIOPS, &out.MaxStorageThroughputPerIOPS
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinIOPSPerDBInstance != nil {
+		in, out := &in.MinIOPSPerDBInstance, &out.MinIOPSPerDBInstance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinIOPSPerGib != nil {
+		in, out := &in.MinIOPSPerGib, &out.MinIOPSPerGib
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinStorageSize != nil {
+		in, out := &in.MinStorageSize, &out.MinStorageSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinStorageThroughputPerDBInstance != nil {
+		in, out := &in.MinStorageThroughputPerDBInstance, &out.MinStorageThroughputPerDBInstance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinStorageThroughputPerIOPS != nil {
+		in, out := &in.MinStorageThroughputPerIOPS, &out.MinStorageThroughputPerIOPS
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MultiAZCapable != nil {
+		in, out := &in.MultiAZCapable, &out.MultiAZCapable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OutpostCapable != nil {
+		in, out := &in.OutpostCapable, &out.OutpostCapable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadReplicaCapable != nil {
+		in, out := &in.ReadReplicaCapable, &out.ReadReplicaCapable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription.
-func (in *EventSubscription) DeepCopy() *EventSubscription {
-	if in == nil {
-		return nil
+	if in.SupportedEngineModes != nil {
+		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	out := new(EventSubscription)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExportTask) DeepCopyInto(out *ExportTask) {
-	*out = *in
-	if in.ExportOnly != nil {
-		in, out := &in.ExportOnly, &out.ExportOnly
+	if in.SupportedNetworkTypes != nil {
+		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -5142,225 +9807,153 @@ func (in *ExportTask) DeepCopyInto(out *ExportTask) {
 			}
 		}
 	}
-	if in.ExportTaskIdentifier != nil {
-		in, out := &in.ExportTaskIdentifier, &out.ExportTaskIdentifier
-		*out = new(string)
+	if in.SupportsClusters != nil {
+		in, out := &in.SupportsClusters, &out.SupportsClusters
+		*out = new(bool)
 		**out = **in
 	}
-	if in.FailureCause != nil {
-		in, out := &in.FailureCause, &out.FailureCause
-		*out = new(string)
+	if in.SupportsDedicatedLogVolume != nil {
+		in, out := &in.SupportsDedicatedLogVolume, &out.SupportsDedicatedLogVolume
+		*out = new(bool)
 		**out = **in
 	}
-	if in.IAMRoleARN != nil {
-		in, out := &in.IAMRoleARN, &out.IAMRoleARN
-		*out = new(string)
+	if in.SupportsEnhancedMonitoring != nil {
+		in, out := &in.SupportsEnhancedMonitoring, &out.SupportsEnhancedMonitoring
+		*out = new(bool)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
+	if in.SupportsGlobalDatabases != nil {
+		in, out := &in.SupportsGlobalDatabases, &out.SupportsGlobalDatabases
+		*out = new(bool)
 		**out = **in
 	}
-	if in.PercentProgress != nil {
-		in, out := &in.PercentProgress, &out.PercentProgress
-		*out = new(int64)
+	if in.SupportsIAMDatabaseAuthentication != nil {
+		in, out := &in.SupportsIAMDatabaseAuthentication, &out.SupportsIAMDatabaseAuthentication
+		*out = new(bool)
 		**out = **in
 	}
-	if in.S3Bucket != nil {
-		in, out := &in.S3Bucket, &out.S3Bucket
-		*out = new(string)
+	if in.SupportsIOPS != nil {
+		in, out := &in.SupportsIOPS, &out.SupportsIOPS
+		*out = new(bool)
 		**out = **in
 	}
-	if in.S3Prefix != nil {
-		in, out := &in.S3Prefix, &out.S3Prefix
-		*out = new(string)
+	if in.SupportsKerberosAuthentication != nil {
+		in, out := &in.SupportsKerberosAuthentication, &out.SupportsKerberosAuthentication
+		*out = new(bool)
 		**out = **in
 	}
-	if in.SnapshotTime != nil {
-		in, out := &in.SnapshotTime, &out.SnapshotTime
-		*out = (*in).DeepCopy()
-	}
-	if in.SourceARN != nil {
-		in, out := &in.SourceARN, &out.SourceARN
-		*out = new(string)
+	if in.SupportsPerformanceInsights != nil {
+		in, out := &in.SupportsPerformanceInsights, &out.SupportsPerformanceInsights
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
+	if in.SupportsStorageAutoscaling != nil {
+		in, out := &in.SupportsStorageAutoscaling, &out.SupportsStorageAutoscaling
+		*out = new(bool)
 		**out = **in
 	}
-	if in.TaskEndTime != nil {
-		in, out := &in.TaskEndTime, &out.TaskEndTime
-		*out = (*in).DeepCopy()
-	}
-	if in.TaskStartTime != nil {
-		in, out := &in.TaskStartTime, &out.TaskStartTime
-		*out = (*in).DeepCopy()
+	if in.SupportsStorageEncryption != nil {
+		in, out := &in.SupportsStorageEncryption, &out.SupportsStorageEncryption
+		*out = new(bool)
+		**out = **in
 	}
-	if in.TotalExtractedDataInGB != nil {
-		in, out := &in.TotalExtractedDataInGB, &out.TotalExtractedDataInGB
-		*out = new(int64)
+	if in.SupportsStorageThroughput != nil {
+		in, out := &in.SupportsStorageThroughput, &out.SupportsStorageThroughput
+		*out = new(bool)
 		**out = **in
 	}
-	if in.WarningMessage != nil {
-		in, out := &in.WarningMessage, &out.WarningMessage
-		*out = new(string)
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTask.
-func (in *ExportTask) DeepCopy() *ExportTask {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrderableDBInstanceOption.
+func (in *OrderableDBInstanceOption) DeepCopy() *OrderableDBInstanceOption {
 	if in == nil {
 		return nil
 	}
-	out := new(ExportTask)
+	out := new(OrderableDBInstanceOption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailoverState) DeepCopyInto(out *FailoverState) {
+func (in *Outpost) DeepCopyInto(out *Outpost) {
 	*out = *in
-	if in.FromDBClusterARN != nil {
-		in, out := &in.FromDBClusterARN, &out.FromDBClusterARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.ToDBClusterARN != nil {
-		in, out := &in.ToDBClusterARN, &out.ToDBClusterARN
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverState.
-func (in *FailoverState) DeepCopy() *FailoverState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Outpost.
+func (in *Outpost) DeepCopy() *Outpost {
 	if in == nil {
 		return nil
 	}
-	out := new(FailoverState)
+	out := new(Outpost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Filter) DeepCopyInto(out *Filter) {
+func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
 		*out = new(string)
 		**out = **in
 	}
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
-func (in *Filter) DeepCopy() *Filter {
-	if in == nil {
-		return nil
+	if in.ApplyMethod != nil {
+		in, out := &in.ApplyMethod, &out.ApplyMethod
+		*out = new(string)
+		**out = **in
 	}
-	out := new(Filter)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalCluster) DeepCopyInto(out *GlobalCluster) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalCluster.
-func (in *GlobalCluster) DeepCopy() *GlobalCluster {
-	if in == nil {
-		return nil
+	if in.ApplyType != nil {
+		in, out := &in.ApplyType, &out.ApplyType
+		*out = new(string)
+		**out = **in
 	}
-	out := new(GlobalCluster)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GlobalCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.DataType != nil {
+		in, out := &in.DataType, &out.DataType
+		*out = new(string)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalClusterList) DeepCopyInto(out *GlobalClusterList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GlobalCluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterList.
-func (in *GlobalClusterList) DeepCopy() *GlobalClusterList {
-	if in == nil {
-		return nil
+	if in.IsModifiable != nil {
+		in, out := &in.IsModifiable, &out.IsModifiable
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(GlobalClusterList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GlobalClusterList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.MinimumEngineVersion != nil {
+		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+		*out = new(string)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalClusterMember) DeepCopyInto(out *GlobalClusterMember) {
-	*out = *in
-	if in.DBClusterARN != nil {
-		in, out := &in.DBClusterARN, &out.DBClusterARN
+	if in.ParameterName != nil {
+		in, out := &in.ParameterName, &out.ParameterName
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalWriteForwardingStatus != nil {
-		in, out := &in.GlobalWriteForwardingStatus, &out.GlobalWriteForwardingStatus
+	if in.ParameterValue != nil {
+		in, out := &in.ParameterValue, &out.ParameterValue
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsWriter != nil {
-		in, out := &in.IsWriter, &out.IsWriter
-		*out = new(bool)
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
 		**out = **in
 	}
-	if in.Readers != nil {
-		in, out := &in.Readers, &out.Readers
+	if in.SupportedEngineModes != nil {
+		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -5372,696 +9965,608 @@ func (in *GlobalClusterMember) DeepCopyInto(out *GlobalClusterMember) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterMember.
-func (in *GlobalClusterMember) DeepCopy() *GlobalClusterMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalClusterMember)
+	out := new(Parameter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalClusterSpec) DeepCopyInto(out *GlobalClusterSpec) {
+func (in *ParameterApplyRecord) DeepCopyInto(out *ParameterApplyRecord) {
 	*out = *in
-	if in.DatabaseName != nil {
-		in, out := &in.DatabaseName, &out.DatabaseName
-		*out = new(string)
-		**out = **in
-	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalClusterIdentifier != nil {
-		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
-		*out = new(string)
-		**out = **in
-	}
-	if in.SourceDBClusterIdentifier != nil {
-		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
-		*out = new(string)
-		**out = **in
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
-		*out = new(bool)
-		**out = **in
+	if in.ParameterNames != nil {
+		in, out := &in.ParameterNames, &out.ParameterNames
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterSpec.
-func (in *GlobalClusterSpec) DeepCopy() *GlobalClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterApplyRecord.
+func (in *ParameterApplyRecord) DeepCopy() *ParameterApplyRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalClusterSpec)
+	out := new(ParameterApplyRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalClusterStatus) DeepCopyInto(out *GlobalClusterStatus) {
+func (in *PendingCloudwatchLogsExports) DeepCopyInto(out *PendingCloudwatchLogsExports) {
 	*out = *in
-	if in.ACKResourceMetadata != nil {
-		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
-		*out = new(corev1alpha1.ResourceMetadata)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]*corev1alpha1.Condition, len(*in))
+	if in.LogTypesToDisable != nil {
+		in, out := &in.LogTypesToDisable, &out.LogTypesToDisable
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(corev1alpha1.Condition)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-	if in.FailoverState != nil {
-		in, out := &in.FailoverState, &out.FailoverState
-		*out = new(FailoverState)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GlobalClusterMembers != nil {
-		in, out := &in.GlobalClusterMembers, &out.GlobalClusterMembers
-		*out = make([]*GlobalClusterMember, len(*in))
+	if in.LogTypesToEnable != nil {
+		in, out := &in.LogTypesToEnable, &out.LogTypesToEnable
+		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(GlobalClusterMember)
-				(*in).DeepCopyInto(*out)
+				*out = new(string)
+				**out = **in
 			}
 		}
 	}
-	if in.GlobalClusterResourceID != nil {
-		in, out := &in.GlobalClusterResourceID, &out.GlobalClusterResourceID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCloudwatchLogsExports.
+func (in *PendingCloudwatchLogsExports) DeepCopy() *PendingCloudwatchLogsExports {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCloudwatchLogsExports)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingMaintenanceAction) DeepCopyInto(out *PendingMaintenanceAction) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.AutoAppliedAfterDate != nil {
+		in, out := &in.AutoAppliedAfterDate, &out.AutoAppliedAfterDate
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentApplyDate != nil {
+		in, out := &in.CurrentApplyDate, &out.CurrentApplyDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForcedApplyDate != nil {
+		in, out := &in.ForcedApplyDate, &out.ForcedApplyDate
+		*out = (*in).DeepCopy()
+	}
+	if in.OptInStatus != nil {
+		in, out := &in.OptInStatus, &out.OptInStatus
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterStatus.
-func (in *GlobalClusterStatus) DeepCopy() *GlobalClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingMaintenanceAction.
+func (in *PendingMaintenanceAction) DeepCopy() *PendingMaintenanceAction {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalClusterStatus)
+	out := new(PendingMaintenanceAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalCluster_SDK) DeepCopyInto(out *GlobalCluster_SDK) {
+func (in *PendingModifiedValues) DeepCopyInto(out *PendingModifiedValues) {
 	*out = *in
-	if in.DatabaseName != nil {
-		in, out := &in.DatabaseName, &out.DatabaseName
+	if in.AllocatedStorage != nil {
+		in, out := &in.AllocatedStorage, &out.AllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutomationMode != nil {
+		in, out := &in.AutomationMode, &out.AutomationMode
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeletionProtection != nil {
-		in, out := &in.DeletionProtection, &out.DeletionProtection
-		*out = new(bool)
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
+	if in.CACertificateIdentifier != nil {
+		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
 		**out = **in
 	}
-	if in.FailoverState != nil {
-		in, out := &in.FailoverState, &out.FailoverState
-		*out = new(FailoverState)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GlobalClusterARN != nil {
-		in, out := &in.GlobalClusterARN, &out.GlobalClusterARN
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalClusterIdentifier != nil {
-		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalClusterMembers != nil {
-		in, out := &in.GlobalClusterMembers, &out.GlobalClusterMembers
-		*out = make([]*GlobalClusterMember, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(GlobalClusterMember)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.GlobalClusterResourceID != nil {
-		in, out := &in.GlobalClusterResourceID, &out.GlobalClusterResourceID
-		*out = new(string)
+	if in.DedicatedLogVolume != nil {
+		in, out := &in.DedicatedLogVolume, &out.DedicatedLogVolume
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageEncrypted != nil {
-		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+	if in.IAMDatabaseAuthenticationEnabled != nil {
+		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalCluster_SDK.
-func (in *GlobalCluster_SDK) DeepCopy() *GlobalCluster_SDK {
-	if in == nil {
-		return nil
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
 	}
-	out := new(GlobalCluster_SDK)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPRange) DeepCopyInto(out *IPRange) {
-	*out = *in
-	if in.CIDRIP != nil {
-		in, out := &in.CIDRIP, &out.CIDRIP
+	if in.LicenseModel != nil {
+		in, out := &in.LicenseModel, &out.LicenseModel
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.MasterUserPassword != nil {
+		in, out := &in.MasterUserPassword, &out.MasterUserPassword
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
-func (in *IPRange) DeepCopy() *IPRange {
-	if in == nil {
-		return nil
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(IPRange)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MasterUserSecret) DeepCopyInto(out *MasterUserSecret) {
-	*out = *in
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
+	if in.PendingCloudwatchLogsExports != nil {
+		in, out := &in.PendingCloudwatchLogsExports, &out.PendingCloudwatchLogsExports
+		*out = new(PendingCloudwatchLogsExports)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
 		**out = **in
 	}
-	if in.SecretARN != nil {
-		in, out := &in.SecretARN, &out.SecretARN
-		*out = new(string)
+	if in.ProcessorFeatures != nil {
+		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
+		*out = make([]*ProcessorFeature, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProcessorFeature)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ResumeFullAutomationModeTime != nil {
+		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StorageThroughput != nil {
+		in, out := &in.StorageThroughput, &out.StorageThroughput
+		*out = new(int64)
 		**out = **in
 	}
-	if in.SecretStatus != nil {
-		in, out := &in.SecretStatus, &out.SecretStatus
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecret.
-func (in *MasterUserSecret) DeepCopy() *MasterUserSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingModifiedValues.
+func (in *PendingModifiedValues) DeepCopy() *PendingModifiedValues {
 	if in == nil {
 		return nil
 	}
-	out := new(MasterUserSecret)
+	out := new(PendingModifiedValues)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MinimumEngineVersionPerAllowedValue) DeepCopyInto(out *MinimumEngineVersionPerAllowedValue) {
+func (in *ProcessorFeature) DeepCopyInto(out *ProcessorFeature) {
 	*out = *in
-	if in.AllowedValue != nil {
-		in, out := &in.AllowedValue, &out.AllowedValue
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.MinimumEngineVersion != nil {
-		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinimumEngineVersionPerAllowedValue.
-func (in *MinimumEngineVersionPerAllowedValue) DeepCopy() *MinimumEngineVersionPerAllowedValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFeature.
+func (in *ProcessorFeature) DeepCopy() *ProcessorFeature {
 	if in == nil {
 		return nil
 	}
-	out := new(MinimumEngineVersionPerAllowedValue)
+	out := new(ProcessorFeature)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Option) DeepCopyInto(out *Option) {
+func (in *Range) DeepCopyInto(out *Range) {
 	*out = *in
-	if in.OptionDescription != nil {
-		in, out := &in.OptionDescription, &out.OptionDescription
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionName != nil {
-		in, out := &in.OptionName, &out.OptionName
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionVersion != nil {
-		in, out := &in.OptionVersion, &out.OptionVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.Permanent != nil {
-		in, out := &in.Permanent, &out.Permanent
-		*out = new(bool)
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Persistent != nil {
-		in, out := &in.Persistent, &out.Persistent
-		*out = new(bool)
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
+	if in.To != nil {
+		in, out := &in.To, &out.To
 		*out = new(int64)
 		**out = **in
 	}
-	if in.VPCSecurityGroupMemberships != nil {
-		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
-		*out = make([]*VPCSecurityGroupMembership, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(VPCSecurityGroupMembership)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Option.
-func (in *Option) DeepCopy() *Option {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Range.
+func (in *Range) DeepCopy() *Range {
 	if in == nil {
 		return nil
 	}
-	out := new(Option)
+	out := new(Range)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionConfiguration) DeepCopyInto(out *OptionConfiguration) {
+func (in *RebootRecord) DeepCopyInto(out *RebootRecord) {
 	*out = *in
-	if in.DBSecurityGroupMemberships != nil {
-		in, out := &in.DBSecurityGroupMemberships, &out.DBSecurityGroupMemberships
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
-	if in.OptionName != nil {
-		in, out := &in.OptionName, &out.OptionName
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionVersion != nil {
-		in, out := &in.OptionVersion, &out.OptionVersion
-		*out = new(string)
-		**out = **in
+	if in.RebootedAt != nil {
+		in, out := &in.RebootedAt, &out.RebootedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
+	if in.ForceFailover != nil {
+		in, out := &in.ForceFailover, &out.ForceFailover
+		*out = new(bool)
 		**out = **in
 	}
-	if in.VPCSecurityGroupMemberships != nil {
-		in, out := &in.VPCSecurityGroupMemberships, &out.VPCSecurityGroupMemberships
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionConfiguration.
-func (in *OptionConfiguration) DeepCopy() *OptionConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootRecord.
+func (in *RebootRecord) DeepCopy() *RebootRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionConfiguration)
+	out := new(RebootRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
+func (in *RecurringCharge) DeepCopyInto(out *RecurringCharge) {
 	*out = *in
-	if in.AllowsVPCAndNonVPCInstanceMemberships != nil {
-		in, out := &in.AllowsVPCAndNonVPCInstanceMemberships, &out.AllowsVPCAndNonVPCInstanceMemberships
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CopyTimestamp != nil {
-		in, out := &in.CopyTimestamp, &out.CopyTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.EngineName != nil {
-		in, out := &in.EngineName, &out.EngineName
-		*out = new(string)
-		**out = **in
-	}
-	if in.MajorEngineVersion != nil {
-		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionGroupARN != nil {
-		in, out := &in.OptionGroupARN, &out.OptionGroupARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionGroupDescription != nil {
-		in, out := &in.OptionGroupDescription, &out.OptionGroupDescription
-		*out = new(string)
-		**out = **in
-	}
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SourceAccountID != nil {
-		in, out := &in.SourceAccountID, &out.SourceAccountID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SourceOptionGroup != nil {
-		in, out := &in.SourceOptionGroup, &out.SourceOptionGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	if in.RecurringChargeFrequency != nil {
+		in, out := &in.RecurringChargeFrequency, &out.RecurringChargeFrequency
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
-func (in *OptionGroup) DeepCopy() *OptionGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringCharge.
+func (in *RecurringCharge) DeepCopy() *RecurringCharge {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroup)
+	out := new(RecurringCharge)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
+func (in *ReservedDBInstance) DeepCopyInto(out *ReservedDBInstance) {
 	*out = *in
-	if in.OptionGroupName != nil {
-		in, out := &in.OptionGroupName, &out.OptionGroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupMembership.
-func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstance.
+func (in *ReservedDBInstance) DeepCopy() *ReservedDBInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroupMembership)
+	out := new(ReservedDBInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedDBInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionGroupOption) DeepCopyInto(out *OptionGroupOption) {
+func (in *ReservedDBInstanceList) DeepCopyInto(out *ReservedDBInstanceList) {
 	*out = *in
-	if in.CopyableCrossAccount != nil {
-		in, out := &in.CopyableCrossAccount, &out.CopyableCrossAccount
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DefaultPort != nil {
-		in, out := &in.DefaultPort, &out.DefaultPort
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReservedDBInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceList.
+func (in *ReservedDBInstanceList) DeepCopy() *ReservedDBInstanceList {
+	if in == nil {
+		return nil
 	}
-	if in.EngineName != nil {
-		in, out := &in.EngineName, &out.EngineName
-		*out = new(string)
-		**out = **in
+	out := new(ReservedDBInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedDBInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MajorEngineVersion != nil {
-		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceSpec) DeepCopyInto(out *ReservedDBInstanceSpec) {
+	*out = *in
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
 		**out = **in
 	}
-	if in.MinimumRequiredMinorEngineVersion != nil {
-		in, out := &in.MinimumRequiredMinorEngineVersion, &out.MinimumRequiredMinorEngineVersion
-		*out = new(string)
+	if in.DBInstanceCount != nil {
+		in, out := &in.DBInstanceCount, &out.DBInstanceCount
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Permanent != nil {
-		in, out := &in.Permanent, &out.Permanent
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Persistent != nil {
-		in, out := &in.Persistent, &out.Persistent
-		*out = new(bool)
+	if in.OfferingType != nil {
+		in, out := &in.OfferingType, &out.OfferingType
+		*out = new(string)
 		**out = **in
 	}
-	if in.PortRequired != nil {
-		in, out := &in.PortRequired, &out.PortRequired
-		*out = new(bool)
+	if in.ProductDescription != nil {
+		in, out := &in.ProductDescription, &out.ProductDescription
+		*out = new(string)
 		**out = **in
 	}
-	if in.RequiresAutoMinorEngineVersionUpgrade != nil {
-		in, out := &in.RequiresAutoMinorEngineVersionUpgrade, &out.RequiresAutoMinorEngineVersionUpgrade
-		*out = new(bool)
+	if in.ReservedDBInstanceID != nil {
+		in, out := &in.ReservedDBInstanceID, &out.ReservedDBInstanceID
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsOptionVersionDowngrade != nil {
-		in, out := &in.SupportsOptionVersionDowngrade, &out.SupportsOptionVersionDowngrade
-		*out = new(bool)
+	if in.ReservedDBInstancesOfferingID != nil {
+		in, out := &in.ReservedDBInstancesOfferingID, &out.ReservedDBInstancesOfferingID
+		*out = new(string)
 		**out = **in
 	}
-	if in.VPCOnly != nil {
-		in, out := &in.VPCOnly, &out.VPCOnly
-		*out = new(bool)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupOption.
-func (in *OptionGroupOption) DeepCopy() *OptionGroupOption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceSpec.
+func (in *ReservedDBInstanceSpec) DeepCopy() *ReservedDBInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroupOption)
+	out := new(ReservedDBInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionGroupOptionSetting) DeepCopyInto(out *OptionGroupOptionSetting) {
+func (in *ReservedDBInstanceStatus) DeepCopyInto(out *ReservedDBInstanceStatus) {
 	*out = *in
-	if in.AllowedValues != nil {
-		in, out := &in.AllowedValues, &out.AllowedValues
-		*out = new(string)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ApplyType != nil {
-		in, out := &in.ApplyType, &out.ApplyType
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.DefaultValue != nil {
-		in, out := &in.DefaultValue, &out.DefaultValue
+	if in.CurrencyCode != nil {
+		in, out := &in.CurrencyCode, &out.CurrencyCode
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsModifiable != nil {
-		in, out := &in.IsModifiable, &out.IsModifiable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IsRequired != nil {
-		in, out := &in.IsRequired, &out.IsRequired
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SettingDescription != nil {
-		in, out := &in.SettingDescription, &out.SettingDescription
+	if in.LeaseID != nil {
+		in, out := &in.LeaseID, &out.LeaseID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SettingName != nil {
-		in, out := &in.SettingName, &out.SettingName
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupOptionSetting.
-func (in *OptionGroupOptionSetting) DeepCopy() *OptionGroupOptionSetting {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceStatus.
+func (in *ReservedDBInstanceStatus) DeepCopy() *ReservedDBInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroupOptionSetting)
+	out := new(ReservedDBInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionSetting) DeepCopyInto(out *OptionSetting) {
+func (in *ReservedDBInstance_SDK) DeepCopyInto(out *ReservedDBInstance_SDK) {
 	*out = *in
-	if in.AllowedValues != nil {
-		in, out := &in.AllowedValues, &out.AllowedValues
+	if in.CurrencyCode != nil {
+		in, out := &in.CurrencyCode, &out.CurrencyCode
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyType != nil {
-		in, out := &in.ApplyType, &out.ApplyType
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
 		**out = **in
 	}
-	if in.DataType != nil {
-		in, out := &in.DataType, &out.DataType
-		*out = new(string)
+	if in.DBInstanceCount != nil {
+		in, out := &in.DBInstanceCount, &out.DBInstanceCount
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DefaultValue != nil {
-		in, out := &in.DefaultValue, &out.DefaultValue
-		*out = new(string)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.LeaseID != nil {
+		in, out := &in.LeaseID, &out.LeaseID
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsCollection != nil {
-		in, out := &in.IsCollection, &out.IsCollection
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IsModifiable != nil {
-		in, out := &in.IsModifiable, &out.IsModifiable
-		*out = new(bool)
+	if in.OfferingType != nil {
+		in, out := &in.OfferingType, &out.OfferingType
+		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.ProductDescription != nil {
+		in, out := &in.ProductDescription, &out.ProductDescription
 		*out = new(string)
 		**out = **in
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
+	if in.ReservedDBInstanceARN != nil {
+		in, out := &in.ReservedDBInstanceARN, &out.ReservedDBInstanceARN
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionSetting.
-func (in *OptionSetting) DeepCopy() *OptionSetting {
-	if in == nil {
-		return nil
+	if in.ReservedDBInstanceID != nil {
+		in, out := &in.ReservedDBInstanceID, &out.ReservedDBInstanceID
+		*out = new(string)
+		**out = **in
 	}
-	out := new(OptionSetting)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OptionVersion) DeepCopyInto(out *OptionVersion) {
-	*out = *in
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
+	if in.ReservedDBInstancesOfferingID != nil {
+		in, out := &in.ReservedDBInstancesOfferingID, &out.ReservedDBInstancesOfferingID
+		*out = new(string)
 		**out = **in
 	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionVersion.
-func (in *OptionVersion) DeepCopy() *OptionVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstance_SDK.
+func (in *ReservedDBInstance_SDK) DeepCopy() *ReservedDBInstance_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionVersion)
+	out := new(ReservedDBInstance_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrderableDBInstanceOption) DeepCopyInto(out *OrderableDBInstanceOption) {
+func (in *ReservedDBInstancesOffering) DeepCopyInto(out *ReservedDBInstancesOffering) {
 	*out = *in
-	if in.AvailabilityZoneGroup != nil {
-		in, out := &in.AvailabilityZoneGroup, &out.AvailabilityZoneGroup
+	if in.CurrencyCode != nil {
+		in, out := &in.CurrencyCode, &out.CurrencyCode
 		*out = new(string)
 		**out = **in
 	}
@@ -6070,93 +10575,93 @@ func (in *OrderableDBInstanceOption) DeepCopyInto(out *OrderableDBInstanceOption
 		*out = new(string)
 		**out = **in
 	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
-		**out = **in
-	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxIOPSPerDBInstance != nil {
-		in, out := &in.MaxIOPSPerDBInstance, &out.MaxIOPSPerDBInstance
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MaxIOPSPerGib != nil {
-		in, out := &in.MaxIOPSPerGib, &out.MaxIOPSPerGib
-		*out = new(float64)
-		**out = **in
-	}
-	if in.MaxStorageSize != nil {
-		in, out := &in.MaxStorageSize, &out.MaxStorageSize
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MaxStorageThroughputPerDBInstance != nil {
-		in, out := &in.MaxStorageThroughputPerDBInstance, &out.MaxStorageThroughputPerDBInstance
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MaxStorageThroughputPerIOPS != nil {
-		in, out := &in.MaxStorageThroughputPerIOPS, &out.MaxStorageThroughputPerIOPS
-		*out = new(float64)
-		**out = **in
-	}
-	if in.MinIOPSPerDBInstance != nil {
-		in, out := &in.MinIOPSPerDBInstance, &out.MinIOPSPerDBInstance
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
 		*out = new(int64)
 		**out = **in
 	}
-	if in.MinIOPSPerGib != nil {
-		in, out := &in.MinIOPSPerGib, &out.MinIOPSPerGib
-		*out = new(float64)
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MinStorageSize != nil {
-		in, out := &in.MinStorageSize, &out.MinStorageSize
-		*out = new(int64)
+	if in.OfferingType != nil {
+		in, out := &in.OfferingType, &out.OfferingType
+		*out = new(string)
 		**out = **in
 	}
-	if in.MinStorageThroughputPerDBInstance != nil {
-		in, out := &in.MinStorageThroughputPerDBInstance, &out.MinStorageThroughputPerDBInstance
-		*out = new(int64)
+	if in.ProductDescription != nil {
+		in, out := &in.ProductDescription, &out.ProductDescription
+		*out = new(string)
 		**out = **in
 	}
-	if in.MinStorageThroughputPerIOPS != nil {
-		in, out := &in.MinStorageThroughputPerIOPS, &out.MinStorageThroughputPerIOPS
-		*out = new(float64)
+	if in.ReservedDBInstancesOfferingID != nil {
+		in, out := &in.ReservedDBInstancesOfferingID, &out.ReservedDBInstancesOfferingID
+		*out = new(string)
 		**out = **in
 	}
-	if in.MultiAZCapable != nil {
-		in, out := &in.MultiAZCapable, &out.MultiAZCapable
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstancesOffering.
+func (in *ReservedDBInstancesOffering) DeepCopy() *ReservedDBInstancesOffering {
+	if in == nil {
+		return nil
 	}
-	if in.OutpostCapable != nil {
-		in, out := &in.OutpostCapable, &out.OutpostCapable
-		*out = new(bool)
+	out := new(ReservedDBInstancesOffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePendingMaintenanceActions) DeepCopyInto(out *ResourcePendingMaintenanceActions) {
+	*out = *in
+	if in.ResourceIdentifier != nil {
+		in, out := &in.ResourceIdentifier, &out.ResourceIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.ReadReplicaCapable != nil {
-		in, out := &in.ReadReplicaCapable, &out.ReadReplicaCapable
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePendingMaintenanceActions.
+func (in *ResourcePendingMaintenanceActions) DeepCopy() *ResourcePendingMaintenanceActions {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePendingMaintenanceActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFrom) DeepCopyInto(out *RestoreFrom) {
+	*out = *in
+	if in.SourceDBClusterIdentifier != nil {
+		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
+	if in.RestoreType != nil {
+		in, out := &in.RestoreType, &out.RestoreType
 		*out = new(string)
 		**out = **in
 	}
-	if in.SupportedEngineModes != nil {
-		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFrom.
+func (in *RestoreFrom) DeepCopy() *RestoreFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreHealthCheck) DeepCopyInto(out *RestoreHealthCheck) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -6166,8 +10671,8 @@ func (in *OrderableDBInstanceOption) DeepCopyInto(out *OrderableDBInstanceOption
 			}
 		}
 	}
-	if in.SupportedNetworkTypes != nil {
-		in, out := &in.SupportedNetworkTypes, &out.SupportedNetworkTypes
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
@@ -6177,861 +10682,1174 @@ func (in *OrderableDBInstanceOption) DeepCopyInto(out *OrderableDBInstanceOption
 			}
 		}
 	}
-	if in.SupportsClusters != nil {
-		in, out := &in.SupportsClusters, &out.SupportsClusters
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SupportsEnhancedMonitoring != nil {
-		in, out := &in.SupportsEnhancedMonitoring, &out.SupportsEnhancedMonitoring
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SupportsGlobalDatabases != nil {
-		in, out := &in.SupportsGlobalDatabases, &out.SupportsGlobalDatabases
-		*out = new(bool)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsIAMDatabaseAuthentication != nil {
-		in, out := &in.SupportsIAMDatabaseAuthentication, &out.SupportsIAMDatabaseAuthentication
-		*out = new(bool)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1alpha1.SecretKeyReference)
 		**out = **in
 	}
-	if in.SupportsIOPS != nil {
-		in, out := &in.SupportsIOPS, &out.SupportsIOPS
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreHealthCheck.
+func (in *RestoreHealthCheck) DeepCopy() *RestoreHealthCheck {
+	if in == nil {
+		return nil
 	}
-	if in.SupportsKerberosAuthentication != nil {
-		in, out := &in.SupportsKerberosAuthentication, &out.SupportsKerberosAuthentication
-		*out = new(bool)
+	out := new(RestoreHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreToPointInTime) DeepCopyInto(out *RestoreToPointInTime) {
+	*out = *in
+	if in.SourceDBInstanceIdentifier != nil {
+		in, out := &in.SourceDBInstanceIdentifier, &out.SourceDBInstanceIdentifier
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsPerformanceInsights != nil {
-		in, out := &in.SupportsPerformanceInsights, &out.SupportsPerformanceInsights
-		*out = new(bool)
+	if in.SourceDBInstanceAutomatedBackupsARN != nil {
+		in, out := &in.SourceDBInstanceAutomatedBackupsARN, &out.SourceDBInstanceAutomatedBackupsARN
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsStorageAutoscaling != nil {
-		in, out := &in.SupportsStorageAutoscaling, &out.SupportsStorageAutoscaling
-		*out = new(bool)
+	if in.SourceDBIResourceID != nil {
+		in, out := &in.SourceDBIResourceID, &out.SourceDBIResourceID
+		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsStorageEncryption != nil {
-		in, out := &in.SupportsStorageEncryption, &out.SupportsStorageEncryption
-		*out = new(bool)
-		**out = **in
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
 	}
-	if in.SupportsStorageThroughput != nil {
-		in, out := &in.SupportsStorageThroughput, &out.SupportsStorageThroughput
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
 		*out = new(bool)
 		**out = **in
 	}
-	if in.VPC != nil {
-		in, out := &in.VPC, &out.VPC
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreToPointInTime.
+func (in *RestoreToPointInTime) DeepCopy() *RestoreToPointInTime {
+	if in == nil {
+		return nil
 	}
+	out := new(RestoreToPointInTime)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrderableDBInstanceOption.
-func (in *OrderableDBInstanceOption) DeepCopy() *OrderableDBInstanceOption {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreVerification) DeepCopyInto(out *RestoreVerification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerification.
+func (in *RestoreVerification) DeepCopy() *RestoreVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(OrderableDBInstanceOption)
+	out := new(RestoreVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreVerification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Outpost) DeepCopyInto(out *Outpost) {
+func (in *RestoreVerificationList) DeepCopyInto(out *RestoreVerificationList) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Outpost.
-func (in *Outpost) DeepCopy() *Outpost {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerificationList.
+func (in *RestoreVerificationList) DeepCopy() *RestoreVerificationList {
 	if in == nil {
 		return nil
 	}
-	out := new(Outpost)
+	out := new(RestoreVerificationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreVerificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Parameter) DeepCopyInto(out *Parameter) {
+func (in *RestoreVerificationResult) DeepCopyInto(out *RestoreVerificationResult) {
 	*out = *in
-	if in.AllowedValues != nil {
-		in, out := &in.AllowedValues, &out.AllowedValues
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DBSnapshot != nil {
+		in, out := &in.DBSnapshot, &out.DBSnapshot
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyMethod != nil {
-		in, out := &in.ApplyMethod, &out.ApplyMethod
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyType != nil {
-		in, out := &in.ApplyType, &out.ApplyType
-		*out = new(string)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Succeeded != nil {
+		in, out := &in.Succeeded, &out.Succeeded
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DataType != nil {
-		in, out := &in.DataType, &out.DataType
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerificationResult.
+func (in *RestoreVerificationResult) DeepCopy() *RestoreVerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreVerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreVerificationRun) DeepCopyInto(out *RestoreVerificationRun) {
+	*out = *in
+	if in.DBInstance != nil {
+		in, out := &in.DBInstance, &out.DBInstance
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.DBSnapshot != nil {
+		in, out := &in.DBSnapshot, &out.DBSnapshot
 		*out = new(string)
 		**out = **in
 	}
-	if in.IsModifiable != nil {
-		in, out := &in.IsModifiable, &out.IsModifiable
-		*out = new(bool)
-		**out = **in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.MinimumEngineVersion != nil {
-		in, out := &in.MinimumEngineVersion, &out.MinimumEngineVersion
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerificationRun.
+func (in *RestoreVerificationRun) DeepCopy() *RestoreVerificationRun {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreVerificationRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreVerificationSpec) DeepCopyInto(out *RestoreVerificationSpec) {
+	*out = *in
+	if in.DBInstanceClass != nil {
+		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
 		**out = **in
 	}
-	if in.ParameterName != nil {
-		in, out := &in.ParameterName, &out.ParameterName
+	if in.DBSubnetGroupName != nil {
+		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ParameterValue != nil {
-		in, out := &in.ParameterValue, &out.ParameterValue
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(RestoreHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
 		*out = new(string)
 		**out = **in
 	}
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
+	if in.SnapshotSelector != nil {
+		in, out := &in.SnapshotSelector, &out.SnapshotSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
 		*out = new(string)
 		**out = **in
 	}
-	if in.SupportedEngineModes != nil {
-		in, out := &in.SupportedEngineModes, &out.SupportedEngineModes
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]*string, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerificationSpec.
+func (in *RestoreVerificationSpec) DeepCopy() *RestoreVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreVerificationStatus) DeepCopyInto(out *RestoreVerificationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.CurrentRun != nil {
+		in, out := &in.CurrentRun, &out.CurrentRun
+		*out = new(RestoreVerificationRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastResult != nil {
+		in, out := &in.LastResult, &out.LastResult
+		*out = new(RestoreVerificationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
-func (in *Parameter) DeepCopy() *Parameter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerificationStatus.
+func (in *RestoreVerificationStatus) DeepCopy() *RestoreVerificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Parameter)
+	out := new(RestoreVerificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PendingCloudwatchLogsExports) DeepCopyInto(out *PendingCloudwatchLogsExports) {
+func (in *RestoreWindow) DeepCopyInto(out *RestoreWindow) {
 	*out = *in
-	if in.LogTypesToDisable != nil {
-		in, out := &in.LogTypesToDisable, &out.LogTypesToDisable
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.EarliestTime != nil {
+		in, out := &in.EarliestTime, &out.EarliestTime
+		*out = (*in).DeepCopy()
 	}
-	if in.LogTypesToEnable != nil {
-		in, out := &in.LogTypesToEnable, &out.LogTypesToEnable
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.LatestTime != nil {
+		in, out := &in.LatestTime, &out.LatestTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCloudwatchLogsExports.
-func (in *PendingCloudwatchLogsExports) DeepCopy() *PendingCloudwatchLogsExports {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreWindow.
+func (in *RestoreWindow) DeepCopy() *RestoreWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(PendingCloudwatchLogsExports)
+	out := new(RestoreWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PendingMaintenanceAction) DeepCopyInto(out *PendingMaintenanceAction) {
+func (in *S3Restore) DeepCopyInto(out *S3Restore) {
 	*out = *in
-	if in.Action != nil {
-		in, out := &in.Action, &out.Action
+	if in.S3BucketName != nil {
+		in, out := &in.S3BucketName, &out.S3BucketName
 		*out = new(string)
 		**out = **in
 	}
-	if in.AutoAppliedAfterDate != nil {
-		in, out := &in.AutoAppliedAfterDate, &out.AutoAppliedAfterDate
-		*out = (*in).DeepCopy()
-	}
-	if in.CurrentApplyDate != nil {
-		in, out := &in.CurrentApplyDate, &out.CurrentApplyDate
-		*out = (*in).DeepCopy()
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.S3IngestionRoleARN != nil {
+		in, out := &in.S3IngestionRoleARN, &out.S3IngestionRoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ForcedApplyDate != nil {
-		in, out := &in.ForcedApplyDate, &out.ForcedApplyDate
-		*out = (*in).DeepCopy()
+	if in.SourceEngine != nil {
+		in, out := &in.SourceEngine, &out.SourceEngine
+		*out = new(string)
+		**out = **in
 	}
-	if in.OptInStatus != nil {
-		in, out := &in.OptInStatus, &out.OptInStatus
+	if in.SourceEngineVersion != nil {
+		in, out := &in.SourceEngineVersion, &out.SourceEngineVersion
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingMaintenanceAction.
-func (in *PendingMaintenanceAction) DeepCopy() *PendingMaintenanceAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Restore.
+func (in *S3Restore) DeepCopy() *S3Restore {
 	if in == nil {
 		return nil
 	}
-	out := new(PendingMaintenanceAction)
+	out := new(S3Restore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PendingModifiedValues) DeepCopyInto(out *PendingModifiedValues) {
+func (in *ScalingConfiguration) DeepCopyInto(out *ScalingConfiguration) {
 	*out = *in
-	if in.AllocatedStorage != nil {
-		in, out := &in.AllocatedStorage, &out.AllocatedStorage
-		*out = new(int64)
+	if in.AutoPause != nil {
+		in, out := &in.AutoPause, &out.AutoPause
+		*out = new(bool)
 		**out = **in
 	}
-	if in.AutomationMode != nil {
-		in, out := &in.AutomationMode, &out.AutomationMode
-		*out = new(string)
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(int64)
 		**out = **in
 	}
-	if in.BackupRetentionPeriod != nil {
-		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
 		*out = new(int64)
 		**out = **in
 	}
-	if in.CACertificateIdentifier != nil {
-		in, out := &in.CACertificateIdentifier, &out.CACertificateIdentifier
-		*out = new(string)
+	if in.SecondsBeforeTimeout != nil {
+		in, out := &in.SecondsBeforeTimeout, &out.SecondsBeforeTimeout
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DBInstanceClass != nil {
-		in, out := &in.DBInstanceClass, &out.DBInstanceClass
-		*out = new(string)
+	if in.SecondsUntilAutoPause != nil {
+		in, out := &in.SecondsUntilAutoPause, &out.SecondsUntilAutoPause
+		*out = new(int64)
 		**out = **in
 	}
-	if in.DBInstanceIdentifier != nil {
-		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
+	if in.TimeoutAction != nil {
+		in, out := &in.TimeoutAction, &out.TimeoutAction
 		*out = new(string)
 		**out = **in
 	}
-	if in.DBSubnetGroupName != nil {
-		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfiguration.
+func (in *ScalingConfiguration) DeepCopy() *ScalingConfiguration {
+	if in == nil {
+		return nil
 	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
+	out := new(ScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingConfigurationInfo) DeepCopyInto(out *ScalingConfigurationInfo) {
+	*out = *in
+	if in.AutoPause != nil {
+		in, out := &in.AutoPause, &out.AutoPause
+		*out = new(bool)
 		**out = **in
 	}
-	if in.IAMDatabaseAuthenticationEnabled != nil {
-		in, out := &in.IAMDatabaseAuthenticationEnabled, &out.IAMDatabaseAuthenticationEnabled
-		*out = new(bool)
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(int64)
 		**out = **in
 	}
-	if in.IOPS != nil {
-		in, out := &in.IOPS, &out.IOPS
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
 		*out = new(int64)
 		**out = **in
 	}
-	if in.LicenseModel != nil {
-		in, out := &in.LicenseModel, &out.LicenseModel
-		*out = new(string)
+	if in.SecondsBeforeTimeout != nil {
+		in, out := &in.SecondsBeforeTimeout, &out.SecondsBeforeTimeout
+		*out = new(int64)
 		**out = **in
 	}
-	if in.MasterUserPassword != nil {
-		in, out := &in.MasterUserPassword, &out.MasterUserPassword
-		*out = new(string)
+	if in.SecondsUntilAutoPause != nil {
+		in, out := &in.SecondsUntilAutoPause, &out.SecondsUntilAutoPause
+		*out = new(int64)
 		**out = **in
 	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
+	if in.TimeoutAction != nil {
+		in, out := &in.TimeoutAction, &out.TimeoutAction
+		*out = new(string)
 		**out = **in
 	}
-	if in.PendingCloudwatchLogsExports != nil {
-		in, out := &in.PendingCloudwatchLogsExports, &out.PendingCloudwatchLogsExports
-		*out = new(PendingCloudwatchLogsExports)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfigurationInfo.
+func (in *ScalingConfigurationInfo) DeepCopy() *ScalingConfigurationInfo {
+	if in == nil {
+		return nil
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int64)
+	out := new(ScalingConfigurationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessV2ScalingConfiguration) DeepCopyInto(out *ServerlessV2ScalingConfiguration) {
+	*out = *in
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(float64)
 		**out = **in
 	}
-	if in.ProcessorFeatures != nil {
-		in, out := &in.ProcessorFeatures, &out.ProcessorFeatures
-		*out = make([]*ProcessorFeature, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProcessorFeature)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		*out = new(float64)
+		**out = **in
 	}
-	if in.ResumeFullAutomationModeTime != nil {
-		in, out := &in.ResumeFullAutomationModeTime, &out.ResumeFullAutomationModeTime
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessV2ScalingConfiguration.
+func (in *ServerlessV2ScalingConfiguration) DeepCopy() *ServerlessV2ScalingConfiguration {
+	if in == nil {
+		return nil
 	}
-	if in.StorageThroughput != nil {
-		in, out := &in.StorageThroughput, &out.StorageThroughput
-		*out = new(int64)
+	out := new(ServerlessV2ScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessV2ScalingConfigurationInfo) DeepCopyInto(out *ServerlessV2ScalingConfigurationInfo) {
+	*out = *in
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(float64)
 		**out = **in
 	}
-	if in.StorageType != nil {
-		in, out := &in.StorageType, &out.StorageType
-		*out = new(string)
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		*out = new(float64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingModifiedValues.
-func (in *PendingModifiedValues) DeepCopy() *PendingModifiedValues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessV2ScalingConfigurationInfo.
+func (in *ServerlessV2ScalingConfigurationInfo) DeepCopy() *ServerlessV2ScalingConfigurationInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(PendingModifiedValues)
+	out := new(ServerlessV2ScalingConfigurationInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessorFeature) DeepCopyInto(out *ProcessorFeature) {
+func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBinding.
+func (in *ServiceBinding) DeepCopy() *ServiceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRetention) DeepCopyInto(out *SnapshotRetention) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorFeature.
-func (in *ProcessorFeature) DeepCopy() *ProcessorFeature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRetention.
+func (in *SnapshotRetention) DeepCopy() *SnapshotRetention {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessorFeature)
+	out := new(SnapshotRetention)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Range) DeepCopyInto(out *Range) {
+func (in *SnapshotRetentionCounts) DeepCopyInto(out *SnapshotRetentionCounts) {
 	*out = *in
-	if in.From != nil {
-		in, out := &in.From, &out.From
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Step != nil {
-		in, out := &in.Step, &out.Step
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
 		*out = new(int64)
 		**out = **in
 	}
-	if in.To != nil {
-		in, out := &in.To, &out.To
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
 		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Range.
-func (in *Range) DeepCopy() *Range {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRetentionCounts.
+func (in *SnapshotRetentionCounts) DeepCopy() *SnapshotRetentionCounts {
 	if in == nil {
 		return nil
 	}
-	out := new(Range)
+	out := new(SnapshotRetentionCounts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecurringCharge) DeepCopyInto(out *RecurringCharge) {
+func (in *SnapshotSchedule) DeepCopyInto(out *SnapshotSchedule) {
 	*out = *in
-	if in.RecurringChargeFrequency != nil {
-		in, out := &in.RecurringChargeFrequency, &out.RecurringChargeFrequency
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringCharge.
-func (in *RecurringCharge) DeepCopy() *RecurringCharge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedule.
+func (in *SnapshotSchedule) DeepCopy() *SnapshotSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(RecurringCharge)
+	out := new(SnapshotSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReservedDBInstance) DeepCopyInto(out *ReservedDBInstance) {
+func (in *SnapshotScheduleList) DeepCopyInto(out *SnapshotScheduleList) {
 	*out = *in
-	if in.CurrencyCode != nil {
-		in, out := &in.CurrencyCode, &out.CurrencyCode
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.DBInstanceClass != nil {
-		in, out := &in.DBInstanceClass, &out.DBInstanceClass
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleList.
+func (in *SnapshotScheduleList) DeepCopy() *SnapshotScheduleList {
+	if in == nil {
+		return nil
 	}
-	if in.DBInstanceCount != nil {
-		in, out := &in.DBInstanceCount, &out.DBInstanceCount
-		*out = new(int64)
-		**out = **in
+	out := new(SnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(int64)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleSpec) DeepCopyInto(out *SnapshotScheduleSpec) {
+	*out = *in
+	if in.DBClusterRef != nil {
+		in, out := &in.DBClusterRef, &out.DBClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LeaseID != nil {
-		in, out := &in.LeaseID, &out.LeaseID
-		*out = new(string)
-		**out = **in
+	if in.DBInstanceRef != nil {
+		in, out := &in.DBInstanceRef, &out.DBInstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
-		**out = **in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(SnapshotRetention)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OfferingType != nil {
-		in, out := &in.OfferingType, &out.OfferingType
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
 		*out = new(string)
 		**out = **in
 	}
-	if in.ProductDescription != nil {
-		in, out := &in.ProductDescription, &out.ProductDescription
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReservedDBInstanceARN != nil {
-		in, out := &in.ReservedDBInstanceARN, &out.ReservedDBInstanceARN
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleSpec.
+func (in *SnapshotScheduleSpec) DeepCopy() *SnapshotScheduleSpec {
+	if in == nil {
+		return nil
 	}
-	if in.ReservedDBInstanceID != nil {
-		in, out := &in.ReservedDBInstanceID, &out.ReservedDBInstanceID
-		*out = new(string)
-		**out = **in
+	out := new(SnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleStatus) DeepCopyInto(out *SnapshotScheduleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.ReservedDBInstancesOfferingID != nil {
-		in, out := &in.ReservedDBInstancesOfferingID, &out.ReservedDBInstancesOfferingID
+	if in.LastSnapshot != nil {
+		in, out := &in.LastSnapshot, &out.LastSnapshot
 		*out = new(string)
 		**out = **in
 	}
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
 		*out = (*in).DeepCopy()
 	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RetainedSnapshots != nil {
+		in, out := &in.RetainedSnapshots, &out.RetainedSnapshots
+		*out = new(int64)
 		**out = **in
 	}
+	if in.RetentionCounts != nil {
+		in, out := &in.RetentionCounts, &out.RetentionCounts
+		*out = new(SnapshotRetentionCounts)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstance.
-func (in *ReservedDBInstance) DeepCopy() *ReservedDBInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleStatus.
+func (in *SnapshotScheduleStatus) DeepCopy() *SnapshotScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReservedDBInstance)
+	out := new(SnapshotScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReservedDBInstancesOffering) DeepCopyInto(out *ReservedDBInstancesOffering) {
+func (in *SourceRegion) DeepCopyInto(out *SourceRegion) {
 	*out = *in
-	if in.CurrencyCode != nil {
-		in, out := &in.CurrencyCode, &out.CurrencyCode
-		*out = new(string)
-		**out = **in
-	}
-	if in.DBInstanceClass != nil {
-		in, out := &in.DBInstanceClass, &out.DBInstanceClass
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(string)
 		**out = **in
 	}
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MultiAZ != nil {
-		in, out := &in.MultiAZ, &out.MultiAZ
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OfferingType != nil {
-		in, out := &in.OfferingType, &out.OfferingType
+	if in.RegionName != nil {
+		in, out := &in.RegionName, &out.RegionName
 		*out = new(string)
 		**out = **in
 	}
-	if in.ProductDescription != nil {
-		in, out := &in.ProductDescription, &out.ProductDescription
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReservedDBInstancesOfferingID != nil {
-		in, out := &in.ReservedDBInstancesOfferingID, &out.ReservedDBInstancesOfferingID
-		*out = new(string)
+	if in.SupportsDBInstanceAutomatedBackupsReplication != nil {
+		in, out := &in.SupportsDBInstanceAutomatedBackupsReplication, &out.SupportsDBInstanceAutomatedBackupsReplication
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstancesOffering.
-func (in *ReservedDBInstancesOffering) DeepCopy() *ReservedDBInstancesOffering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceRegion.
+func (in *SourceRegion) DeepCopy() *SourceRegion {
 	if in == nil {
 		return nil
 	}
-	out := new(ReservedDBInstancesOffering)
+	out := new(SourceRegion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourcePendingMaintenanceActions) DeepCopyInto(out *ResourcePendingMaintenanceActions) {
+func (in *StopStartSchedule) DeepCopyInto(out *StopStartSchedule) {
 	*out = *in
-	if in.ResourceIdentifier != nil {
-		in, out := &in.ResourceIdentifier, &out.ResourceIdentifier
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePendingMaintenanceActions.
-func (in *ResourcePendingMaintenanceActions) DeepCopy() *ResourcePendingMaintenanceActions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopStartSchedule.
+func (in *StopStartSchedule) DeepCopy() *StopStartSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourcePendingMaintenanceActions)
+	out := new(StopStartSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreWindow) DeepCopyInto(out *RestoreWindow) {
+func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
-	if in.EarliestTime != nil {
-		in, out := &in.EarliestTime, &out.EarliestTime
-		*out = (*in).DeepCopy()
+	if in.SubnetAvailabilityZone != nil {
+		in, out := &in.SubnetAvailabilityZone, &out.SubnetAvailabilityZone
+		*out = new(AvailabilityZone)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LatestTime != nil {
-		in, out := &in.LatestTime, &out.LatestTime
-		*out = (*in).DeepCopy()
+	if in.SubnetIdentifier != nil {
+		in, out := &in.SubnetIdentifier, &out.SubnetIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetOutpost != nil {
+		in, out := &in.SubnetOutpost, &out.SubnetOutpost
+		*out = new(Outpost)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetStatus != nil {
+		in, out := &in.SubnetStatus, &out.SubnetStatus
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreWindow.
-func (in *RestoreWindow) DeepCopy() *RestoreWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
+func (in *Subnet) DeepCopy() *Subnet {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreWindow)
+	out := new(Subnet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalingConfiguration) DeepCopyInto(out *ScalingConfiguration) {
+func (in *SwitchoverDetail) DeepCopyInto(out *SwitchoverDetail) {
 	*out = *in
-	if in.AutoPause != nil {
-		in, out := &in.AutoPause, &out.AutoPause
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MaxCapacity != nil {
-		in, out := &in.MaxCapacity, &out.MaxCapacity
-		*out = new(int64)
-		**out = **in
-	}
-	if in.MinCapacity != nil {
-		in, out := &in.MinCapacity, &out.MinCapacity
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SecondsBeforeTimeout != nil {
-		in, out := &in.SecondsBeforeTimeout, &out.SecondsBeforeTimeout
-		*out = new(int64)
+	if in.SourceMember != nil {
+		in, out := &in.SourceMember, &out.SourceMember
+		*out = new(string)
 		**out = **in
 	}
-	if in.SecondsUntilAutoPause != nil {
-		in, out := &in.SecondsUntilAutoPause, &out.SecondsUntilAutoPause
-		*out = new(int64)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
-	if in.TimeoutAction != nil {
-		in, out := &in.TimeoutAction, &out.TimeoutAction
+	if in.TargetMember != nil {
+		in, out := &in.TargetMember, &out.TargetMember
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfiguration.
-func (in *ScalingConfiguration) DeepCopy() *ScalingConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverDetail.
+func (in *SwitchoverDetail) DeepCopy() *SwitchoverDetail {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalingConfiguration)
+	out := new(SwitchoverDetail)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalingConfigurationInfo) DeepCopyInto(out *ScalingConfigurationInfo) {
+func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
-	if in.AutoPause != nil {
-		in, out := &in.AutoPause, &out.AutoPause
-		*out = new(bool)
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxCapacity != nil {
-		in, out := &in.MaxCapacity, &out.MaxCapacity
-		*out = new(int64)
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
 		**out = **in
 	}
-	if in.MinCapacity != nil {
-		in, out := &in.MinCapacity, &out.MinCapacity
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
 	}
-	if in.SecondsBeforeTimeout != nil {
-		in, out := &in.SecondsBeforeTimeout, &out.SecondsBeforeTimeout
-		*out = new(int64)
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetHealth) DeepCopyInto(out *TargetHealth) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.SecondsUntilAutoPause != nil {
-		in, out := &in.SecondsUntilAutoPause, &out.SecondsUntilAutoPause
-		*out = new(int64)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
 		**out = **in
 	}
-	if in.TimeoutAction != nil {
-		in, out := &in.TimeoutAction, &out.TimeoutAction
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfigurationInfo.
-func (in *ScalingConfigurationInfo) DeepCopy() *ScalingConfigurationInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealth.
+func (in *TargetHealth) DeepCopy() *TargetHealth {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalingConfigurationInfo)
+	out := new(TargetHealth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerlessV2ScalingConfiguration) DeepCopyInto(out *ServerlessV2ScalingConfiguration) {
+func (in *TenantDatabase) DeepCopyInto(out *TenantDatabase) {
 	*out = *in
-	if in.MaxCapacity != nil {
-		in, out := &in.MaxCapacity, &out.MaxCapacity
-		*out = new(float64)
-		**out = **in
-	}
-	if in.MinCapacity != nil {
-		in, out := &in.MinCapacity, &out.MinCapacity
-		*out = new(float64)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessV2ScalingConfiguration.
-func (in *ServerlessV2ScalingConfiguration) DeepCopy() *ServerlessV2ScalingConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDatabase.
+func (in *TenantDatabase) DeepCopy() *TenantDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerlessV2ScalingConfiguration)
+	out := new(TenantDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerlessV2ScalingConfigurationInfo) DeepCopyInto(out *ServerlessV2ScalingConfigurationInfo) {
+func (in *TenantDatabaseList) DeepCopyInto(out *TenantDatabaseList) {
 	*out = *in
-	if in.MaxCapacity != nil {
-		in, out := &in.MaxCapacity, &out.MaxCapacity
-		*out = new(float64)
-		**out = **in
-	}
-	if in.MinCapacity != nil {
-		in, out := &in.MinCapacity, &out.MinCapacity
-		*out = new(float64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessV2ScalingConfigurationInfo.
-func (in *ServerlessV2ScalingConfigurationInfo) DeepCopy() *ServerlessV2ScalingConfigurationInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDatabaseList.
+func (in *TenantDatabaseList) DeepCopy() *TenantDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerlessV2ScalingConfigurationInfo)
+	out := new(TenantDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceRegion) DeepCopyInto(out *SourceRegion) {
+func (in *TenantDatabaseSpec) DeepCopyInto(out *TenantDatabaseSpec) {
 	*out = *in
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
 		*out = new(string)
 		**out = **in
 	}
-	if in.RegionName != nil {
-		in, out := &in.RegionName, &out.RegionName
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.DBInstanceRef != nil {
+		in, out := &in.DBInstanceRef, &out.DBInstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterUserPassword != nil {
+		in, out := &in.MasterUserPassword, &out.MasterUserPassword
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
 		*out = new(string)
 		**out = **in
 	}
-	if in.SupportsDBInstanceAutomatedBackupsReplication != nil {
-		in, out := &in.SupportsDBInstanceAutomatedBackupsReplication, &out.SupportsDBInstanceAutomatedBackupsReplication
-		*out = new(bool)
+	if in.NcharCharacterSetName != nil {
+		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TenantDBName != nil {
+		in, out := &in.TenantDBName, &out.TenantDBName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceRegion.
-func (in *SourceRegion) DeepCopy() *SourceRegion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDatabaseSpec.
+func (in *TenantDatabaseSpec) DeepCopy() *TenantDatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceRegion)
+	out := new(TenantDatabaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Subnet) DeepCopyInto(out *Subnet) {
+func (in *TenantDatabaseStatus) DeepCopyInto(out *TenantDatabaseStatus) {
 	*out = *in
-	if in.SubnetAvailabilityZone != nil {
-		in, out := &in.SubnetAvailabilityZone, &out.SubnetAvailabilityZone
-		*out = new(AvailabilityZone)
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SubnetIdentifier != nil {
-		in, out := &in.SubnetIdentifier, &out.SubnetIdentifier
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetOutpost != nil {
-		in, out := &in.SubnetOutpost, &out.SubnetOutpost
-		*out = new(Outpost)
-		(*in).DeepCopyInto(*out)
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
 	}
-	if in.SubnetStatus != nil {
-		in, out := &in.SubnetStatus, &out.SubnetStatus
+	if in.MasterUserPasswordHash != nil {
+		in, out := &in.MasterUserPasswordHash, &out.MasterUserPasswordHash
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDatabaseCreateTime != nil {
+		in, out := &in.TenantDatabaseCreateTime, &out.TenantDatabaseCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TenantDatabaseResourceID != nil {
+		in, out := &in.TenantDatabaseResourceID, &out.TenantDatabaseResourceID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
-func (in *Subnet) DeepCopy() *Subnet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDatabaseStatus.
+func (in *TenantDatabaseStatus) DeepCopy() *TenantDatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Subnet)
+	out := new(TenantDatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *TenantDatabase_SDK) DeepCopyInto(out *TenantDatabase_SDK) {
 	*out = *in
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
+	if in.CharacterSetName != nil {
+		in, out := &in.CharacterSetName, &out.CharacterSetName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
+	if in.DBInstanceIdentifier != nil {
+		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
-	if in == nil {
-		return nil
+	if in.DBIResourceID != nil {
+		in, out := &in.DBIResourceID, &out.DBIResourceID
+		*out = new(string)
+		**out = **in
 	}
-	out := new(Tag)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetHealth) DeepCopyInto(out *TargetHealth) {
-	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUsername != nil {
+		in, out := &in.MasterUsername, &out.MasterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.NcharCharacterSetName != nil {
+		in, out := &in.NcharCharacterSetName, &out.NcharCharacterSetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDBName != nil {
+		in, out := &in.TenantDBName, &out.TenantDBName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDatabaseARN != nil {
+		in, out := &in.TenantDatabaseARN, &out.TenantDatabaseARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDatabaseCreateTime != nil {
+		in, out := &in.TenantDatabaseCreateTime, &out.TenantDatabaseCreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TenantDatabaseResourceID != nil {
+		in, out := &in.TenantDatabaseResourceID, &out.TenantDatabaseResourceID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealth.
-func (in *TargetHealth) DeepCopy() *TargetHealth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDatabase_SDK.
+func (in *TenantDatabase_SDK) DeepCopy() *TenantDatabase_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetHealth)
+	out := new(TenantDatabase_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -38,16 +38,33 @@ import (
 	ctrlrtwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	svctypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	fleetadoption "github.com/aws-controllers-k8s/rds-controller/pkg/controller/fleet_adoption"
+	restoreverification "github.com/aws-controllers-k8s/rds-controller/pkg/controller/restore_verification"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/controller/resync"
+	snapshotschedule "github.com/aws-controllers-k8s/rds-controller/pkg/controller/snapshot_schedule"
 	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+	svcutil "github.com/aws-controllers-k8s/rds-controller/pkg/util"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/blue_green_deployment"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/custom_db_engine_version"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster_endpoint"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster_parameter_group"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster_snapshot"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_instance"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_parameter_group"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_proxy"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_proxy_endpoint"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_proxy_target_group"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_snapshot"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_subnet_group"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/event_subscription"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/export_task"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/global_cluster"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/option_group"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/reserved_db_instance"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/tenant_database"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/version"
 )
@@ -139,6 +156,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Parameter group resource managers emit Events describing the
+	// parameters the controller changes, and DB instance and DB cluster
+	// resource managers Events about their deletion.
+	eventRecorder := mgr.GetEventRecorderFor("ack-" + awsServiceAlias + "-controller")
+	for _, mf := range managerFactories {
+		if setter, ok := mf.(svcresource.EventRecorderSetter); ok {
+			setter.SetEventRecorder(eventRecorder)
+		}
+	}
+	// DB instance and DB cluster resource managers maintain the Kubernetes
+	// Services pointed at their endpoints, their Service Binding Secrets and
+	// the ConfigMaps holding the RDS certificate bundle.
+	svcutil.SetKubeClient(mgr.GetClient())
+
+	// SnapshotSchedules have no AWS counterpart, they are reconciled by a
+	// controller of their own creating DBSnapshots and DBClusterSnapshots.
+	if err = snapshotschedule.SetupWithManager(mgr); err != nil {
+		setupLog.Error(
+			err, "unable to set up snapshot schedule controller",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
+	// RestoreVerifications have no AWS counterpart either, they restore
+	// DBSnapshots into DBInstances and run health check Jobs against them.
+	if err = restoreverification.SetupWithManager(mgr); err != nil {
+		setupLog.Error(
+			err, "unable to set up restore verification controller",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
 	stopChan := ctrlrt.SetupSignalHandler()
 
 	setupLog.Info(
@@ -172,7 +223,9 @@ func main() {
 		}
 	}
 
-	if err = sc.BindControllerManager(mgr, ackCfg); err != nil {
+	// The controllers of the runtime also reconcile the resources whose
+	// resync is requested by the resync controllers set up below.
+	if err = sc.BindControllerManager(resync.NewRuntimeManager(mgr), ackCfg); err != nil {
 		setupLog.Error(
 			err, "unable bind to controller manager to service controller",
 			"aws.service", awsServiceAlias,
@@ -180,6 +233,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	// FleetAdoptions list the DB instances and DB clusters of the account of
+	// their target namespace and create the AdoptedResources onboarding them.
+	if err = fleetadoption.SetupWithManager(mgr, sc, ackCfg); err != nil {
+		setupLog.Error(
+			err, "unable to set up fleet adoption controller",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
+	// Some resources are reconciled outside of the resync period of the
+	// runtime, when the resources they are derived from change.
+	if err = resync.SetupAllWithManager(mgr, sc.GetReconcilers(), ackCfg); err != nil {
+		setupLog.Error(
+			err, "unable to set up resync controllers",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
 	if err = mgr.AddHealthzCheck("health", ctrlrthealthz.Ping); err != nil {
 		setupLog.Error(
 			err, "unable to set up health check",
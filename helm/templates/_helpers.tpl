@@ -56,10 +56,19 @@ rules:
   resources:
   - configmaps
   verbs:
+  - create
   - get
   - list
   - patch
+  - update
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
 - apiGroups:
   - ""
   resources:
@@ -73,9 +82,31 @@ rules:
   resources:
   - secrets
   verbs:
+  - create
   - get
   - list
   - patch
+  - update
+  - watch
+- apiGroups:
+  - ""
+  resources:
+  - services
+  verbs:
+  - create
+  - get
+  - list
+  - update
+  - watch
+- apiGroups:
+  - batch
+  resources:
+  - jobs
+  verbs:
+  - create
+  - delete
+  - get
+  - list
   - watch
 - apiGroups:
   - ec2.services.k8s.aws
@@ -119,6 +150,46 @@ rules:
   verbs:
   - get
   - list
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - bluegreendeployments
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - bluegreendeployments/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbclusterendpoints
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbclusterendpoints/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - rds.services.k8s.aws
   resources:
@@ -139,6 +210,26 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - customdbengineversions
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - customdbengineversions/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - rds.services.k8s.aws
   resources:
@@ -159,6 +250,26 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbclustersnapshots
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbclustersnapshots/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - rds.services.k8s.aws
   resources:
@@ -219,6 +330,66 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbproxyendpoints
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbproxyendpoints/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbproxytargetgroups
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbproxytargetgroups/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbsnapshots
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - dbsnapshots/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - rds.services.k8s.aws
   resources:
@@ -239,6 +410,66 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - eventsubscriptions
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - eventsubscriptions/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - exporttasks
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - exporttasks/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - fleetadoptions
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - fleetadoptions/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - rds.services.k8s.aws
   resources:
@@ -259,6 +490,106 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - optiongroups
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - optiongroups/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - reserveddbinstances
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - reserveddbinstances/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - restoreverifications
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - restoreverifications/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - snapshotschedules
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - snapshotschedules/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - tenantdatabases
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - rds.services.k8s.aws
+  resources:
+  - tenantdatabases/status
+  verbs:
+  - get
+  - patch
+  - update
 - apiGroups:
   - services.k8s.aws
   resources:
@@ -299,4 +630,18 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - sns.services.k8s.aws
+  resources:
+  - topics
+  verbs:
+  - get
+  - list
+- apiGroups:
+  - sns.services.k8s.aws
+  resources:
+  - topics/status
+  verbs:
+  - get
+  - list
 {{- end }}
\ No newline at end of file
@@ -0,0 +1,297 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fleet_adoption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtcache "github.com/aws-controllers-k8s/runtime/pkg/runtime/cache"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtlog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=fleetadoptions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=fleetadoptions/status,verbs=get;update;patch
+
+// Reconciler lists the DB instances and DB clusters matching the tag filters
+// of FleetAdoption resources and creates AdoptedResources for them. The
+// AdoptedResources are reconciled by the adoption reconciler of the ACK
+// runtime, which creates the DBInstance and DBCluster resources from the
+// described state of the RDS resources.
+type Reconciler struct {
+	kc  client.Client
+	sc  acktypes.ServiceController
+	cfg ackcfg.Config
+	// cache holds the annotations of the namespaces and the CARM ConfigMap,
+	// like the caches of the reconcilers of the ACK runtime
+	cache ackrtcache.Caches
+}
+
+// SetupWithManager registers the FleetAdoption controller with the supplied
+// controller manager. The DB instances and DB clusters are listed with the
+// sessions of the supplied service controller, in the account, region and
+// endpoint the adoption reconciler of the ACK runtime uses for the target
+// namespace of the FleetAdoption.
+func SetupWithManager(
+	mgr ctrlrt.Manager,
+	sc acktypes.ServiceController,
+	cfg ackcfg.Config,
+) error {
+	namespaces, err := cfg.GetWatchNamespaces()
+	if err != nil {
+		return fmt.Errorf("unable to get watch namespaces: %v", err)
+	}
+	cache := ackrtcache.New(mgr.GetLogger(), ackrtcache.Config{
+		WatchScope: namespaces,
+		Ignored: []string{
+			ackrt.NamespaceKubeSystem,
+			ackrt.NamespaceKubePublic,
+			ackrt.NamespaceKubeNodeLease,
+		},
+	})
+	// Like the service controller, the caches only run when the controller
+	// watches several namespaces, the only case in which namespaces can be
+	// annotated with other accounts.
+	if len(namespaces) == 0 || len(namespaces) >= 2 {
+		clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			return err
+		}
+		cache.Run(clientSet)
+	}
+	return ctrlrt.NewControllerManagedBy(mgr).
+		// The status updates of a FleetAdoption would otherwise trigger
+		// a new discovery each.
+		For(&svcapitypes.FleetAdoption{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(&Reconciler{kc: mgr.GetClient(), sc: sc, cfg: cfg, cache: cache})
+}
+
+// Reconcile adopts the DB instances and DB clusters matching the tag filters
+// of the FleetAdoption and requeues it for its next discovery.
+func (r *Reconciler) Reconcile(
+	ctx context.Context,
+	req ctrlrt.Request,
+) (ctrlrt.Result, error) {
+	rlog := ctrlrtlog.FromContext(ctx)
+	fleet := &svcapitypes.FleetAdoption{}
+	if err := r.kc.Get(ctx, req.NamespacedName, fleet); err != nil {
+		return ctrlrt.Result{}, client.IgnoreNotFound(err)
+	}
+	if !fleet.DeletionTimestamp.IsZero() {
+		// The adopted resources are not owned by the fleet adoption
+		return ctrlrt.Result{}, nil
+	}
+	syncErr := r.sync(ctx, fleet, time.Now())
+	util.SetReconcileConditions(&fleet.Status.Conditions, syncErr, syncedMessage(fleet))
+	if err := r.kc.Status().Update(ctx, fleet); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	var termErr *ackerr.TerminalError
+	if errors.As(syncErr, &termErr) {
+		rlog.Info("fleet adoption is invalid", "error", syncErr.Error())
+		return ctrlrt.Result{}, nil
+	}
+	if syncErr != nil {
+		return ctrlrt.Result{}, syncErr
+	}
+	return ctrlrt.Result{RequeueAfter: util.FleetAdoptionInterval(&fleet.Spec)}, nil
+}
+
+// sync creates the missing AdoptedResources of the DB instances and DB
+// clusters matching the tag filters of the supplied FleetAdoption, and reports
+// the number of matching resources and created AdoptedResources in its
+// status.
+func (r *Reconciler) sync(
+	ctx context.Context,
+	fleet *svcapitypes.FleetAdoption,
+	now time.Time,
+) error {
+	rlog := ctrlrtlog.FromContext(ctx)
+	if err := util.ValidateFleetAdoption(&fleet.Spec); err != nil {
+		return err
+	}
+	sdkapi, err := r.newSDKAPI(*fleet.Spec.TargetNamespace)
+	if err != nil {
+		return err
+	}
+	fleet.Status.MatchedDBClusters = nil
+	fleet.Status.MatchedDBInstances = nil
+	var created int64
+	for _, kind := range util.FleetAdoptionKinds(&fleet.Spec) {
+		identifiers, err := r.discover(ctx, sdkapi, kind, fleet.Spec.TagFilters)
+		if err != nil {
+			return err
+		}
+		for _, identifier := range identifiers {
+			ok, err := r.adopt(ctx, fleet, kind, identifier)
+			if err != nil {
+				return err
+			}
+			if ok {
+				rlog.Info("adopting resource", "kind", kind, "identifier", identifier)
+				created++
+			}
+		}
+		matched := int64(len(identifiers))
+		if kind == util.FleetAdoptionKindDBCluster {
+			fleet.Status.MatchedDBClusters = &matched
+		} else {
+			fleet.Status.MatchedDBInstances = &matched
+		}
+	}
+	fleet.Status.CreatedAdoptedResources = &created
+	fleet.Status.LastDiscoveryTime = &metav1.Time{Time: now}
+	return nil
+}
+
+// newSDKAPI returns the RDS client listing the DB instances and DB clusters to
+// adopt in the supplied namespace. Like the adoption reconciler of the ACK
+// runtime, it assumes the CARM role of the owner account of the namespace,
+// and uses the default region and endpoint of the namespace.
+func (r *Reconciler) newSDKAPI(namespace string) (svcsdkapi.RDSAPI, error) {
+	var roleARN ackv1alpha1.AWSResourceName
+	if accountID, ok := r.cache.Namespaces.GetOwnerAccountID(namespace); ok {
+		arn, err := r.cache.Accounts.GetAccountRoleARN(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve role ARN for account %s: %v", accountID, err)
+		}
+		roleARN = ackv1alpha1.AWSResourceName(arn)
+	}
+	region := r.cfg.Region
+	if defaultRegion, ok := r.cache.Namespaces.GetDefaultRegion(namespace); ok {
+		region = defaultRegion
+	}
+	endpointURL := r.cfg.EndpointURL
+	if namespaceURL, ok := r.cache.Namespaces.GetEndpointURL(namespace); ok {
+		endpointURL = namespaceURL
+	}
+	sess, err := r.sc.NewSession(
+		ackv1alpha1.AWSRegion(region), &endpointURL, roleARN,
+		svcapitypes.GroupVersion.WithKind("FleetAdoption"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return svcsdk.New(sess), nil
+}
+
+// discover returns the identifiers of the DB instances or DB clusters, by the
+// supplied kind, whose tags match the supplied tag filters
+func (r *Reconciler) discover(
+	ctx context.Context,
+	sdkapi svcsdkapi.RDSAPI,
+	kind string,
+	filters []*svcapitypes.FleetAdoptionTagFilter,
+) ([]string, error) {
+	var identifiers []string
+	if kind == util.FleetAdoptionKindDBCluster {
+		err := sdkapi.DescribeDBClustersPagesWithContext(
+			ctx,
+			&svcsdk.DescribeDBClustersInput{},
+			func(page *svcsdk.DescribeDBClustersOutput, _ bool) bool {
+				for _, cluster := range page.DBClusters {
+					if util.FleetAdoptionMatches(filters, cluster.TagList) {
+						identifiers = append(identifiers, *cluster.DBClusterIdentifier)
+					}
+				}
+				return true
+			},
+		)
+		return identifiers, err
+	}
+	err := sdkapi.DescribeDBInstancesPagesWithContext(
+		ctx,
+		&svcsdk.DescribeDBInstancesInput{},
+		func(page *svcsdk.DescribeDBInstancesOutput, _ bool) bool {
+			for _, instance := range page.DBInstances {
+				if util.FleetAdoptionMatches(filters, instance.TagList) {
+					identifiers = append(identifiers, *instance.DBInstanceIdentifier)
+				}
+			}
+			return true
+		},
+	)
+	return identifiers, err
+}
+
+// adopt creates the AdoptedResource of the DB instance or DB cluster of the
+// supplied kind and identifier in the target namespace of the supplied
+// FleetAdoption, and returns false when it already exists. The adopted
+// resource is named after its RDS identifier, and both are labeled with the
+// name of the FleetAdoption but not owned by it.
+func (r *Reconciler) adopt(
+	ctx context.Context,
+	fleet *svcapitypes.FleetAdoption,
+	kind string,
+	identifier string,
+) (bool, error) {
+	namespace := *fleet.Spec.TargetNamespace
+	labels := map[string]string{svcapitypes.FleetAdoptionLabel: fleet.Name}
+	adopted := &ackv1alpha1.AdoptedResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      util.FleetAdoptedResourceName(kind, identifier),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: ackv1alpha1.AdoptedResourceSpec{
+			Kubernetes: &ackv1alpha1.ResourceWithMetadata{
+				GroupKind: metav1.GroupKind{
+					Group: svcapitypes.GroupVersion.Group,
+					Kind:  kind,
+				},
+				Metadata: &ackv1alpha1.PartialObjectMeta{
+					Name:      identifier,
+					Namespace: namespace,
+					Labels:    labels,
+				},
+			},
+			AWS: &ackv1alpha1.AWSIdentifiers{NameOrID: identifier},
+		},
+	}
+	err := r.kc.Create(ctx, adopted)
+	if apierrors.IsAlreadyExists(err) {
+		// Adopted by a previous discovery
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// syncedMessage returns the message of the ACK.ResourceSynced condition of the
+// supplied FleetAdoption, counting the AdoptedResources it created
+func syncedMessage(fleet *svcapitypes.FleetAdoption) *string {
+	if fleet.Status.CreatedAdoptedResources == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("Created %d AdoptedResources", *fleet.Status.CreatedAdoptedResources)
+	return &msg
+}
@@ -0,0 +1,434 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package restore_verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	ctrlrtlog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	// runPollInterval is how often a verification in progress is checked, on
+	// top of the changes of its DBInstance and Job
+	runPollInterval = 30 * time.Second
+	// dbInstanceStatusAvailable is the status of a restored DB instance ready
+	// to be health checked
+	dbInstanceStatusAvailable = "available"
+	// healthCheckContainerName is the name of the container of health check
+	// Jobs
+	healthCheckContainerName = "health-check"
+)
+
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=restoreverifications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=restoreverifications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+
+// Reconciler verifies, at the times of the schedule of RestoreVerification
+// resources, that their latest DB snapshot restores. Like a SnapshotSchedule,
+// a RestoreVerification has no AWS counterpart: it creates a DBInstance
+// resource restored from the snapshot and, once the DB instance is available,
+// a health check Job, then deletes both and records the outcome. The
+// DBInstance and Job are owned by the RestoreVerification, so that they are
+// garbage collected with it.
+type Reconciler struct {
+	kc client.Client
+}
+
+// SetupWithManager registers the RestoreVerification controller with the
+// supplied controller manager
+func SetupWithManager(mgr ctrlrt.Manager) error {
+	return ctrlrt.NewControllerManagedBy(mgr).
+		For(&svcapitypes.RestoreVerification{}).
+		Owns(&svcapitypes.DBInstance{}).
+		Owns(&batchv1.Job{}).
+		Complete(&Reconciler{kc: mgr.GetClient()})
+}
+
+// Reconcile starts the verification of the RestoreVerification that is due,
+// if any, advances the verification in progress and requeues the
+// RestoreVerification until the verification is over or its next time.
+func (r *Reconciler) Reconcile(
+	ctx context.Context,
+	req ctrlrt.Request,
+) (ctrlrt.Result, error) {
+	rlog := ctrlrtlog.FromContext(ctx)
+	rv := &svcapitypes.RestoreVerification{}
+	if err := r.kc.Get(ctx, req.NamespacedName, rv); err != nil {
+		return ctrlrt.Result{}, client.IgnoreNotFound(err)
+	}
+	if !rv.DeletionTimestamp.IsZero() {
+		// The DBInstance and Job of the verification in progress are garbage
+		// collected with the RestoreVerification
+		return ctrlrt.Result{}, nil
+	}
+	now := time.Now()
+	requeueAfter, syncErr := r.sync(ctx, rv, now)
+	util.SetReconcileConditions(&rv.Status.Conditions, syncErr, syncedMessage(rv))
+	if err := r.kc.Status().Update(ctx, rv); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	var termErr *ackerr.TerminalError
+	if errors.As(syncErr, &termErr) {
+		rlog.Info("restore verification is invalid", "error", syncErr.Error())
+		return ctrlrt.Result{}, nil
+	}
+	if syncErr != nil {
+		return ctrlrt.Result{}, syncErr
+	}
+	return ctrlrt.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// sync advances the verification in progress of the supplied
+// RestoreVerification, starts a new one if one is due and returns how long to
+// wait before the next reconciliation.
+func (r *Reconciler) sync(
+	ctx context.Context,
+	rv *svcapitypes.RestoreVerification,
+	now time.Time,
+) (time.Duration, error) {
+	if err := util.ValidateRestoreVerification(&rv.Spec); err != nil {
+		return 0, err
+	}
+	if rv.Status.CurrentRun != nil {
+		if err := r.checkRun(ctx, rv, now); err != nil {
+			return 0, err
+		}
+		if rv.Status.CurrentRun != nil {
+			return runPollInterval, nil
+		}
+	}
+	last := rv.CreationTimestamp.Time
+	if rv.Status.LastScheduleTime != nil {
+		last = rv.Status.LastScheduleTime.Time
+	}
+	due, next, err := util.RestoreVerificationTimes(&rv.Spec, last, now)
+	if err != nil {
+		return 0, err
+	}
+	rv.Status.NextScheduleTime = &metav1.Time{Time: next}
+	if due != nil {
+		if err = r.startRun(ctx, rv, *due, now); err != nil {
+			return 0, err
+		}
+		rv.Status.LastScheduleTime = &metav1.Time{Time: *due}
+		if rv.Status.CurrentRun != nil {
+			return runPollInterval, nil
+		}
+	}
+	return next.Sub(now), nil
+}
+
+// startRun restores the latest available DB snapshot selected by the supplied
+// RestoreVerification into a new DBInstance resource named after the supplied
+// time of its schedule, and records the verification in progress. The
+// verification fails right away when no snapshot can be restored.
+func (r *Reconciler) startRun(
+	ctx context.Context,
+	rv *svcapitypes.RestoreVerification,
+	due time.Time,
+	now time.Time,
+) error {
+	rlog := ctrlrtlog.FromContext(ctx)
+	selector, err := metav1.LabelSelectorAsSelector(rv.Spec.SnapshotSelector)
+	if err != nil {
+		return err
+	}
+	snapshots := &svcapitypes.DBSnapshotList{}
+	if err = r.kc.List(
+		ctx, snapshots,
+		client.InNamespace(rv.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return err
+	}
+	start := metav1.Time{Time: now}
+	snapshot := util.LatestAvailableSnapshot(snapshots.Items)
+	if snapshot == nil {
+		setResult(rv, nil, start, false, "No available DBSnapshot matches the snapshot selector")
+		return nil
+	}
+	if snapshot.Status.Engine == nil {
+		msg := fmt.Sprintf("Engine of DBSnapshot %s is unknown", snapshot.Name)
+		setResult(rv, &snapshot.Name, start, false, msg)
+		return nil
+	}
+	name := util.ScheduledResourceName(rv.Name, due)
+	db := &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rv.Namespace,
+			Labels: map[string]string{
+				svcapitypes.RestoreVerificationLabel: rv.Name,
+			},
+		},
+		Spec: svcapitypes.DBInstanceSpec{
+			DBInstanceClass:      rv.Spec.DBInstanceClass,
+			DBInstanceIdentifier: &name,
+			DBSnapshotIdentifier: snapshot.Spec.DBSnapshotIdentifier,
+			DBSubnetGroupName:    rv.Spec.DBSubnetGroupName,
+			DeletionProtection:   aws.Bool(false),
+			Engine:               snapshot.Status.Engine,
+			VPCSecurityGroupIDs:  rv.Spec.VPCSecurityGroupIDs,
+		},
+	}
+	if err = controllerutil.SetControllerReference(rv, db, r.kc.Scheme()); err != nil {
+		return err
+	}
+	rlog.Info("restoring snapshot", "snapshot", snapshot.Name, "dbInstance", name)
+	if err = r.kc.Create(ctx, db); err != nil && !apierrors.IsAlreadyExists(err) {
+		// AlreadyExists means it was created by a previous reconciliation
+		// whose status update failed
+		return err
+	}
+	rv.Status.CurrentRun = &svcapitypes.RestoreVerificationRun{
+		DBInstance: &name,
+		DBSnapshot: &snapshot.Name,
+		StartTime:  &start,
+	}
+	return nil
+}
+
+// checkRun advances the verification in progress of the supplied
+// RestoreVerification: it fails the verification once its timeout elapsed or
+// its DB instance could not be restored, and health checks the DB instance
+// once it is available. The verification is over when CurrentRun is cleared.
+func (r *Reconciler) checkRun(
+	ctx context.Context,
+	rv *svcapitypes.RestoreVerification,
+	now time.Time,
+) error {
+	run := rv.Status.CurrentRun
+	timeout := util.RestoreVerificationTimeout(&rv.Spec)
+	if run.DBInstance == nil || run.StartTime == nil {
+		return r.finishRun(ctx, rv, false, "Verification in progress is incomplete")
+	}
+	if now.Sub(run.StartTime.Time) > timeout {
+		msg := fmt.Sprintf("Verification did not complete within %s", timeout)
+		return r.finishRun(ctx, rv, false, msg)
+	}
+	db := &svcapitypes.DBInstance{}
+	key := client.ObjectKey{Namespace: rv.Namespace, Name: *run.DBInstance}
+	if err := r.kc.Get(ctx, key, db); err != nil {
+		if apierrors.IsNotFound(err) {
+			msg := fmt.Sprintf("DBInstance %s was deleted", *run.DBInstance)
+			return r.finishRun(ctx, rv, false, msg)
+		}
+		return err
+	}
+	if cond := terminalCondition(db.Status.Conditions); cond != nil {
+		msg := fmt.Sprintf("DB snapshot could not be restored: %s", aws.StringValue(cond.Message))
+		return r.finishRun(ctx, rv, false, msg)
+	}
+	if aws.StringValue(db.Status.DBInstanceStatus) != dbInstanceStatusAvailable ||
+		db.Status.Endpoint == nil {
+		return nil
+	}
+	if rv.Spec.HealthCheck == nil {
+		msg := fmt.Sprintf("DB snapshot was restored into DB instance %s", *run.DBInstance)
+		return r.finishRun(ctx, rv, true, msg)
+	}
+	job := &batchv1.Job{}
+	if err := r.kc.Get(ctx, key, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.createHealthCheck(ctx, rv, db, timeout-now.Sub(run.StartTime.Time))
+		}
+		return err
+	}
+	switch {
+	case job.Status.Succeeded > 0:
+		msg := fmt.Sprintf("DB snapshot was restored into DB instance %s and passed the health check", *run.DBInstance)
+		return r.finishRun(ctx, rv, true, msg)
+	case job.Status.Failed > 0:
+		msg := fmt.Sprintf("DB snapshot was restored into DB instance %s but failed the health check, see Job %s", *run.DBInstance, job.Name)
+		return r.finishRun(ctx, rv, false, msg)
+	}
+	return nil
+}
+
+// createHealthCheck creates the Job running the health check of the supplied
+// RestoreVerification against the supplied restored DB instance, named after
+// it. The Job is not retried and is stopped once the supplied time left to
+// the verification elapsed.
+func (r *Reconciler) createHealthCheck(
+	ctx context.Context,
+	rv *svcapitypes.RestoreVerification,
+	db *svcapitypes.DBInstance,
+	timeLeft time.Duration,
+) error {
+	hc := rv.Spec.HealthCheck
+	env := []corev1.EnvVar{
+		{Name: "DB_HOST", Value: aws.StringValue(db.Status.Endpoint.Address)},
+		{Name: "DB_PORT", Value: fmt.Sprint(aws.Int64Value(db.Status.Endpoint.Port))},
+		{Name: "DB_ENGINE", Value: aws.StringValue(db.Spec.Engine)},
+	}
+	snapshot := &svcapitypes.DBSnapshot{}
+	key := client.ObjectKey{Namespace: rv.Namespace, Name: *rv.Status.CurrentRun.DBSnapshot}
+	if err := r.kc.Get(ctx, key, snapshot); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	if snapshot.Status.MasterUsername != nil {
+		env = append(env, corev1.EnvVar{Name: "DB_USER", Value: *snapshot.Status.MasterUsername})
+	}
+	if ref := hc.PasswordSecretRef; ref != nil {
+		env = append(env, corev1.EnvVar{
+			Name: "DB_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Key:                  ref.Key,
+				},
+			},
+		})
+	}
+	deadline := int64(timeLeft.Seconds())
+	if deadline < 1 {
+		deadline = 1
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      db.Name,
+			Namespace: rv.Namespace,
+			Labels: map[string]string{
+				svcapitypes.RestoreVerificationLabel: rv.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			ActiveDeadlineSeconds: &deadline,
+			BackoffLimit:          aws.Int32(0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						svcapitypes.RestoreVerificationLabel: rv.Name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    healthCheckContainerName,
+						Image:   *hc.Image,
+						Command: aws.StringValueSlice(hc.Command),
+						Args:    aws.StringValueSlice(hc.Args),
+						Env:     env,
+					}},
+				},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(rv, job, r.kc.Scheme()); err != nil {
+		return err
+	}
+	ctrlrtlog.FromContext(ctx).Info("running health check", "job", job.Name)
+	if err := r.kc.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// finishRun tears down the health check Job and the DBInstance of the
+// verification in progress of the supplied RestoreVerification, and records
+// the supplied outcome.
+func (r *Reconciler) finishRun(
+	ctx context.Context,
+	rv *svcapitypes.RestoreVerification,
+	succeeded bool,
+	msg string,
+) error {
+	rlog := ctrlrtlog.FromContext(ctx)
+	run := rv.Status.CurrentRun
+	if run.DBInstance != nil {
+		meta := metav1.ObjectMeta{Name: *run.DBInstance, Namespace: rv.Namespace}
+		job := &batchv1.Job{ObjectMeta: meta}
+		err := r.kc.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		rlog.Info("deleting restored DB instance", "dbInstance", meta.Name)
+		db := &svcapitypes.DBInstance{ObjectMeta: meta}
+		if err = r.kc.Delete(ctx, db); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	start := metav1.Now()
+	if run.StartTime != nil {
+		start = *run.StartTime
+	}
+	setResult(rv, run.DBSnapshot, start, succeeded, msg)
+	return nil
+}
+
+// setResult records the supplied outcome of a verification of the supplied
+// RestoreVerification in its LastResult and RestoreVerified condition, and
+// clears the verification in progress.
+func setResult(
+	rv *svcapitypes.RestoreVerification,
+	snapshot *string,
+	start metav1.Time,
+	succeeded bool,
+	msg string,
+) {
+	end := metav1.Now()
+	rv.Status.CurrentRun = nil
+	rv.Status.LastResult = &svcapitypes.RestoreVerificationResult{
+		CompletionTime: &end,
+		DBSnapshot:     snapshot,
+		Message:        &msg,
+		StartTime:      &start,
+		Succeeded:      &succeeded,
+	}
+	status, reason := corev1.ConditionTrue, util.ReasonRestoreVerificationSucceeded
+	if !succeeded {
+		status, reason = corev1.ConditionFalse, util.ReasonRestoreVerificationFailed
+	}
+	util.UpdateCondition(&rv.Status.Conditions, util.ConditionTypeRestoreVerified, status, &reason, &msg)
+}
+
+// terminalCondition returns the ACK.Terminal condition of the supplied
+// conditions if its status is True, or nil
+func terminalCondition(conds []*ackv1alpha1.Condition) *ackv1alpha1.Condition {
+	for _, c := range conds {
+		if c.Type == ackv1alpha1.ConditionTypeTerminal && c.Status == corev1.ConditionTrue {
+			return c
+		}
+	}
+	return nil
+}
+
+// syncedMessage returns the message of the ACK.ResourceSynced condition of the
+// supplied RestoreVerification, describing its verification in progress
+func syncedMessage(rv *svcapitypes.RestoreVerification) *string {
+	run := rv.Status.CurrentRun
+	if run == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("Verifying DB snapshot %s in DB instance %s",
+		aws.StringValue(run.DBSnapshot), aws.StringValue(run.DBInstance))
+	return &msg
+}
@@ -0,0 +1,250 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package restore_verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+func TestReconcilerSync(t *testing.T) {
+	const namespace = "default"
+	// The verification of 6am is due at 6:30am, and restored into the
+	// nightly-202401070600 DBInstance
+	now := time.Date(2024, 1, 7, 6, 30, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 7, 6, 0, 0, 0, time.UTC)
+	dbName := "nightly-202401070600"
+
+	snapshot := func(status string) *svcapitypes.DBSnapshot {
+		return &svcapitypes.DBSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "snapshot", Namespace: namespace,
+				Labels: map[string]string{"app": "db"},
+			},
+			Spec: svcapitypes.DBSnapshotSpec{DBSnapshotIdentifier: aws.String("snapshot")},
+			Status: svcapitypes.DBSnapshotStatus{
+				Engine: aws.String("postgres"),
+				Status: aws.String(status),
+			},
+		}
+	}
+	dbInstance := func(status string, conds ...*ackv1alpha1.Condition) *svcapitypes.DBInstance {
+		return &svcapitypes.DBInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: dbName, Namespace: namespace},
+			Spec:       svcapitypes.DBInstanceSpec{Engine: aws.String("postgres")},
+			Status: svcapitypes.DBInstanceStatus{
+				Conditions:       conds,
+				DBInstanceStatus: aws.String(status),
+				Endpoint: &svcapitypes.Endpoint{
+					Address: aws.String("nightly.rds.amazonaws.com"),
+					Port:    aws.Int64(5432),
+				},
+			},
+		}
+	}
+	job := func(failed int32) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: dbName, Namespace: namespace},
+			Status:     batchv1.JobStatus{Failed: failed},
+		}
+	}
+	run := func(start time.Time) *svcapitypes.RestoreVerificationRun {
+		return &svcapitypes.RestoreVerificationRun{
+			DBInstance: aws.String(dbName),
+			DBSnapshot: aws.String("snapshot"),
+			StartTime:  &metav1.Time{Time: start},
+		}
+	}
+	terminal := &ackv1alpha1.Condition{
+		Type:    ackv1alpha1.ConditionTypeTerminal,
+		Status:  corev1.ConditionTrue,
+		Message: aws.String("DBSnapshotNotFound"),
+	}
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		run         *svcapitypes.RestoreVerificationRun
+		healthCheck bool
+		createErr   error
+		wantErr     bool
+		wantRun     bool
+		// wantSucceeded is the outcome of the verification, nil while no
+		// verification is over
+		wantSucceeded    *bool
+		wantLastSchedule bool
+		wantDBInstance   bool
+		wantJob          bool
+	}{
+		{
+			name:             "run started",
+			objects:          []client.Object{snapshot("available")},
+			wantRun:          true,
+			wantLastSchedule: true,
+			wantDBInstance:   true,
+		},
+		{
+			name:             "no available snapshot",
+			objects:          []client.Object{snapshot("creating")},
+			wantSucceeded:    aws.Bool(false),
+			wantLastSchedule: true,
+		},
+		{
+			name:      "restore not created",
+			objects:   []client.Object{snapshot("available")},
+			createErr: errors.New("apiserver unavailable"),
+			wantErr:   true,
+		},
+		{
+			name:             "restore in progress",
+			objects:          []client.Object{dbInstance("creating")},
+			run:              run(due),
+			wantRun:          true,
+			wantLastSchedule: true,
+			wantDBInstance:   true,
+		},
+		{
+			name:             "restore failed",
+			objects:          []client.Object{dbInstance("creating", terminal)},
+			run:              run(due),
+			wantSucceeded:    aws.Bool(false),
+			wantLastSchedule: true,
+		},
+		{
+			name:             "restore timed out",
+			objects:          []client.Object{dbInstance("creating")},
+			run:              run(now.Add(-3 * time.Hour)),
+			wantSucceeded:    aws.Bool(false),
+			wantLastSchedule: true,
+		},
+		{
+			name:             "restore verified",
+			objects:          []client.Object{dbInstance("available")},
+			run:              run(due),
+			wantSucceeded:    aws.Bool(true),
+			wantLastSchedule: true,
+		},
+		{
+			name:             "health check started",
+			objects:          []client.Object{snapshot("available"), dbInstance("available")},
+			run:              run(due),
+			healthCheck:      true,
+			wantRun:          true,
+			wantLastSchedule: true,
+			wantDBInstance:   true,
+			wantJob:          true,
+		},
+		{
+			name:             "health check failed",
+			objects:          []client.Object{dbInstance("available"), job(1)},
+			run:              run(due),
+			healthCheck:      true,
+			wantSucceeded:    aws.Bool(false),
+			wantLastSchedule: true,
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rv := &svcapitypes.RestoreVerification{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "nightly", Namespace: namespace, UID: "nightly-uid",
+					CreationTimestamp: metav1.NewTime(now.Add(-24 * time.Hour)),
+				},
+				Spec: svcapitypes.RestoreVerificationSpec{
+					DBInstanceClass: aws.String("db.t3.micro"),
+					Schedule:        aws.String("0 6 * * *"),
+					SnapshotSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "db"},
+					},
+				},
+			}
+			if tt.healthCheck {
+				rv.Spec.HealthCheck = &svcapitypes.RestoreHealthCheck{Image: aws.String("postgres:16")}
+			}
+			if tt.run != nil {
+				rv.Status.CurrentRun = tt.run
+				rv.Status.LastScheduleTime = &metav1.Time{Time: due}
+			}
+			kc := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, rv)...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, kc client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						if tt.createErr != nil {
+							return tt.createErr
+						}
+						return kc.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			r := &Reconciler{kc: kc}
+
+			_, err := r.sync(context.TODO(), rv, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sync() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := rv.Status.CurrentRun != nil; got != tt.wantRun {
+				t.Errorf("sync() run in progress = %v, want %v", got, tt.wantRun)
+			}
+			switch {
+			case tt.wantSucceeded == nil && rv.Status.LastResult != nil:
+				t.Errorf("sync() result = %v, want none", aws.StringValue(rv.Status.LastResult.Message))
+			case tt.wantSucceeded != nil && rv.Status.LastResult == nil:
+				t.Errorf("sync() result = none, want succeeded %v", *tt.wantSucceeded)
+			case tt.wantSucceeded != nil && aws.BoolValue(rv.Status.LastResult.Succeeded) != *tt.wantSucceeded:
+				t.Errorf("sync() succeeded = %v, want %v (%s)", aws.BoolValue(rv.Status.LastResult.Succeeded),
+					*tt.wantSucceeded, aws.StringValue(rv.Status.LastResult.Message))
+			}
+			gotLastSchedule := rv.Status.LastScheduleTime != nil && rv.Status.LastScheduleTime.Time.Equal(due)
+			if gotLastSchedule != tt.wantLastSchedule {
+				t.Errorf("sync() recorded schedule time = %v, want %v", gotLastSchedule, tt.wantLastSchedule)
+			}
+			key := client.ObjectKey{Namespace: namespace, Name: dbName}
+			db := &svcapitypes.DBInstance{}
+			err = kc.Get(context.TODO(), key, db)
+			if got := !apierrors.IsNotFound(err); got != tt.wantDBInstance {
+				t.Errorf("sync() kept DBInstance = %v, want %v", got, tt.wantDBInstance)
+			}
+			if err == nil && tt.run == nil && !metav1.IsControlledBy(db, rv) {
+				t.Errorf("sync() created DBInstance not controlled by the RestoreVerification")
+			}
+			err = kc.Get(context.TODO(), key, &batchv1.Job{})
+			if got := !apierrors.IsNotFound(err); got != tt.wantJob {
+				t.Errorf("sync() kept Job = %v, want %v", got, tt.wantJob)
+			}
+		})
+	}
+}
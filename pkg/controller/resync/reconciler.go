@@ -0,0 +1,270 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resync
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlrtlog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// Options describes when the resources of one kind are reconciled outside of
+// the resync period of the ACK runtime.
+type Options struct {
+	// An empty object of the kind of resources reconciled.
+	Object client.Object
+	// An empty list of the kind of resources reconciled.
+	List client.ObjectList
+	// Returns the next time after now the supplied resource must be
+	// reconciled at, or nil when there is none.
+	ResyncAt func(obj client.Object, now time.Time) *time.Time
+	// The objects whose changes reconcile the resources referencing them.
+	Dependencies []Dependency
+}
+
+// Dependency describes the objects, in their namespace, referenced by the
+// resources of a kind. A change to one of them reconciles the resources
+// referencing it.
+type Dependency struct {
+	// An empty object of the kind of objects referenced.
+	Object client.Object
+	// Returns the keys the supplied referenced object is referenced by.
+	Keys func(obj client.Object) []string
+	// Returns the keys of the objects referenced by the supplied resource.
+	References func(obj client.Object) []string
+	// Whether the referenced objects may be in another namespace than the
+	// resources referencing them, in which case Keys and References include
+	// the namespace of the referenced objects. The referenced objects are
+	// still only watched in the namespaces watched by the controller.
+	AnyNamespace bool
+	// Whether only the metadata of the referenced objects is watched, which
+	// keeps their content, like the data of Secrets, out of the cache of the
+	// manager. Keys is then called with the metadata of the objects.
+	MetadataOnly bool
+}
+
+// Reconciler requests the reconciliation of the resources of one kind, by
+// the controller of the ACK runtime, at the times returned by ResyncAt and
+// when the objects they depend on change. The ACK runtime only reconciles a
+// resource when its generation changes and at the resync period of its kind,
+// which is too late for scheduled actions and changes to the objects a
+// resource is derived from.
+//
+// The reconciliation is requested by setting the ResyncRequestedAnnotation of
+// the resource, which the controllers of the ACK runtime bound with the
+// manager returned by NewRuntimeManager react to, so that a resource is only
+// ever reconciled from the queue of the controller of the ACK runtime.
+type Reconciler struct {
+	kc   client.Client
+	opts Options
+
+	mu sync.Mutex
+	// The times the resources are due to be reconciled at
+	due map[types.NamespacedName]time.Time
+}
+
+// SetupWithManager registers a resync controller for the kind of resources of
+// the supplied options, reconciled by the supplied ACK runtime reconciler,
+// with the supplied controller manager. The ACK runtime reconciler must be
+// bound with the manager returned by NewRuntimeManager. The dependencies are
+// only watched in the supplied namespaces, or in all of them when there are
+// none, like the resources.
+func SetupWithManager(
+	mgr ctrlrt.Manager,
+	rec acktypes.AWSResourceReconciler,
+	opts Options,
+	namespaces []string,
+) error {
+	r := &Reconciler{
+		kc:   mgr.GetClient(),
+		opts: opts,
+		due:  map[types.NamespacedName]time.Time{},
+	}
+	kind := strings.ToLower(rec.GroupVersionKind().Kind)
+	b := ctrlrt.NewControllerManagedBy(mgr).
+		Named("resync-"+kind).
+		For(opts.Object, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	for i, dep := range opts.Dependencies {
+		field := indexField(i)
+		references := dep.References
+		err := mgr.GetFieldIndexer().IndexField(
+			context.Background(), opts.Object, field,
+			func(obj client.Object) []string { return references(obj) },
+		)
+		if err != nil {
+			return err
+		}
+		eventHandler := handler.EnqueueRequestsFromMapFunc(r.referencing(field, dep))
+		watched := builder.WithPredicates(inNamespaces(namespaces))
+		if dep.MetadataOnly {
+			b = b.WatchesMetadata(dep.Object, eventHandler, watched)
+		} else {
+			b = b.Watches(dep.Object, eventHandler, watched)
+		}
+	}
+	return b.Complete(r)
+}
+
+// Reconcile requests the reconciliation of the resource by the ACK runtime
+// when it is due and requeues the resource for its next resync time.
+func (r *Reconciler) Reconcile(
+	ctx context.Context,
+	req ctrlrt.Request,
+) (ctrlrt.Result, error) {
+	rlog := ctrlrtlog.FromContext(ctx)
+	obj := r.opts.Object.DeepCopyObject().(client.Object)
+	if err := r.kc.Get(ctx, req.NamespacedName, obj); err != nil {
+		r.setDue(req.NamespacedName, nil)
+		return ctrlrt.Result{}, client.IgnoreNotFound(err)
+	}
+	now := time.Now()
+	if r.isDue(req.NamespacedName, now) && obj.GetDeletionTimestamp().IsZero() {
+		if err := r.requestResync(ctx, obj, now); err != nil {
+			// The resource is due again at its next reconciliation
+			r.setDue(req.NamespacedName, &now)
+			return ctrlrt.Result{}, err
+		}
+		rlog.V(1).Info("requested resync")
+	}
+	if r.opts.ResyncAt == nil {
+		return ctrlrt.Result{}, nil
+	}
+	next := r.opts.ResyncAt(obj, now)
+	if next == nil {
+		return ctrlrt.Result{}, nil
+	}
+	r.setDue(req.NamespacedName, next)
+	return ctrlrt.Result{RequeueAfter: next.Sub(now)}, nil
+}
+
+// requestResync sets the ResyncRequestedAnnotation of the supplied resource
+// to the supplied time, which reconciles it through the controller of the ACK
+// runtime
+func (r *Reconciler) requestResync(
+	ctx context.Context,
+	obj client.Object,
+	now time.Time,
+) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[svcapitypes.ResyncRequestedAnnotation] = now.UTC().Format(time.RFC3339Nano)
+	obj.SetAnnotations(annotations)
+	return client.IgnoreNotFound(r.kc.Patch(ctx, obj, patch))
+}
+
+// referencing returns the function mapping an object of the supplied
+// dependency to the resources whose references, indexed in the supplied
+// field, match one of the keys of the object. The resources are due
+// immediately.
+func (r *Reconciler) referencing(
+	field string,
+	dep Dependency,
+) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		rlog := ctrlrtlog.FromContext(ctx)
+		var reqs []reconcile.Request
+		for _, key := range dep.Keys(obj) {
+			list := r.opts.List.DeepCopyObject().(client.ObjectList)
+			opts := []client.ListOption{client.MatchingFields{field: key}}
+			if !dep.AnyNamespace {
+				opts = append(opts, client.InNamespace(obj.GetNamespace()))
+			}
+			err := r.kc.List(ctx, list, opts...)
+			if err != nil {
+				rlog.Info("unable to list referencing resources", "error", err.Error())
+				continue
+			}
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				continue
+			}
+			for _, item := range items {
+				ref := item.(client.Object)
+				name := types.NamespacedName{Namespace: ref.GetNamespace(), Name: ref.GetName()}
+				now := time.Now()
+				r.setDue(name, &now)
+				reqs = append(reqs, reconcile.Request{NamespacedName: name})
+			}
+		}
+		return reqs
+	}
+}
+
+// inNamespaces returns the predicate filtering out the objects outside of the
+// supplied namespaces, unless there are none
+func inNamespaces(namespaces []string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if len(namespaces) == 0 {
+			return true
+		}
+		for _, namespace := range namespaces {
+			if obj.GetNamespace() == namespace {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// isDue returns true if the resource with the supplied name was due at or
+// before now, and clears its due time
+func (r *Reconciler) isDue(name types.NamespacedName, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	at, ok := r.due[name]
+	if !ok || at.After(now) {
+		return false
+	}
+	delete(r.due, name)
+	return true
+}
+
+// setDue sets the time the resource with the supplied name is due at, unless
+// it is already due earlier, or clears it when the supplied time is nil
+func (r *Reconciler) setDue(name types.NamespacedName, at *time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if at == nil {
+		delete(r.due, name)
+		return
+	}
+	if current, ok := r.due[name]; ok && current.Before(*at) {
+		return
+	}
+	r.due[name] = *at
+}
+
+// indexField returns the name of the field indexing the references of the
+// dependency with the supplied position
+func indexField(i int) string {
+	return ".resync.dependency" + strconv.Itoa(i)
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+func TestReconcilerReconcile(t *testing.T) {
+	name := types.NamespacedName{Namespace: "default", Name: "nightly"}
+	tests := []struct {
+		name       string
+		due        bool
+		wantResync bool
+	}{
+		{name: "due", due: true, wantResync: true},
+		{name: "not due"},
+	}
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &svcapitypes.DBInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+				Spec:       svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("nightly")},
+			}
+			kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).Build()
+			r := &Reconciler{
+				kc:   kc,
+				opts: resources()["DBInstance"],
+				due:  map[types.NamespacedName]time.Time{},
+			}
+			if tt.due {
+				r.setDue(name, &time.Time{})
+			}
+
+			if _, err := r.Reconcile(context.TODO(), ctrlrt.Request{NamespacedName: name}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			got := &svcapitypes.DBInstance{}
+			if err := kc.Get(context.TODO(), name, got); err != nil {
+				t.Fatal(err)
+			}
+			_, gotResync := got.Annotations[svcapitypes.ResyncRequestedAnnotation]
+			if gotResync != tt.wantResync {
+				t.Errorf("Reconcile() requested resync = %v, want %v", gotResync, tt.wantResync)
+			}
+			if gotResync && got.Generation != instance.Generation {
+				t.Errorf("Reconcile() changed the generation of the DBInstance")
+			}
+		})
+	}
+}
+
+// recordingEventHandler records the events it handles
+type recordingEventHandler struct {
+	adds, updates int
+}
+
+func (h *recordingEventHandler) OnAdd(interface{}, bool)           { h.adds++ }
+func (h *recordingEventHandler) OnUpdate(interface{}, interface{}) { h.updates++ }
+func (h *recordingEventHandler) OnDelete(interface{})              {}
+
+func TestResyncEventHandler(t *testing.T) {
+	instance := func(generation int64, annotations map[string]string) client.Object {
+		return &svcapitypes.DBInstance{ObjectMeta: metav1.ObjectMeta{
+			Name: "nightly", Namespace: "default",
+			Generation: generation, Annotations: annotations,
+		}}
+	}
+	requested := map[string]string{svcapitypes.ResyncRequestedAnnotation: "2024-01-07T06:00:00Z"}
+	tests := []struct {
+		name        string
+		old, new    client.Object
+		wantAdds    int
+		wantUpdates int
+	}{
+		{
+			name:     "resync requested",
+			old:      instance(1, nil),
+			new:      instance(1, requested),
+			wantAdds: 1,
+		},
+		{
+			name:        "resync already requested",
+			old:         instance(1, requested),
+			new:         instance(2, requested),
+			wantUpdates: 1,
+		},
+		{
+			name:        "other annotation",
+			old:         instance(1, nil),
+			new:         instance(1, map[string]string{"app": "db"}),
+			wantUpdates: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingEventHandler{}
+			var handler toolscache.ResourceEventHandler = resyncEventHandler{recorder}
+
+			handler.OnUpdate(tt.old, tt.new)
+			if recorder.adds != tt.wantAdds || recorder.updates != tt.wantUpdates {
+				t.Errorf("OnUpdate() passed %d creations and %d updates, want %d and %d",
+					recorder.adds, recorder.updates, tt.wantAdds, tt.wantUpdates)
+			}
+		})
+	}
+}
+
+func TestInNamespaces(t *testing.T) {
+	secret := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "password", Namespace: "team-a"}}
+	tests := []struct {
+		name       string
+		namespaces []string
+		want       bool
+	}{
+		{name: "all namespaces", want: true},
+		{name: "watched namespace", namespaces: []string{"team-b", "team-a"}, want: true},
+		{name: "other namespace", namespaces: []string{"team-b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inNamespaces(tt.namespaces).Generic(event.GenericEvent{Object: secret}); got != tt.want {
+				t.Errorf("inNamespaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resync
+
+import (
+	"fmt"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// resources returns the resync options of the kinds of resources reconciled
+// outside of the resync period of the ACK runtime, keyed by kind
+func resources() map[string]Options {
+	return map[string]Options{
+		"DBCluster": {
+			Object: &svcapitypes.DBCluster{},
+			List:   &svcapitypes.DBClusterList{},
+			// Scheduled stops and starts happen on time rather than at
+			// the next resync.
+			ResyncAt: clusterScheduleResyncAt,
+			Dependencies: []Dependency{
+				masterUserPasswordDependency(clusterMasterUserPassword),
+			},
+		},
+		"DBInstance": {
+			Object:   &svcapitypes.DBInstance{},
+			List:     &svcapitypes.DBInstanceList{},
+			ResyncAt: instanceScheduleResyncAt,
+			Dependencies: []Dependency{
+				masterUserPasswordDependency(instanceMasterUserPassword),
+			},
+		},
+		"DBParameterGroup": {
+			Object: &svcapitypes.DBParameterGroup{},
+			List:   &svcapitypes.DBParameterGroupList{},
+			Dependencies: []Dependency{{
+				// The parameters of a base parameter group are layered
+				// onto the parameter groups built on top of it.
+				Object:     &svcapitypes.DBParameterGroup{},
+				Keys:       parameterGroupKeys,
+				References: baseParameterGroupReferences,
+			}},
+		},
+	}
+}
+
+// SetupAllWithManager registers the resync controllers of the kinds of
+// resources reconciled by the supplied ACK runtime reconcilers with the
+// supplied controller manager, watching the namespaces of the supplied
+// configuration
+func SetupAllWithManager(
+	mgr ctrlrt.Manager,
+	reconcilers []acktypes.AWSResourceReconciler,
+	cfg ackcfg.Config,
+) error {
+	namespaces, err := cfg.GetWatchNamespaces()
+	if err != nil {
+		return fmt.Errorf("unable to get watch namespaces: %v", err)
+	}
+	opts := resources()
+	for _, rec := range reconcilers {
+		kindOpts, ok := opts[rec.GroupVersionKind().Kind]
+		if !ok {
+			continue
+		}
+		if err := SetupWithManager(mgr, rec, kindOpts, namespaces); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parameterGroupKeys returns the keys a DB parameter group is referenced by
+// as a base parameter group: the name of its resource and its name in RDS.
+func parameterGroupKeys(obj client.Object) []string {
+	group := obj.(*svcapitypes.DBParameterGroup)
+	keys := []string{"ref:" + group.Name}
+	if group.Spec.Name != nil {
+		keys = append(keys, "name:"+*group.Spec.Name)
+	}
+	return keys
+}
+
+// baseParameterGroupReferences returns the key of the base parameter group
+// of a DB parameter group, if any
+func baseParameterGroupReferences(obj client.Object) []string {
+	group := obj.(*svcapitypes.DBParameterGroup)
+	if ref := group.Spec.BaseParameterGroupRef; ref != nil && ref.From != nil && ref.From.Name != nil {
+		return []string{"ref:" + *ref.From.Name}
+	}
+	if group.Spec.BaseParameterGroupName != nil {
+		return []string{"name:" + *group.Spec.BaseParameterGroupName}
+	}
+	return nil
+}
+
+// clusterScheduleResyncAt returns the time of the next scheduled stop or start
+// of a DB cluster
+func clusterScheduleResyncAt(obj client.Object, now time.Time) *time.Time {
+	cluster := obj.(*svcapitypes.DBCluster)
+	return util.NextScheduledStopStart(cluster.Spec.Schedule, now)
+}
+
+// instanceScheduleResyncAt returns the time of the next scheduled stop or
+// start of a DB instance, unless its schedule is overridden by its desired
+// state
+func instanceScheduleResyncAt(obj client.Object, now time.Time) *time.Time {
+	instance := obj.(*svcapitypes.DBInstance)
+	if instance.Spec.DesiredState != nil {
+		return nil
+	}
+	return util.NextScheduledStopStart(instance.Spec.Schedule, now)
+}
+
+// masterUserPasswordDependency returns the dependency of DB clusters or DB
+// instances on the Secret holding their master user password, returned by
+// the supplied function, so that a new password is applied as soon as the
+// Secret is changed. Only the Secrets of the watched namespaces are watched,
+// with the RBAC permissions on Secrets the controller already has there.
+func masterUserPasswordDependency(
+	password func(obj client.Object) *ackv1alpha1.SecretKeyReference,
+) Dependency {
+	return Dependency{
+		Object:       &corev1.Secret{},
+		Keys:         secretKeys,
+		AnyNamespace: true,
+		MetadataOnly: true,
+		References: func(obj client.Object) []string {
+			ref := password(obj)
+			if ref == nil || ref.Name == "" {
+				return nil
+			}
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = obj.GetNamespace()
+			}
+			return []string{namespace + "/" + ref.Name}
+		},
+	}
+}
+
+// secretKeys returns the key a Secret is referenced by: its namespace and
+// name.
+func secretKeys(obj client.Object) []string {
+	return []string{obj.GetNamespace() + "/" + obj.GetName()}
+}
+
+// clusterMasterUserPassword returns the reference to the Secret holding the
+// master user password of a DB cluster
+func clusterMasterUserPassword(obj client.Object) *ackv1alpha1.SecretKeyReference {
+	return obj.(*svcapitypes.DBCluster).Spec.MasterUserPassword
+}
+
+// instanceMasterUserPassword returns the reference to the Secret holding the
+// master user password of a DB instance
+func instanceMasterUserPassword(obj client.Object) *ackv1alpha1.SecretKeyReference {
+	return obj.(*svcapitypes.DBInstance).Spec.MasterUserPassword
+}
@@ -0,0 +1,108 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resync
+
+import (
+	"context"
+	"time"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// NewRuntimeManager returns the supplied controller manager, to bind the
+// controllers of the ACK runtime with, such that they reconcile a resource
+// when its ResyncRequestedAnnotation changes. The controllers of the ACK
+// runtime filter out the changes that leave the generation of a resource
+// unchanged, like the changes of its annotations, so the changes of the
+// annotation are passed to their event handlers as creations instead.
+func NewRuntimeManager(mgr ctrlrt.Manager) ctrlrt.Manager {
+	return &runtimeManager{Manager: mgr}
+}
+
+// runtimeManager is a controller manager whose cache passes the changes of
+// the ResyncRequestedAnnotation to its event handlers as creations
+type runtimeManager struct {
+	ctrlrt.Manager
+}
+
+func (m *runtimeManager) GetCache() cache.Cache {
+	return &runtimeCache{Cache: m.Manager.GetCache()}
+}
+
+type runtimeCache struct {
+	cache.Cache
+}
+
+func (c *runtimeCache) GetInformer(
+	ctx context.Context,
+	obj client.Object,
+	opts ...cache.InformerGetOption,
+) (cache.Informer, error) {
+	informer, err := c.Cache.GetInformer(ctx, obj, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &runtimeInformer{Informer: informer}, nil
+}
+
+type runtimeInformer struct {
+	cache.Informer
+}
+
+func (i *runtimeInformer) AddEventHandler(
+	handler toolscache.ResourceEventHandler,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	return i.Informer.AddEventHandler(resyncEventHandler{handler})
+}
+
+func (i *runtimeInformer) AddEventHandlerWithResyncPeriod(
+	handler toolscache.ResourceEventHandler,
+	resyncPeriod time.Duration,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	return i.Informer.AddEventHandlerWithResyncPeriod(resyncEventHandler{handler}, resyncPeriod)
+}
+
+// resyncEventHandler passes the changes of the ResyncRequestedAnnotation of
+// the objects to the supplied event handler as creations
+type resyncEventHandler struct {
+	toolscache.ResourceEventHandler
+}
+
+func (h resyncEventHandler) OnUpdate(oldObj, newObj interface{}) {
+	if resyncRequested(oldObj, newObj) {
+		h.ResourceEventHandler.OnAdd(newObj, false)
+		return
+	}
+	h.ResourceEventHandler.OnUpdate(oldObj, newObj)
+}
+
+// resyncRequested returns true if the ResyncRequestedAnnotation of the
+// supplied objects differ
+func resyncRequested(oldObj, newObj interface{}) bool {
+	oldMeta, ok := oldObj.(client.Object)
+	if !ok {
+		return false
+	}
+	newMeta, ok := newObj.(client.Object)
+	if !ok {
+		return false
+	}
+	key := svcapitypes.ResyncRequestedAnnotation
+	return newMeta.GetAnnotations()[key] != oldMeta.GetAnnotations()[key]
+}
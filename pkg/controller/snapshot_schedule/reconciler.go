@@ -0,0 +1,243 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package snapshot_schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtlog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=snapshotschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rds.services.k8s.aws,resources=snapshotschedules/status,verbs=get;update;patch
+
+// Reconciler takes the snapshots of SnapshotSchedule resources when they are
+// due and deletes the snapshots past their retention. Unlike the resources of
+// the pkg/resource packages, a SnapshotSchedule has no AWS counterpart: it
+// creates and deletes DBSnapshot and DBClusterSnapshot resources, which in
+// turn manage the RDS snapshots.
+type Reconciler struct {
+	kc client.Client
+}
+
+// SetupWithManager registers the SnapshotSchedule controller with the
+// supplied controller manager
+func SetupWithManager(mgr ctrlrt.Manager) error {
+	return ctrlrt.NewControllerManagedBy(mgr).
+		For(&svcapitypes.SnapshotSchedule{}).
+		Complete(&Reconciler{kc: mgr.GetClient()})
+}
+
+// Reconcile takes the snapshot of the SnapshotSchedule that is due, if any,
+// prunes its expired snapshots and requeues the SnapshotSchedule for its next
+// time.
+func (r *Reconciler) Reconcile(
+	ctx context.Context,
+	req ctrlrt.Request,
+) (ctrlrt.Result, error) {
+	rlog := ctrlrtlog.FromContext(ctx)
+	schedule := &svcapitypes.SnapshotSchedule{}
+	if err := r.kc.Get(ctx, req.NamespacedName, schedule); err != nil {
+		return ctrlrt.Result{}, client.IgnoreNotFound(err)
+	}
+	if !schedule.DeletionTimestamp.IsZero() {
+		// The snapshots outlive the schedule that took them
+		return ctrlrt.Result{}, nil
+	}
+	now := time.Now()
+	next, syncErr := r.sync(ctx, schedule, now)
+	util.SetReconcileConditions(&schedule.Status.Conditions, syncErr, syncedMessage(schedule))
+	if err := r.kc.Status().Update(ctx, schedule); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	var termErr *ackerr.TerminalError
+	if errors.As(syncErr, &termErr) {
+		rlog.Info("snapshot schedule is invalid", "error", syncErr.Error())
+		return ctrlrt.Result{}, nil
+	}
+	if syncErr != nil {
+		return ctrlrt.Result{}, syncErr
+	}
+	return ctrlrt.Result{RequeueAfter: next.Sub(now)}, nil
+}
+
+// sync takes the snapshot of the supplied SnapshotSchedule if one is due,
+// deletes its expired snapshots and returns the next time of the schedule.
+func (r *Reconciler) sync(
+	ctx context.Context,
+	schedule *svcapitypes.SnapshotSchedule,
+	now time.Time,
+) (time.Time, error) {
+	if err := util.ValidateSnapshotSchedule(&schedule.Spec); err != nil {
+		return time.Time{}, err
+	}
+	last := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		last = schedule.Status.LastScheduleTime.Time
+	}
+	due, next, err := util.SnapshotScheduleTimes(&schedule.Spec, last, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	schedule.Status.NextScheduleTime = &metav1.Time{Time: next}
+	if due != nil {
+		name := util.ScheduledResourceName(schedule.Name, *due)
+		if err = r.createSnapshot(ctx, schedule, name); err != nil {
+			return time.Time{}, err
+		}
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: *due}
+		schedule.Status.LastSnapshot = &name
+	}
+	loc, err := util.SnapshotScheduleLocation(&schedule.Spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err = r.pruneSnapshots(ctx, schedule, loc, now); err != nil {
+		return time.Time{}, err
+	}
+	return next, nil
+}
+
+// createSnapshot creates the DBSnapshot or DBClusterSnapshot resource with the
+// supplied name, and RDS identifier, of the target of the supplied
+// SnapshotSchedule. The resource is labeled with the name of the schedule but
+// not owned by it, so that it is kept when the schedule is deleted.
+func (r *Reconciler) createSnapshot(
+	ctx context.Context,
+	schedule *svcapitypes.SnapshotSchedule,
+	name string,
+) error {
+	meta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: schedule.Namespace,
+		Labels: map[string]string{
+			svcapitypes.SnapshotScheduleLabel: schedule.Name,
+		},
+	}
+	var snapshot client.Object
+	if schedule.Spec.DBClusterRef != nil {
+		snapshot = &svcapitypes.DBClusterSnapshot{
+			ObjectMeta: meta,
+			Spec: svcapitypes.DBClusterSnapshotSpec{
+				DBClusterRef:                localRef(schedule.Spec.DBClusterRef),
+				DBClusterSnapshotIdentifier: &name,
+			},
+		}
+	} else {
+		snapshot = &svcapitypes.DBSnapshot{
+			ObjectMeta: meta,
+			Spec: svcapitypes.DBSnapshotSpec{
+				DBInstanceRef:        localRef(schedule.Spec.DBInstanceRef),
+				DBSnapshotIdentifier: &name,
+			},
+		}
+	}
+	err := r.kc.Create(ctx, snapshot)
+	if apierrors.IsAlreadyExists(err) {
+		// Created by a previous reconciliation whose status update failed
+		return nil
+	}
+	return err
+}
+
+// pruneSnapshots deletes the snapshot resources taken by the supplied
+// SnapshotSchedule that are past its retention, evaluated in the supplied
+// time zone, and reports the snapshot resources kept in its status.
+func (r *Reconciler) pruneSnapshots(
+	ctx context.Context,
+	schedule *svcapitypes.SnapshotSchedule,
+	loc *time.Location,
+	now time.Time,
+) error {
+	rlog := ctrlrtlog.FromContext(ctx)
+	opts := []client.ListOption{
+		client.InNamespace(schedule.Namespace),
+		client.MatchingLabels{svcapitypes.SnapshotScheduleLabel: schedule.Name},
+	}
+	var snapshots []util.ScheduledSnapshot
+	objects := map[string]client.Object{}
+	if schedule.Spec.DBClusterRef != nil {
+		list := &svcapitypes.DBClusterSnapshotList{}
+		if err := r.kc.List(ctx, list, opts...); err != nil {
+			return err
+		}
+		for i := range list.Items {
+			snapshots = append(snapshots, scheduledSnapshot(&list.Items[i]))
+			objects[list.Items[i].Name] = &list.Items[i]
+		}
+	} else {
+		list := &svcapitypes.DBSnapshotList{}
+		if err := r.kc.List(ctx, list, opts...); err != nil {
+			return err
+		}
+		for i := range list.Items {
+			snapshots = append(snapshots, scheduledSnapshot(&list.Items[i]))
+			objects[list.Items[i].Name] = &list.Items[i]
+		}
+	}
+	expired, counts := util.ExpiredSnapshots(snapshots, schedule.Spec.Retention, loc, now)
+	for _, name := range expired {
+		rlog.Info("deleting expired snapshot", "snapshot", name)
+		if err := r.kc.Delete(ctx, objects[name]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	retained := int64(len(snapshots) - len(expired))
+	schedule.Status.RetainedSnapshots = &retained
+	schedule.Status.RetentionCounts = counts
+	return nil
+}
+
+// scheduledSnapshot returns the name and creation time of the supplied
+// snapshot resource
+func scheduledSnapshot(obj client.Object) util.ScheduledSnapshot {
+	return util.ScheduledSnapshot{
+		Name:         obj.GetName(),
+		CreationTime: obj.GetCreationTimestamp().Time,
+	}
+}
+
+// localRef returns a reference to the resource named by the supplied
+// reference. The namespace is dropped since snapshot resources are created in
+// the namespace of their schedule, where references are resolved.
+func localRef(
+	ref *ackv1alpha1.AWSResourceReferenceWrapper,
+) *ackv1alpha1.AWSResourceReferenceWrapper {
+	name := *ref.From.Name
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: &name},
+	}
+}
+
+// syncedMessage returns the message of the ACK.ResourceSynced condition of the
+// supplied SnapshotSchedule, naming its last snapshot
+func syncedMessage(schedule *svcapitypes.SnapshotSchedule) *string {
+	if schedule.Status.LastSnapshot == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("Last snapshot: %s", *schedule.Status.LastSnapshot)
+	return &msg
+}
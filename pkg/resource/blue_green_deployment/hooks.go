@@ -0,0 +1,200 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package blue_green_deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusProvisioning         = "PROVISIONING"
+	StatusAvailable            = "AVAILABLE"
+	StatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+	StatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	StatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	StatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	StatusDeleting             = "DELETING"
+)
+
+var (
+	// TerminalStatuses are the status strings that are terminal states for a
+	// blue/green deployment.
+	TerminalStatuses = []string{
+		StatusInvalidConfiguration,
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("Blue/green deployment in 'DELETING' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// deploymentHasTerminalStatus returns whether the supplied blue/green
+// deployment is in a terminal state
+func deploymentHasTerminalStatus(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range TerminalStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// deploymentDeleting returns true if the supplied blue/green deployment is in
+// the process of being deleted
+func deploymentDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// deploymentAvailable returns true if the green environment of the supplied
+// blue/green deployment is ready to be switched over
+func deploymentAvailable(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusAvailable
+}
+
+// deploymentSwitchedOver returns true if the supplied blue/green deployment
+// was switched over
+func deploymentSwitchedOver(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusSwitchoverCompleted
+}
+
+// deploymentSynced returns true if the supplied blue/green deployment reached
+// the state described by its Spec: its green environment is available and,
+// when a switchover is requested, the switchover is over.
+func deploymentSynced(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	switch *r.ko.Status.Status {
+	case StatusAvailable:
+		return !switchoverRequested(r)
+	case StatusSwitchoverCompleted, StatusSwitchoverFailed:
+		return true
+	}
+	return false
+}
+
+// switchoverRequested returns true if the Spec of the supplied blue/green
+// deployment requests a switchover
+func switchoverRequested(r *resource) bool {
+	return r.ko.Spec.Switchover != nil && *r.ko.Spec.Switchover
+}
+
+// compareSwitchover adds a difference to the supplied delta when the desired
+// blue/green deployment requests a switchover and the latest one is ready to
+// be switched over, so that the update issues it.
+func compareSwitchover(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if switchoverRequested(a) && deploymentAvailable(b) {
+		delta.Add("Spec.Switchover", a.ko.Spec.Switchover, false)
+	}
+}
+
+// customUpdate switches the blue/green deployment over when requested. The
+// other Spec fields of a blue/green deployment cannot be modified.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	if deploymentDeleting(latest) {
+		msg := "Blue/green deployment is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if delta.DifferentAt("Spec.Switchover") {
+		return rm.switchoverBlueGreenDeployment(ctx, desired)
+	}
+	return desired, nil
+}
+
+// switchoverBlueGreenDeployment switches the supplied blue/green deployment
+// over and reports the switchover in progress in the Switchover condition.
+func (rm *resourceManager) switchoverBlueGreenDeployment(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.switchoverBlueGreenDeployment")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: r.ko.Status.BlueGreenDeploymentIdentifier,
+		SwitchoverTimeout:             r.ko.Spec.SwitchoverTimeout,
+	}
+	resp, respErr := rm.sdkapi.SwitchoverBlueGreenDeploymentWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "SwitchoverBlueGreenDeployment", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	r.ko.Status.Status = resp.BlueGreenDeployment.Status
+	msg := "Blue/green deployment is being switched over"
+	util.SetSwitchover(r, corev1.ConditionUnknown, util.ReasonSwitchoverInProgress, msg)
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, nil
+}
+
+// setSwitchoverProgress reports the progress of the switchover of the
+// supplied blue/green deployment in the Switchover condition.
+func setSwitchoverProgress(r *resource) {
+	if r.ko.Status.Status == nil {
+		return
+	}
+	switch *r.ko.Status.Status {
+	case StatusSwitchoverInProgress:
+		msg := "Blue/green deployment is being switched over"
+		util.SetSwitchover(r, corev1.ConditionUnknown, util.ReasonSwitchoverInProgress, msg)
+	case StatusSwitchoverCompleted:
+		msg := "Blue/green deployment was switched over"
+		util.SetSwitchover(r, corev1.ConditionTrue, util.ReasonSwitchoverCompleted, msg)
+	case StatusSwitchoverFailed:
+		msg := "Blue/green deployment switchover failed"
+		if r.ko.Status.StatusDetails != nil {
+			msg += ": " + *r.ko.Status.StatusDetails
+		}
+		util.SetSwitchover(r, corev1.ConditionFalse, util.ReasonSwitchoverFailed, msg)
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package custom_db_engine_version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusAvailable                      = "available"
+	StatusCreating                       = "creating"
+	StatusPendingValidation              = "pending-validation"
+	StatusInactive                       = "inactive"
+	StatusInactiveExceptRestore          = "inactive-except-restore"
+	StatusDeleting                       = "deleting"
+	StatusFailed                         = "failed"
+	StatusIncompatibleImageConfiguration = "incompatible-image-configuration"
+)
+
+var (
+	// ModifiableStatuses are the status strings of the custom engine versions
+	// that can be modified. A custom engine version that was deactivated can
+	// still be modified.
+	ModifiableStatuses = []string{
+		StatusAvailable,
+		StatusInactive,
+		StatusInactiveExceptRestore,
+	}
+	// TerminalStatuses are the status strings that are terminal states for a
+	// custom engine version. The installation media of a custom engine
+	// version in one of these states can only be fixed by creating a new
+	// custom engine version.
+	TerminalStatuses = []string{
+		StatusFailed,
+		StatusIncompatibleImageConfiguration,
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("Custom engine version in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the custom engine version cannot be modified until it reaches an
+// available status.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"Custom engine version in '%s' state, cannot be modified until '%s'.",
+		status, StatusAvailable,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// engineVersionCreating returns true if the supplied custom engine version is
+// in the process of being created
+func engineVersionCreating(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusCreating
+}
+
+// engineVersionDeleting returns true if the supplied custom engine version is
+// in the process of being deleted
+func engineVersionDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// engineVersionHasStatus returns true if the supplied custom engine version
+// is in one of the supplied statuses
+func engineVersionHasStatus(r *resource, statuses []string) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range statuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// engineVersionModifiable returns true if the supplied custom engine version
+// is in a status allowing it to be modified
+func engineVersionModifiable(r *resource) bool {
+	return engineVersionHasStatus(r, ModifiableStatuses)
+}
+
+// engineVersionHasTerminalStatus returns whether the supplied custom engine
+// version is in a terminal state
+func engineVersionHasTerminalStatus(r *resource) bool {
+	return engineVersionHasStatus(r, TerminalStatuses)
+}
+
+// compareDescription adds a difference to the delta if the desired resource
+// manages the description of the custom engine version and the supplied
+// resources have different descriptions. The description of a custom engine
+// version cannot be removed, only replaced.
+func compareDescription(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.Description == nil {
+		return
+	}
+	if b.ko.Spec.Description == nil || *a.ko.Spec.Description != *b.ko.Spec.Description {
+		delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
+	}
+}
+
+// customUpdate syncs the tags and the description of the custom engine
+// version, the only Spec fields of a custom engine version that can be
+// modified.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	if engineVersionDeleting(latest) {
+		msg := "Custom engine version is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if !engineVersionModifiable(latest) {
+		msg := "Custom engine version cannot be modified until it is available"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilCanModify(latest)
+	}
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Description") {
+		if err = rm.modifyCustomDBEngineVersion(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+	}
+	return &resource{ko}, nil
+}
+
+// modifyCustomDBEngineVersion sets the description of the supplied custom
+// engine version to its Spec.Description, and records the resulting status
+// of the custom engine version.
+func (rm *resourceManager) modifyCustomDBEngineVersion(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyCustomDBEngineVersion")
+	defer func() { exit(err) }()
+
+	resp, err := rm.sdkapi.ModifyCustomDBEngineVersionWithContext(
+		ctx,
+		&svcsdk.ModifyCustomDBEngineVersionInput{
+			Engine:        r.ko.Spec.Engine,
+			EngineVersion: r.ko.Spec.EngineVersion,
+			Description:   r.ko.Spec.Description,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyCustomDBEngineVersion", err)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.Status = resp.Status
+	return nil
+}
+
+// syncTags keeps the resource's tags in sync. See the db_proxy package for
+// the differences between the RDS tagging APIs and the other AWS APIs.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+
+	toAdd, toDelete := util.ComputeTagsDelta(
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+
+	if len(toDelete) > 0 {
+		rlog.Debug("removing tags from custom engine version", "tags", toDelete)
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: arn,
+				TagKeys:      toDelete,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	if len(toAdd) > 0 {
+		rlog.Debug("adding tags to custom engine version", "tags", toAdd)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: arn,
+				Tags:         sdkTagsFromResourceTags(toAdd),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := rm.sdkapi.ListTagsForResourceWithContext(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
+// array.
+func sdkTagsFromResourceTags(
+	rTags []*svcapitypes.Tag,
+) []*svcsdk.Tag {
+	tags := make([]*svcsdk.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = &svcsdk.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// populateAdoptionFields takes the engine and engineVersion of the supplied
+// custom engine version from its adoption fields when it sets the
+// adopt-or-create adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"engine":        &r.ko.Spec.Engine,
+			"engineVersion": &r.ko.Spec.EngineVersion,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
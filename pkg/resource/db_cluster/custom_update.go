@@ -17,6 +17,7 @@ import (
 	"context"
 	"regexp"
 	"slices"
+	"time"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
@@ -27,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
 
 var r = regexp.MustCompile(`[0-9]*$`)
@@ -55,6 +57,63 @@ func (rm *resourceManager) customUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if _, _, err = util.ScheduledStop(desired.ko.Spec.Schedule, time.Now()); err != nil {
+		return nil, err
+	}
+	if err = validateDeletion(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWindows(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecret(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecretRotation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateActivityStream(desired); err != nil {
+		return nil, err
+	}
+	if err = validateServerlessV2Scaling(desired); err != nil {
+		return nil, err
+	}
+	if err = validateServerlessV1Scaling(desired); err != nil {
+		return nil, err
+	}
+	if err = validateBacktrackWindow(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMultiAZCluster(desired); err != nil {
+		return nil, err
+	}
+	if err = validateStorageType(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWriteForwarding(desired); err != nil {
+		return nil, err
+	}
+	// The network types supported by the DB subnet group are also recorded
+	// for the DB clusters created before they were reported in the status
+	if delta.DifferentAt("Spec.NetworkType") || delta.DifferentAt("Spec.DBSubnetGroupName") ||
+		latest.ko.Status.SupportedNetworkTypes == nil {
+		if err = rm.validateNetworkType(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.EnableIAMDatabaseAuthentication") || delta.DifferentAt("Spec.EngineVersion") {
+		if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Schedule") && clusterStopped(latest) {
+		if *latest.ko.Status.Status == StatusStopping {
+			msg := "DB cluster cannot be started while in '" + StatusStopping + "' status"
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+			return desired, requeueWaitWhileStopping
+		}
+		return rm.startDBCluster(ctx, desired)
+	}
 	if !clusterAvailable(latest) {
 		msg := "DB cluster is not available for modification in '" +
 			*latest.ko.Status.Status + "' status"
@@ -67,6 +126,16 @@ func (rm *resourceManager) customUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionTrue, nil, nil)
 		return desired, nil
 	}
+	// The member DBInstances are maintained in Kubernetes, without modifying
+	// the DB cluster
+	if delta.DifferentAt("Spec.Instances") {
+		if err = rm.syncClusterInstances(ctx, desired); err != nil {
+			return nil, err
+		}
+		if !delta.DifferentExcept("Spec.Instances") {
+			return desired, nil
+		}
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
@@ -76,6 +145,56 @@ func (rm *resourceManager) customUpdate(
 		// Spec.Tags field, we can skip the modify db cluster call.
 		return desired, nil
 	}
+	// Only the Data API of Aurora Serverless v1 DB clusters is turned on and
+	// off, with ModifyDBCluster
+	if delta.DifferentAt("Spec.EnableHTTPEndpoint") && !clusterServerlessV1(desired) {
+		return nil, newErrHTTPEndpointUnsupported()
+	}
+	// The rotation schedule of the master user secret is applied through
+	// Secrets Manager
+	if delta.DifferentAt("Spec.MasterUserSecretRotation") {
+		if err = rm.syncMasterUserSecretRotation(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		if masterUserPasswordRotationRequested(desired) {
+			return rm.rotateMasterUserPassword(ctx, desired)
+		}
+		if !delta.DifferentExcept("Spec.MasterUserSecretRotation", "Spec.EnableHTTPEndpoint", "Spec.Tags", "Spec.Instances") {
+			return desired, nil
+		}
+	}
+	if delta.DifferentAt("Spec.ActivityStream") {
+		return rm.syncActivityStream(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.ApplyPendingMaintenanceAction") {
+		return rm.applyPendingMaintenanceAction(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.BacktrackTo") {
+		return rm.backtrackDBCluster(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.PromoteReadReplica") {
+		return rm.promoteReadReplicaDBCluster(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.Failover") {
+		return rm.failoverDBCluster(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.GlobalClusterIdentifier") {
+		return rm.syncGlobalClusterMembership(ctx, desired, latest)
+	}
+	// Stop the DB cluster once every other modification has been applied,
+	// since a stopped DB cluster cannot be modified.
+	if delta.DifferentAt("Spec.Schedule") &&
+		!delta.DifferentExcept("Spec.Schedule", "Spec.Tags", "Spec.Instances") {
+		return rm.stopDBCluster(ctx, desired)
+	}
+	if err = waitForIOOptimizedSwitch(desired, latest, delta); err != nil {
+		return desired, err
+	}
+	if safetySnapshotRequired(desired, latest, delta) {
+		if err = rm.syncSafetySnapshot(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
 
 	input, err := rm.newCustomUpdateRequestPayload(ctx, desired, latest, delta)
 	if err != nil {
@@ -88,6 +207,9 @@ func (rm *resourceManager) customUpdate(
 
 	rm.metrics.RecordAPICall("UPDATE", "ModifyDBCluster", err)
 	if err != nil {
+		if rejected := ioOptimizedSwitchRejected(desired, latest, delta, err); rejected != nil {
+			return desired, rejected
+		}
 		return nil, err
 	}
 	// Merge in the information we read from the API call above to the copy of
@@ -284,6 +406,18 @@ func (rm *resourceManager) customUpdate(
 		f26 := []*svcapitypes.DomainMembership{}
 		for _, f26iter := range resp.DBCluster.DomainMemberships {
 			f26elem := &svcapitypes.DomainMembership{}
+			if f26iter.AuthSecretArn != nil {
+				f26elem.AuthSecretARN = f26iter.AuthSecretArn
+			}
+			if f26iter.DnsIps != nil {
+				f26elemf1 := []*string{}
+				for _, f26elemf1iter := range f26iter.DnsIps {
+					var f26elemf1elem string
+					f26elemf1elem = *f26elemf1iter
+					f26elemf1 = append(f26elemf1, &f26elemf1elem)
+				}
+				f26elem.DNSIPs = f26elemf1
+			}
 			if f26iter.Domain != nil {
 				f26elem.Domain = f26iter.Domain
 			}
@@ -293,6 +427,9 @@ func (rm *resourceManager) customUpdate(
 			if f26iter.IAMRoleName != nil {
 				f26elem.IAMRoleName = f26iter.IAMRoleName
 			}
+			if f26iter.OU != nil {
+				f26elem.OU = f26iter.OU
+			}
 			if f26iter.Status != nil {
 				f26elem.Status = f26iter.Status
 			}
@@ -368,6 +505,11 @@ func (rm *resourceManager) customUpdate(
 	} else {
 		ko.Status.IAMDatabaseAuthenticationEnabled = nil
 	}
+	if resp.DBCluster.IOOptimizedNextAllowedModificationTime != nil {
+		ko.Status.IOOptimizedNextAllowedModificationTime = &metav1.Time{*resp.DBCluster.IOOptimizedNextAllowedModificationTime}
+	} else {
+		ko.Status.IOOptimizedNextAllowedModificationTime = nil
+	}
 	if resp.DBCluster.KmsKeyId != nil {
 		ko.Spec.KMSKeyID = resp.DBCluster.KmsKeyId
 	} else {
@@ -378,6 +520,11 @@ func (rm *resourceManager) customUpdate(
 	} else {
 		ko.Status.LatestRestorableTime = nil
 	}
+	if resp.DBCluster.LocalWriteForwardingStatus != nil {
+		ko.Status.LocalWriteForwardingStatus = resp.DBCluster.LocalWriteForwardingStatus
+	} else {
+		ko.Status.LocalWriteForwardingStatus = nil
+	}
 	if resp.DBCluster.MasterUsername != nil {
 		ko.Spec.MasterUsername = resp.DBCluster.MasterUsername
 	} else {
@@ -520,6 +667,11 @@ func (rm *resourceManager) customUpdate(
 		// set the last-applied-secret-reference annotation on the DB instance
 		// resource.
 		setLastAppliedSecretReferenceAnnotation(r)
+		// The new value of the Secret referenced by Spec.MasterUserPassword
+		// has been applied
+		ko.Status.MasterUserPasswordHash = latest.ko.Status.MasterUserPasswordHash
+		ko.Status.MasterUserPasswordSecretVersionID = latest.ko.Status.MasterUserPasswordSecretVersionID
+		setSafetySnapshotCompleted(r)
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(r, corev1.ConditionFalse, nil, nil)
@@ -544,6 +696,12 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 
 	res.SetApplyImmediately(true)
 	res.SetAllowMajorVersionUpgrade(true)
+	if desired.ko.Spec.AllocatedStorage != nil && delta.DifferentAt("Spec.AllocatedStorage") {
+		res.SetAllocatedStorage(*desired.ko.Spec.AllocatedStorage)
+	}
+	if desired.ko.Spec.AutoMinorVersionUpgrade != nil && delta.DifferentAt("Spec.AutoMinorVersionUpgrade") {
+		res.SetAutoMinorVersionUpgrade(*desired.ko.Spec.AutoMinorVersionUpgrade)
+	}
 	if desired.ko.Spec.BacktrackWindow != nil && delta.DifferentAt("Spec.BacktrackWindow") {
 		res.SetBacktrackWindow(*desired.ko.Spec.BacktrackWindow)
 	}
@@ -558,6 +716,9 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 	if desired.ko.Spec.DBClusterIdentifier != nil {
 		res.SetDBClusterIdentifier(*desired.ko.Spec.DBClusterIdentifier)
 	}
+	if desired.ko.Spec.DBClusterInstanceClass != nil && delta.DifferentAt("Spec.DBClusterInstanceClass") {
+		res.SetDBClusterInstanceClass(*desired.ko.Spec.DBClusterInstanceClass)
+	}
 	if desired.ko.Spec.DBClusterParameterGroupName != nil && delta.DifferentAt("Spec.DBClusterParameterGroupName") {
 		res.SetDBClusterParameterGroupName(*desired.ko.Spec.DBClusterParameterGroupName)
 	}
@@ -573,12 +734,19 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 	if desired.ko.Spec.EnableGlobalWriteForwarding != nil && delta.DifferentAt("Spec.EnableGlobalWriteForwarding") {
 		res.SetEnableGlobalWriteForwarding(*desired.ko.Spec.EnableGlobalWriteForwarding)
 	}
-	if desired.ko.Spec.EnableHTTPEndpoint != nil && delta.DifferentAt("Spec.EnableHTTPEndpoint") {
+	if desired.ko.Spec.EnableHTTPEndpoint != nil && delta.DifferentAt("Spec.EnableHTTPEndpoint") &&
+		clusterServerlessV1(desired) {
 		res.SetEnableHttpEndpoint(*desired.ko.Spec.EnableHTTPEndpoint)
 	}
 	if desired.ko.Spec.EnableIAMDatabaseAuthentication != nil && delta.DifferentAt("Spec.EnableIAMDatabaseAuthentication") {
 		res.SetEnableIAMDatabaseAuthentication(*desired.ko.Spec.EnableIAMDatabaseAuthentication)
 	}
+	if desired.ko.Spec.EnableLocalWriteForwarding != nil && delta.DifferentAt("Spec.EnableLocalWriteForwarding") {
+		res.SetEnableLocalWriteForwarding(*desired.ko.Spec.EnableLocalWriteForwarding)
+	}
+	if desired.ko.Spec.EnablePerformanceInsights != nil && delta.DifferentAt("Spec.EnablePerformanceInsights") {
+		res.SetEnablePerformanceInsights(*desired.ko.Spec.EnablePerformanceInsights)
+	}
 	if desired.ko.Spec.EngineVersion != nil && delta.DifferentAt("Spec.EngineVersion") {
 		autoMinorVersionUpgrade := true
 		if desired.ko.Spec.AutoMinorVersionUpgrade != nil {
@@ -588,6 +756,16 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 			res.SetEngineVersion(*desired.ko.Spec.EngineVersion)
 		}
 	}
+	// The IOPS of a Multi-AZ DB cluster are sent along with its storage
+	// changes, since they are validated against the allocated storage and
+	// storage type
+	if desired.ko.Spec.IOPS != nil && (delta.DifferentAt("Spec.IOPS") ||
+		delta.DifferentAt("Spec.AllocatedStorage") || delta.DifferentAt("Spec.StorageType")) {
+		res.SetIops(*desired.ko.Spec.IOPS)
+	}
+	if desired.ko.Spec.ManageMasterUserPassword != nil && delta.DifferentAt("Spec.ManageMasterUserPassword") {
+		res.SetManageMasterUserPassword(*desired.ko.Spec.ManageMasterUserPassword)
+	}
 	if desired.ko.Spec.MasterUserPassword != nil && delta.DifferentAt("Spec.MasterUserPassword") {
 		tmpSecret, err := rm.rr.SecretValueFromReference(ctx, desired.ko.Spec.MasterUserPassword)
 		if err != nil {
@@ -597,9 +775,31 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 			res.SetMasterUserPassword(tmpSecret)
 		}
 	}
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil && delta.DifferentAt("Spec.MasterUserPassword") {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		res.SetMasterUserPassword(password)
+	}
+	if desired.ko.Spec.MasterUserSecretKMSKeyID != nil && delta.DifferentAt("Spec.MasterUserSecretKMSKeyID") {
+		res.SetMasterUserSecretKmsKeyId(*desired.ko.Spec.MasterUserSecretKMSKeyID)
+	}
+	if desired.ko.Spec.MonitoringInterval != nil && delta.DifferentAt("Spec.MonitoringInterval") {
+		res.SetMonitoringInterval(*desired.ko.Spec.MonitoringInterval)
+	}
+	if desired.ko.Spec.MonitoringRoleARN != nil && delta.DifferentAt("Spec.MonitoringRoleARN") {
+		res.SetMonitoringRoleArn(*desired.ko.Spec.MonitoringRoleARN)
+	}
 	if desired.ko.Spec.OptionGroupName != nil && delta.DifferentAt("Spec.OptionGroupName") {
 		res.SetOptionGroupName(*desired.ko.Spec.OptionGroupName)
 	}
+	if desired.ko.Spec.PerformanceInsightsKMSKeyID != nil && delta.DifferentAt("Spec.PerformanceInsightsKMSKeyID") {
+		res.SetPerformanceInsightsKMSKeyId(*desired.ko.Spec.PerformanceInsightsKMSKeyID)
+	}
+	if desired.ko.Spec.PerformanceInsightsRetentionPeriod != nil && delta.DifferentAt("Spec.PerformanceInsightsRetentionPeriod") {
+		res.SetPerformanceInsightsRetentionPeriod(*desired.ko.Spec.PerformanceInsightsRetentionPeriod)
+	}
 	if desired.ko.Spec.Port != nil && delta.DifferentAt("Spec.Port") {
 		res.SetPort(*desired.ko.Spec.Port)
 	}
@@ -628,6 +828,9 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 		}
 		res.SetScalingConfiguration(f22)
 	}
+	if desired.ko.Spec.StorageType != nil && delta.DifferentAt("Spec.StorageType") {
+		res.SetStorageType(*desired.ko.Spec.StorageType)
+	}
 	if desired.ko.Spec.VPCSecurityGroupIDs != nil && delta.DifferentAt("Spec.VPCSecurityGroupIDs") {
 		f23 := []*string{}
 		for _, f23iter := range desired.ko.Spec.VPCSecurityGroupIDs {
@@ -644,7 +847,7 @@ func (rm *resourceManager) newCustomUpdateRequestPayload(
 			if desired.ko.Spec.ServerlessV2ScalingConfiguration.MaxCapacity != nil {
 				f23.SetMaxCapacity(*desired.ko.Spec.ServerlessV2ScalingConfiguration.MaxCapacity)
 			}
-			if desired.ko.Spec.ServerlessV2ScalingConfiguration.MaxCapacity != nil {
+			if desired.ko.Spec.ServerlessV2ScalingConfiguration.MinCapacity != nil {
 				f23.SetMinCapacity(*desired.ko.Spec.ServerlessV2ScalingConfiguration.MinCapacity)
 			}
 		}
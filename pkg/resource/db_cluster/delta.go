@@ -44,6 +44,20 @@ func newResourceDelta(
 	}
 	compareTags(delta, a, b)
 	compareSecretReferenceChanges(delta, a, b)
+	compareMasterUserPasswordHash(delta, a, b)
+	compareMasterUserSecretRotation(delta, a, b)
+	compareActivityStream(delta, a, b)
+	compareSchedule(delta, a, b)
+	compareApplyPendingMaintenanceAction(delta, a, b)
+	compareBacktrackTo(delta, a, b)
+	compareFailover(delta, a, b)
+	comparePromoteReadReplica(delta, a, b)
+	compareGlobalClusterMembership(delta, a, b)
+	compareClusterInstances(delta, a, b)
+	reconcileEngineVersion(a, b)
+	reconcileWindows(a, b)
+	reconcileNetworkType(a, b)
+	reconcileScalingConfiguration(a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage) {
 		delta.Add("Spec.AllocatedStorage", a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage)
@@ -198,6 +212,13 @@ func newResourceDelta(
 			delta.Add("Spec.EnableIAMDatabaseAuthentication", a.ko.Spec.EnableIAMDatabaseAuthentication, b.ko.Spec.EnableIAMDatabaseAuthentication)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.EnableLocalWriteForwarding, b.ko.Spec.EnableLocalWriteForwarding) {
+		delta.Add("Spec.EnableLocalWriteForwarding", a.ko.Spec.EnableLocalWriteForwarding, b.ko.Spec.EnableLocalWriteForwarding)
+	} else if a.ko.Spec.EnableLocalWriteForwarding != nil && b.ko.Spec.EnableLocalWriteForwarding != nil {
+		if *a.ko.Spec.EnableLocalWriteForwarding != *b.ko.Spec.EnableLocalWriteForwarding {
+			delta.Add("Spec.EnableLocalWriteForwarding", a.ko.Spec.EnableLocalWriteForwarding, b.ko.Spec.EnableLocalWriteForwarding)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.EnablePerformanceInsights, b.ko.Spec.EnablePerformanceInsights) {
 		delta.Add("Spec.EnablePerformanceInsights", a.ko.Spec.EnablePerformanceInsights, b.ko.Spec.EnablePerformanceInsights)
 	} else if a.ko.Spec.EnablePerformanceInsights != nil && b.ko.Spec.EnablePerformanceInsights != nil {
@@ -233,6 +254,9 @@ func newResourceDelta(
 			delta.Add("Spec.GlobalClusterIdentifier", a.ko.Spec.GlobalClusterIdentifier, b.ko.Spec.GlobalClusterIdentifier)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.GlobalClusterRef, b.ko.Spec.GlobalClusterRef) {
+		delta.Add("Spec.GlobalClusterRef", a.ko.Spec.GlobalClusterRef, b.ko.Spec.GlobalClusterRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IOPS, b.ko.Spec.IOPS) {
 		delta.Add("Spec.IOPS", a.ko.Spec.IOPS, b.ko.Spec.IOPS)
 	} else if a.ko.Spec.IOPS != nil && b.ko.Spec.IOPS != nil {
@@ -17,15 +17,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdkcloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
@@ -74,6 +82,19 @@ const (
 	StatusArchived                          = "archived"
 )
 
+// serverlessV2CapacityPeriod is how far back the ServerlessDatabaseCapacity
+// metric of an Aurora Serverless v2 DB cluster is read from
+const serverlessV2CapacityPeriod = 10 * time.Minute
+
+const (
+	// RestoreTypeCopyOnWrite restores a DB cluster as a clone of its source
+	// DB cluster
+	RestoreTypeCopyOnWrite = "copy-on-write"
+	// RestoreTypeFullCopy restores a DB cluster as a full copy of its source
+	// DB cluster
+	RestoreTypeFullCopy = "full-copy"
+)
+
 var (
 	// TerminalStatuses are the status strings that are terminal states for a
 	// DB cluster.
@@ -91,6 +112,26 @@ var (
 		errors.New("DB cluster in 'deleting' state, cannot be modified or deleted."),
 		ackrequeue.DefaultRequeueAfterDuration,
 	)
+	requeueWaitWhileStopping = ackrequeue.NeededAfter(
+		errors.New("DB cluster in 'stopping' state, waiting until 'stopped'."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileDeletionProtected = ackrequeue.NeededAfter(
+		errors.New("DB cluster has deletion protection enabled, cannot be deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitForSafetySnapshot = ackrequeue.NeededAfter(
+		errors.New("DB cluster safety snapshot is not available yet, cannot be modified."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileDeletingInstances = ackrequeue.NeededAfter(
+		errors.New("DB cluster member DB instances are being deleted, cannot be deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileChangingActivityStream = ackrequeue.NeededAfter(
+		errors.New("DB cluster activity stream is being started or stopped, cannot be started or stopped."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
 )
 
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
@@ -156,6 +197,100 @@ func clusterDeleting(r *resource) bool {
 	return dbcs == StatusDeleting
 }
 
+// clusterStopped returns true if the supplied DB cluster is stopped or in the
+// process of being stopped
+func clusterStopped(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	dbcs := *r.ko.Status.Status
+	return dbcs == StatusStopped || dbcs == StatusStopping
+}
+
+// clusterServerlessV1 returns true if the supplied DB cluster is an Aurora
+// Serverless v1 DB cluster
+func clusterServerlessV1(r *resource) bool {
+	return r.ko.Spec.EngineMode != nil && *r.ko.Spec.EngineMode == util.EngineModeServerless
+}
+
+// clusterPaused returns true if the supplied Aurora Serverless v1 DB cluster
+// is paused, scaled down to zero capacity after being idle
+func clusterPaused(r *resource) bool {
+	return clusterServerlessV1(r) && r.ko.Status.Capacity != nil && *r.ko.Status.Capacity == 0
+}
+
+// clusterStoppedAsScheduled returns true if the supplied DB cluster is stopped
+// and the stop/start schedule of the supplied desired resource wants it
+// stopped
+func clusterStoppedAsScheduled(desired *resource, latest *resource) bool {
+	stop, scheduled, _ := util.ScheduledStop(desired.ko.Spec.Schedule, time.Now())
+	return scheduled && stop &&
+		latest.ko.Status.Status != nil &&
+		*latest.ko.Status.Status == StatusStopped
+}
+
+// compareSchedule adds a difference to the supplied delta when the stop/start
+// schedule of the desired resource wants the DB cluster in another state than
+// the observed status of the latest resource, or when the schedule is invalid
+// so that the error gets reported by the update.
+func compareSchedule(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	stop, scheduled, err := util.ScheduledStop(a.ko.Spec.Schedule, time.Now())
+	if err == nil && (!scheduled || stop == clusterStopped(b)) {
+		return
+	}
+	delta.Add("Spec.Schedule", a.ko.Spec.Schedule, b.ko.Spec.Schedule)
+}
+
+// startDBCluster starts the supplied stopped DB cluster
+func (rm *resourceManager) startDBCluster(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.startDBCluster")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.StartDBClusterInput{
+		DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+	}
+	resp, respErr := rm.sdkapi.StartDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "StartDBCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	r.ko.Status.Status = resp.DBCluster.Status
+	msg := "DB cluster is being started"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitUntilCanModify(r)
+}
+
+// stopDBCluster stops the supplied available DB cluster
+func (rm *resourceManager) stopDBCluster(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.stopDBCluster")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.StopDBClusterInput{
+		DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+	}
+	resp, respErr := rm.sdkapi.StopDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "StopDBCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	r.ko.Status.Status = resp.DBCluster.Status
+	msg := "DB cluster is being stopped"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitWhileStopping
+}
+
 // syncTags keeps the resource's tags in sync
 //
 // NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
@@ -296,8 +431,156 @@ func (rm *resourceManager) restoreDbClusterFromSnapshot(
 		return nil, respErr
 	}
 
+	desired := r.ko.Spec.DeepCopy()
 	rm.setResourceFromRestoreDBClusterFromSnapshotOutput(r, resp)
 	rm.setStatusDefaults(r.ko)
+	keepModifiableSpecAfterRestore(&r.ko.Spec, desired)
+
+	// We expect the DB cluster to be in 'creating' status since we just
+	// issued the call to create it, but I suppose it doesn't hurt to check
+	// here.
+	if clusterCreating(&resource{r.ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{r.ko}, corev1.ConditionFalse, nil, nil)
+	}
+	return &resource{r.ko}, nil
+}
+
+// validateRestoreType returns a terminal error when the supplied restore
+// type, set in the supplied Spec field, is not valid.
+func validateRestoreType(restoreType *string, field string) error {
+	if restoreType != nil &&
+		!slices.Contains([]string{RestoreTypeCopyOnWrite, RestoreTypeFullCopy}, *restoreType) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"restoreType %q in %s is not one of %s and %s",
+			*restoreType, field, RestoreTypeCopyOnWrite, RestoreTypeFullCopy,
+		))
+	}
+	return nil
+}
+
+// validateRestoreFrom returns a terminal error when the supplied resource's
+// Spec.RestoreFrom does not name a source DB cluster or a valid restore type,
+// or when the DB cluster is also restored from a snapshot or a point in time.
+func validateRestoreFrom(r *resource) error {
+	restoreFrom := r.ko.Spec.RestoreFrom
+	if restoreFrom.SourceDBClusterIdentifier == nil || *restoreFrom.SourceDBClusterIdentifier == "" {
+		return ackerr.NewTerminalError(errors.New(
+			"sourceDBClusterIdentifier must be set in restoreFrom",
+		))
+	}
+	if err := validateRestoreType(restoreFrom.RestoreType, "restoreFrom"); err != nil {
+		return err
+	}
+	if r.ko.Spec.SnapshotIdentifier != nil || r.ko.Spec.RestoreToPointInTime != nil {
+		return ackerr.NewTerminalError(errors.New(
+			"only one of restoreFrom, restoreToPointInTime and snapshotIdentifier can be set",
+		))
+	}
+	return nil
+}
+
+// validateRestoreToPointInTime returns a terminal error when the supplied
+// resource's point in time restore does not name exactly one source DB
+// cluster, exactly one point in time and a valid restore type, or when the
+// DB cluster is also restored from a snapshot.
+func validateRestoreToPointInTime(r *resource) error {
+	pitr := r.ko.Spec.RestoreToPointInTime
+	if (pitr.SourceDBClusterIdentifier != nil) == (pitr.SourceDBClusterResourceID != nil) {
+		return ackerr.NewTerminalError(errors.New(
+			"exactly one of sourceDBClusterIdentifier and " +
+				"sourceDBClusterResourceID must be set in restoreToPointInTime",
+		))
+	}
+	useLatest := pitr.UseLatestRestorableTime != nil && *pitr.UseLatestRestorableTime
+	if (pitr.RestoreTime != nil) == useLatest {
+		return ackerr.NewTerminalError(errors.New(
+			"exactly one of restoreTime and useLatestRestorableTime " +
+				"must be set in restoreToPointInTime",
+		))
+	}
+	if err := validateRestoreType(pitr.RestoreType, "restoreToPointInTime"); err != nil {
+		return err
+	}
+	if r.ko.Spec.SnapshotIdentifier != nil {
+		return ackerr.NewTerminalError(errors.New(
+			"restoreToPointInTime and snapshotIdentifier cannot both be set",
+		))
+	}
+	return nil
+}
+
+// restoreDBClusterFromCluster creates the DB cluster by restoring the source
+// DB cluster of the supplied resource's Spec.RestoreFrom at its latest
+// restorable time, by default as a copy-on-write clone.
+func (rm *resourceManager) restoreDBClusterFromCluster(
+	ctx context.Context,
+	r *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.restoreDBClusterFromCluster")
+	defer func(err error) { exit(err) }(err)
+
+	if err = validateRestoreFrom(r); err != nil {
+		return nil, err
+	}
+	restoreFrom := r.ko.Spec.RestoreFrom
+	input := rm.newRestoreDBClusterToPointInTimeInput(r)
+	input.SourceDBClusterIdentifier = restoreFrom.SourceDBClusterIdentifier
+	input.RestoreType = restoreFrom.RestoreType
+	if input.RestoreType == nil {
+		input.RestoreType = aws.String(RestoreTypeCopyOnWrite)
+	}
+	input.UseLatestRestorableTime = aws.Bool(true)
+	return rm.issueRestoreDBClusterToPointInTime(ctx, r, input)
+}
+
+// restoreDBClusterToPointInTime creates the DB cluster by restoring the
+// source DB cluster of the supplied resource's Spec.RestoreToPointInTime.
+func (rm *resourceManager) restoreDBClusterToPointInTime(
+	ctx context.Context,
+	r *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.restoreDBClusterToPointInTime")
+	defer func(err error) { exit(err) }(err)
+
+	if err = validateRestoreToPointInTime(r); err != nil {
+		return nil, err
+	}
+	pitr := r.ko.Spec.RestoreToPointInTime
+	input := rm.newRestoreDBClusterToPointInTimeInput(r)
+	input.SourceDBClusterIdentifier = pitr.SourceDBClusterIdentifier
+	input.SourceDbClusterResourceId = pitr.SourceDBClusterResourceID
+	if pitr.RestoreTime != nil {
+		restoreTime := pitr.RestoreTime.Time
+		input.RestoreToTime = &restoreTime
+	}
+	input.UseLatestRestorableTime = pitr.UseLatestRestorableTime
+	input.RestoreType = pitr.RestoreType
+	return rm.issueRestoreDBClusterToPointInTime(ctx, r, input)
+}
+
+// issueRestoreDBClusterToPointInTime calls RestoreDBClusterToPointInTime with
+// the supplied input and sets the supplied resource from the restored DB
+// cluster, keeping the desired values of the fields the call does not
+// support.
+func (rm *resourceManager) issueRestoreDBClusterToPointInTime(
+	ctx context.Context,
+	r *resource,
+	input *svcsdk.RestoreDBClusterToPointInTimeInput,
+) (*resource, error) {
+	resp, respErr := rm.sdkapi.RestoreDBClusterToPointInTimeWithContext(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "RestoreDBClusterToPointInTime", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	desired := r.ko.Spec.DeepCopy()
+	rm.setResourceFromRestoreDBClusterToPointInTimeOutput(r, resp)
+	rm.setStatusDefaults(r.ko)
+	keepModifiableSpecAfterRestore(&r.ko.Spec, desired)
 
 	// We expect the DB cluster to be in 'creating' status since we just
 	// issued the call to create it, but I suppose it doesn't hurt to check
@@ -310,6 +593,53 @@ func (rm *resourceManager) restoreDbClusterFromSnapshot(
 	return &resource{r.ko}, nil
 }
 
+// keepModifiableSpecAfterRestore sets back the desired values of the fields
+// the RestoreDBClusterFromSnapshot and RestoreDBClusterToPointInTime API calls
+// do not support, which would otherwise be overwritten by the values of the
+// restored DB cluster. Once the restored DB cluster is available, these
+// fields are set with ModifyDBCluster like any other modification.
+func keepModifiableSpecAfterRestore(
+	spec *svcapitypes.DBClusterSpec,
+	desired *svcapitypes.DBClusterSpec,
+) {
+	if desired.AllocatedStorage != nil {
+		spec.AllocatedStorage = desired.AllocatedStorage
+	}
+	if desired.AutoMinorVersionUpgrade != nil {
+		spec.AutoMinorVersionUpgrade = desired.AutoMinorVersionUpgrade
+	}
+	if desired.BackupRetentionPeriod != nil {
+		spec.BackupRetentionPeriod = desired.BackupRetentionPeriod
+	}
+	if desired.EnableHTTPEndpoint != nil {
+		spec.EnableHTTPEndpoint = desired.EnableHTTPEndpoint
+	}
+	if desired.EnablePerformanceInsights != nil {
+		spec.EnablePerformanceInsights = desired.EnablePerformanceInsights
+	}
+	if desired.EngineVersion != nil {
+		spec.EngineVersion = desired.EngineVersion
+	}
+	if desired.MonitoringInterval != nil {
+		spec.MonitoringInterval = desired.MonitoringInterval
+	}
+	if desired.MonitoringRoleARN != nil {
+		spec.MonitoringRoleARN = desired.MonitoringRoleARN
+	}
+	if desired.PerformanceInsightsKMSKeyID != nil {
+		spec.PerformanceInsightsKMSKeyID = desired.PerformanceInsightsKMSKeyID
+	}
+	if desired.PerformanceInsightsRetentionPeriod != nil {
+		spec.PerformanceInsightsRetentionPeriod = desired.PerformanceInsightsRetentionPeriod
+	}
+	if desired.PreferredBackupWindow != nil {
+		spec.PreferredBackupWindow = desired.PreferredBackupWindow
+	}
+	if desired.PreferredMaintenanceWindow != nil {
+		spec.PreferredMaintenanceWindow = desired.PreferredMaintenanceWindow
+	}
+}
+
 // TODO(a-hilaly): generate this code.
 
 // getLastAppliedSecretReferenceString returns a string representation of the
@@ -350,3 +680,1797 @@ func compareSecretReferenceChanges(
 		delta.Add("Spec.MasterUserPassword", oldRef, newRef)
 	}
 }
+
+// setMasterUserPasswordHash records in the status of the supplied DB cluster a
+// hash of the current master user password held by the Secret referenced by
+// Spec.MasterUserPassword or the Secrets Manager secret set in
+// Spec.MasterUserPasswordSecretARN. The value of the Secrets Manager secret is
+// only read when the secret has a new version since the hash was recorded, so
+// that reading the DB cluster doesn't read the secret every time. The hash is
+// left untouched when the password cannot be read.
+func (rm *resourceManager) setMasterUserPasswordHash(
+	ctx context.Context,
+	r *resource,
+) {
+	var value string
+	var err error
+	switch {
+	case r.ko.Spec.MasterUserPassword != nil:
+		r.ko.Status.MasterUserPasswordSecretVersionID = nil
+		value, err = rm.rr.SecretValueFromReference(ctx, r.ko.Spec.MasterUserPassword)
+	case r.ko.Spec.MasterUserPasswordSecretARN != nil:
+		var version string
+		version, err = rm.getMasterUserPasswordSecretVersionID(ctx, r)
+		if err != nil {
+			return
+		}
+		if r.ko.Status.MasterUserPasswordHash != nil &&
+			aws.StringValue(r.ko.Status.MasterUserPasswordSecretVersionID) == version {
+			return
+		}
+		value, err = rm.getMasterUserPasswordFromSecretARN(ctx, r)
+		if err == nil {
+			r.ko.Status.MasterUserPasswordSecretVersionID = &version
+		}
+	default:
+		r.ko.Status.MasterUserPasswordHash = nil
+		r.ko.Status.MasterUserPasswordSecretVersionID = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	hash := util.SecretValueHash(string(r.ko.UID), value)
+	r.ko.Status.MasterUserPasswordHash = &hash
+}
+
+// compareMasterUserPasswordHash adds a difference to the supplied delta when
+// the master user password read from its Secret or Secrets Manager secret
+// changed since it was last applied to the DB cluster, so that the update
+// applies the new password. A change to the Secret reconciles the DB cluster
+// right away, while a change to the Secrets Manager secret is picked up on the
+// next reconciliation of the DB cluster.
+func compareMasterUserPasswordHash(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	applied := desired.ko.Status.MasterUserPasswordHash
+	current := latest.ko.Status.MasterUserPasswordHash
+	// The first hash recorded, for instance for the DB clusters created before
+	// hashes were recorded, is recorded without modifying the DB cluster
+	if applied == nil || current == nil {
+		return
+	}
+	if *applied != *current {
+		delta.Add("Spec.MasterUserPassword", desired.ko.Spec.MasterUserPassword, latest.ko.Spec.MasterUserPassword)
+	}
+}
+
+// validateDeletion returns a terminal error when the deletion policy of the
+// supplied resource is invalid or its final snapshot identifier template does
+// not render into a valid identifier.
+func validateDeletion(r *resource) error {
+	if _, err := util.DeletionPolicy(r.ko.Spec.DeletionPolicy); err != nil {
+		return err
+	}
+	if r.ko.Spec.FinalSnapshotIdentifierTemplate == nil || r.ko.Spec.DBClusterIdentifier == nil {
+		return nil
+	}
+	_, err := util.FinalSnapshotIdentifier(
+		r.ko.Spec.FinalSnapshotIdentifierTemplate, r.ko,
+		*r.ko.Spec.DBClusterIdentifier, time.Now(),
+	)
+	return err
+}
+
+// validateWindows returns a terminal error when the preferred backup or
+// maintenance window of the supplied resource is not valid, or when they
+// overlap.
+func validateWindows(r *resource) error {
+	return util.ValidateWindows(
+		r.ko.Spec.PreferredBackupWindow, r.ko.Spec.PreferredMaintenanceWindow,
+	)
+}
+
+// validateIAMDatabaseAuthentication returns an ACK terminal error when the
+// supplied DB cluster enables IAM database authentication that its engine and
+// engine version, and DB cluster instance class for Multi-AZ DB clusters, do
+// not support, as reported by DescribeOrderableDBInstanceOptions.
+func (rm *resourceManager) validateIAMDatabaseAuthentication(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateIAMDatabaseAuthentication")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.EnableIAMDatabaseAuthentication == nil || !*r.ko.Spec.EnableIAMDatabaseAuthentication ||
+		r.ko.Spec.Engine == nil {
+		return nil
+	}
+	input := &svcsdk.DescribeOrderableDBInstanceOptionsInput{
+		DBInstanceClass: r.ko.Spec.DBClusterInstanceClass,
+		Engine:          r.ko.Spec.Engine,
+		EngineVersion:   r.ko.Spec.EngineVersion,
+	}
+	var options []*svcsdk.OrderableDBInstanceOption
+	err = rm.sdkapi.DescribeOrderableDBInstanceOptionsPagesWithContext(
+		ctx,
+		input,
+		func(page *svcsdk.DescribeOrderableDBInstanceOptionsOutput, _ bool) bool {
+			options = append(options, page.OrderableDBInstanceOptions...)
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeOrderableDBInstanceOptions", err)
+	if err != nil {
+		return err
+	}
+	return util.ValidateIAMDatabaseAuthentication(options)
+}
+
+// validateServerlessV2Scaling returns a terminal error when the Aurora
+// Serverless v2 scaling configuration of the supplied DB cluster is not valid.
+func validateServerlessV2Scaling(r *resource) error {
+	config := r.ko.Spec.ServerlessV2ScalingConfiguration
+	if config == nil {
+		return nil
+	}
+	return util.ValidateServerlessV2Scaling(
+		aws.StringValue(r.ko.Spec.Engine),
+		r.ko.Spec.EngineVersion,
+		config.MinCapacity,
+		config.MaxCapacity,
+	)
+}
+
+// validateMultiAZCluster returns a terminal error when the supplied Multi-AZ
+// DB cluster, an RDS for MySQL or RDS for PostgreSQL DB cluster, is not
+// valid.
+func validateMultiAZCluster(r *resource) error {
+	return util.ValidateMultiAZCluster(&r.ko.Spec)
+}
+
+// validateWriteForwarding returns a terminal error when the supplied DB
+// cluster turns on a write forwarding it does not support.
+func validateWriteForwarding(r *resource) error {
+	return util.ValidateWriteForwarding(&r.ko.Spec)
+}
+
+// validateHTTPEndpoint returns a terminal error when the supplied DB cluster,
+// about to be created, turns on the Data API of an Aurora provisioned or
+// Aurora Serverless v2 DB cluster.
+func validateHTTPEndpoint(r *resource) error {
+	if clusterServerlessV1(r) || !aws.BoolValue(r.ko.Spec.EnableHTTPEndpoint) {
+		return nil
+	}
+	return newErrHTTPEndpointUnsupported()
+}
+
+// newErrHTTPEndpointUnsupported returns the terminal error about the Data API
+// of an Aurora provisioned or Aurora Serverless v2 DB cluster, which is turned
+// on and off with the EnableHttpEndpoint and DisableHttpEndpoint operations
+// the aws-sdk-go version the controller is built with does not have.
+func newErrHTTPEndpointUnsupported() error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"the Data API can only be turned on and off on Aurora Serverless v1 " +
+			"DB clusters by this controller version",
+	))
+}
+
+// localWriteForwardingEnabled returns true if the local write forwarding of
+// the supplied DB cluster is turned on or being turned on.
+func localWriteForwardingEnabled(r *resource) bool {
+	return util.WriteForwardingEnabled(r.ko.Status.LocalWriteForwardingStatus)
+}
+
+// writeForwardingChanging returns true if the global or local write
+// forwarding of the supplied DB cluster is being turned on or off.
+func writeForwardingChanging(r *resource) bool {
+	return util.WriteForwardingChanging(r.ko.Status.GlobalWriteForwardingStatus) ||
+		util.WriteForwardingChanging(r.ko.Status.LocalWriteForwardingStatus)
+}
+
+// validateStorageType returns a terminal error when the storage type of the
+// supplied Aurora DB cluster is not one of the Aurora storage types.
+func validateStorageType(r *resource) error {
+	return util.ValidateAuroraStorageType(aws.StringValue(r.ko.Spec.Engine), r.ko.Spec.StorageType)
+}
+
+// ioOptimizedSwitchRequested returns true if the desired DB cluster switches
+// to the Aurora I/O-Optimized storage type in the supplied delta.
+func ioOptimizedSwitchRequested(desired *resource, delta *ackcompare.Delta) bool {
+	return delta.DifferentAt("Spec.StorageType") && desired.ko.Spec.StorageType != nil &&
+		*desired.ko.Spec.StorageType == util.StorageTypeAuroraIOOptimized
+}
+
+// waitForIOOptimizedSwitch returns a requeue error, until the next time the
+// supplied latest DB cluster is allowed to switch to the Aurora I/O-Optimized
+// storage type, when the desired DB cluster switches to it before that time.
+// The next allowed switch time is reported in the status and the Synced
+// condition of the desired DB cluster.
+func waitForIOOptimizedSwitch(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) error {
+	if !ioOptimizedSwitchRequested(desired, delta) {
+		return nil
+	}
+	nextAllowed := latest.ko.Status.IOOptimizedNextAllowedModificationTime
+	wait := util.IOOptimizedSwitchWait(nextAllowed, time.Now())
+	if wait == 0 {
+		return nil
+	}
+	desired.ko.Status.IOOptimizedNextAllowedModificationTime = nextAllowed
+	msg := ioOptimizedSwitchMessage(nextAllowed)
+	ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+	return ackrequeue.NeededAfter(errors.New(msg), wait)
+}
+
+// ioOptimizedSwitchRejected returns a requeue error when the supplied error
+// of the modification of the desired DB cluster rejects its switch to the
+// Aurora I/O-Optimized storage type, because it switched to it less than 30
+// days ago, and reports the next allowed switch time of the supplied latest
+// DB cluster in the status and the Synced condition of the desired one. It
+// returns nil for the other errors.
+func ioOptimizedSwitchRejected(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+	err error,
+) error {
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok || awsErr.Code() != "InvalidParameterCombination" || !ioOptimizedSwitchRequested(desired, delta) {
+		return nil
+	}
+	nextAllowed := latest.ko.Status.IOOptimizedNextAllowedModificationTime
+	desired.ko.Status.IOOptimizedNextAllowedModificationTime = nextAllowed
+	msg := ioOptimizedSwitchMessage(nextAllowed) + ": " + awsErr.Message()
+	ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+	return ackrequeue.NeededAfter(errors.New(msg), ackrequeue.DefaultRequeueAfterDuration)
+}
+
+// ioOptimizedSwitchMessage returns the message explaining the DB cluster cannot
+// switch to the Aurora I/O-Optimized storage type before the supplied time.
+func ioOptimizedSwitchMessage(nextAllowed *metav1.Time) string {
+	msg := "DB cluster switched to the " + util.StorageTypeAuroraIOOptimized +
+		" storage type less than 30 days ago"
+	if nextAllowed != nil {
+		msg += ", cannot switch again until " + nextAllowed.UTC().Format(time.RFC3339)
+	}
+	return msg
+}
+
+// multiAZClusterModifying returns true if the storage modification of the
+// supplied Multi-AZ DB cluster is still pending: the DB cluster stays
+// available while its DB instances are modified one after the other.
+func multiAZClusterModifying(r *resource) bool {
+	if r.ko.Spec.Engine == nil || !util.MultiAZCluster(*r.ko.Spec.Engine) {
+		return false
+	}
+	pending := r.ko.Status.PendingModifiedValues
+	return pending != nil && (pending.AllocatedStorage != nil || pending.IOPS != nil)
+}
+
+// setServerlessV2Capacity records in the status of the supplied Aurora
+// Serverless v2 DB cluster its current capacity, the latest
+// ServerlessDatabaseCapacity metric of the DB cluster in CloudWatch. The
+// capacity is left untouched when the metric cannot be read.
+func (rm *resourceManager) setServerlessV2Capacity(
+	ctx context.Context,
+	r *resource,
+) {
+	if r.ko.Spec.ServerlessV2ScalingConfiguration == nil {
+		r.ko.Status.ServerlessV2Capacity = nil
+		return
+	}
+	now := time.Now()
+	resp, err := svcsdkcloudwatch.New(rm.sess).GetMetricStatisticsWithContext(
+		ctx,
+		&svcsdkcloudwatch.GetMetricStatisticsInput{
+			Namespace:  aws.String("AWS/RDS"),
+			MetricName: aws.String("ServerlessDatabaseCapacity"),
+			Dimensions: []*svcsdkcloudwatch.Dimension{{
+				Name:  aws.String("DBClusterIdentifier"),
+				Value: r.ko.Spec.DBClusterIdentifier,
+			}},
+			StartTime:  aws.Time(now.Add(-serverlessV2CapacityPeriod)),
+			EndTime:    aws.Time(now),
+			Period:     aws.Int64(60),
+			Statistics: []*string{aws.String(svcsdkcloudwatch.StatisticAverage)},
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "GetMetricStatistics", err)
+	if err != nil {
+		return
+	}
+	var latest *svcsdkcloudwatch.Datapoint
+	for _, datapoint := range resp.Datapoints {
+		if datapoint.Timestamp == nil || datapoint.Average == nil {
+			continue
+		}
+		if latest == nil || datapoint.Timestamp.After(*latest.Timestamp) {
+			latest = datapoint
+		}
+	}
+	if latest != nil {
+		r.ko.Status.ServerlessV2Capacity = latest.Average
+	}
+}
+
+// validateServerlessV1Scaling returns a terminal error when the Aurora
+// Serverless v1 scaling configuration of the supplied DB cluster is not valid.
+func validateServerlessV1Scaling(r *resource) error {
+	return util.ValidateServerlessV1Scaling(
+		aws.StringValue(r.ko.Spec.Engine),
+		r.ko.Spec.EngineMode,
+		r.ko.Spec.ScalingConfiguration,
+	)
+}
+
+// validateMasterUserSecret returns a terminal error when the master user
+// password of the supplied resource is set in more than one way, or copied to
+// a Secret without being managed in Secrets Manager.
+func validateMasterUserSecret(r *resource) error {
+	return util.ValidateMasterUserSecret(
+		r.ko.Spec.ManageMasterUserPassword,
+		r.ko.Spec.MasterUserPassword,
+		r.ko.Spec.MasterUserPasswordSecretARN,
+		r.ko.Spec.MasterUserSecretDestination,
+	)
+}
+
+// copyMasterUserSecret copies the master user password of the supplied
+// DB cluster, managed by RDS in Secrets Manager, to the key of the Kubernetes
+// Secret set in Spec.MasterUserSecretDestination, unless the Secret already
+// holds it. Rotations of the password are copied on the next reconciliation
+// of the DB cluster.
+func (rm *resourceManager) copyMasterUserSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.copyMasterUserSecret")
+	defer func(err error) { exit(err) }(err)
+
+	destination := r.ko.Spec.MasterUserSecretDestination
+	if destination == nil {
+		return nil
+	}
+	password, err := rm.getManagedMasterUserPassword(ctx, r)
+	if err != nil || password == "" {
+		return err
+	}
+	// The password is only ever copied to a Secret in the namespace of the
+	// resource, which the owner of the resource can already read.
+	ref := &v1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{
+			Name:      aws.StringValue(destination.Name),
+			Namespace: r.ko.Namespace,
+		},
+		Key: aws.StringValue(destination.Key),
+	}
+	if current, err := rm.rr.SecretValueFromReference(ctx, ref); err == nil && current == password {
+		return nil
+	}
+	return rm.rr.WriteToSecret(ctx, password, ref.Namespace, ref.Name, ref.Key)
+}
+
+// getManagedMasterUserPassword returns the master user password of the
+// supplied DB cluster managed by RDS in Secrets Manager, or an empty string when
+// it is not managed in Secrets Manager or its secret is not active yet.
+func (rm *resourceManager) getManagedMasterUserPassword(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	secret := r.ko.Status.MasterUserSecret
+	if secret == nil || secret.SecretARN == nil ||
+		secret.SecretStatus == nil || *secret.SecretStatus != util.MasterUserSecretStatusActive {
+		return "", nil
+	}
+	resp, err := svcsdksecretsmanager.New(rm.sess).GetSecretValueWithContext(
+		ctx,
+		&svcsdksecretsmanager.GetSecretValueInput{
+			SecretId: secret.SecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "GetSecretValue", err)
+	if err != nil {
+		return "", err
+	}
+	return util.MasterUserSecretPassword(aws.StringValue(resp.SecretString))
+}
+
+// getMasterUserPasswordFromSecretARN returns the master user password of the
+// supplied DB cluster held by the Secrets Manager secret set in
+// Spec.MasterUserPasswordSecretARN.
+func (rm *resourceManager) getMasterUserPasswordFromSecretARN(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	resp, err := svcsdksecretsmanager.New(rm.sess).GetSecretValueWithContext(
+		ctx,
+		&svcsdksecretsmanager.GetSecretValueInput{
+			SecretId: r.ko.Spec.MasterUserPasswordSecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "GetSecretValue", err)
+	if err != nil {
+		return "", err
+	}
+	return util.SecretStringPassword(aws.StringValue(resp.SecretString)), nil
+}
+
+// getMasterUserPasswordSecretVersionID returns the identifier of the current
+// version of the Secrets Manager secret set in Spec.MasterUserPasswordSecretARN
+// of the supplied DB cluster.
+func (rm *resourceManager) getMasterUserPasswordSecretVersionID(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	resp, err := svcsdksecretsmanager.New(rm.sess).DescribeSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DescribeSecretInput{
+			SecretId: r.ko.Spec.MasterUserPasswordSecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", err)
+	if err != nil {
+		return "", err
+	}
+	return util.CurrentSecretVersionID(resp.VersionIdsToStages), nil
+}
+
+// validateMasterUserSecretRotation returns an ACK terminal error when the
+// rotation of the master user password of the supplied DB cluster is not valid.
+func validateMasterUserSecretRotation(r *resource) error {
+	return util.ValidateMasterUserSecretRotation(
+		r.ko.Spec.ManageMasterUserPassword,
+		r.ko.Spec.MasterUserSecretRotation,
+	)
+}
+
+// setMasterUserSecretRotation records in the latest DB cluster the last
+// rotation of its master user password managed by RDS in Secrets Manager and,
+// when it differs from the desired one, the rotation schedule of the secret,
+// as reported by DescribeSecret. The secret is only described when the
+// desired DB cluster sets Spec.MasterUserSecretRotation.
+func (rm *resourceManager) setMasterUserSecretRotation(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	secret := latest.ko.Status.MasterUserSecret
+	if desired.ko.Spec.MasterUserSecretRotation == nil || secret == nil || secret.SecretARN == nil {
+		return nil
+	}
+	resp, err := svcsdksecretsmanager.New(rm.sess).DescribeSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DescribeSecretInput{
+			SecretId: secret.SecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", err)
+	if err != nil {
+		return err
+	}
+	if resp.LastRotatedDate != nil {
+		latest.ko.Status.MasterUserSecretLastRotatedDate = &metav1.Time{Time: *resp.LastRotatedDate}
+	} else {
+		latest.ko.Status.MasterUserSecretLastRotatedDate = nil
+	}
+	rotation := util.MasterUserSecretRotationFromRules(
+		resp.RotationRules, desired.ko.Spec.MasterUserSecretRotation.RotateImmediately,
+	)
+	// The desired rotation schedule is kept when the secret follows it, so
+	// that the way Secrets Manager reports it does not leak into the Spec
+	if util.MasterUserSecretRotationDiffers(desired.ko.Spec.MasterUserSecretRotation, rotation) {
+		latest.ko.Spec.MasterUserSecretRotation = rotation
+	}
+	return nil
+}
+
+// compareMasterUserSecretRotation adds a difference to the supplied delta when
+// the rotation schedule of the master user secret of the desired resource
+// differs from the one of the latest resource.
+func compareMasterUserSecretRotation(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.MasterUserSecretRotationDiffers(a.ko.Spec.MasterUserSecretRotation, b.ko.Spec.MasterUserSecretRotation) {
+		delta.Add("Spec.MasterUserSecretRotation", a.ko.Spec.MasterUserSecretRotation, b.ko.Spec.MasterUserSecretRotation)
+	}
+}
+
+// syncMasterUserSecretRotation applies the rotation schedule of the desired
+// DB cluster to its master user secret managed by RDS in Secrets Manager.
+func (rm *resourceManager) syncMasterUserSecretRotation(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncMasterUserSecretRotation")
+	defer func(err error) { exit(err) }(err)
+
+	_, err = svcsdksecretsmanager.New(rm.sess).RotateSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.RotateSecretInput{
+			SecretId:      latest.ko.Status.MasterUserSecret.SecretARN,
+			RotationRules: util.MasterUserSecretRotationRules(desired.ko.Spec.MasterUserSecretRotation),
+			// The password is rotated immediately through RDS, see
+			// rotateMasterUserPassword
+			RotateImmediately: aws.Bool(false),
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "RotateSecret", err)
+	return err
+}
+
+// masterUserPasswordRotationRequested returns true if the supplied resource
+// requests to rotate its master user password immediately.
+func masterUserPasswordRotationRequested(r *resource) bool {
+	rotation := r.ko.Spec.MasterUserSecretRotation
+	return rotation != nil && rotation.RotateImmediately != nil && *rotation.RotateImmediately
+}
+
+// rotateMasterUserPassword immediately rotates the master user password of
+// the desired DB cluster managed by RDS in Secrets Manager, through
+// ModifyDBCluster, and returns a copy of the resource reporting the rotation in
+// progress.
+func (rm *resourceManager) rotateMasterUserPassword(
+	ctx context.Context,
+	desired *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rotateMasterUserPassword")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.ModifyDBClusterInput{
+		DBClusterIdentifier:      desired.ko.Spec.DBClusterIdentifier,
+		RotateMasterUserPassword: aws.Bool(true),
+		ApplyImmediately:         aws.Bool(true),
+	}
+	resp, respErr := rm.sdkapi.ModifyDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	ko.Status.Status = resp.DBCluster.Status
+	msg := "Master user password is being rotated"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// writeConnectionSecret writes the connection details of the supplied DB cluster
+// to the Kubernetes Secret set in Spec.ConnectionSecret, in the namespace of
+// the resource and owned by it, unless they did not change since they were
+// last written, and records their hash in Status.ConnectionSecretHash.
+func (rm *resourceManager) writeConnectionSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeConnectionSecret")
+	defer func(err error) { exit(err) }(err)
+
+	destination := r.ko.Spec.ConnectionSecret
+	if destination == nil || destination.Name == nil {
+		r.ko.Status.ConnectionSecretHash = nil
+		return nil
+	}
+	details, err := rm.getConnectionDetails(ctx, r)
+	if err != nil {
+		return err
+	}
+	details, err = util.RenderConnectionTemplates(details, destination.Templates)
+	if err != nil {
+		return err
+	}
+	hash := util.ConnectionDetailsHash(string(r.ko.UID), details)
+	if r.ko.Status.ConnectionSecretHash != nil && *r.ko.Status.ConnectionSecretHash == hash {
+		return nil
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	err = util.EnsureConnectionSecret(ctx, *owner, r.ko.Namespace, *destination.Name, details)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.ConnectionSecretHash = &hash
+	return nil
+}
+
+// getConnectionDetails returns the connection details of the supplied
+// DB cluster, by connection Secret key, including its writer and reader
+// endpoints, along with the master user password
+// when it is set from a Secret or a Secrets Manager secret, or managed in
+// Secrets Manager, and the RDS certificate bundle of its region.
+func (rm *resourceManager) getConnectionDetails(
+	ctx context.Context,
+	r *resource,
+) (map[string]string, error) {
+	var password string
+	var err error
+	switch {
+	case r.ko.Spec.MasterUserPassword != nil:
+		password, err = rm.rr.SecretValueFromReference(ctx, r.ko.Spec.MasterUserPassword)
+	case r.ko.Spec.MasterUserPasswordSecretARN != nil:
+		password, err = rm.getMasterUserPasswordFromSecretARN(ctx, r)
+	default:
+		password, err = rm.getManagedMasterUserPassword(ctx, r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	details := util.ConnectionDetails(
+		r.ko.Status.Endpoint, r.ko.Spec.Port, r.ko.Spec.DatabaseName,
+		r.ko.Spec.MasterUsername, password,
+	)
+	util.AddClusterEndpoints(details, r.ko.Status.Endpoint, r.ko.Status.ReaderEndpoint)
+	// The connection details are still written when the RDS certificate
+	// bundle cannot be fetched, for instance without access to the internet
+	if bundle, err := util.CABundle(ctx, string(rm.awsRegion)); err == nil {
+		details[util.CABundleKey] = bundle
+	} else {
+		ackrtlog.FromContext(ctx).Info("unable to fetch the RDS certificate bundle", "error", err)
+	}
+	return details, nil
+}
+
+// syncCABundleConfigMap maintains the RDS certificate bundle of the region
+// of the supplied DB cluster in the ConfigMap set in Spec.CABundleConfigMap.
+func (rm *resourceManager) syncCABundleConfigMap(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncCABundleConfigMap")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.CABundleConfigMap == nil {
+		return nil
+	}
+	bundle, err := util.CABundle(ctx, string(rm.awsRegion))
+	if err != nil {
+		return err
+	}
+	// The ConfigMap can be shared by several DB instances and DB clusters, so
+	// none of them controls it
+	owner := metav1.OwnerReference{
+		APIVersion: svcapitypes.GroupVersion.String(),
+		Kind:       "DBCluster",
+		Name:       r.ko.Name,
+		UID:        r.ko.UID,
+	}
+	return util.EnsureCABundleConfigMap(ctx, owner, r.ko.Namespace, *r.ko.Spec.CABundleConfigMap, bundle)
+}
+
+// writeServiceBindingSecret writes the connection details of the supplied
+// DB cluster to its Service Binding Secret, once the DB cluster has an
+// endpoint, and refers to the Secret in Status.Binding when
+// Spec.EnableServiceBinding is set.
+func (rm *resourceManager) writeServiceBindingSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeServiceBindingSecret")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.EnableServiceBinding == nil || !*r.ko.Spec.EnableServiceBinding {
+		r.ko.Status.Binding = nil
+		return nil
+	}
+	if r.ko.Status.Endpoint == nil {
+		return nil
+	}
+	details, err := rm.getConnectionDetails(ctx, r)
+	if err != nil {
+		return err
+	}
+	binding := util.ServiceBindingDetails(aws.StringValue(r.ko.Spec.Engine), details)
+	name := r.ko.Name + util.ServiceBindingSecretSuffix
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	if err = util.EnsureServiceBindingSecret(ctx, *owner, r.ko.Namespace, name, binding); err != nil {
+		return err
+	}
+	r.ko.Status.Binding = &svcapitypes.ServiceBinding{Name: &name}
+	return nil
+}
+
+// syncEndpointServices points the Kubernetes ExternalName Services set in
+// Spec.EndpointService at the endpoint and reader endpoint of the supplied DB
+// cluster, once the DB cluster has them.
+func (rm *resourceManager) syncEndpointServices(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncEndpointServices")
+	defer func(err error) { exit(err) }(err)
+
+	service := r.ko.Spec.EndpointService
+	if service == nil {
+		return nil
+	}
+	name := r.ko.Name
+	if service.Name != nil {
+		name = *service.Name
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	if r.ko.Status.Endpoint != nil {
+		if err = util.EnsureExternalNameService(ctx, *owner, r.ko.Namespace, name, *r.ko.Status.Endpoint); err != nil {
+			return err
+		}
+	}
+	if r.ko.Status.ReaderEndpoint != nil {
+		return util.EnsureExternalNameService(ctx, *owner, r.ko.Namespace, name+"-reader", *r.ko.Status.ReaderEndpoint)
+	}
+	return nil
+}
+
+// setClusterInstancesSynced records in Status.InstancesSynced of the supplied
+// DB cluster whether its member DBInstances match Spec.Instances, once the DB
+// cluster is available. The DBInstances are only read: they are created,
+// updated and deleted by the update of the DB cluster.
+func (rm *resourceManager) setClusterInstancesSynced(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setClusterInstancesSynced")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.Instances == nil || !clusterAvailable(r) {
+		r.ko.Status.InstancesSynced = nil
+		return nil
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	synced, err := util.ClusterInstancesInSync(ctx, *owner, r.ko)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.InstancesSynced = &synced
+	return nil
+}
+
+// compareClusterInstances adds a difference to the supplied delta when the
+// member DBInstances of the latest DB cluster don't match Spec.Instances.
+func compareClusterInstances(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if b.ko.Status.InstancesSynced != nil && !*b.ko.Status.InstancesSynced {
+		delta.Add("Spec.Instances", a.ko.Spec.Instances, b.ko.Status.InstancesSynced)
+	}
+}
+
+// syncClusterInstances creates, updates and deletes the member DBInstances of
+// the supplied DB cluster to match Spec.Instances.
+func (rm *resourceManager) syncClusterInstances(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncClusterInstances")
+	defer func(err error) { exit(err) }(err)
+
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	if err = util.EnsureClusterInstances(ctx, *owner, r.ko); err != nil {
+		return err
+	}
+	synced := true
+	r.ko.Status.InstancesSynced = &synced
+	return nil
+}
+
+// deleteClusterInstances deletes the member DBInstances the controller
+// created for the supplied DB cluster, and returns a requeue error until they
+// are gone, since a DB cluster cannot be deleted while it has DB instances.
+func (rm *resourceManager) deleteClusterInstances(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deleteClusterInstances")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.Instances == nil {
+		return nil
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBCluster"))
+	remaining, err := util.DeleteClusterInstances(ctx, *owner, r.ko.Namespace)
+	if err != nil {
+		return err
+	}
+	if remaining > 0 {
+		return requeueWaitWhileDeletingInstances
+	}
+	return nil
+}
+
+// RDS returns the preferred backup and maintenance windows in their canonical
+// form, with lower case day abbreviations and two digit hours, like
+// mon:03:00-mon:03:30. Controller should treat a desired window that only
+// differs in form, like Monday:3:00-Monday:3:30, as the same.
+func reconcileWindows(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil {
+		return
+	}
+	if a.ko.Spec.PreferredBackupWindow != nil && b.ko.Spec.PreferredBackupWindow != nil {
+		window, err := util.NormalizeBackupWindow(*a.ko.Spec.PreferredBackupWindow)
+		if err == nil && window == *b.ko.Spec.PreferredBackupWindow {
+			a.ko.Spec.PreferredBackupWindow = b.ko.Spec.PreferredBackupWindow
+		}
+	}
+	if a.ko.Spec.PreferredMaintenanceWindow != nil && b.ko.Spec.PreferredMaintenanceWindow != nil {
+		window, err := util.NormalizeMaintenanceWindow(*a.ko.Spec.PreferredMaintenanceWindow)
+		if err == nil && window == *b.ko.Spec.PreferredMaintenanceWindow {
+			a.ko.Spec.PreferredMaintenanceWindow = b.ko.Spec.PreferredMaintenanceWindow
+		}
+	}
+}
+
+// setFinalSnapshot makes the supplied DeleteDBCluster input take a final
+// DB cluster snapshot when the deletion policy of the supplied resource says so.
+func setFinalSnapshot(r *resource, input *svcsdk.DeleteDBClusterInput) error {
+	policy, _ := util.DeletionPolicy(r.ko.Spec.DeletionPolicy)
+	if policy != util.DeletionPolicySnapshot {
+		return nil
+	}
+	identifier, err := util.FinalSnapshotIdentifier(
+		r.ko.Spec.FinalSnapshotIdentifierTemplate, r.ko,
+		*r.ko.Spec.DBClusterIdentifier, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+	skip := false
+	input.SkipFinalSnapshot = &skip
+	input.FinalDBSnapshotIdentifier = &identifier
+	return nil
+}
+
+// recordFinalSnapshot emits an Event on the supplied resource naming the final
+// DB cluster snapshot the supplied DeleteDBCluster input takes.
+func (rm *resourceManager) recordFinalSnapshot(r *resource, input *svcsdk.DeleteDBClusterInput) {
+	if input.FinalDBSnapshotIdentifier != nil {
+		util.RecordFinalSnapshot(rm.eventRecorder, r.ko, *input.FinalDBSnapshotIdentifier)
+	}
+}
+
+// checkDeletionProtection returns nil when the deletion protection of the
+// supplied DB cluster does not block its deletion. When its deletion protection
+// is enabled, it either disables it, if the
+// DisableDeletionProtectionAnnotation annotation is set to "true", or reports
+// the blocked deletion in the DeletionBlocked condition and an Event and
+// returns a requeue error.
+func (rm *resourceManager) checkDeletionProtection(
+	ctx context.Context,
+	r *resource,
+) error {
+	if r.ko.Spec.DeletionProtection == nil || !*r.ko.Spec.DeletionProtection {
+		return nil
+	}
+	if r.ko.Annotations[svcapitypes.DisableDeletionProtectionAnnotation] == "true" {
+		return rm.disableDeletionProtection(ctx, r)
+	}
+	msg := "DB cluster has deletion protection enabled, disable it or set the " +
+		svcapitypes.DisableDeletionProtectionAnnotation +
+		" annotation to \"true\" to delete it"
+	if util.GetDeletionBlocked(r) == nil {
+		util.RecordDeletionBlocked(rm.eventRecorder, r.ko, msg)
+	}
+	util.SetDeletionBlocked(r, msg)
+	return requeueWaitWhileDeletionProtected
+}
+
+// disableDeletionProtection disables the deletion protection of the supplied
+// DB cluster.
+func (rm *resourceManager) disableDeletionProtection(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disableDeletionProtection")
+	defer func(err error) { exit(err) }(err)
+
+	applyImmediately := true
+	deletionProtection := false
+	input := &svcsdk.ModifyDBClusterInput{
+		ApplyImmediately:    &applyImmediately,
+		DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+		DeletionProtection:  &deletionProtection,
+	}
+	_, err = rm.sdkapi.ModifyDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBCluster", err)
+	return err
+}
+
+// safetySnapshotRequired returns true if the desired DB cluster requests a
+// safety snapshot before the modifications of the supplied delta, because they
+// upgrade its major engine version or change its storage type.
+func safetySnapshotRequired(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) bool {
+	if desired.ko.Spec.SafetySnapshot == nil || !*desired.ko.Spec.SafetySnapshot {
+		return false
+	}
+	if delta.DifferentAt("Spec.StorageType") {
+		return true
+	}
+	return delta.DifferentAt("Spec.EngineVersion") && desired.ko.Spec.Engine != nil &&
+		desired.ko.Spec.EngineVersion != nil && latest.ko.Spec.EngineVersion != nil &&
+		util.MajorEngineVersionUpgrade(
+			*desired.ko.Spec.Engine,
+			*latest.ko.Spec.EngineVersion,
+			*desired.ko.Spec.EngineVersion,
+		)
+}
+
+// syncSafetySnapshot returns nil once the safety snapshot of the supplied DB
+// cluster is available, so that the modification can be issued. Until then, it
+// takes the snapshot, reports its progress in the SafetySnapshot condition and
+// returns a requeue error.
+func (rm *resourceManager) syncSafetySnapshot(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncSafetySnapshot")
+	defer func(err error) { exit(err) }(err)
+
+	cond := util.GetSafetySnapshot(r)
+	if r.ko.Status.SafetySnapshotIdentifier == nil || cond == nil ||
+		cond.Reason == nil || *cond.Reason != util.ReasonSafetySnapshotInProgress {
+		if err = rm.createSafetySnapshot(ctx, r); err != nil {
+			return err
+		}
+		return waitForSafetySnapshot(r)
+	}
+	input := &svcsdk.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: r.ko.Status.SafetySnapshotIdentifier,
+	}
+	resp, err := rm.sdkapi.DescribeDBClusterSnapshotsWithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBClusterSnapshots", err)
+	if err != nil {
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "DBClusterSnapshotNotFoundFault" {
+			// The snapshot was deleted before the modification was issued
+			if err = rm.createSafetySnapshot(ctx, r); err != nil {
+				return err
+			}
+			return waitForSafetySnapshot(r)
+		}
+		return err
+	}
+	for _, snapshot := range resp.DBClusterSnapshots {
+		if snapshot.Status != nil && *snapshot.Status == "available" {
+			return nil
+		}
+	}
+	return waitForSafetySnapshot(r)
+}
+
+// waitForSafetySnapshot reports in the Synced condition of the supplied DB
+// cluster that it waits for its safety snapshot and returns a requeue error.
+func waitForSafetySnapshot(r *resource) error {
+	msg := "DB cluster cannot be modified until its safety snapshot is available"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return requeueWaitForSafetySnapshot
+}
+
+// createSafetySnapshot takes a manual DB cluster snapshot of the supplied DB
+// cluster, records its identifier in Status.SafetySnapshotIdentifier and
+// reports it in the SafetySnapshot condition.
+func (rm *resourceManager) createSafetySnapshot(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.createSafetySnapshot")
+	defer func(err error) { exit(err) }(err)
+
+	id := util.SafetySnapshotIdentifier(*r.ko.Spec.DBClusterIdentifier, time.Now())
+	input := &svcsdk.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         r.ko.Spec.DBClusterIdentifier,
+		DBClusterSnapshotIdentifier: &id,
+	}
+	_, err = rm.sdkapi.CreateDBClusterSnapshotWithContext(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateDBClusterSnapshot", err)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.SafetySnapshotIdentifier = &id
+	msg := "DB cluster snapshot " + id + " is being taken before the modification"
+	util.SetSafetySnapshot(r, corev1.ConditionUnknown, util.ReasonSafetySnapshotInProgress, msg)
+	return nil
+}
+
+// setSafetySnapshotCompleted reports in the SafetySnapshot condition of the
+// supplied DB cluster that the modification waiting for its safety snapshot
+// was issued.
+func setSafetySnapshotCompleted(r *resource) {
+	cond := util.GetSafetySnapshot(r)
+	if cond == nil || cond.Reason == nil || *cond.Reason != util.ReasonSafetySnapshotInProgress ||
+		r.ko.Status.SafetySnapshotIdentifier == nil {
+		return
+	}
+	msg := "Modification issued after DB cluster snapshot " + *r.ko.Status.SafetySnapshotIdentifier + " was taken"
+	util.SetSafetySnapshot(r, corev1.ConditionTrue, util.ReasonSafetySnapshotCompleted, msg)
+}
+
+// getPendingMaintenanceActions returns the maintenance actions pending for the
+// DB cluster with the supplied ARN.
+func (rm *resourceManager) getPendingMaintenanceActions(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.PendingMaintenanceAction, error) {
+	resp, err := rm.sdkapi.DescribePendingMaintenanceActionsWithContext(
+		ctx,
+		&svcsdk.DescribePendingMaintenanceActionsInput{
+			ResourceIdentifier: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribePendingMaintenanceActions", err)
+	if err != nil {
+		return nil, err
+	}
+	return util.PendingMaintenanceActions(resp.PendingMaintenanceActions...), nil
+}
+
+// pendingMaintenanceActionRequested returns the name of the pending
+// maintenance action the apply-pending-maintenance-action annotation of the
+// supplied resource requests to apply, or an empty string.
+func pendingMaintenanceActionRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.ApplyPendingMaintenanceActionAnnotation]
+}
+
+// compareApplyPendingMaintenanceAction adds a difference to the supplied delta
+// when the desired resource requests to apply a pending maintenance action, so
+// that the update applies it.
+func compareApplyPendingMaintenanceAction(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if action := pendingMaintenanceActionRequested(a); action != "" {
+		// There is no Spec field for maintenance actions, but only
+		// differences in the Spec trigger an update.
+		delta.Add("Spec.ApplyPendingMaintenanceAction", action, nil)
+	}
+}
+
+// applyPendingMaintenanceAction immediately applies the pending maintenance
+// action requested by the apply-pending-maintenance-action annotation of the
+// desired DB cluster and returns a copy of the resource with the annotation
+// removed. The error returned is nil on success, so that the removal of the
+// annotation is persisted.
+func (rm *resourceManager) applyPendingMaintenanceAction(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.applyPendingMaintenanceAction")
+	defer func(err error) { exit(err) }(err)
+
+	action, err := util.PendingMaintenanceAction(
+		latest.ko.Status.PendingMaintenanceActions,
+		pendingMaintenanceActionRequested(desired),
+	)
+	if err != nil {
+		return nil, err
+	}
+	optInType := util.MaintenanceOptInImmediate
+	input := &svcsdk.ApplyPendingMaintenanceActionInput{
+		ApplyAction:        action.Action,
+		OptInType:          &optInType,
+		ResourceIdentifier: (*string)(latest.ko.Status.ACKResourceMetadata.ARN),
+	}
+	resp, respErr := rm.sdkapi.ApplyPendingMaintenanceActionWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ApplyPendingMaintenanceAction", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.ApplyPendingMaintenanceActionAnnotation)
+	ko.Status.PendingMaintenanceActions = util.PendingMaintenanceActions(
+		resp.ResourcePendingMaintenanceActions,
+	)
+	msg := "Pending maintenance action " + *action.Action + " is being applied"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// validateBacktrackWindow returns a terminal error when the backtrack window
+// of the supplied DB cluster is not valid.
+func validateBacktrackWindow(r *resource) error {
+	return util.ValidateBacktrackWindow(
+		aws.StringValue(r.ko.Spec.Engine),
+		r.ko.Spec.BacktrackWindow,
+	)
+}
+
+// backtrackRequested returns the time the backtrack-to annotation of the
+// supplied resource requests to backtrack the DB cluster to, or an empty
+// string.
+func backtrackRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.BacktrackToAnnotation]
+}
+
+// compareBacktrackTo adds a difference to the supplied delta when the desired
+// resource requests to backtrack the DB cluster, so that the update issues
+// the backtrack.
+func compareBacktrackTo(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if to := backtrackRequested(a); to != "" {
+		// There is no Spec field for backtracks, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.BacktrackTo", to, nil)
+	}
+}
+
+// backtrackDBCluster backtracks the desired DB cluster to the time requested
+// by its backtrack-to annotation and returns a copy of the resource with the
+// annotation removed and the backtrack recorded in Status.LastBacktrack. The
+// error returned is nil on success, so that the removal of the annotation is
+// persisted.
+func (rm *resourceManager) backtrackDBCluster(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.backtrackDBCluster")
+	defer func(err error) { exit(err) }(err)
+
+	var earliest *time.Time
+	if latest.ko.Status.EarliestBacktrackTime != nil {
+		earliest = &latest.ko.Status.EarliestBacktrackTime.Time
+	}
+	to, err := util.BacktrackTime(
+		backtrackRequested(desired),
+		latest.ko.Spec.BacktrackWindow,
+		earliest,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.BacktrackDBClusterInput{
+		DBClusterIdentifier: desired.ko.Spec.DBClusterIdentifier,
+		BacktrackTo:         &to,
+	}
+	resp, respErr := rm.sdkapi.BacktrackDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "BacktrackDBCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.BacktrackToAnnotation)
+	now := metav1.Now()
+	ko.Status.LastBacktrack = &svcapitypes.BacktrackRecord{
+		BacktrackIdentifier: resp.BacktrackIdentifier,
+		BacktrackTo:         &metav1.Time{Time: to},
+		BacktrackedAt:       &now,
+		Status:              resp.Status,
+	}
+	msg := "DB cluster is being backtracked to " + to.UTC().Format(time.RFC3339)
+	util.SetBacktrack(&resource{ko}, corev1.ConditionUnknown, util.ReasonBacktrackInProgress, msg)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// setBacktrackProgress refreshes the status of the last backtrack of the
+// supplied DB cluster while it is not over, and reports its progress in the
+// Backtrack condition. The status is left untouched when the backtrack cannot
+// be described.
+func (rm *resourceManager) setBacktrackProgress(
+	ctx context.Context,
+	r *resource,
+) {
+	backtrack := r.ko.Status.LastBacktrack
+	if backtrack == nil || backtrack.BacktrackIdentifier == nil {
+		return
+	}
+	status := aws.StringValue(backtrack.Status)
+	if status != util.BacktrackStatusCompleted && status != util.BacktrackStatusFailed {
+		resp, err := rm.sdkapi.DescribeDBClusterBacktracksWithContext(
+			ctx,
+			&svcsdk.DescribeDBClusterBacktracksInput{
+				DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+				BacktrackIdentifier: backtrack.BacktrackIdentifier,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeDBClusterBacktracks", err)
+		if err == nil && len(resp.DBClusterBacktracks) > 0 {
+			backtrack.Status = resp.DBClusterBacktracks[0].Status
+		}
+	}
+	to := ""
+	if backtrack.BacktrackTo != nil {
+		to = backtrack.BacktrackTo.UTC().Format(time.RFC3339)
+	}
+	switch aws.StringValue(backtrack.Status) {
+	case util.BacktrackStatusCompleted:
+		msg := "DB cluster was backtracked to " + to
+		util.SetBacktrack(r, corev1.ConditionTrue, util.ReasonBacktrackCompleted, msg)
+	case util.BacktrackStatusFailed:
+		msg := "DB cluster backtrack to " + to + " failed"
+		util.SetBacktrack(r, corev1.ConditionFalse, util.ReasonBacktrackFailed, msg)
+	default:
+		msg := "DB cluster is being backtracked to " + to
+		util.SetBacktrack(r, corev1.ConditionUnknown, util.ReasonBacktrackInProgress, msg)
+	}
+}
+
+// clusterFailoverTimeout is how long after a failover is issued the writer DB
+// instance of the DB cluster is expected to change
+const clusterFailoverTimeout = 15 * time.Minute
+
+// promotionRequested returns true if the promote-read-replica annotation of
+// the supplied resource is set to "true".
+func promotionRequested(r *resource) bool {
+	return r.ko.Annotations[svcapitypes.PromoteReadReplicaAnnotation] == "true"
+}
+
+// comparePromoteReadReplica adds a difference to the supplied delta when the
+// promotion of the desired read replica DB cluster is requested, so that the
+// update issues it.
+func comparePromoteReadReplica(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if promotionRequested(a) {
+		// There is no Spec field for promotions, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.PromoteReadReplica", true, false)
+	}
+}
+
+// promoteReadReplicaDBCluster promotes the supplied read replica DB cluster,
+// like a cross-Region Aurora read replica, to a standalone DB cluster. It
+// returns a copy of the resource with the promote-read-replica annotation and
+// the Spec fields naming the source DB cluster removed, so that the resource
+// is reconciled as a standalone DB cluster from then on. The error returned
+// is nil on success, so that these removals are persisted.
+func (rm *resourceManager) promoteReadReplicaDBCluster(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.promoteReadReplicaDBCluster")
+	defer func(err error) { exit(err) }(err)
+
+	ko := desired.ko.DeepCopy()
+	// The DB cluster may have been promoted already, for instance outside of
+	// the rds-controller, in which case only the resource is updated.
+	if latest.ko.Spec.ReplicationSourceIdentifier != nil {
+		input := &svcsdk.PromoteReadReplicaDBClusterInput{
+			DBClusterIdentifier: desired.ko.Spec.DBClusterIdentifier,
+		}
+		resp, respErr := rm.sdkapi.PromoteReadReplicaDBClusterWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "PromoteReadReplicaDBCluster", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.Status = resp.DBCluster.Status
+	}
+	delete(ko.Annotations, svcapitypes.PromoteReadReplicaAnnotation)
+	ko.Spec.ReplicationSourceIdentifier = nil
+	ko.Spec.SourceRegion = nil
+	ko.Spec.PreSignedURL = nil
+	msg := "DB cluster is being promoted"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// failoverRequested returns the value of the failover-db-cluster annotation
+// of the supplied resource
+func failoverRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.FailoverDBClusterAnnotation]
+}
+
+// compareFailover adds a difference to the supplied delta when the desired
+// resource requests to fail the DB cluster over, so that the update issues
+// the failover.
+func compareFailover(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if target := failoverRequested(a); target != "" {
+		// There is no Spec field for failovers, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.Failover", target, nil)
+	}
+}
+
+// failoverDBCluster fails the desired DB cluster over to the reader DB
+// instance named by its failover-db-cluster annotation and returns a copy of
+// the resource with the annotation removed and the failover recorded in
+// Status.LastFailover. The error returned is nil on success, so that the
+// removal of the annotation is persisted.
+func (rm *resourceManager) failoverDBCluster(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.failoverDBCluster")
+	defer func(err error) { exit(err) }(err)
+
+	members := latest.ko.Status.DBClusterMembers
+	target := failoverRequested(desired)
+	if target != "true" && !slices.ContainsFunc(members, func(m *svcapitypes.DBClusterMember) bool {
+		return aws.StringValue(m.DBInstanceIdentifier) == target
+	}) {
+		// The annotation names a member DBInstance rather than its DB instance
+		identifier, err := util.DBInstanceIdentifier(ctx, desired.ko.Namespace, target)
+		if err != nil {
+			return nil, err
+		}
+		if identifier != "" {
+			target = identifier
+		}
+	}
+	targetIdentifier, err := util.ClusterFailoverTarget(members, target)
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.FailoverDBClusterInput{
+		DBClusterIdentifier: desired.ko.Spec.DBClusterIdentifier,
+	}
+	if targetIdentifier != "" {
+		input.TargetDBInstanceIdentifier = &targetIdentifier
+	}
+	resp, respErr := rm.sdkapi.FailoverDBClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "FailoverDBCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.FailoverDBClusterAnnotation)
+	now := metav1.Now()
+	ko.Status.LastFailover = &svcapitypes.DBClusterFailoverRecord{
+		FromDBInstanceIdentifier:   aws.String(util.ClusterWriter(members)),
+		TargetDBInstanceIdentifier: input.TargetDBInstanceIdentifier,
+		FailedOverAt:               &now,
+	}
+	if resp.DBCluster != nil {
+		ko.Status.Status = resp.DBCluster.Status
+	}
+	msg := "DB cluster is being failed over"
+	if targetIdentifier != "" {
+		msg += " to " + targetIdentifier
+	}
+	util.SetFailover(&resource{ko}, corev1.ConditionUnknown, util.ReasonFailoverInProgress, msg)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// setFailoverProgress reports the progress of the last failover of the
+// supplied DB cluster in the Failover condition, until it is over. The
+// failover is over once the writer DB instance changed, and completed when
+// the writer DB instance is then its target, if any. A failover that did not
+// change the writer DB instance within clusterFailoverTimeout failed.
+func setFailoverProgress(r *resource) {
+	last := r.ko.Status.LastFailover
+	cond := util.GetFailover(r)
+	if last == nil || cond == nil || cond.Reason == nil || *cond.Reason != util.ReasonFailoverInProgress {
+		return
+	}
+	from := aws.StringValue(last.FromDBInstanceIdentifier)
+	target := aws.StringValue(last.TargetDBInstanceIdentifier)
+	writer := util.ClusterWriter(r.ko.Status.DBClusterMembers)
+	switch {
+	case clusterAvailable(r) && writer != "" && writer != from && (target == "" || writer == target):
+		msg := "DB cluster was failed over to " + writer
+		util.SetFailover(r, corev1.ConditionTrue, util.ReasonFailoverCompleted, msg)
+	case clusterAvailable(r) && writer != "" && writer != from:
+		msg := "DB cluster was failed over to " + writer + " rather than " + target
+		util.SetFailover(r, corev1.ConditionFalse, util.ReasonFailoverFailed, msg)
+	case clusterAvailable(r) && last.FailedOverAt != nil &&
+		time.Since(last.FailedOverAt.Time) > clusterFailoverTimeout:
+		msg := "DB cluster was not failed over, " + from + " is still the writer DB instance"
+		util.SetFailover(r, corev1.ConditionFalse, util.ReasonFailoverFailed, msg)
+	}
+}
+
+// setGlobalClusterMembership records in the status of the supplied DB cluster
+// the global cluster it is a member of, among the global cluster it was last
+// recorded a member of and the one named by Spec.GlobalClusterIdentifier. The
+// membership is left untouched when a global cluster cannot be described.
+func (rm *resourceManager) setGlobalClusterMembership(
+	ctx context.Context,
+	r *resource,
+) {
+	if r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil {
+		return
+	}
+	arn := string(*r.ko.Status.ACKResourceMetadata.ARN)
+	var candidates []string
+	for _, id := range []*string{r.ko.Status.GlobalClusterMembership, r.ko.Spec.GlobalClusterIdentifier} {
+		if id != nil && !slices.Contains(candidates, *id) {
+			candidates = append(candidates, *id)
+		}
+	}
+	for _, id := range candidates {
+		resp, err := rm.sdkapi.DescribeGlobalClustersWithContext(
+			ctx,
+			&svcsdk.DescribeGlobalClustersInput{
+				GlobalClusterIdentifier: aws.String(id),
+			},
+		)
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeGlobalClusters", err)
+		if err != nil {
+			if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "GlobalClusterNotFoundFault" {
+				continue
+			}
+			return
+		}
+		for _, globalCluster := range resp.GlobalClusters {
+			for _, member := range globalCluster.GlobalClusterMembers {
+				if aws.StringValue(member.DBClusterArn) == arn {
+					r.ko.Status.GlobalClusterMembership = aws.String(id)
+					return
+				}
+			}
+		}
+	}
+	r.ko.Status.GlobalClusterMembership = nil
+}
+
+// compareGlobalClusterMembership adds a difference to the supplied delta when
+// the global cluster the desired resource names differs from the one the
+// latest DB cluster is a member of, so that the update detaches the DB
+// cluster from it.
+func compareGlobalClusterMembership(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	desired := aws.StringValue(a.ko.Spec.GlobalClusterIdentifier)
+	member := aws.StringValue(b.ko.Status.GlobalClusterMembership)
+	if desired != member {
+		delta.Add("Spec.GlobalClusterIdentifier", a.ko.Spec.GlobalClusterIdentifier, b.ko.Status.GlobalClusterMembership)
+	}
+}
+
+// syncGlobalClusterMembership detaches the latest DB cluster from the global
+// cluster it is a member of when the desired resource no longer names it. A
+// DB cluster only joins a global cluster when it is created, or when it is
+// the source DB cluster of a new global cluster, so the error returned is
+// terminal when the desired resource names a global cluster the DB cluster is
+// not a member of.
+func (rm *resourceManager) syncGlobalClusterMembership(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncGlobalClusterMembership")
+	defer func(err error) { exit(err) }(err)
+
+	member := latest.ko.Status.GlobalClusterMembership
+	if member == nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"DB cluster can only join global cluster %s when it is created",
+			aws.StringValue(desired.ko.Spec.GlobalClusterIdentifier),
+		))
+	}
+	input := &svcsdk.RemoveFromGlobalClusterInput{
+		DbClusterIdentifier:     (*string)(latest.ko.Status.ACKResourceMetadata.ARN),
+		GlobalClusterIdentifier: member,
+	}
+	_, respErr := rm.sdkapi.RemoveFromGlobalClusterWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "RemoveFromGlobalCluster", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	ko.Status.GlobalClusterMembership = nil
+	msg := "DB cluster is being detached from global cluster " + *member
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// RDS chooses the preferred minor engine version when only the major engine
+// version is provided, and upgrades minor engine versions on its own when
+// AutoMinorVersionUpgrade is enabled. The controller should treat these
+// engine versions as the desired one instead of attempting to revert them.
+func reconcileEngineVersion(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil || a.ko.Spec.Engine == nil ||
+		a.ko.Spec.EngineVersion == nil || b.ko.Spec.EngineVersion == nil {
+		return
+	}
+	autoMinorVersionUpgrade := a.ko.Spec.AutoMinorVersionUpgrade == nil || *a.ko.Spec.AutoMinorVersionUpgrade
+	if util.EngineVersionMatches(*a.ko.Spec.Engine, *a.ko.Spec.EngineVersion, *b.ko.Spec.EngineVersion, autoMinorVersionUpgrade) {
+		a.ko.Spec.EngineVersion = b.ko.Spec.EngineVersion
+	}
+}
+
+// resolveEngineVersion returns the supplied engine version of the supplied
+// engine when it exists, or else the latest available engine version it is a
+// prefix of, like 15.4 for 15, so that the DB cluster is created with the latest minor
+// engine version rather than the default one.
+func (rm *resourceManager) resolveEngineVersion(
+	ctx context.Context,
+	engine *string,
+	engineVersion *string,
+) (*string, error) {
+	if engine == nil || engineVersion == nil {
+		return engineVersion, nil
+	}
+	var versions []string
+	err := rm.sdkapi.DescribeDBEngineVersionsPagesWithContext(
+		ctx,
+		&svcsdk.DescribeDBEngineVersionsInput{
+			Engine: engine,
+		},
+		func(page *svcsdk.DescribeDBEngineVersionsOutput, _ bool) bool {
+			for _, v := range page.DBEngineVersions {
+				if v.EngineVersion != nil && (v.Status == nil || *v.Status == "available") {
+					versions = append(versions, *v.EngineVersion)
+				}
+			}
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBEngineVersions", err)
+	if err != nil {
+		return nil, err
+	}
+	if latest := util.LatestEngineVersion(versions, *engineVersion); latest != "" {
+		return &latest, nil
+	}
+	return engineVersion, nil
+}
+
+// validateNetworkType returns an ACK terminal error when the network type of
+// the desired DB cluster is not valid or is not supported by its DB subnet
+// group. The network types supported by the DB subnet group are recorded in
+// Status.SupportedNetworkTypes of the desired DB cluster, and read from the
+// status of the latest DB cluster, which is nil on creation, while the DB
+// subnet group does not change.
+func (rm *resourceManager) validateNetworkType(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateNetworkType")
+	defer func(err error) { exit(err) }(err)
+
+	subnetGroupName := ""
+	if desired.ko.Spec.DBSubnetGroupName != nil {
+		subnetGroupName = *desired.ko.Spec.DBSubnetGroupName
+	}
+	var supported []*string
+	if latest != nil && latest.ko.Status.DBSubnetGroup != nil &&
+		(subnetGroupName == "" || subnetGroupName == *latest.ko.Status.DBSubnetGroup) {
+		subnetGroupName = *latest.ko.Status.DBSubnetGroup
+		supported = latest.ko.Status.SupportedNetworkTypes
+	}
+	if supported == nil && subnetGroupName != "" {
+		if supported, err = rm.getSupportedNetworkTypes(ctx, subnetGroupName); err != nil {
+			return err
+		}
+	}
+	desired.ko.Status.SupportedNetworkTypes = supported
+	if desired.ko.Spec.NetworkType == nil {
+		return nil
+	}
+	return util.ValidateNetworkType(*desired.ko.Spec.NetworkType, subnetGroupName, supported)
+}
+
+// RDS defaults the network type to IPV4 when it is not specified. Controller
+// should not treat a missing desired network type as different.
+func reconcileNetworkType(
+	a *resource,
+	b *resource,
+) {
+	if a != nil && b != nil && a.ko.Spec.NetworkType == nil &&
+		b.ko.Spec.NetworkType != nil && *b.ko.Spec.NetworkType == util.NetworkTypeIPv4 {
+		a.ko.Spec.NetworkType = b.ko.Spec.NetworkType
+	}
+}
+
+// RDS returns the whole scaling configuration of an Aurora Serverless v1 DB
+// cluster, defaulting the settings that are not specified. Controller should
+// not treat the missing desired settings as different.
+func reconcileScalingConfiguration(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil || b.ko.Spec.ScalingConfiguration == nil {
+		return
+	}
+	if a.ko.Spec.ScalingConfiguration == nil {
+		a.ko.Spec.ScalingConfiguration = b.ko.Spec.ScalingConfiguration.DeepCopy()
+		return
+	}
+	desired := a.ko.Spec.ScalingConfiguration
+	latest := b.ko.Spec.ScalingConfiguration
+	if desired.AutoPause == nil {
+		desired.AutoPause = latest.AutoPause
+	}
+	if desired.MaxCapacity == nil {
+		desired.MaxCapacity = latest.MaxCapacity
+	}
+	if desired.MinCapacity == nil {
+		desired.MinCapacity = latest.MinCapacity
+	}
+	if desired.SecondsBeforeTimeout == nil {
+		desired.SecondsBeforeTimeout = latest.SecondsBeforeTimeout
+	}
+	if desired.SecondsUntilAutoPause == nil {
+		desired.SecondsUntilAutoPause = latest.SecondsUntilAutoPause
+	}
+	if desired.TimeoutAction == nil {
+		desired.TimeoutAction = latest.TimeoutAction
+	}
+}
+
+// getSupportedNetworkTypes returns the network types supported by the DB
+// subnet group with the supplied name.
+func (rm *resourceManager) getSupportedNetworkTypes(
+	ctx context.Context,
+	subnetGroupName string,
+) ([]*string, error) {
+	resp, err := rm.sdkapi.DescribeDBSubnetGroupsWithContext(
+		ctx,
+		&svcsdk.DescribeDBSubnetGroupsInput{
+			DBSubnetGroupName: &subnetGroupName,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeDBSubnetGroups", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.DBSubnetGroups) == 0 {
+		return nil, nil
+	}
+	return resp.DBSubnetGroups[0].SupportedNetworkTypes, nil
+}
+
+// validateActivityStream returns an ACK terminal error when the activity
+// stream of the supplied DB cluster is not supported.
+func validateActivityStream(r *resource) error {
+	return util.ValidateActivityStream(aws.StringValue(r.ko.Spec.Engine), true, r.ko.Spec.ActivityStream)
+}
+
+// activityStreamChanging returns true if the activity stream of the supplied
+// DB cluster is being started or stopped.
+func activityStreamChanging(r *resource) bool {
+	return util.ActivityStreamChanging(r.ko.Status.ActivityStreamStatus)
+}
+
+// setActivityStream sets the activity stream of the supplied latest DB cluster
+// from its status when the supplied desired one sets Spec.ActivityStream.
+func setActivityStream(desired *resource, latest *resource) {
+	if desired.ko.Spec.ActivityStream == nil {
+		return
+	}
+	latest.ko.Spec.ActivityStream = util.LatestActivityStream(
+		desired.ko.Spec.ActivityStream,
+		latest.ko.Status.ActivityStreamStatus,
+		latest.ko.Status.ActivityStreamMode,
+	)
+}
+
+// compareActivityStream adds a difference to the supplied delta when the
+// desired resource starts its activity stream while the latest one is
+// stopped, or the other way around, or starts it in another mode.
+func compareActivityStream(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.ActivityStreamDiffers(a.ko.Spec.ActivityStream, b.ko.Spec.ActivityStream) {
+		delta.Add("Spec.ActivityStream", a.ko.Spec.ActivityStream, b.ko.Spec.ActivityStream)
+	}
+}
+
+// syncActivityStream starts or stops the activity stream of the desired
+// DB cluster as its Spec says. An activity stream started in another mode is
+// stopped, to be started again in the desired mode once stopped. It returns a
+// copy of the resource reporting the change in progress.
+func (rm *resourceManager) syncActivityStream(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncActivityStream")
+	defer func(err error) { exit(err) }(err)
+
+	if activityStreamChanging(latest) {
+		msg := "DB cluster activity stream is being started or stopped"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileChangingActivityStream
+	}
+	resourceARN := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	stream := desired.ko.Spec.ActivityStream
+	ko := desired.ko.DeepCopy()
+	var msg string
+	if *stream.Enabled && !util.ActivityStreamStarted(latest.ko.Status.ActivityStreamStatus) {
+		input := &svcsdk.StartActivityStreamInput{
+			ResourceArn:                     resourceARN,
+			Mode:                            stream.Mode,
+			KmsKeyId:                        stream.KMSKeyID,
+			EngineNativeAuditFieldsIncluded: stream.EngineNativeAuditFieldsIncluded,
+			ApplyImmediately:                aws.Bool(true),
+		}
+		resp, respErr := rm.sdkapi.StartActivityStreamWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StartActivityStream", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.ActivityStreamStatus = resp.Status
+		ko.Status.ActivityStreamMode = resp.Mode
+		ko.Status.ActivityStreamKMSKeyID = resp.KmsKeyId
+		ko.Status.ActivityStreamKinesisStreamName = resp.KinesisStreamName
+		msg = "DB cluster activity stream is being started"
+	} else {
+		input := &svcsdk.StopActivityStreamInput{
+			ResourceArn:      resourceARN,
+			ApplyImmediately: aws.Bool(true),
+		}
+		resp, respErr := rm.sdkapi.StopActivityStreamWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StopActivityStream", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.ActivityStreamStatus = resp.Status
+		msg = "DB cluster activity stream is being stopped"
+	}
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// populateAdoptionFields takes the dbClusterIdentifier of the supplied DB
+// cluster from its adoption fields when it sets the adopt-or-create adoption
+// policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"dbClusterIdentifier": &r.ko.Spec.DBClusterIdentifier,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
+
+// SetEventRecorder sets the recorder the resource managers produced by the
+// factory emit Kubernetes Events with. It implements the
+// svcresource.EventRecorderSetter interface.
+func (f *resourceManagerFactory) SetEventRecorder(recorder record.EventRecorder) {
+	f.Lock()
+	defer f.Unlock()
+	f.eventRecorder = recorder
+}
@@ -37,6 +37,7 @@ import (
 	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 )
@@ -79,6 +80,9 @@ type resourceManager struct {
 	// sdk is a pointer to the AWS service API interface exposed by the
 	// aws-sdk-go/services/{alias}/{alias}iface package.
 	sdkapi svcsdkapi.RDSAPI
+	// eventRecorder emits the Kubernetes Events about the changes the
+	// resource manager makes to AWS resources, when set
+	eventRecorder record.EventRecorder
 }
 
 // concreteResource returns a pointer to a resource from the supplied
@@ -25,6 +25,7 @@ import (
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
 
 	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
 )
@@ -35,6 +36,9 @@ type resourceManagerFactory struct {
 	sync.RWMutex
 	// rmCache contains resource managers for a particular AWS account ID
 	rmCache map[string]*resourceManager
+	// eventRecorder is passed to the resource managers to emit Kubernetes
+	// Events with
+	eventRecorder record.EventRecorder
 }
 
 // ResourcePrototype returns an AWSResource that resource managers produced by
@@ -70,6 +74,7 @@ func (f *resourceManagerFactory) ManagerFor(
 	if err != nil {
 		return nil, err
 	}
+	rm.eventRecorder = f.eventRecorder
 	f.rmCache[rmId] = rm
 	return rm, nil
 }
@@ -56,6 +56,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.DBSubnetGroupName = nil
 	}
 
+	if ko.Spec.GlobalClusterRef != nil {
+		ko.Spec.GlobalClusterIdentifier = nil
+	}
+
 	if ko.Spec.KMSKeyRef != nil {
 		ko.Spec.KMSKeyID = nil
 	}
@@ -100,6 +104,12 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForGlobalClusterIdentifier(ctx, apiReader, namespace, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForKMSKeyID(ctx, apiReader, namespace, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -133,6 +143,10 @@ func validateReferenceFields(ko *svcapitypes.DBCluster) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBSubnetGroupName", "DBSubnetGroupRef")
 	}
 
+	if ko.Spec.GlobalClusterRef != nil && ko.Spec.GlobalClusterIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("GlobalClusterIdentifier", "GlobalClusterRef")
+	}
+
 	if ko.Spec.KMSKeyRef != nil && ko.Spec.KMSKeyID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("KMSKeyID", "KMSKeyRef")
 	}
@@ -301,6 +315,83 @@ func getReferencedResourceState_DBSubnetGroup(
 	return nil
 }
 
+// resolveReferenceForGlobalClusterIdentifier reads the resource referenced
+// from GlobalClusterRef field and sets the GlobalClusterIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForGlobalClusterIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	namespace string,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.GlobalClusterRef != nil && ko.Spec.GlobalClusterRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.GlobalClusterRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: GlobalClusterRef")
+		}
+		obj := &svcapitypes.GlobalCluster{}
+		if err := getReferencedResourceState_GlobalCluster(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.GlobalClusterIdentifier = (*string)(obj.Spec.GlobalClusterIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_GlobalCluster looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_GlobalCluster(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.GlobalCluster,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceSynced, refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"GlobalCluster",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"GlobalCluster",
+			namespace, name)
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"GlobalCluster",
+			namespace, name)
+	}
+	if obj.Spec.GlobalClusterIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"GlobalCluster",
+			namespace, name,
+			"Spec.GlobalClusterIdentifier")
+	}
+	return nil
+}
+
 // resolveReferenceForKMSKeyID reads the resource referenced
 // from KMSKeyRef field and sets the KMSKeyID
 // from referenced resource. Returns a boolean indicating whether a reference
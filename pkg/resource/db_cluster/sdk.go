@@ -61,6 +61,12 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -298,6 +304,18 @@ func (rm *resourceManager) sdkFind(
 			f30 := []*svcapitypes.DomainMembership{}
 			for _, f30iter := range elem.DomainMemberships {
 				f30elem := &svcapitypes.DomainMembership{}
+				if f30iter.AuthSecretArn != nil {
+					f30elem.AuthSecretARN = f30iter.AuthSecretArn
+				}
+				if f30iter.DnsIps != nil {
+					f30elemf1 := []*string{}
+					for _, f30elemf1iter := range f30iter.DnsIps {
+						var f30elemf1elem string
+						f30elemf1elem = *f30elemf1iter
+						f30elemf1 = append(f30elemf1, &f30elemf1elem)
+					}
+					f30elem.DNSIPs = f30elemf1
+				}
 				if f30iter.Domain != nil {
 					f30elem.Domain = f30iter.Domain
 				}
@@ -307,6 +325,9 @@ func (rm *resourceManager) sdkFind(
 				if f30iter.IAMRoleName != nil {
 					f30elem.IAMRoleName = f30iter.IAMRoleName
 				}
+				if f30iter.OU != nil {
+					f30elem.OU = f30iter.OU
+				}
 				if f30iter.Status != nil {
 					f30elem.Status = f30iter.Status
 				}
@@ -382,6 +403,11 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Status.IAMDatabaseAuthenticationEnabled = nil
 		}
+		if elem.IOOptimizedNextAllowedModificationTime != nil {
+			ko.Status.IOOptimizedNextAllowedModificationTime = &metav1.Time{*elem.IOOptimizedNextAllowedModificationTime}
+		} else {
+			ko.Status.IOOptimizedNextAllowedModificationTime = nil
+		}
 		if elem.Iops != nil {
 			ko.Spec.IOPS = elem.Iops
 		} else {
@@ -397,6 +423,11 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Status.LatestRestorableTime = nil
 		}
+		if elem.LocalWriteForwardingStatus != nil {
+			ko.Status.LocalWriteForwardingStatus = elem.LocalWriteForwardingStatus
+		} else {
+			ko.Status.LocalWriteForwardingStatus = nil
+		}
 		if elem.MasterUserSecret != nil {
 			f46 := &svcapitypes.MasterUserSecret{}
 			if elem.MasterUserSecret.KmsKeyId != nil {
@@ -621,11 +652,57 @@ func (rm *resourceManager) sdkFind(
 			return nil, err
 		}
 		ko.Spec.Tags = tags
+		pendingActions, err := rm.getPendingMaintenanceActions(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.PendingMaintenanceActions = pendingActions
+	}
+	rm.setMasterUserPasswordHash(ctx, &resource{ko})
+	rm.setServerlessV2Capacity(ctx, &resource{ko})
+	rm.setBacktrackProgress(ctx, &resource{ko})
+	setFailoverProgress(&resource{ko})
+	rm.setGlobalClusterMembership(ctx, &resource{ko})
+	if err = rm.setMasterUserSecretRotation(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	setActivityStream(r, &resource{ko})
+	if err = rm.copyMasterUserSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeConnectionSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeServiceBindingSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncCABundleConfigMap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncEndpointServices(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.setClusterInstancesSynced(ctx, &resource{ko}); err != nil {
+		return nil, err
 	}
-	if !clusterAvailable(&resource{ko}) {
+	if !clusterAvailable(&resource{ko}) && !clusterStoppedAsScheduled(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if writeForwardingChanging(&resource{ko}) {
+		msg := "DB cluster write forwarding is being turned on or off"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if activityStreamChanging(&resource{ko}) {
+		msg := "DB cluster activity stream is being started or stopped"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if multiAZClusterModifying(&resource{ko}) {
+		msg := "Multi-AZ DB cluster storage modification is pending"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if clusterPaused(&resource{ko}) {
+		// A paused Aurora Serverless v1 DB cluster is resumed on the next
+		// connection to it, so it is synced
+		msg := "Aurora Serverless v1 DB cluster is paused"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, &msg, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
@@ -653,6 +730,23 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.EnableIAMDatabaseAuthentication = ko.Status.IAMDatabaseAuthenticationEnabled
 	}
 
+	if r.ko.Spec.EnableGlobalWriteForwarding != nil {
+		// If the desired resource turns global write forwarding explicitly on
+		// or off then update the spec of the latest resource with the
+		// requested state.
+		ko.Spec.EnableGlobalWriteForwarding = aws.Bool(aws.BoolValue(ko.Status.GlobalWriteForwardingRequested))
+	}
+	if r.ko.Spec.EnableLocalWriteForwarding != nil {
+		// If the desired resource turns local write forwarding explicitly on
+		// or off then update the spec of the latest resource with its state.
+		ko.Spec.EnableLocalWriteForwarding = aws.Bool(localWriteForwardingEnabled(&resource{ko}))
+	}
+	if r.ko.Spec.EnableHTTPEndpoint != nil {
+		// If the desired resource turns the Data API explicitly on or off then
+		// update the spec of the latest resource with its effective state.
+		ko.Spec.EnableHTTPEndpoint = aws.Bool(aws.BoolValue(ko.Status.HTTPEndpointEnabled))
+	}
+
 	ko.Spec.EnableCloudwatchLogsExports = ko.Status.EnabledCloudwatchLogsExports
 
 	return &resource{ko}, nil
@@ -693,16 +787,82 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateDeletion(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWindows(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecret(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecretRotation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateActivityStream(desired); err != nil {
+		return nil, err
+	}
+	if err = validateServerlessV2Scaling(desired); err != nil {
+		return nil, err
+	}
+	if err = validateServerlessV1Scaling(desired); err != nil {
+		return nil, err
+	}
+	if err = validateBacktrackWindow(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMultiAZCluster(desired); err != nil {
+		return nil, err
+	}
+	if err = validateStorageType(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWriteForwarding(desired); err != nil {
+		return nil, err
+	}
+	if err = validateHTTPEndpoint(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateNetworkType(ctx, desired, nil); err != nil {
+		return nil, err
+	}
+	if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+		return nil, err
+	}
 	// if request has SnapshotIdentifier spec, create request will call RestoreDBClusterFromSnapshotWithContext
 	// instead of normal create api
 	if desired.ko.Spec.SnapshotIdentifier != nil {
 		return rm.restoreDbClusterFromSnapshot(ctx, desired)
 	}
+	// if request has RestoreFrom spec, create request will call RestoreDBClusterToPointInTimeWithContext
+	// instead of normal create api
+	if desired.ko.Spec.RestoreFrom != nil {
+		return rm.restoreDBClusterFromCluster(ctx, desired)
+	}
+	// if request has RestoreToPointInTime spec, create request will call RestoreDBClusterToPointInTimeWithContext
+	// instead of normal create api
+	if desired.ko.Spec.RestoreToPointInTime != nil {
+		return rm.restoreDBClusterToPointInTime(ctx, desired)
+	}
 
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
+	// An engine version made of only its first components, like 15, is
+	// resolved to the latest minor engine version
+	if input.EngineVersion, err = rm.resolveEngineVersion(ctx, input.Engine, input.EngineVersion); err != nil {
+		return nil, err
+	}
+	// The master user password of the Secrets Manager secret set in
+	// Spec.MasterUserPasswordSecretARN is read when creating the DB cluster
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
 
 	var resp *svcsdk.CreateDBClusterOutput
 	_ = resp
@@ -925,6 +1085,18 @@ func (rm *resourceManager) sdkCreate(
 		f30 := []*svcapitypes.DomainMembership{}
 		for _, f30iter := range resp.DBCluster.DomainMemberships {
 			f30elem := &svcapitypes.DomainMembership{}
+			if f30iter.AuthSecretArn != nil {
+				f30elem.AuthSecretARN = f30iter.AuthSecretArn
+			}
+			if f30iter.DnsIps != nil {
+				f30elemf1 := []*string{}
+				for _, f30elemf1iter := range f30iter.DnsIps {
+					var f30elemf1elem string
+					f30elemf1elem = *f30elemf1iter
+					f30elemf1 = append(f30elemf1, &f30elemf1elem)
+				}
+				f30elem.DNSIPs = f30elemf1
+			}
 			if f30iter.Domain != nil {
 				f30elem.Domain = f30iter.Domain
 			}
@@ -934,6 +1106,9 @@ func (rm *resourceManager) sdkCreate(
 			if f30iter.IAMRoleName != nil {
 				f30elem.IAMRoleName = f30iter.IAMRoleName
 			}
+			if f30iter.OU != nil {
+				f30elem.OU = f30iter.OU
+			}
 			if f30iter.Status != nil {
 				f30elem.Status = f30iter.Status
 			}
@@ -1009,6 +1184,11 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Status.IAMDatabaseAuthenticationEnabled = nil
 	}
+	if resp.DBCluster.IOOptimizedNextAllowedModificationTime != nil {
+		ko.Status.IOOptimizedNextAllowedModificationTime = &metav1.Time{*resp.DBCluster.IOOptimizedNextAllowedModificationTime}
+	} else {
+		ko.Status.IOOptimizedNextAllowedModificationTime = nil
+	}
 	if resp.DBCluster.Iops != nil {
 		ko.Spec.IOPS = resp.DBCluster.Iops
 	} else {
@@ -1024,6 +1204,11 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Status.LatestRestorableTime = nil
 	}
+	if resp.DBCluster.LocalWriteForwardingStatus != nil {
+		ko.Status.LocalWriteForwardingStatus = resp.DBCluster.LocalWriteForwardingStatus
+	} else {
+		ko.Status.LocalWriteForwardingStatus = nil
+	}
 	if resp.DBCluster.MasterUserSecret != nil {
 		f46 := &svcapitypes.MasterUserSecret{}
 		if resp.DBCluster.MasterUserSecret.KmsKeyId != nil {
@@ -1359,6 +1544,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil {
 		res.SetEnableIAMDatabaseAuthentication(*r.ko.Spec.EnableIAMDatabaseAuthentication)
 	}
+	if r.ko.Spec.EnableLocalWriteForwarding != nil {
+		res.SetEnableLocalWriteForwarding(*r.ko.Spec.EnableLocalWriteForwarding)
+	}
 	if r.ko.Spec.EnablePerformanceInsights != nil {
 		res.SetEnablePerformanceInsights(*r.ko.Spec.EnablePerformanceInsights)
 	}
@@ -1526,15 +1714,28 @@ func (rm *resourceManager) sdkDelete(
 	if clusterDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.checkDeletionProtection(ctx, r); err != nil {
+		return r, err
+	}
+	if err = rm.deleteClusterInstances(ctx, r); err != nil {
+		return r, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	if err = setFinalSnapshot(r, input); err != nil {
+		return nil, err
+	}
+
 	var resp *svcsdk.DeleteDBClusterOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteDBClusterWithContext(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteDBCluster", err)
+	if err == nil {
+		rm.recordFinalSnapshot(r, input)
+	}
 	return nil, err
 }
 
@@ -2041,6 +2242,18 @@ func (rm *resourceManager) setResourceFromRestoreDBClusterFromSnapshotOutput(
 		f30 := []*svcapitypes.DomainMembership{}
 		for _, f30iter := range resp.DBCluster.DomainMemberships {
 			f30elem := &svcapitypes.DomainMembership{}
+			if f30iter.AuthSecretArn != nil {
+				f30elem.AuthSecretARN = f30iter.AuthSecretArn
+			}
+			if f30iter.DnsIps != nil {
+				f30elemf1 := []*string{}
+				for _, f30elemf1iter := range f30iter.DnsIps {
+					var f30elemf1elem string
+					f30elemf1elem = *f30elemf1iter
+					f30elemf1 = append(f30elemf1, &f30elemf1elem)
+				}
+				f30elem.DNSIPs = f30elemf1
+			}
 			if f30iter.Domain != nil {
 				f30elem.Domain = f30iter.Domain
 			}
@@ -2050,6 +2263,9 @@ func (rm *resourceManager) setResourceFromRestoreDBClusterFromSnapshotOutput(
 			if f30iter.IAMRoleName != nil {
 				f30elem.IAMRoleName = f30iter.IAMRoleName
 			}
+			if f30iter.OU != nil {
+				f30elem.OU = f30iter.OU
+			}
 			if f30iter.Status != nil {
 				f30elem.Status = f30iter.Status
 			}
@@ -2125,6 +2341,11 @@ func (rm *resourceManager) setResourceFromRestoreDBClusterFromSnapshotOutput(
 	} else {
 		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
 	}
+	if resp.DBCluster.IOOptimizedNextAllowedModificationTime != nil {
+		r.ko.Status.IOOptimizedNextAllowedModificationTime = &metav1.Time{*resp.DBCluster.IOOptimizedNextAllowedModificationTime}
+	} else {
+		r.ko.Status.IOOptimizedNextAllowedModificationTime = nil
+	}
 	if resp.DBCluster.Iops != nil {
 		r.ko.Spec.IOPS = resp.DBCluster.Iops
 	} else {
@@ -2140,6 +2361,717 @@ func (rm *resourceManager) setResourceFromRestoreDBClusterFromSnapshotOutput(
 	} else {
 		r.ko.Status.LatestRestorableTime = nil
 	}
+	if resp.DBCluster.LocalWriteForwardingStatus != nil {
+		r.ko.Status.LocalWriteForwardingStatus = resp.DBCluster.LocalWriteForwardingStatus
+	} else {
+		r.ko.Status.LocalWriteForwardingStatus = nil
+	}
+	if resp.DBCluster.MasterUserSecret != nil {
+		f46 := &svcapitypes.MasterUserSecret{}
+		if resp.DBCluster.MasterUserSecret.KmsKeyId != nil {
+			f46.KMSKeyID = resp.DBCluster.MasterUserSecret.KmsKeyId
+		}
+		if resp.DBCluster.MasterUserSecret.SecretArn != nil {
+			f46.SecretARN = resp.DBCluster.MasterUserSecret.SecretArn
+		}
+		if resp.DBCluster.MasterUserSecret.SecretStatus != nil {
+			f46.SecretStatus = resp.DBCluster.MasterUserSecret.SecretStatus
+		}
+		r.ko.Status.MasterUserSecret = f46
+	} else {
+		r.ko.Status.MasterUserSecret = nil
+	}
+	if resp.DBCluster.MasterUsername != nil {
+		r.ko.Spec.MasterUsername = resp.DBCluster.MasterUsername
+	} else {
+		r.ko.Spec.MasterUsername = nil
+	}
+	if resp.DBCluster.MonitoringInterval != nil {
+		r.ko.Spec.MonitoringInterval = resp.DBCluster.MonitoringInterval
+	} else {
+		r.ko.Spec.MonitoringInterval = nil
+	}
+	if resp.DBCluster.MonitoringRoleArn != nil {
+		r.ko.Spec.MonitoringRoleARN = resp.DBCluster.MonitoringRoleArn
+	} else {
+		r.ko.Spec.MonitoringRoleARN = nil
+	}
+	if resp.DBCluster.MultiAZ != nil {
+		r.ko.Status.MultiAZ = resp.DBCluster.MultiAZ
+	} else {
+		r.ko.Status.MultiAZ = nil
+	}
+	if resp.DBCluster.NetworkType != nil {
+		r.ko.Spec.NetworkType = resp.DBCluster.NetworkType
+	} else {
+		r.ko.Spec.NetworkType = nil
+	}
+	if resp.DBCluster.PendingModifiedValues != nil {
+		f52 := &svcapitypes.ClusterPendingModifiedValues{}
+		if resp.DBCluster.PendingModifiedValues.AllocatedStorage != nil {
+			f52.AllocatedStorage = resp.DBCluster.PendingModifiedValues.AllocatedStorage
+		}
+		if resp.DBCluster.PendingModifiedValues.BackupRetentionPeriod != nil {
+			f52.BackupRetentionPeriod = resp.DBCluster.PendingModifiedValues.BackupRetentionPeriod
+		}
+		if resp.DBCluster.PendingModifiedValues.DBClusterIdentifier != nil {
+			f52.DBClusterIdentifier = resp.DBCluster.PendingModifiedValues.DBClusterIdentifier
+		}
+		if resp.DBCluster.PendingModifiedValues.EngineVersion != nil {
+			f52.EngineVersion = resp.DBCluster.PendingModifiedValues.EngineVersion
+		}
+		if resp.DBCluster.PendingModifiedValues.IAMDatabaseAuthenticationEnabled != nil {
+			f52.IAMDatabaseAuthenticationEnabled = resp.DBCluster.PendingModifiedValues.IAMDatabaseAuthenticationEnabled
+		}
+		if resp.DBCluster.PendingModifiedValues.Iops != nil {
+			f52.IOPS = resp.DBCluster.PendingModifiedValues.Iops
+		}
+		if resp.DBCluster.PendingModifiedValues.MasterUserPassword != nil {
+			f52.MasterUserPassword = resp.DBCluster.PendingModifiedValues.MasterUserPassword
+		}
+		if resp.DBCluster.PendingModifiedValues.PendingCloudwatchLogsExports != nil {
+			f52f7 := &svcapitypes.PendingCloudwatchLogsExports{}
+			if resp.DBCluster.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable != nil {
+				f52f7f0 := []*string{}
+				for _, f52f7f0iter := range resp.DBCluster.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable {
+					var f52f7f0elem string
+					f52f7f0elem = *f52f7f0iter
+					f52f7f0 = append(f52f7f0, &f52f7f0elem)
+				}
+				f52f7.LogTypesToDisable = f52f7f0
+			}
+			if resp.DBCluster.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable != nil {
+				f52f7f1 := []*string{}
+				for _, f52f7f1iter := range resp.DBCluster.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable {
+					var f52f7f1elem string
+					f52f7f1elem = *f52f7f1iter
+					f52f7f1 = append(f52f7f1, &f52f7f1elem)
+				}
+				f52f7.LogTypesToEnable = f52f7f1
+			}
+			f52.PendingCloudwatchLogsExports = f52f7
+		}
+		r.ko.Status.PendingModifiedValues = f52
+	} else {
+		r.ko.Status.PendingModifiedValues = nil
+	}
+	if resp.DBCluster.PercentProgress != nil {
+		r.ko.Status.PercentProgress = resp.DBCluster.PercentProgress
+	} else {
+		r.ko.Status.PercentProgress = nil
+	}
+	if resp.DBCluster.PerformanceInsightsEnabled != nil {
+		r.ko.Status.PerformanceInsightsEnabled = resp.DBCluster.PerformanceInsightsEnabled
+	} else {
+		r.ko.Status.PerformanceInsightsEnabled = nil
+	}
+	if resp.DBCluster.PerformanceInsightsKMSKeyId != nil {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = resp.DBCluster.PerformanceInsightsKMSKeyId
+	} else {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = nil
+	}
+	if resp.DBCluster.PerformanceInsightsRetentionPeriod != nil {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = resp.DBCluster.PerformanceInsightsRetentionPeriod
+	} else {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = nil
+	}
+	if resp.DBCluster.Port != nil {
+		r.ko.Spec.Port = resp.DBCluster.Port
+	} else {
+		r.ko.Spec.Port = nil
+	}
+	if resp.DBCluster.PreferredBackupWindow != nil {
+		r.ko.Spec.PreferredBackupWindow = resp.DBCluster.PreferredBackupWindow
+	} else {
+		r.ko.Spec.PreferredBackupWindow = nil
+	}
+	if resp.DBCluster.PreferredMaintenanceWindow != nil {
+		r.ko.Spec.PreferredMaintenanceWindow = resp.DBCluster.PreferredMaintenanceWindow
+	} else {
+		r.ko.Spec.PreferredMaintenanceWindow = nil
+	}
+	if resp.DBCluster.PubliclyAccessible != nil {
+		r.ko.Spec.PubliclyAccessible = resp.DBCluster.PubliclyAccessible
+	} else {
+		r.ko.Spec.PubliclyAccessible = nil
+	}
+	if resp.DBCluster.ReadReplicaIdentifiers != nil {
+		f61 := []*string{}
+		for _, f61iter := range resp.DBCluster.ReadReplicaIdentifiers {
+			var f61elem string
+			f61elem = *f61iter
+			f61 = append(f61, &f61elem)
+		}
+		r.ko.Status.ReadReplicaIdentifiers = f61
+	} else {
+		r.ko.Status.ReadReplicaIdentifiers = nil
+	}
+	if resp.DBCluster.ReaderEndpoint != nil {
+		r.ko.Status.ReaderEndpoint = resp.DBCluster.ReaderEndpoint
+	} else {
+		r.ko.Status.ReaderEndpoint = nil
+	}
+	if resp.DBCluster.ReplicationSourceIdentifier != nil {
+		r.ko.Spec.ReplicationSourceIdentifier = resp.DBCluster.ReplicationSourceIdentifier
+	} else {
+		r.ko.Spec.ReplicationSourceIdentifier = nil
+	}
+	if resp.DBCluster.ScalingConfigurationInfo != nil {
+		f64 := &svcapitypes.ScalingConfiguration{}
+		if resp.DBCluster.ScalingConfigurationInfo.AutoPause != nil {
+			f64.AutoPause = resp.DBCluster.ScalingConfigurationInfo.AutoPause
+		}
+		if resp.DBCluster.ScalingConfigurationInfo.MaxCapacity != nil {
+			f64.MaxCapacity = resp.DBCluster.ScalingConfigurationInfo.MaxCapacity
+		}
+		if resp.DBCluster.ScalingConfigurationInfo.MinCapacity != nil {
+			f64.MinCapacity = resp.DBCluster.ScalingConfigurationInfo.MinCapacity
+		}
+		if resp.DBCluster.ScalingConfigurationInfo.SecondsBeforeTimeout != nil {
+			f64.SecondsBeforeTimeout = resp.DBCluster.ScalingConfigurationInfo.SecondsBeforeTimeout
+		}
+		if resp.DBCluster.ScalingConfigurationInfo.SecondsUntilAutoPause != nil {
+			f64.SecondsUntilAutoPause = resp.DBCluster.ScalingConfigurationInfo.SecondsUntilAutoPause
+		}
+		if resp.DBCluster.ScalingConfigurationInfo.TimeoutAction != nil {
+			f64.TimeoutAction = resp.DBCluster.ScalingConfigurationInfo.TimeoutAction
+		}
+		r.ko.Spec.ScalingConfiguration = f64
+	} else {
+		r.ko.Spec.ScalingConfiguration = nil
+	}
+	if resp.DBCluster.ServerlessV2ScalingConfiguration != nil {
+		f65 := &svcapitypes.ServerlessV2ScalingConfiguration{}
+		if resp.DBCluster.ServerlessV2ScalingConfiguration.MaxCapacity != nil {
+			f65.MaxCapacity = resp.DBCluster.ServerlessV2ScalingConfiguration.MaxCapacity
+		}
+		if resp.DBCluster.ServerlessV2ScalingConfiguration.MinCapacity != nil {
+			f65.MinCapacity = resp.DBCluster.ServerlessV2ScalingConfiguration.MinCapacity
+		}
+		r.ko.Spec.ServerlessV2ScalingConfiguration = f65
+	} else {
+		r.ko.Spec.ServerlessV2ScalingConfiguration = nil
+	}
+	if resp.DBCluster.Status != nil {
+		r.ko.Status.Status = resp.DBCluster.Status
+	} else {
+		r.ko.Status.Status = nil
+	}
+	if resp.DBCluster.StorageEncrypted != nil {
+		r.ko.Spec.StorageEncrypted = resp.DBCluster.StorageEncrypted
+	} else {
+		r.ko.Spec.StorageEncrypted = nil
+	}
+	if resp.DBCluster.StorageType != nil {
+		r.ko.Spec.StorageType = resp.DBCluster.StorageType
+	} else {
+		r.ko.Spec.StorageType = nil
+	}
+	if resp.DBCluster.TagList != nil {
+		f69 := []*svcapitypes.Tag{}
+		for _, f69iter := range resp.DBCluster.TagList {
+			f69elem := &svcapitypes.Tag{}
+			if f69iter.Key != nil {
+				f69elem.Key = f69iter.Key
+			}
+			if f69iter.Value != nil {
+				f69elem.Value = f69iter.Value
+			}
+			f69 = append(f69, f69elem)
+		}
+		r.ko.Status.TagList = f69
+	} else {
+		r.ko.Status.TagList = nil
+	}
+	if resp.DBCluster.VpcSecurityGroups != nil {
+		f70 := []*svcapitypes.VPCSecurityGroupMembership{}
+		for _, f70iter := range resp.DBCluster.VpcSecurityGroups {
+			f70elem := &svcapitypes.VPCSecurityGroupMembership{}
+			if f70iter.Status != nil {
+				f70elem.Status = f70iter.Status
+			}
+			if f70iter.VpcSecurityGroupId != nil {
+				f70elem.VPCSecurityGroupID = f70iter.VpcSecurityGroupId
+			}
+			f70 = append(f70, f70elem)
+		}
+		r.ko.Status.VPCSecurityGroups = f70
+	} else {
+		r.ko.Status.VPCSecurityGroups = nil
+	}
+
+}
+
+// newRestoreDBClusterToPointInTimeInput returns a RestoreDBClusterToPointInTimeInput object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newRestoreDBClusterToPointInTimeInput(
+	r *resource,
+) *svcsdk.RestoreDBClusterToPointInTimeInput {
+	res := &svcsdk.RestoreDBClusterToPointInTimeInput{}
+
+	if r.ko.Spec.BacktrackWindow != nil {
+		res.SetBacktrackWindow(*r.ko.Spec.BacktrackWindow)
+	}
+	if r.ko.Spec.CopyTagsToSnapshot != nil {
+		res.SetCopyTagsToSnapshot(*r.ko.Spec.CopyTagsToSnapshot)
+	}
+	if r.ko.Spec.DBClusterIdentifier != nil {
+		res.SetDBClusterIdentifier(*r.ko.Spec.DBClusterIdentifier)
+	}
+	if r.ko.Spec.DBClusterInstanceClass != nil {
+		res.SetDBClusterInstanceClass(*r.ko.Spec.DBClusterInstanceClass)
+	}
+	if r.ko.Spec.DBClusterParameterGroupName != nil {
+		res.SetDBClusterParameterGroupName(*r.ko.Spec.DBClusterParameterGroupName)
+	}
+	if r.ko.Spec.DBSubnetGroupName != nil {
+		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
+	}
+	if r.ko.Spec.DeletionProtection != nil {
+		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
+	}
+	if r.ko.Spec.Domain != nil {
+		res.SetDomain(*r.ko.Spec.Domain)
+	}
+	if r.ko.Spec.DomainIAMRoleName != nil {
+		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
+	}
+	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
+		resf9 := []*string{}
+		for _, resf9iter := range r.ko.Spec.EnableCloudwatchLogsExports {
+			var resf9elem string
+			resf9elem = *resf9iter
+			resf9 = append(resf9, &resf9elem)
+		}
+		res.SetEnableCloudwatchLogsExports(resf9)
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil {
+		res.SetEnableIAMDatabaseAuthentication(*r.ko.Spec.EnableIAMDatabaseAuthentication)
+	}
+	if r.ko.Spec.EngineMode != nil {
+		res.SetEngineMode(*r.ko.Spec.EngineMode)
+	}
+	if r.ko.Spec.IOPS != nil {
+		res.SetIops(*r.ko.Spec.IOPS)
+	}
+	if r.ko.Spec.KMSKeyID != nil {
+		res.SetKmsKeyId(*r.ko.Spec.KMSKeyID)
+	}
+	if r.ko.Spec.NetworkType != nil {
+		res.SetNetworkType(*r.ko.Spec.NetworkType)
+	}
+	if r.ko.Spec.OptionGroupName != nil {
+		res.SetOptionGroupName(*r.ko.Spec.OptionGroupName)
+	}
+	if r.ko.Spec.Port != nil {
+		res.SetPort(*r.ko.Spec.Port)
+	}
+	if r.ko.Spec.PubliclyAccessible != nil {
+		res.SetPubliclyAccessible(*r.ko.Spec.PubliclyAccessible)
+	}
+	if r.ko.Spec.ScalingConfiguration != nil {
+		resf21 := &svcsdk.ScalingConfiguration{}
+		if r.ko.Spec.ScalingConfiguration.AutoPause != nil {
+			resf21.SetAutoPause(*r.ko.Spec.ScalingConfiguration.AutoPause)
+		}
+		if r.ko.Spec.ScalingConfiguration.MaxCapacity != nil {
+			resf21.SetMaxCapacity(*r.ko.Spec.ScalingConfiguration.MaxCapacity)
+		}
+		if r.ko.Spec.ScalingConfiguration.MinCapacity != nil {
+			resf21.SetMinCapacity(*r.ko.Spec.ScalingConfiguration.MinCapacity)
+		}
+		if r.ko.Spec.ScalingConfiguration.SecondsBeforeTimeout != nil {
+			resf21.SetSecondsBeforeTimeout(*r.ko.Spec.ScalingConfiguration.SecondsBeforeTimeout)
+		}
+		if r.ko.Spec.ScalingConfiguration.SecondsUntilAutoPause != nil {
+			resf21.SetSecondsUntilAutoPause(*r.ko.Spec.ScalingConfiguration.SecondsUntilAutoPause)
+		}
+		if r.ko.Spec.ScalingConfiguration.TimeoutAction != nil {
+			resf21.SetTimeoutAction(*r.ko.Spec.ScalingConfiguration.TimeoutAction)
+		}
+		res.SetScalingConfiguration(resf21)
+	}
+	if r.ko.Spec.ServerlessV2ScalingConfiguration != nil {
+		resf22 := &svcsdk.ServerlessV2ScalingConfiguration{}
+		if r.ko.Spec.ServerlessV2ScalingConfiguration.MaxCapacity != nil {
+			resf22.SetMaxCapacity(*r.ko.Spec.ServerlessV2ScalingConfiguration.MaxCapacity)
+		}
+		if r.ko.Spec.ServerlessV2ScalingConfiguration.MinCapacity != nil {
+			resf22.SetMinCapacity(*r.ko.Spec.ServerlessV2ScalingConfiguration.MinCapacity)
+		}
+		res.SetServerlessV2ScalingConfiguration(resf22)
+	}
+	if r.ko.Spec.StorageType != nil {
+		res.SetStorageType(*r.ko.Spec.StorageType)
+	}
+	if r.ko.Spec.Tags != nil {
+		resf26 := []*svcsdk.Tag{}
+		for _, resf26iter := range r.ko.Spec.Tags {
+			resf26elem := &svcsdk.Tag{}
+			if resf26iter.Key != nil {
+				resf26elem.SetKey(*resf26iter.Key)
+			}
+			if resf26iter.Value != nil {
+				resf26elem.SetValue(*resf26iter.Value)
+			}
+			resf26 = append(resf26, resf26elem)
+		}
+		res.SetTags(resf26)
+	}
+	if r.ko.Spec.VPCSecurityGroupIDs != nil {
+		resf28 := []*string{}
+		for _, resf28iter := range r.ko.Spec.VPCSecurityGroupIDs {
+			var resf28elem string
+			resf28elem = *resf28iter
+			resf28 = append(resf28, &resf28elem)
+		}
+		res.SetVpcSecurityGroupIds(resf28)
+	}
+
+	return res
+}
+
+// setResourceFromRestoreDBClusterToPointInTimeOutput sets a resource RestoreDBClusterToPointInTimeOutput type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromRestoreDBClusterToPointInTimeOutput(
+	r *resource,
+	resp *svcsdk.RestoreDBClusterToPointInTimeOutput,
+) {
+
+	if resp.DBCluster.ActivityStreamKinesisStreamName != nil {
+		r.ko.Status.ActivityStreamKinesisStreamName = resp.DBCluster.ActivityStreamKinesisStreamName
+	} else {
+		r.ko.Status.ActivityStreamKinesisStreamName = nil
+	}
+	if resp.DBCluster.ActivityStreamKmsKeyId != nil {
+		r.ko.Status.ActivityStreamKMSKeyID = resp.DBCluster.ActivityStreamKmsKeyId
+	} else {
+		r.ko.Status.ActivityStreamKMSKeyID = nil
+	}
+	if resp.DBCluster.ActivityStreamMode != nil {
+		r.ko.Status.ActivityStreamMode = resp.DBCluster.ActivityStreamMode
+	} else {
+		r.ko.Status.ActivityStreamMode = nil
+	}
+	if resp.DBCluster.ActivityStreamStatus != nil {
+		r.ko.Status.ActivityStreamStatus = resp.DBCluster.ActivityStreamStatus
+	} else {
+		r.ko.Status.ActivityStreamStatus = nil
+	}
+	if resp.DBCluster.AllocatedStorage != nil {
+		r.ko.Spec.AllocatedStorage = resp.DBCluster.AllocatedStorage
+	} else {
+		r.ko.Spec.AllocatedStorage = nil
+	}
+	if resp.DBCluster.AssociatedRoles != nil {
+		f5 := []*svcapitypes.DBClusterRole{}
+		for _, f5iter := range resp.DBCluster.AssociatedRoles {
+			f5elem := &svcapitypes.DBClusterRole{}
+			if f5iter.FeatureName != nil {
+				f5elem.FeatureName = f5iter.FeatureName
+			}
+			if f5iter.RoleArn != nil {
+				f5elem.RoleARN = f5iter.RoleArn
+			}
+			if f5iter.Status != nil {
+				f5elem.Status = f5iter.Status
+			}
+			f5 = append(f5, f5elem)
+		}
+		r.ko.Status.AssociatedRoles = f5
+	} else {
+		r.ko.Status.AssociatedRoles = nil
+	}
+	if resp.DBCluster.AutoMinorVersionUpgrade != nil {
+		r.ko.Spec.AutoMinorVersionUpgrade = resp.DBCluster.AutoMinorVersionUpgrade
+	} else {
+		r.ko.Spec.AutoMinorVersionUpgrade = nil
+	}
+	if resp.DBCluster.AutomaticRestartTime != nil {
+		r.ko.Status.AutomaticRestartTime = &metav1.Time{*resp.DBCluster.AutomaticRestartTime}
+	} else {
+		r.ko.Status.AutomaticRestartTime = nil
+	}
+	if resp.DBCluster.AvailabilityZones != nil {
+		f8 := []*string{}
+		for _, f8iter := range resp.DBCluster.AvailabilityZones {
+			var f8elem string
+			f8elem = *f8iter
+			f8 = append(f8, &f8elem)
+		}
+		r.ko.Spec.AvailabilityZones = f8
+	} else {
+		r.ko.Spec.AvailabilityZones = nil
+	}
+	if resp.DBCluster.BacktrackConsumedChangeRecords != nil {
+		r.ko.Status.BacktrackConsumedChangeRecords = resp.DBCluster.BacktrackConsumedChangeRecords
+	} else {
+		r.ko.Status.BacktrackConsumedChangeRecords = nil
+	}
+	if resp.DBCluster.BacktrackWindow != nil {
+		r.ko.Spec.BacktrackWindow = resp.DBCluster.BacktrackWindow
+	} else {
+		r.ko.Spec.BacktrackWindow = nil
+	}
+	if resp.DBCluster.BackupRetentionPeriod != nil {
+		r.ko.Spec.BackupRetentionPeriod = resp.DBCluster.BackupRetentionPeriod
+	} else {
+		r.ko.Spec.BackupRetentionPeriod = nil
+	}
+	if resp.DBCluster.Capacity != nil {
+		r.ko.Status.Capacity = resp.DBCluster.Capacity
+	} else {
+		r.ko.Status.Capacity = nil
+	}
+	if resp.DBCluster.CharacterSetName != nil {
+		r.ko.Spec.CharacterSetName = resp.DBCluster.CharacterSetName
+	} else {
+		r.ko.Spec.CharacterSetName = nil
+	}
+	if resp.DBCluster.CloneGroupId != nil {
+		r.ko.Status.CloneGroupID = resp.DBCluster.CloneGroupId
+	} else {
+		r.ko.Status.CloneGroupID = nil
+	}
+	if resp.DBCluster.ClusterCreateTime != nil {
+		r.ko.Status.ClusterCreateTime = &metav1.Time{*resp.DBCluster.ClusterCreateTime}
+	} else {
+		r.ko.Status.ClusterCreateTime = nil
+	}
+	if resp.DBCluster.CopyTagsToSnapshot != nil {
+		r.ko.Spec.CopyTagsToSnapshot = resp.DBCluster.CopyTagsToSnapshot
+	} else {
+		r.ko.Spec.CopyTagsToSnapshot = nil
+	}
+	if resp.DBCluster.CrossAccountClone != nil {
+		r.ko.Status.CrossAccountClone = resp.DBCluster.CrossAccountClone
+	} else {
+		r.ko.Status.CrossAccountClone = nil
+	}
+	if resp.DBCluster.CustomEndpoints != nil {
+		f18 := []*string{}
+		for _, f18iter := range resp.DBCluster.CustomEndpoints {
+			var f18elem string
+			f18elem = *f18iter
+			f18 = append(f18, &f18elem)
+		}
+		r.ko.Status.CustomEndpoints = f18
+	} else {
+		r.ko.Status.CustomEndpoints = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBCluster.DBClusterArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBCluster.DBClusterArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBCluster.DBClusterIdentifier != nil {
+		r.ko.Spec.DBClusterIdentifier = resp.DBCluster.DBClusterIdentifier
+	} else {
+		r.ko.Spec.DBClusterIdentifier = nil
+	}
+	if resp.DBCluster.DBClusterInstanceClass != nil {
+		r.ko.Spec.DBClusterInstanceClass = resp.DBCluster.DBClusterInstanceClass
+	} else {
+		r.ko.Spec.DBClusterInstanceClass = nil
+	}
+	if resp.DBCluster.DBClusterMembers != nil {
+		f22 := []*svcapitypes.DBClusterMember{}
+		for _, f22iter := range resp.DBCluster.DBClusterMembers {
+			f22elem := &svcapitypes.DBClusterMember{}
+			if f22iter.DBClusterParameterGroupStatus != nil {
+				f22elem.DBClusterParameterGroupStatus = f22iter.DBClusterParameterGroupStatus
+			}
+			if f22iter.DBInstanceIdentifier != nil {
+				f22elem.DBInstanceIdentifier = f22iter.DBInstanceIdentifier
+			}
+			if f22iter.IsClusterWriter != nil {
+				f22elem.IsClusterWriter = f22iter.IsClusterWriter
+			}
+			if f22iter.PromotionTier != nil {
+				f22elem.PromotionTier = f22iter.PromotionTier
+			}
+			f22 = append(f22, f22elem)
+		}
+		r.ko.Status.DBClusterMembers = f22
+	} else {
+		r.ko.Status.DBClusterMembers = nil
+	}
+	if resp.DBCluster.DBClusterOptionGroupMemberships != nil {
+		f23 := []*svcapitypes.DBClusterOptionGroupStatus{}
+		for _, f23iter := range resp.DBCluster.DBClusterOptionGroupMemberships {
+			f23elem := &svcapitypes.DBClusterOptionGroupStatus{}
+			if f23iter.DBClusterOptionGroupName != nil {
+				f23elem.DBClusterOptionGroupName = f23iter.DBClusterOptionGroupName
+			}
+			if f23iter.Status != nil {
+				f23elem.Status = f23iter.Status
+			}
+			f23 = append(f23, f23elem)
+		}
+		r.ko.Status.DBClusterOptionGroupMemberships = f23
+	} else {
+		r.ko.Status.DBClusterOptionGroupMemberships = nil
+	}
+	if resp.DBCluster.DBClusterParameterGroup != nil {
+		r.ko.Status.DBClusterParameterGroup = resp.DBCluster.DBClusterParameterGroup
+	} else {
+		r.ko.Status.DBClusterParameterGroup = nil
+	}
+	if resp.DBCluster.DBSubnetGroup != nil {
+		r.ko.Status.DBSubnetGroup = resp.DBCluster.DBSubnetGroup
+	} else {
+		r.ko.Status.DBSubnetGroup = nil
+	}
+	if resp.DBCluster.DBSystemId != nil {
+		r.ko.Spec.DBSystemID = resp.DBCluster.DBSystemId
+	} else {
+		r.ko.Spec.DBSystemID = nil
+	}
+	if resp.DBCluster.DatabaseName != nil {
+		r.ko.Spec.DatabaseName = resp.DBCluster.DatabaseName
+	} else {
+		r.ko.Spec.DatabaseName = nil
+	}
+	if resp.DBCluster.DbClusterResourceId != nil {
+		r.ko.Status.DBClusterResourceID = resp.DBCluster.DbClusterResourceId
+	} else {
+		r.ko.Status.DBClusterResourceID = nil
+	}
+	if resp.DBCluster.DeletionProtection != nil {
+		r.ko.Spec.DeletionProtection = resp.DBCluster.DeletionProtection
+	} else {
+		r.ko.Spec.DeletionProtection = nil
+	}
+	if resp.DBCluster.DomainMemberships != nil {
+		f30 := []*svcapitypes.DomainMembership{}
+		for _, f30iter := range resp.DBCluster.DomainMemberships {
+			f30elem := &svcapitypes.DomainMembership{}
+			if f30iter.AuthSecretArn != nil {
+				f30elem.AuthSecretARN = f30iter.AuthSecretArn
+			}
+			if f30iter.DnsIps != nil {
+				f30elemf1 := []*string{}
+				for _, f30elemf1iter := range f30iter.DnsIps {
+					var f30elemf1elem string
+					f30elemf1elem = *f30elemf1iter
+					f30elemf1 = append(f30elemf1, &f30elemf1elem)
+				}
+				f30elem.DNSIPs = f30elemf1
+			}
+			if f30iter.Domain != nil {
+				f30elem.Domain = f30iter.Domain
+			}
+			if f30iter.FQDN != nil {
+				f30elem.FQDN = f30iter.FQDN
+			}
+			if f30iter.IAMRoleName != nil {
+				f30elem.IAMRoleName = f30iter.IAMRoleName
+			}
+			if f30iter.OU != nil {
+				f30elem.OU = f30iter.OU
+			}
+			if f30iter.Status != nil {
+				f30elem.Status = f30iter.Status
+			}
+			f30 = append(f30, f30elem)
+		}
+		r.ko.Status.DomainMemberships = f30
+	} else {
+		r.ko.Status.DomainMemberships = nil
+	}
+	if resp.DBCluster.EarliestBacktrackTime != nil {
+		r.ko.Status.EarliestBacktrackTime = &metav1.Time{*resp.DBCluster.EarliestBacktrackTime}
+	} else {
+		r.ko.Status.EarliestBacktrackTime = nil
+	}
+	if resp.DBCluster.EarliestRestorableTime != nil {
+		r.ko.Status.EarliestRestorableTime = &metav1.Time{*resp.DBCluster.EarliestRestorableTime}
+	} else {
+		r.ko.Status.EarliestRestorableTime = nil
+	}
+	if resp.DBCluster.EnabledCloudwatchLogsExports != nil {
+		f33 := []*string{}
+		for _, f33iter := range resp.DBCluster.EnabledCloudwatchLogsExports {
+			var f33elem string
+			f33elem = *f33iter
+			f33 = append(f33, &f33elem)
+		}
+		r.ko.Status.EnabledCloudwatchLogsExports = f33
+	} else {
+		r.ko.Status.EnabledCloudwatchLogsExports = nil
+	}
+	if resp.DBCluster.Endpoint != nil {
+		r.ko.Status.Endpoint = resp.DBCluster.Endpoint
+	} else {
+		r.ko.Status.Endpoint = nil
+	}
+	if resp.DBCluster.Engine != nil {
+		r.ko.Spec.Engine = resp.DBCluster.Engine
+	} else {
+		r.ko.Spec.Engine = nil
+	}
+	if resp.DBCluster.EngineMode != nil {
+		r.ko.Spec.EngineMode = resp.DBCluster.EngineMode
+	} else {
+		r.ko.Spec.EngineMode = nil
+	}
+	if resp.DBCluster.EngineVersion != nil {
+		r.ko.Spec.EngineVersion = resp.DBCluster.EngineVersion
+	} else {
+		r.ko.Spec.EngineVersion = nil
+	}
+	if resp.DBCluster.GlobalWriteForwardingRequested != nil {
+		r.ko.Status.GlobalWriteForwardingRequested = resp.DBCluster.GlobalWriteForwardingRequested
+	} else {
+		r.ko.Status.GlobalWriteForwardingRequested = nil
+	}
+	if resp.DBCluster.GlobalWriteForwardingStatus != nil {
+		r.ko.Status.GlobalWriteForwardingStatus = resp.DBCluster.GlobalWriteForwardingStatus
+	} else {
+		r.ko.Status.GlobalWriteForwardingStatus = nil
+	}
+	if resp.DBCluster.HostedZoneId != nil {
+		r.ko.Status.HostedZoneID = resp.DBCluster.HostedZoneId
+	} else {
+		r.ko.Status.HostedZoneID = nil
+	}
+	if resp.DBCluster.HttpEndpointEnabled != nil {
+		r.ko.Status.HTTPEndpointEnabled = resp.DBCluster.HttpEndpointEnabled
+	} else {
+		r.ko.Status.HTTPEndpointEnabled = nil
+	}
+	if resp.DBCluster.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBCluster.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBCluster.IOOptimizedNextAllowedModificationTime != nil {
+		r.ko.Status.IOOptimizedNextAllowedModificationTime = &metav1.Time{*resp.DBCluster.IOOptimizedNextAllowedModificationTime}
+	} else {
+		r.ko.Status.IOOptimizedNextAllowedModificationTime = nil
+	}
+	if resp.DBCluster.Iops != nil {
+		r.ko.Spec.IOPS = resp.DBCluster.Iops
+	} else {
+		r.ko.Spec.IOPS = nil
+	}
+	if resp.DBCluster.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBCluster.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBCluster.LatestRestorableTime != nil {
+		r.ko.Status.LatestRestorableTime = &metav1.Time{*resp.DBCluster.LatestRestorableTime}
+	} else {
+		r.ko.Status.LatestRestorableTime = nil
+	}
+	if resp.DBCluster.LocalWriteForwardingStatus != nil {
+		r.ko.Status.LocalWriteForwardingStatus = resp.DBCluster.LocalWriteForwardingStatus
+	} else {
+		r.ko.Status.LocalWriteForwardingStatus = nil
+	}
 	if resp.DBCluster.MasterUserSecret != nil {
 		f46 := &svcapitypes.MasterUserSecret{}
 		if resp.DBCluster.MasterUserSecret.KmsKeyId != nil {
@@ -0,0 +1,108 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_endpoint
+
+import (
+	"context"
+	"errors"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusAvailable = "available"
+	StatusCreating  = "creating"
+	StatusDeleting  = "deleting"
+	StatusInactive  = "inactive"
+	StatusModifying = "modifying"
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("DB cluster endpoint in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the DB cluster endpoint cannot be modified until it is
+// available.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		errors.New("DB cluster endpoint in '"+aws.StringValue(r.ko.Status.Status)+"' state, cannot be modified until 'available'."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// endpointAvailable returns true if the supplied DB cluster endpoint is in
+// the available status
+func endpointAvailable(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusAvailable
+}
+
+// endpointDeleting returns true if the supplied DB cluster endpoint is in
+// the process of being deleted
+func endpointDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// validateCustomEndpoint returns a terminal error when the type or the
+// members of the supplied custom endpoint are not valid.
+func validateCustomEndpoint(r *resource) error {
+	return util.ValidateCustomEndpoint(
+		aws.StringValue(r.ko.Spec.EndpointType),
+		r.ko.Spec.StaticMembers,
+		r.ko.Spec.ExcludedMembers,
+	)
+}
+
+// clearRemovedMembers sets the static and excluded members removed from the
+// Spec of the custom endpoint to empty lists in the supplied
+// ModifyDBClusterEndpoint input, since members that are not set in the input
+// are left unchanged.
+func clearRemovedMembers(
+	input *svcsdk.ModifyDBClusterEndpointInput,
+	delta *ackcompare.Delta,
+) {
+	if delta.DifferentAt("Spec.StaticMembers") && input.StaticMembers == nil {
+		input.SetStaticMembers([]*string{})
+	}
+	if delta.DifferentAt("Spec.ExcludedMembers") && input.ExcludedMembers == nil {
+		input.SetExcludedMembers([]*string{})
+	}
+}
+
+// populateAdoptionFields takes the dbClusterEndpointIdentifier of the
+// supplied DB cluster endpoint from its adoption fields when it sets the
+// adopt-or-create adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"dbClusterEndpointIdentifier": &r.ko.Spec.DBClusterEndpointIdentifier,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -43,6 +43,7 @@ func newResourceDelta(
 		return delta
 	}
 	compareTags(delta, a, b)
+	compareParameterOverrides(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.Description, b.ko.Spec.Description) {
 		delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
@@ -58,6 +59,16 @@ func newResourceDelta(
 			delta.Add("Spec.Family", a.ko.Spec.Family, b.ko.Spec.Family)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.InstanceParameterGroupName, b.ko.Spec.InstanceParameterGroupName) {
+		delta.Add("Spec.InstanceParameterGroupName", a.ko.Spec.InstanceParameterGroupName, b.ko.Spec.InstanceParameterGroupName)
+	} else if a.ko.Spec.InstanceParameterGroupName != nil && b.ko.Spec.InstanceParameterGroupName != nil {
+		if *a.ko.Spec.InstanceParameterGroupName != *b.ko.Spec.InstanceParameterGroupName {
+			delta.Add("Spec.InstanceParameterGroupName", a.ko.Spec.InstanceParameterGroupName, b.ko.Spec.InstanceParameterGroupName)
+		}
+	}
+	if !reflect.DeepEqual(a.ko.Spec.InstanceParameterGroupRef, b.ko.Spec.InstanceParameterGroupRef) {
+		delta.Add("Spec.InstanceParameterGroupRef", a.ko.Spec.InstanceParameterGroupRef, b.ko.Spec.InstanceParameterGroupRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
 		delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	} else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
@@ -65,13 +76,6 @@ func newResourceDelta(
 			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		}
 	}
-	if len(a.ko.Spec.ParameterOverrides) != len(b.ko.Spec.ParameterOverrides) {
-		delta.Add("Spec.ParameterOverrides", a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides)
-	} else if len(a.ko.Spec.ParameterOverrides) > 0 {
-		if !ackcompare.MapStringStringPEqual(a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides) {
-			delta.Add("Spec.ParameterOverrides", a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides)
-		}
-	}
 	if len(a.ko.Spec.Parameters) != len(b.ko.Spec.Parameters) {
 		delta.Add("Spec.Parameters", a.ko.Spec.Parameters, b.ko.Spec.Parameters)
 	} else if len(a.ko.Spec.Parameters) > 0 {
@@ -15,6 +15,7 @@ package db_cluster_parameter_group
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,19 +26,22 @@ import (
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
 
 const (
-	applyTypeStatic        = "static"
-	sourceUser             = "user"
-	maxResetParametersSize = 20
+	sourceUser = "user"
+	// sourceEngineDefault is the source of the parameters left at their
+	// engine default value
+	sourceEngineDefault = "engine-default"
 )
 
 var (
 	// cache of parameter defaults
 	cachedParamMeta = util.ParamMetaCache{
+		TTL:   util.DefaultParamMetaCacheTTL,
 		Cache: map[string]map[string]util.ParamMeta{},
 	}
 
@@ -64,12 +68,31 @@ func (rm *resourceManager) customUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if delta.DifferentAt("Spec.ParameterOverrides") {
+		if err = rm.validateParameters(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
-	if delta.DifferentAt("Spec.ParameterOverrides") {
+	if resetAllParametersRequested(desired) {
+		if err = rm.resetAllParameters(ctx, desired.ko.Spec.Name); err != nil {
+			return nil, err
+		}
+		desired.ko.Status.ParameterApplyHistory = util.AppendParameterApplyHistory(
+			desired.ko.Status.ParameterApplyHistory,
+			util.ParameterOperationResetAll, nil,
+		)
+		// All the parameters now have their default values so all the
+		// parameter overrides need to be applied again.
+		if err = rm.syncParameters(ctx, desired, nil); err != nil {
+			return nil, err
+		}
+		setLastResetAllParameters(desired)
+	} else if delta.DifferentAt("Spec.ParameterOverrides") {
 		if err = rm.syncParameters(ctx, desired, latest); err != nil {
 			return nil, err
 		}
@@ -219,59 +242,303 @@ func (rm *resourceManager) syncParameters(
 	groupName := desired.ko.Spec.Name
 	family := desired.ko.Spec.Family
 
-	desiredOverrides := desired.ko.Spec.ParameterOverrides
+	overrides, err := expandedOverrides(desired)
+	if err != nil {
+		return err
+	}
+	desiredOverrides, err := rm.desiredParameters(ctx, *family, overrides)
+	if err != nil {
+		return err
+	}
 	latestOverrides := util.Parameters{}
 	// In the create code paths, we pass a nil latest...
 	if latest != nil {
-		latestOverrides = latest.ko.Spec.ParameterOverrides
+		latestOverrides = latestParameters(latest)
 	}
 
 	toModify, _, toDelete := util.GetParametersDifference(
 		desiredOverrides, latestOverrides,
 	)
 
+	// NOTE(jaypipes): ResetDBClusterParameterGroup and
+	// ModifyDBClusterParameterGroup only accept 20 parameters at a time,
+	// which is why we "chunk" both the deleted and modified parameter sets.
+
+	// Parameters that were removed from Spec.ParameterOverrides are reset to
+	// their engine default values so that the group actually converges
+	// instead of keeping the previously overridden values.
 	if len(toDelete) > 0 {
-		err = rm.resetParameters(ctx, family, groupName, toDelete)
-		if err != nil {
-			return err
+		chunks := util.ChunkParameters(toDelete, util.MaxParametersPerCall)
+		for _, chunk := range chunks {
+			err = rm.resetParameters(ctx, family, groupName, chunk)
+			if err != nil {
+				return err
+			}
 		}
+		util.RecordParametersReset(rm.eventRecorder, desired.ko, toDelete.Names())
+		desired.ko.Status.ParameterApplyHistory = util.AppendParameterApplyHistory(
+			desired.ko.Status.ParameterApplyHistory,
+			util.ParameterOperationReset, toDelete.Names(),
+		)
 	}
 
 	if len(toModify) > 0 {
-		err = rm.modifyParameters(ctx, family, groupName, toModify)
-		if err != nil {
-			return err
+		chunks := util.ChunkParameters(toModify, util.MaxParametersPerCall)
+		for _, chunk := range chunks {
+			err = rm.modifyParameters(ctx, family, groupName, chunk)
+			if err != nil {
+				return err
+			}
+		}
+		var added, changed []string
+		for _, name := range toModify.Names() {
+			if _, _, found := latestOverrides.Lookup(name); found {
+				changed = append(changed, name)
+			} else {
+				added = append(added, name)
+			}
 		}
+		util.RecordParametersModified(rm.eventRecorder, desired.ko, added, changed)
+		desired.ko.Status.ParameterApplyHistory = util.AppendParameterApplyHistory(
+			desired.ko.Status.ParameterApplyHistory,
+			util.ParameterOperationModify, toModify.Names(),
+		)
 	}
+	desired.ko.Status.AppliedParameterOverrides = desiredOverrides.Values()
 	return nil
 }
 
+// validateParameters ensures that all the parameter overrides of the supplied
+// resource are known to, and modifiable in, the cluster parameter group's family,
+// according to the engine defaults returned by RDS. Catching these up front
+// means we return a terminal error instead of discovering the problem only
+// when RDS rejects the modify call.
+func (rm *resourceManager) validateParameters(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateParameters")
+	defer func() { exit(err) }()
+
+	if r.ko.Spec.Family == nil {
+		return nil
+	}
+	overrides, err := expandedOverrides(r)
+	if err != nil || len(overrides) == 0 {
+		return err
+	}
+	return cachedParamMeta.Validate(
+		ctx, *r.ko.Spec.Family, overrides, rm.getFamilyParameters,
+	)
+}
+
+// expandedOverrides returns the parameter overrides of the supplied resource
+// merged with the parameters of the resource's parameter profile, if any.
+// Parameter overrides take precedence over the profile's parameters.
+func expandedOverrides(r *resource) (map[string]*string, error) {
+	if r.ko.Spec.Family == nil {
+		return r.ko.Spec.ParameterOverrides, nil
+	}
+	return util.DBClusterParameterProfiles.Expand(
+		r.ko.Spec.ParameterProfile, *r.ko.Spec.Family,
+		r.ko.Spec.ParameterOverrides,
+	)
+}
+
+// desiredParameters returns the supplied parameter overrides along with the
+// apply method RDS requires for each of them. Static parameters must be
+// applied pending a reboot while dynamic parameters are applied immediately.
+func (rm *resourceManager) desiredParameters(
+	ctx context.Context,
+	family string,
+	overrides map[string]*string,
+) (util.Parameters, error) {
+	err := cachedParamMeta.Validate(
+		ctx, family, overrides, rm.getFamilyParameters,
+	)
+	if err != nil {
+		return nil, err
+	}
+	params := util.Parameters{}
+	for paramName, paramValue := range overrides {
+		pMeta, err := cachedParamMeta.Get(
+			ctx, family, paramName, rm.getFamilyParameters,
+		)
+		if err != nil {
+			return nil, err
+		}
+		applyMethod := svcsdk.ApplyMethodImmediate
+		if !pMeta.IsDynamic {
+			applyMethod = svcsdk.ApplyMethodPendingReboot
+		}
+		params[paramName] = util.Parameter{
+			Value:       paramValue,
+			ApplyMethod: aws.String(applyMethod),
+		}
+	}
+	return params, nil
+}
+
+// latestParameters returns the parameter overrides of the supplied resource
+// along with the apply method RDS last used for each of them, as reported in
+// the resource's parameter override statuses.
+func latestParameters(r *resource) util.Parameters {
+	params := util.NewParameters(r.ko.Spec.ParameterOverrides)
+	for _, status := range r.ko.Status.ParameterOverrideStatuses {
+		if status == nil || status.ParameterName == nil {
+			continue
+		}
+		if param, found := params[*status.ParameterName]; found {
+			param.ApplyMethod = status.ApplyMethod
+			params[*status.ParameterName] = param
+		}
+	}
+	return params
+}
+
+// compareParameterOverrides adds a difference to the delta if the supplied
+// resources have different parameter overrides. A parameter whose value is
+// unchanged but that was last applied with the wrong apply method for its
+// apply type (for instance a dynamic parameter applied "pending-reboot"
+// outside of the controller) is also considered different. The parameters of
+// the desired resource's parameter profile are compared along with its
+// parameter overrides.
+func compareParameterOverrides(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if resetAllParametersRequested(a) {
+		delta.Add(
+			"Spec.ParameterOverrides",
+			a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides,
+		)
+		return
+	}
+	overrides, err := expandedOverrides(a)
+	if err != nil {
+		// Let the update surface the (terminal) error about the profile.
+		delta.Add(
+			"Spec.ParameterOverrides",
+			a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides,
+		)
+		return
+	}
+	if parameterDriftIgnored(a, b, overrides) {
+		return
+	}
+	desired := util.NewParameters(overrides)
+	for _, status := range b.ko.Status.ParameterOverrideStatuses {
+		if status == nil || status.ParameterName == nil {
+			continue
+		}
+		if name, param, found := desired.Lookup(*status.ParameterName); found {
+			param.ApplyMethod = aws.String(
+				util.ApplyMethodFromApplyType(status.ApplyType),
+			)
+			desired[name] = param
+		}
+	}
+	added, _, removed := util.GetParametersDifference(
+		desired, latestParameters(b),
+	)
+	if len(added) > 0 || len(removed) > 0 {
+		delta.Add(
+			"Spec.ParameterOverrides",
+			a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides,
+		)
+	}
+}
+
+// validateDriftPolicy returns an ACK terminal error if the supplied resource's
+// drift policy is unknown.
+func validateDriftPolicy(r *resource) error {
+	return util.ValidateDriftPolicy(r.ko.Spec.DriftPolicy)
+}
+
+// parameterDriftIgnored returns true if the differences between the supplied
+// desired and latest resources' parameters must be left alone: the desired
+// resource's drift policy is "Ignore" or "Alert" and the desired parameters
+// are the ones the controller last applied, meaning the parameters were
+// modified outside of the controller.
+func parameterDriftIgnored(
+	a *resource,
+	b *resource,
+	desired map[string]*string,
+) bool {
+	policy := aws.StringValue(a.ko.Spec.DriftPolicy)
+	if policy != util.DriftPolicyIgnore && policy != util.DriftPolicyAlert {
+		return false
+	}
+	applied := b.ko.Status.AppliedParameterOverrides
+	if applied == nil {
+		return false
+	}
+	return len(util.DriftedParameters(desired, applied)) == 0
+}
+
+// setParameterDrift lists, in the status of the supplied resource read from
+// AWS, the parameters that were modified outside of the controller when the
+// resource's drift policy is "Alert", and sets a Drifted condition
+// accordingly.
+func setParameterDrift(ko *svcapitypes.DBClusterParameterGroup) {
+	var drifted []string
+	if aws.StringValue(ko.Spec.DriftPolicy) == util.DriftPolicyAlert &&
+		ko.Status.AppliedParameterOverrides != nil {
+		drifted = util.DriftedParameters(
+			ko.Spec.ParameterOverrides, ko.Status.AppliedParameterOverrides,
+		)
+	}
+	ko.Status.DriftedParameters = nil
+	if len(drifted) > 0 {
+		ko.Status.DriftedParameters = aws.StringSlice(drifted)
+	}
+	util.SetParametersDrifted(&resource{ko}, drifted)
+}
+
 // getParameters retrieves the cluster parameter group's user-defined parameters
-// (overrides) and the "statuses" of those parameter overrides.
+// (overrides), the "statuses" of those parameter overrides and the names of
+// the cluster parameter group's parameters whose value is not the engine
+// default, keyed by their source ("user" or "system").
 func (rm *resourceManager) getParameters(
 	ctx context.Context,
 	groupName *string,
 ) (
 	params map[string]*string,
 	paramStatuses []*svcapitypes.Parameter,
+	paramSources map[string][]*string,
 	err error,
 ) {
 	var marker *string
 	params = make(map[string]*string)
+	paramSources = make(map[string][]*string)
 	for {
 		resp, err := rm.sdkapi.DescribeDBClusterParametersWithContext(
 			ctx,
 			&svcsdk.DescribeDBClusterParametersInput{
 				DBClusterParameterGroupName: groupName,
-				Source:                      aws.String(sourceUser),
 				Marker:                      marker,
+				MaxRecords:                  aws.Int64(util.MaxRecordsPerPage),
 			},
 		)
 		rm.metrics.RecordAPICall("GET", "DescribeDBClusterParameters", err)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		for _, param := range resp.Parameters {
+			if param.ParameterName == nil {
+				continue
+			}
+			// Parameters without a value are neither overridden nor
+			// defaulted, and the ones with their engine default value are
+			// most of them, there's nothing to report about either.
+			if param.Source != nil && *param.Source != sourceEngineDefault && param.ParameterValue != nil {
+				paramSources[*param.Source] = append(paramSources[*param.Source], param.ParameterName)
+			}
+			if param.Source == nil || *param.Source != sourceUser {
+				continue
+			}
 			params[*param.ParameterName] = param.ParameterValue
 			p := svcapitypes.Parameter{
 				ParameterName:  param.ParameterName,
@@ -282,14 +549,55 @@ func (rm *resourceManager) getParameters(
 			paramStatuses = append(paramStatuses, &p)
 		}
 		marker = resp.Marker
-		if marker == nil {
+		if util.IsLastPage(marker) {
 			break
 		}
 	}
-	return params, paramStatuses, nil
+	return params, paramStatuses, paramSources, nil
+}
+
+// resetAllParametersRequested returns true if the reset-all-parameters
+// annotation of the supplied resource holds a different value than the last
+// time all the cluster parameter group's parameters were reset.
+func resetAllParametersRequested(r *resource) bool {
+	token, found := r.ko.Annotations[svcapitypes.ResetAllParametersAnnotation]
+	return found && token != r.ko.Annotations[svcapitypes.LastResetAllParametersAnnotation]
+}
+
+// setLastResetAllParameters records the value of the reset-all-parameters
+// annotation of the supplied resource, so that all the parameters are not
+// reset again until the annotation changes.
+func setLastResetAllParameters(r *resource) {
+	token, found := r.ko.Annotations[svcapitypes.ResetAllParametersAnnotation]
+	if !found {
+		return
+	}
+	r.ko.Annotations[svcapitypes.LastResetAllParametersAnnotation] = token
+}
+
+// resetAllParameters calls the RDS ResetDBClusterParameterGroup API call to reset
+// all the parameters of the cluster parameter group to their default values.
+func (rm *resourceManager) resetAllParameters(
+	ctx context.Context,
+	groupName *string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.resetAllParameters")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.ResetDBClusterParameterGroupWithContext(
+		ctx,
+		&svcsdk.ResetDBClusterParameterGroupInput{
+			DBClusterParameterGroupName: groupName,
+			ResetAllParameters:          aws.Bool(true),
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "ResetDBClusterParameterGroup", err)
+	return err
 }
 
-// resetParameters calls the RDS ResetDBClusterParameterGroup API call
+// resetParameters calls the RDS ResetDBClusterParameterGroup API call with a
+// set of no more than 20 parameters to reset.
 func (rm *resourceManager) resetParameters(
 	ctx context.Context,
 	family *string,
@@ -302,21 +610,30 @@ func (rm *resourceManager) resetParameters(
 
 	var pMeta *util.ParamMeta
 	inputParams := []*svcsdk.Parameter{}
-	for paramName, _ := range toDelete {
-		// default to this if something goes wrong looking up parameter
-		// defaults
-		applyMethod := svcsdk.ApplyMethodImmediate
+	for paramName, param := range toDelete {
+		// The parameter may no longer be part of the family's engine defaults
+		// (RDS occasionally retires parameters). We still want to get rid of
+		// the override in that case, so fall back to the apply method last
+		// used for the parameter, or to "pending-reboot" which RDS accepts
+		// for both static and dynamic parameters.
+		applyMethod := svcsdk.ApplyMethodPendingReboot
+		if param.ApplyMethod != nil {
+			applyMethod = *param.ApplyMethod
+		}
 		pMeta, err = cachedParamMeta.Get(
 			ctx, *family, paramName, rm.getFamilyParameters,
 		)
-		if err != nil {
+		if err != nil && !errors.Is(err, util.ErrUnknownParameter) {
 			return err
 		}
-		if !pMeta.IsModifiable {
-			return util.NewErrUnmodifiableParameter(paramName)
-		}
-		if !pMeta.IsDynamic {
-			applyMethod = svcsdk.ApplyMethodPendingReboot
+		if pMeta != nil {
+			if !pMeta.IsModifiable {
+				return util.NewErrUnmodifiableParameter(paramName)
+			}
+			applyMethod = svcsdk.ApplyMethodImmediate
+			if !pMeta.IsDynamic {
+				applyMethod = svcsdk.ApplyMethodPendingReboot
+			}
 		}
 		p := &svcsdk.Parameter{
 			ParameterName: aws.String(paramName),
@@ -343,7 +660,8 @@ func (rm *resourceManager) resetParameters(
 	return nil
 }
 
-// modifyParameters calls the RDS ModifyDBClusterParameterGroup API call
+// modifyParameters calls the RDS ModifyDBClusterParameterGroup API call with
+// a set of no more than 20 parameters to modify.
 func (rm *resourceManager) modifyParameters(
 	ctx context.Context,
 	family *string,
@@ -354,27 +672,12 @@ func (rm *resourceManager) modifyParameters(
 	exit := rlog.Trace("rm.modifyParameters")
 	defer func() { exit(err) }()
 
-	var pMeta *util.ParamMeta
 	inputParams := []*svcsdk.Parameter{}
-	for paramName, paramValue := range toModify {
-		// default to "immediate" if something goes wrong looking up defaults
-		applyMethod := svcsdk.ApplyMethodImmediate
-		pMeta, err = cachedParamMeta.Get(
-			ctx, *family, paramName, rm.getFamilyParameters,
-		)
-		if err != nil {
-			return err
-		}
-		if !pMeta.IsModifiable {
-			return util.NewErrUnmodifiableParameter(paramName)
-		}
-		if !pMeta.IsDynamic {
-			applyMethod = svcsdk.ApplyMethodPendingReboot
-		}
+	for paramName, param := range toModify {
 		p := &svcsdk.Parameter{
 			ParameterName:  aws.String(paramName),
-			ParameterValue: paramValue,
-			ApplyMethod:    aws.String(applyMethod),
+			ParameterValue: param.Value,
+			ApplyMethod:    param.ApplyMethod,
 		}
 		inputParams = append(inputParams, p)
 	}
@@ -412,23 +715,125 @@ func (rm *resourceManager) getFamilyParameters(
 			&svcsdk.DescribeEngineDefaultClusterParametersInput{
 				DBParameterGroupFamily: aws.String(family),
 				Marker:                 marker,
+				MaxRecords:             aws.Int64(util.MaxRecordsPerPage),
 			},
 		)
 		rm.metrics.RecordAPICall("GET", "DescribeEngineDefaultClusterParameters", err)
 		if err != nil {
 			return nil, err
 		}
+		if resp.EngineDefaults == nil {
+			break
+		}
 		for _, param := range resp.EngineDefaults.Parameters {
+			if param.ParameterName == nil {
+				continue
+			}
 			pName := *param.ParameterName
 			familyMeta[pName] = util.ParamMeta{
-				IsModifiable: *param.IsModifiable,
-				IsDynamic:    *param.ApplyType != applyTypeStatic,
+				IsModifiable:  aws.BoolValue(param.IsModifiable),
+				IsDynamic:     aws.StringValue(param.ApplyType) != util.ApplyTypeStatic,
+				DataType:      aws.StringValue(param.DataType),
+				AllowedValues: aws.StringValue(param.AllowedValues),
 			}
 		}
 		marker = resp.EngineDefaults.Marker
-		if marker == nil {
+		if util.IsLastPage(marker) {
 			break
 		}
 	}
 	return familyMeta, nil
 }
+
+// checkInstanceParameterGroupConsistency sets an ACK.Advisory condition on
+// the supplied resource when parameters set in both the DB cluster parameter
+// group and the DB parameter group in Spec.InstanceParameterGroupName hold
+// conflicting values. The check is best-effort: failing to read the DB
+// parameter group does not fail the reconciliation.
+func (rm *resourceManager) checkInstanceParameterGroupConsistency(
+	ctx context.Context,
+	ko *svcapitypes.DBClusterParameterGroup,
+) {
+	name := ko.Spec.InstanceParameterGroupName
+	var conflicts []string
+	if name != nil {
+		params, err := rm.getInstanceParameterGroupParameters(ctx, name)
+		if err != nil {
+			rlog := ackrtlog.FromContext(ctx)
+			rlog.Info(
+				"unable to check parameter consistency with DB parameter group",
+				"name", *name, "error", err,
+			)
+			return
+		}
+		conflicts = util.ConflictingParameters(ko.Spec.ParameterOverrides, params)
+	}
+	counterpart := ""
+	if name != nil {
+		counterpart = "DB parameter group " + *name
+	}
+	util.SetParameterConflicts(&resource{ko}, counterpart, conflicts)
+}
+
+// getInstanceParameterGroupParameters retrieves the user-defined parameters of the
+// DB parameter group with the supplied name.
+func (rm *resourceManager) getInstanceParameterGroupParameters(
+	ctx context.Context,
+	groupName *string,
+) (map[string]*string, error) {
+	var marker *string
+	params := make(map[string]*string)
+	for {
+		resp, err := rm.sdkapi.DescribeDBParametersWithContext(
+			ctx,
+			&svcsdk.DescribeDBParametersInput{
+				DBParameterGroupName: groupName,
+				Source:               aws.String(sourceUser),
+				Marker:               marker,
+				MaxRecords:           aws.Int64(util.MaxRecordsPerPage),
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "DescribeDBParameters", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, param := range resp.Parameters {
+			if param.ParameterName != nil {
+				params[*param.ParameterName] = param.ParameterValue
+			}
+		}
+		marker = resp.Marker
+		if util.IsLastPage(marker) {
+			break
+		}
+	}
+	return params, nil
+}
+
+// populateAdoptionFields takes the name of the supplied DB cluster parameter
+// group from its adoption fields when it sets the adopt-or-create adoption
+// policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"name": &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
+
+// SetEventRecorder sets the recorder the resource managers produced by the
+// factory emit Kubernetes Events with. It implements the
+// svcresource.EventRecorderSetter interface.
+func (f *resourceManagerFactory) SetEventRecorder(recorder record.EventRecorder) {
+	f.Lock()
+	defer f.Unlock()
+	f.eventRecorder = recorder
+}
@@ -61,6 +61,15 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
+	if err = validateDriftPolicy(r); err != nil {
+		return nil, err
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -128,12 +137,15 @@ func (rm *resourceManager) sdkFind(
 	}
 	if ko.Spec.Name != nil {
 		groupName := ko.Spec.Name
-		params, paramStatuses, err := rm.getParameters(ctx, groupName)
+		params, paramStatuses, paramSources, err := rm.getParameters(ctx, groupName)
 		if err != nil {
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
 		ko.Status.ParameterOverrideStatuses = paramStatuses
+		ko.Status.ParameterSources = paramSources
+		setParameterDrift(ko)
+		rm.checkInstanceParameterGroupConsistency(ctx, ko)
 	}
 
 	return &resource{ko}, nil
@@ -175,6 +187,12 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	// Reject unknown or unmodifiable parameter overrides before creating the
+	// parameter group, rather than after the group has been created.
+	if err = rm.validateParameters(ctx, desired); err != nil {
+		return nil, err
+	}
+
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -215,9 +233,11 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
-	if err = rm.syncParameters(ctx, desired, nil); err != nil {
+	if err = rm.syncParameters(ctx, &resource{ko}, nil); err != nil {
 		return nil, err
 	}
+	// A new parameter group has all its parameters set to their defaults.
+	setLastResetAllParameters(&resource{ko})
 
 	return &resource{ko}, nil
 }
@@ -0,0 +1,318 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusAvailable = "available"
+	StatusCreating  = "creating"
+	StatusDeleting  = "deleting"
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("DB cluster snapshot in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the DB cluster snapshot cannot be shared until it is available.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"DB cluster snapshot in '%s' state, cannot be shared until '%s'.",
+		status, StatusAvailable,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// snapshotAvailable returns true if the supplied DB cluster snapshot is in an
+// available status
+func snapshotAvailable(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusAvailable
+}
+
+// snapshotCreating returns true if the supplied DB cluster snapshot is in the
+// process of being created
+func snapshotCreating(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusCreating
+}
+
+// snapshotDeleting returns true if the supplied DB cluster snapshot is in the
+// process of being deleted
+func snapshotDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// compareSharedAccountIDs adds a difference to the delta if the desired
+// resource manages the shares of the DB cluster snapshot and the supplied
+// resources have different shared account IDs, regardless of their order
+func compareSharedAccountIDs(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.SharedAccountIDs == nil {
+		return
+	}
+	if !util.EqualStringSets(a.ko.Spec.SharedAccountIDs, b.ko.Spec.SharedAccountIDs) {
+		delta.Add("Spec.SharedAccountIDs", a.ko.Spec.SharedAccountIDs, b.ko.Spec.SharedAccountIDs)
+	}
+}
+
+// customUpdate syncs the tags and the shares of the DB cluster snapshot, the
+// only Spec fields of a DB cluster snapshot that can be modified.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	if snapshotDeleting(latest) {
+		msg := "DB cluster snapshot is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.SharedAccountIDs") {
+		if !snapshotAvailable(latest) {
+			msg := "DB cluster snapshot cannot be shared until it is available"
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+			return &resource{ko}, requeueWaitUntilCanModify(latest)
+		}
+		if err = rm.syncSharedAccountIDs(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	return &resource{ko}, nil
+}
+
+// getSharedAccountIDs returns the IDs of the AWS accounts the supplied DB
+// cluster snapshot is shared with, read from its restore attribute
+func (rm *resourceManager) getSharedAccountIDs(
+	ctx context.Context,
+	r *resource,
+) ([]*string, error) {
+	resp, err := rm.sdkapi.DescribeDBClusterSnapshotAttributesWithContext(
+		ctx,
+		&svcsdk.DescribeDBClusterSnapshotAttributesInput{
+			DBClusterSnapshotIdentifier: r.ko.Spec.DBClusterSnapshotIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "DescribeDBClusterSnapshotAttributes", err)
+	if err != nil {
+		return nil, err
+	}
+	ids := []*string{}
+	if resp.DBClusterSnapshotAttributesResult == nil {
+		return ids, nil
+	}
+	for _, attr := range resp.DBClusterSnapshotAttributesResult.DBClusterSnapshotAttributes {
+		if attr.AttributeName != nil && *attr.AttributeName == util.SnapshotAttributeRestore {
+			ids = append(ids, attr.AttributeValues...)
+		}
+	}
+	return ids, nil
+}
+
+// syncSharedAccountIDs shares the DB cluster snapshot with the desired AWS
+// accounts and stops sharing it with the other accounts
+func (rm *resourceManager) syncSharedAccountIDs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncSharedAccountIDs")
+	defer func() { exit(err) }()
+
+	if err = util.ValidateSharedAccountIDs(desired.ko.Spec.SharedAccountIDs); err != nil {
+		return err
+	}
+	toAdd, toRemove := util.StringSetsDelta(
+		desired.ko.Spec.SharedAccountIDs, latest.ko.Spec.SharedAccountIDs,
+	)
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+	rlog.Debug(
+		"modifying shares of DB cluster snapshot",
+		"accounts_to_add", toAdd, "accounts_to_remove", toRemove,
+	)
+	input := &svcsdk.ModifyDBClusterSnapshotAttributeInput{
+		AttributeName:               aws.String(util.SnapshotAttributeRestore),
+		DBClusterSnapshotIdentifier: desired.ko.Spec.DBClusterSnapshotIdentifier,
+	}
+	if len(toAdd) > 0 {
+		input.ValuesToAdd = toAdd
+	}
+	if len(toRemove) > 0 {
+		input.ValuesToRemove = toRemove
+	}
+	_, err = rm.sdkapi.ModifyDBClusterSnapshotAttributeWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBClusterSnapshotAttribute", err)
+	return err
+}
+
+// syncTags keeps the resource's tags in sync. See the db_proxy package for
+// the differences between the RDS tagging APIs and the other AWS APIs.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+
+	toAdd, toDelete := util.ComputeTagsDelta(
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+
+	if len(toDelete) > 0 {
+		rlog.Debug("removing tags from DB cluster snapshot", "tags", toDelete)
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: arn,
+				TagKeys:      toDelete,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	if len(toAdd) > 0 {
+		rlog.Debug("adding tags to DB cluster snapshot", "tags", toAdd)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: arn,
+				Tags:         sdkTagsFromResourceTags(toAdd),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := rm.sdkapi.ListTagsForResourceWithContext(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
+// array.
+func sdkTagsFromResourceTags(
+	rTags []*svcapitypes.Tag,
+) []*svcsdk.Tag {
+	tags := make([]*svcsdk.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = &svcsdk.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// populateAdoptionFields takes the dbClusterSnapshotIdentifier of the
+// supplied DB cluster snapshot from its adoption fields when it sets the
+// adopt-or-create adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"dbClusterSnapshotIdentifier": &r.ko.Spec.DBClusterSnapshotIdentifier,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
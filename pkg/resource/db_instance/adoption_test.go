@@ -0,0 +1,157 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtcache "github.com/aws-controllers-k8s/runtime/pkg/runtime/cache"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// fakeRDSAPI serves the DB instance to adopt, and panics on the RDS API
+// calls the adoption should not make
+type fakeRDSAPI struct {
+	svcsdkapi.RDSAPI
+	dbInstance *svcsdk.DBInstance
+}
+
+func (f *fakeRDSAPI) DescribeDBInstancesWithContext(
+	aws.Context, *svcsdk.DescribeDBInstancesInput, ...request.Option,
+) (*svcsdk.DescribeDBInstancesOutput, error) {
+	return &svcsdk.DescribeDBInstancesOutput{DBInstances: []*svcsdk.DBInstance{f.dbInstance}}, nil
+}
+
+func (f *fakeRDSAPI) ListTagsForResourceWithContext(
+	aws.Context, *svcsdk.ListTagsForResourceInput, ...request.Option,
+) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{}, nil
+}
+
+func (f *fakeRDSAPI) DescribePendingMaintenanceActionsWithContext(
+	aws.Context, *svcsdk.DescribePendingMaintenanceActionsInput, ...request.Option,
+) (*svcsdk.DescribePendingMaintenanceActionsOutput, error) {
+	return &svcsdk.DescribePendingMaintenanceActionsOutput{}, nil
+}
+
+func TestSyncAdoption(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        svcapitypes.DBInstanceSpec
+	}{
+		{
+			name: "adoption fields",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: util.AdoptionPolicyAdopt,
+				svcapitypes.AdoptionFieldsAnnotation: `{"dbInstanceIdentifier": "production"}`,
+			},
+		},
+		{
+			name: "spec identifier",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: util.AdoptionPolicyAdopt,
+			},
+			spec: svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("production")},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &svcapitypes.DBInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "production", Namespace: "default",
+					Annotations: tt.annotations,
+				},
+				Spec: tt.spec,
+			}
+			kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ko).Build()
+			util.SetKubeClient(kc)
+			defer util.SetKubeClient(nil)
+
+			rmf := newResourceManagerFactory()
+			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))
+			rm, err := newResourceManager(
+				ackcfg.Config{}, logr.Discard(), ackmetrics.NewMetrics("rds"), nil,
+				sess, "123456789012", "us-west-2",
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rm.sdkapi = &fakeRDSAPI{dbInstance: &svcsdk.DBInstance{
+				AvailabilityZone:     aws.String("us-west-2a"),
+				BackupTarget:         aws.String("region"),
+				DBInstanceArn:        aws.String("arn:aws:rds:us-west-2:123456789012:db:production"),
+				DBInstanceClass:      aws.String("db.m5.large"),
+				DBInstanceIdentifier: aws.String("production"),
+				DBInstanceStatus:     aws.String("available"),
+				Engine:               aws.String("postgres"),
+				NetworkType:          aws.String("IPV4"),
+			}}
+			sc := ackrt.NewServiceController("rds", "rds.services.k8s.aws", "rds", acktypes.VersionInfo{})
+			r := ackrt.NewReconcilerWithClient(
+				sc, kc, rmf, logr.Discard(), ackcfg.Config{}, ackmetrics.NewMetrics("rds"), ackrtcache.Caches{},
+			)
+
+			latest, err := r.Sync(context.TODO(), rm, &resource{ko.DeepCopy()})
+			if err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+			got := latest.(*resource).ko
+			for _, cond := range got.Status.Conditions {
+				if cond.Type == ackv1alpha1.ConditionTypeTerminal {
+					t.Fatalf("Sync() terminal condition: %s", aws.StringValue(cond.Message))
+				}
+			}
+			if got.Annotations[ackv1alpha1.AnnotationAdopted] != "true" {
+				t.Errorf("Sync() did not annotate the DBInstance as adopted")
+			}
+			if aws.StringValue(got.Spec.DBInstanceClass) != "db.m5.large" {
+				t.Errorf("Sync() dbInstanceClass = %q, want the adopted db.m5.large", aws.StringValue(got.Spec.DBInstanceClass))
+			}
+			stored := &svcapitypes.DBInstance{}
+			if err := kc.Get(context.TODO(), client.ObjectKeyFromObject(ko), stored); err != nil {
+				t.Fatal(err)
+			}
+			if !controllerutil.ContainsFinalizer(stored, finalizerString) {
+				t.Errorf("Sync() did not mark the DBInstance as managed")
+			}
+			if stored.Annotations[ackv1alpha1.AnnotationAdopted] != "true" {
+				t.Errorf("Sync() did not patch the DBInstance as adopted")
+			}
+		})
+	}
+}
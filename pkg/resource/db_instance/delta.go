@@ -42,6 +42,12 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	// The Spec of a resource adopting a DB instance is replaced by the
+	// configuration of the DB instance rather than compared to it
+	if adoptionRequested(a) {
+		delta.Add("Spec.Adoption", true, false)
+		return delta
+	}
 	// Do not consider any of the following fields for delta if they are missing in
 	// desired(a) but are present in latest(b) because each of these fields is
 	// late-initialized
@@ -72,14 +78,53 @@ func newResourceDelta(
 		b.ko.Spec.PerformanceInsightsKMSKeyID != nil {
 		a.ko.Spec.PerformanceInsightsKMSKeyID = b.ko.Spec.PerformanceInsightsKMSKeyID
 	}
+	if a.ko.Spec.CACertificateIdentifier == nil &&
+		b.ko.Spec.CACertificateIdentifier != nil {
+		a.ko.Spec.CACertificateIdentifier = b.ko.Spec.CACertificateIdentifier
+	}
+	if a.ko.Spec.CharacterSetName == nil &&
+		b.ko.Spec.CharacterSetName != nil {
+		a.ko.Spec.CharacterSetName = b.ko.Spec.CharacterSetName
+	}
+	if a.ko.Spec.NcharCharacterSetName == nil &&
+		b.ko.Spec.NcharCharacterSetName != nil {
+		a.ko.Spec.NcharCharacterSetName = b.ko.Spec.NcharCharacterSetName
+	}
+	if a.ko.Spec.DBSystemID == nil &&
+		b.ko.Spec.DBSystemID != nil {
+		a.ko.Spec.DBSystemID = b.ko.Spec.DBSystemID
+	}
 
 	// RDS will choose preferred engine minor version if only
 	// engine major version is provided and controler should not
 	// treat them as different, such as spec has 14, status has 14.1
 	// controller should treat them as same
 	reconcileEngineVersion(a, b)
+	// RDS returns the preferred backup and maintenance windows in their
+	// canonical form
+	reconcileWindows(a, b)
+	// RDS grows the allocated storage on its own when storage autoscaling is
+	// enabled, controller should not attempt to shrink it back
+	reconcileAllocatedStorage(a, b)
+	// RDS returns the IOPS and storage throughput of gp3 storage even when
+	// they are not specified
+	reconcileGP3Storage(a, b)
+	// RDS defaults the promotion tier of the DB instances of an Aurora DB
+	// cluster
+	reconcilePromotionTier(a, b)
 	compareTags(delta, a, b)
 	compareSecretReferenceChanges(delta, a, b)
+	compareMasterUserPasswordHash(delta, a, b)
+	compareMasterUserSecretRotation(delta, a, b)
+	compareActivityStream(delta, a, b)
+	compareAutomatedBackupsReplication(delta, a, b)
+	compareDesiredState(delta, a, b)
+	compareReboot(delta, a, b)
+	comparePromoteReadReplica(delta, a, b)
+	compareSwitchoverReadReplica(delta, a, b)
+	compareApplyPendingMaintenanceAction(delta, a, b)
+	compareRotateCACertificate(delta, a, b)
+	comparePauseAutomation(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage) {
 		delta.Add("Spec.AllocatedStorage", a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage)
@@ -206,6 +251,20 @@ func newResourceDelta(
 	if !reflect.DeepEqual(a.ko.Spec.DBSubnetGroupRef, b.ko.Spec.DBSubnetGroupRef) {
 		delta.Add("Spec.DBSubnetGroupRef", a.ko.Spec.DBSubnetGroupRef, b.ko.Spec.DBSubnetGroupRef)
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DBSystemID, b.ko.Spec.DBSystemID) {
+		delta.Add("Spec.DBSystemID", a.ko.Spec.DBSystemID, b.ko.Spec.DBSystemID)
+	} else if a.ko.Spec.DBSystemID != nil && b.ko.Spec.DBSystemID != nil {
+		if *a.ko.Spec.DBSystemID != *b.ko.Spec.DBSystemID {
+			delta.Add("Spec.DBSystemID", a.ko.Spec.DBSystemID, b.ko.Spec.DBSystemID)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DedicatedLogVolume, b.ko.Spec.DedicatedLogVolume) {
+		delta.Add("Spec.DedicatedLogVolume", a.ko.Spec.DedicatedLogVolume, b.ko.Spec.DedicatedLogVolume)
+	} else if a.ko.Spec.DedicatedLogVolume != nil && b.ko.Spec.DedicatedLogVolume != nil {
+		if *a.ko.Spec.DedicatedLogVolume != *b.ko.Spec.DedicatedLogVolume {
+			delta.Add("Spec.DedicatedLogVolume", a.ko.Spec.DedicatedLogVolume, b.ko.Spec.DedicatedLogVolume)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DeletionProtection, b.ko.Spec.DeletionProtection) {
 		delta.Add("Spec.DeletionProtection", a.ko.Spec.DeletionProtection, b.ko.Spec.DeletionProtection)
 	} else if a.ko.Spec.DeletionProtection != nil && b.ko.Spec.DeletionProtection != nil {
@@ -227,6 +286,27 @@ func newResourceDelta(
 			delta.Add("Spec.Domain", a.ko.Spec.Domain, b.ko.Spec.Domain)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DomainAuthSecretARN, b.ko.Spec.DomainAuthSecretARN) {
+		delta.Add("Spec.DomainAuthSecretARN", a.ko.Spec.DomainAuthSecretARN, b.ko.Spec.DomainAuthSecretARN)
+	} else if a.ko.Spec.DomainAuthSecretARN != nil && b.ko.Spec.DomainAuthSecretARN != nil {
+		if *a.ko.Spec.DomainAuthSecretARN != *b.ko.Spec.DomainAuthSecretARN {
+			delta.Add("Spec.DomainAuthSecretARN", a.ko.Spec.DomainAuthSecretARN, b.ko.Spec.DomainAuthSecretARN)
+		}
+	}
+	if len(a.ko.Spec.DomainDNSIPs) != len(b.ko.Spec.DomainDNSIPs) {
+		delta.Add("Spec.DomainDNSIPs", a.ko.Spec.DomainDNSIPs, b.ko.Spec.DomainDNSIPs)
+	} else if len(a.ko.Spec.DomainDNSIPs) > 0 {
+		if !ackcompare.SliceStringPEqual(a.ko.Spec.DomainDNSIPs, b.ko.Spec.DomainDNSIPs) {
+			delta.Add("Spec.DomainDNSIPs", a.ko.Spec.DomainDNSIPs, b.ko.Spec.DomainDNSIPs)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DomainFqdn, b.ko.Spec.DomainFqdn) {
+		delta.Add("Spec.DomainFqdn", a.ko.Spec.DomainFqdn, b.ko.Spec.DomainFqdn)
+	} else if a.ko.Spec.DomainFqdn != nil && b.ko.Spec.DomainFqdn != nil {
+		if *a.ko.Spec.DomainFqdn != *b.ko.Spec.DomainFqdn {
+			delta.Add("Spec.DomainFqdn", a.ko.Spec.DomainFqdn, b.ko.Spec.DomainFqdn)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DomainIAMRoleName, b.ko.Spec.DomainIAMRoleName) {
 		delta.Add("Spec.DomainIAMRoleName", a.ko.Spec.DomainIAMRoleName, b.ko.Spec.DomainIAMRoleName)
 	} else if a.ko.Spec.DomainIAMRoleName != nil && b.ko.Spec.DomainIAMRoleName != nil {
@@ -234,6 +314,13 @@ func newResourceDelta(
 			delta.Add("Spec.DomainIAMRoleName", a.ko.Spec.DomainIAMRoleName, b.ko.Spec.DomainIAMRoleName)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DomainOu, b.ko.Spec.DomainOu) {
+		delta.Add("Spec.DomainOu", a.ko.Spec.DomainOu, b.ko.Spec.DomainOu)
+	} else if a.ko.Spec.DomainOu != nil && b.ko.Spec.DomainOu != nil {
+		if *a.ko.Spec.DomainOu != *b.ko.Spec.DomainOu {
+			delta.Add("Spec.DomainOu", a.ko.Spec.DomainOu, b.ko.Spec.DomainOu)
+		}
+	}
 	if len(a.ko.Spec.EnableCloudwatchLogsExports) != len(b.ko.Spec.EnableCloudwatchLogsExports) {
 		delta.Add("Spec.EnableCloudwatchLogsExports", a.ko.Spec.EnableCloudwatchLogsExports, b.ko.Spec.EnableCloudwatchLogsExports)
 	} else if len(a.ko.Spec.EnableCloudwatchLogsExports) > 0 {
@@ -373,6 +460,9 @@ func newResourceDelta(
 			delta.Add("Spec.OptionGroupName", a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef) {
+		delta.Add("Spec.OptionGroupRef", a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.PerformanceInsightsEnabled, b.ko.Spec.PerformanceInsightsEnabled) {
 		delta.Add("Spec.PerformanceInsightsEnabled", a.ko.Spec.PerformanceInsightsEnabled, b.ko.Spec.PerformanceInsightsEnabled)
 	} else if a.ko.Spec.PerformanceInsightsEnabled != nil && b.ko.Spec.PerformanceInsightsEnabled != nil {
@@ -457,6 +547,9 @@ func newResourceDelta(
 			delta.Add("Spec.SourceDBInstanceIdentifier", a.ko.Spec.SourceDBInstanceIdentifier, b.ko.Spec.SourceDBInstanceIdentifier)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.SourceDBInstanceRef, b.ko.Spec.SourceDBInstanceRef) {
+		delta.Add("Spec.SourceDBInstanceRef", a.ko.Spec.SourceDBInstanceRef, b.ko.Spec.SourceDBInstanceRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion) {
 		delta.Add("Spec.SourceRegion", a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion)
 	} else if a.ko.Spec.SourceRegion != nil && b.ko.Spec.SourceRegion != nil {
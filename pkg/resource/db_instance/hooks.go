@@ -18,15 +18,24 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	svcsdkec2 "github.com/aws/aws-sdk-go/service/ec2"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
@@ -76,10 +85,30 @@ const (
 	StatusAutomationPaused                             = "automation-paused"
 )
 
+// The values of Spec.DesiredState
+const (
+	DesiredStateAvailable = "available"
+	DesiredStateStopped   = "stopped"
+)
+
 var (
 	ServiceDefaultBackupTarget            = "region"
 	ServiceDefaultNetworkType             = "IPV4"
 	ServiceDefaultInsightsRetentionPeriod = int64(7)
+	DefaultS3RestoreSourceEngine          = "mysql"
+)
+
+const (
+	StorageTypeGP3 = "gp3"
+	// The IOPS and storage throughput, in MiBps, included with gp3 storage.
+	// Below the engine-specific minimum allocated storage returned by
+	// gp3ProvisioningMinimumStorage, these cannot be modified.
+	GP3BaselineIOPS              = int64(3000)
+	GP3BaselineStorageThroughput = int64(125)
+	// The promotion tiers of the DB instances of an Aurora DB cluster, from
+	// the first to be promoted to the last one.
+	MinPromotionTier = int64(0)
+	MaxPromotionTier = int64(15)
 )
 
 var (
@@ -107,6 +136,26 @@ var (
 		errors.New("DB instance in 'deleting' state, cannot be modified or deleted."),
 		ackrequeue.DefaultRequeueAfterDuration,
 	)
+	requeueWaitWhileStopping = ackrequeue.NeededAfter(
+		errors.New("DB instance in 'stopping' state, waiting until 'stopped'."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileDeletionProtected = ackrequeue.NeededAfter(
+		errors.New("DB instance has deletion protection enabled, cannot be deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileConvertingMultiAZ = ackrequeue.NeededAfter(
+		errors.New("DB instance is being converted to or from a Multi-AZ deployment, cannot be modified."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitForSafetySnapshot = ackrequeue.NeededAfter(
+		errors.New("DB instance safety snapshot is not available yet, cannot be modified."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileChangingActivityStream = ackrequeue.NeededAfter(
+		errors.New("DB instance activity stream is being started or stopped, cannot be started or stopped."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
 )
 
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
@@ -201,6 +250,19 @@ func (rm *resourceManager) restoreDbInstanceFromDbSnapshot(
 	return &resource{r.ko}, nil
 }
 
+// sourceRegionFromARN returns the region of the supplied source DB instance
+// identifier when it is an ARN, or an empty string otherwise.
+func sourceRegionFromARN(identifier *string) string {
+	if identifier == nil || !arn.IsARN(*identifier) {
+		return ""
+	}
+	parsed, err := arn.Parse(*identifier)
+	if err != nil {
+		return ""
+	}
+	return parsed.Region
+}
+
 // newCreateDBInstanceReadReplicaInput returns a CreateDBInstanceReadReplicaInput object
 // with each the field set by the corresponding configuration's fields.
 // We copy the function here because currently we don't have logic to rename param
@@ -236,6 +298,9 @@ func newCreateDBInstanceReadReplicaInput(
 	if r.ko.Spec.DBSubnetGroupName != nil {
 		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
 	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
 	if r.ko.Spec.DeletionProtection != nil {
 		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
 	}
@@ -245,9 +310,27 @@ func newCreateDBInstanceReadReplicaInput(
 	if r.ko.Spec.Domain != nil {
 		res.SetDomain(*r.ko.Spec.Domain)
 	}
+	if r.ko.Spec.DomainAuthSecretARN != nil {
+		res.SetDomainAuthSecretArn(*r.ko.Spec.DomainAuthSecretARN)
+	}
+	if r.ko.Spec.DomainDNSIPs != nil {
+		f15 := []*string{}
+		for _, f15iter := range r.ko.Spec.DomainDNSIPs {
+			var f15elem string
+			f15elem = *f15iter
+			f15 = append(f15, &f15elem)
+		}
+		res.SetDomainDnsIps(f15)
+	}
+	if r.ko.Spec.DomainFqdn != nil {
+		res.SetDomainFqdn(*r.ko.Spec.DomainFqdn)
+	}
 	if r.ko.Spec.DomainIAMRoleName != nil {
 		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
 	}
+	if r.ko.Spec.DomainOu != nil {
+		res.SetDomainOu(*r.ko.Spec.DomainOu)
+	}
 	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
 		resf12 := []*string{}
 		for _, resf12iter := range r.ko.Spec.EnableCloudwatchLogsExports {
@@ -367,7 +450,20 @@ func (rm *resourceManager) createDBInstanceReadReplica(
 	exit := rlog.Trace("rm.createDBInstanceReadReplica")
 	defer func(err error) { exit(err) }(err)
 
-	resp, respErr := rm.sdkapi.CreateDBInstanceReadReplicaWithContext(ctx, newCreateDBInstanceReadReplicaInput(r))
+	input := newCreateDBInstanceReadReplicaInput(r)
+	// A source DB instance in another region is identified by its ARN, for
+	// instance when it is referenced through SourceDBInstanceRef. When no
+	// SourceRegion was given, use the region of the ARN so that the SDK
+	// generates the PreSignedUrl of the cross-region read replica. Note that
+	// RDS requires KMSKeyID, a KMS key of the destination region, when the
+	// source DB instance is encrypted.
+	if input.SourceRegion == nil {
+		if region := sourceRegionFromARN(input.SourceDBInstanceIdentifier); region != "" &&
+			region != string(rm.awsRegion) {
+			input.SetSourceRegion(region)
+		}
+	}
+	resp, respErr := rm.sdkapi.CreateDBInstanceReadReplicaWithContext(ctx, input)
 	rm.metrics.RecordAPICall("CREATE", "CreateDBInstanceReadReplica", respErr)
 	if respErr != nil {
 		return nil, respErr
@@ -390,16 +486,164 @@ func (rm *resourceManager) createDBInstanceReadReplica(
 // RDS will choose preferred engine minor version if only
 // engine major version is provided and controler should not
 // treat them as different, such as spec has 14, status has 14.1
-// controller should treat them as same
+// controller should treat them as same. Likewise, minor versions
+// RDS upgrades to on its own when AutoMinorVersionUpgrade is
+// enabled are not reverted.
 func reconcileEngineVersion(
 	a *resource,
 	b *resource,
 ) {
-	if a != nil && b != nil && a.ko.Spec.EngineVersion != nil && b.ko.Spec.EngineVersion != nil && strings.HasPrefix(*b.ko.Spec.EngineVersion, *a.ko.Spec.EngineVersion) {
+	if a == nil || b == nil || a.ko.Spec.Engine == nil ||
+		a.ko.Spec.EngineVersion == nil || b.ko.Spec.EngineVersion == nil {
+		return
+	}
+	autoMinorVersionUpgrade := a.ko.Spec.AutoMinorVersionUpgrade == nil || *a.ko.Spec.AutoMinorVersionUpgrade
+	if util.EngineVersionMatches(*a.ko.Spec.Engine, *a.ko.Spec.EngineVersion, *b.ko.Spec.EngineVersion, autoMinorVersionUpgrade) {
 		a.ko.Spec.EngineVersion = b.ko.Spec.EngineVersion
 	}
 }
 
+// RDS sets the promotion tier of the DB instances of an Aurora DB cluster to
+// 1 when it is not specified, and the promotion tier only applies to them.
+// Controller should not treat a missing desired promotion tier, or one set on
+// a DB instance outside of a DB cluster, as different.
+func reconcilePromotionTier(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil {
+		return
+	}
+	if a.ko.Spec.PromotionTier == nil || a.ko.Spec.DBClusterIdentifier == nil {
+		a.ko.Spec.PromotionTier = b.ko.Spec.PromotionTier
+	}
+}
+
+// validateCharacterSets returns a terminal error when the supplied resource
+// sets an NCHAR character set for an engine other than Oracle.
+func validateCharacterSets(r *resource) error {
+	if r.ko.Spec.NcharCharacterSetName == nil || r.ko.Spec.Engine == nil ||
+		strings.HasPrefix(*r.ko.Spec.Engine, "oracle") ||
+		strings.HasPrefix(*r.ko.Spec.Engine, "custom-oracle") {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"NCHAR character set %s only applies to Oracle DB instances, not to engine %s",
+		*r.ko.Spec.NcharCharacterSetName, *r.ko.Spec.Engine,
+	))
+}
+
+// validateCustomDBInstance returns a terminal error when the supplied
+// resource is an RDS Custom DB instance without the instance profile of its
+// EC2 instance, or sets an instance profile while it is not an RDS Custom DB
+// instance.
+func validateCustomDBInstance(r *resource) error {
+	if r.ko.Spec.Engine == nil {
+		return nil
+	}
+	return util.ValidateCustomDBInstance(*r.ko.Spec.Engine, r.ko.Spec.CustomIAMInstanceProfile)
+}
+
+// validatePromotionTier returns a terminal error when the promotion tier of
+// the supplied resource is not between 0, the highest priority, and 15.
+func validatePromotionTier(r *resource) error {
+	tier := r.ko.Spec.PromotionTier
+	if tier == nil || (*tier >= MinPromotionTier && *tier <= MaxPromotionTier) {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"promotion tier %d must be between %d and %d",
+		*tier, MinPromotionTier, MaxPromotionTier,
+	))
+}
+
+// When storage autoscaling is enabled through MaxAllocatedStorage, RDS grows
+// the allocated storage on its own. The storage of a DB instance can never be
+// reduced, so controller should treat a desired allocated storage below the
+// autoscaled one as the same instead of attempting to shrink it back
+func reconcileAllocatedStorage(
+	a *resource,
+	b *resource,
+) {
+	if a != nil && b != nil && a.ko.Spec.MaxAllocatedStorage != nil &&
+		a.ko.Spec.AllocatedStorage != nil && b.ko.Spec.AllocatedStorage != nil &&
+		*b.ko.Spec.AllocatedStorage > *a.ko.Spec.AllocatedStorage {
+		a.ko.Spec.AllocatedStorage = b.ko.Spec.AllocatedStorage
+	}
+}
+
+// storageTypeGP3 returns true if the supplied resource's storage type is gp3
+func storageTypeGP3(r *resource) bool {
+	return r.ko.Spec.StorageType != nil && *r.ko.Spec.StorageType == StorageTypeGP3
+}
+
+// RDS returns the IOPS and storage throughput of gp3 storage even when they
+// are not specified, either the baseline values or the provisioned ones.
+// Controller should not treat missing desired values as different, unless
+// the storage type is being changed away from gp3
+func reconcileGP3Storage(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil || !storageTypeGP3(b) {
+		return
+	}
+	if a.ko.Spec.StorageType != nil && !storageTypeGP3(a) {
+		return
+	}
+	if a.ko.Spec.IOPS == nil && b.ko.Spec.IOPS != nil {
+		a.ko.Spec.IOPS = b.ko.Spec.IOPS
+	}
+	if a.ko.Spec.StorageThroughput == nil && b.ko.Spec.StorageThroughput != nil {
+		a.ko.Spec.StorageThroughput = b.ko.Spec.StorageThroughput
+	}
+}
+
+// gp3ProvisioningMinimumStorage returns the allocated storage, in gibibytes,
+// from which the IOPS and storage throughput of gp3 storage can be
+// provisioned for the supplied engine. Below it, gp3 storage comes with
+// GP3BaselineIOPS and GP3BaselineStorageThroughput.
+//
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/CHAP_Storage.html#gp3-storage
+func gp3ProvisioningMinimumStorage(engine string) int64 {
+	switch {
+	case strings.HasPrefix(engine, "sqlserver"),
+		strings.HasPrefix(engine, "custom-sqlserver"):
+		return 0
+	case strings.HasPrefix(engine, "oracle"),
+		strings.HasPrefix(engine, "custom-oracle"):
+		return 200
+	}
+	return 400
+}
+
+// validateGP3Storage returns a terminal error when the supplied resource
+// provisions IOPS or storage throughput for gp3 storage that is smaller than
+// the engine-specific minimum allocated storage.
+func validateGP3Storage(r *resource) error {
+	if !storageTypeGP3(r) || r.ko.Spec.AllocatedStorage == nil ||
+		r.ko.Spec.Engine == nil {
+		return nil
+	}
+	iops := r.ko.Spec.IOPS
+	throughput := r.ko.Spec.StorageThroughput
+	if (iops == nil || *iops == GP3BaselineIOPS) &&
+		(throughput == nil || *throughput == GP3BaselineStorageThroughput) {
+		return nil
+	}
+	minimum := gp3ProvisioningMinimumStorage(*r.ko.Spec.Engine)
+	if *r.ko.Spec.AllocatedStorage < minimum {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"provisioning IOPS or storage throughput for gp3 storage "+
+				"requires at least %d GiB of allocated storage for engine %s, "+
+				"below it gp3 storage comes with %d IOPS and %d MiBps",
+			minimum, *r.ko.Spec.Engine,
+			GP3BaselineIOPS, GP3BaselineStorageThroughput,
+		))
+	}
+	return nil
+}
+
 // syncTags keeps the resource's tags in sync
 //
 // NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
@@ -565,3 +809,2656 @@ func compareSecretReferenceChanges(
 		delta.Add("Spec.MasterUserPassword", oldRef, newRef)
 	}
 }
+
+// setMasterUserPasswordHash records in the status of the supplied DB instance a
+// hash of the current master user password held by the Secret referenced by
+// Spec.MasterUserPassword or the Secrets Manager secret set in
+// Spec.MasterUserPasswordSecretARN. The value of the Secrets Manager secret is
+// only read when the secret has a new version since the hash was recorded, so
+// that reading the DB instance doesn't read the secret every time. The hash is
+// left untouched when the password cannot be read.
+func (rm *resourceManager) setMasterUserPasswordHash(
+	ctx context.Context,
+	r *resource,
+) {
+	var value string
+	var err error
+	switch {
+	case r.ko.Spec.MasterUserPassword != nil:
+		r.ko.Status.MasterUserPasswordSecretVersionID = nil
+		value, err = rm.rr.SecretValueFromReference(ctx, r.ko.Spec.MasterUserPassword)
+	case r.ko.Spec.MasterUserPasswordSecretARN != nil:
+		var version string
+		version, err = rm.getMasterUserPasswordSecretVersionID(ctx, r)
+		if err != nil {
+			return
+		}
+		if r.ko.Status.MasterUserPasswordHash != nil &&
+			aws.StringValue(r.ko.Status.MasterUserPasswordSecretVersionID) == version {
+			return
+		}
+		value, err = rm.getMasterUserPasswordFromSecretARN(ctx, r)
+		if err == nil {
+			r.ko.Status.MasterUserPasswordSecretVersionID = &version
+		}
+	default:
+		r.ko.Status.MasterUserPasswordHash = nil
+		r.ko.Status.MasterUserPasswordSecretVersionID = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	hash := util.SecretValueHash(string(r.ko.UID), value)
+	r.ko.Status.MasterUserPasswordHash = &hash
+}
+
+// compareMasterUserPasswordHash adds a difference to the supplied delta when
+// the master user password read from its Secret or Secrets Manager secret
+// changed since it was last applied to the DB instance, so that the update
+// applies the new password. A change to the Secret reconciles the DB instance
+// right away, while a change to the Secrets Manager secret is picked up on the
+// next reconciliation of the DB instance.
+func compareMasterUserPasswordHash(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	applied := desired.ko.Status.MasterUserPasswordHash
+	current := latest.ko.Status.MasterUserPasswordHash
+	// The first hash recorded, for instance for the DB instances created before
+	// hashes were recorded, is recorded without modifying the DB instance
+	if applied == nil || current == nil {
+		return
+	}
+	if *applied != *current {
+		delta.Add("Spec.MasterUserPassword", desired.ko.Spec.MasterUserPassword, latest.ko.Spec.MasterUserPassword)
+	}
+}
+
+// instanceStopped returns true if the supplied DB instance is stopped or in the
+// process of being stopped
+func instanceStopped(r *resource) bool {
+	if r.ko.Status.DBInstanceStatus == nil {
+		return false
+	}
+	dbis := *r.ko.Status.DBInstanceStatus
+	return dbis == StatusStopped || dbis == StatusStopping
+}
+
+// desiredState returns the state the supplied desired DB instance should be
+// in: Spec.DesiredState when set, otherwise the state its stop/start schedule
+// wants it in at the current time. nil is returned when neither has an
+// opinion.
+func desiredState(r *resource) (*string, error) {
+	if r.ko.Spec.DesiredState != nil {
+		return r.ko.Spec.DesiredState, nil
+	}
+	stop, scheduled, err := util.ScheduledStop(r.ko.Spec.Schedule, time.Now())
+	if err != nil || !scheduled {
+		return nil, err
+	}
+	state := DesiredStateAvailable
+	if stop {
+		state = DesiredStateStopped
+	}
+	return &state, nil
+}
+
+// instanceStoppedAsDesired returns true if the supplied DB instance is stopped
+// and the supplied desired resource should be stopped
+func instanceStoppedAsDesired(desired *resource, latest *resource) bool {
+	state, _ := desiredState(desired)
+	return state != nil && *state == DesiredStateStopped &&
+		latest.ko.Status.DBInstanceStatus != nil &&
+		*latest.ko.Status.DBInstanceStatus == StatusStopped
+}
+
+// compareDesiredState adds a difference to the supplied delta when the state
+// the desired resource should be in does not match the observed status of the
+// latest resource. A difference is also added when the stop/start schedule of
+// the desired resource is invalid so that the error gets reported by the
+// update.
+func compareDesiredState(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	state, err := desiredState(a)
+	if err != nil {
+		delta.Add("Spec.Schedule", a.ko.Spec.Schedule, b.ko.Spec.Schedule)
+		return
+	}
+	if state == nil {
+		return
+	}
+	stopped := instanceStopped(b)
+	switch *state {
+	case DesiredStateStopped:
+		if stopped {
+			return
+		}
+	case DesiredStateAvailable:
+		if !stopped {
+			return
+		}
+	}
+	observed := DesiredStateAvailable
+	if stopped {
+		observed = DesiredStateStopped
+	}
+	delta.Add("Spec.DesiredState", state, &observed)
+}
+
+// startDBInstance starts the supplied stopped DB instance
+func (rm *resourceManager) startDBInstance(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.startDBInstance")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.StartDBInstanceInput{
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+	}
+	resp, respErr := rm.sdkapi.StartDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "StartDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	r.ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "DB instance is being started"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitUntilCanModify(r)
+}
+
+// stopDBInstance stops the supplied available DB instance
+func (rm *resourceManager) stopDBInstance(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.stopDBInstance")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.StopDBInstanceInput{
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+	}
+	resp, respErr := rm.sdkapi.StopDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "StopDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	r.ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "DB instance is being stopped"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitWhileStopping
+}
+
+// rebootRequested returns true if the reboot or reboot-with-failover
+// annotations of the supplied resource are set to "true". The second return
+// value is true when the reboot must be conducted through a Multi-AZ
+// failover.
+func rebootRequested(r *resource) (bool, bool) {
+	failover := r.ko.Annotations[svcapitypes.RebootWithFailoverAnnotation] == "true"
+	reboot := r.ko.Annotations[svcapitypes.RebootAnnotation] == "true"
+	return reboot || failover, failover
+}
+
+// compareReboot adds a difference to the supplied delta when a reboot of the
+// desired resource is requested, so that the update issues it.
+func compareReboot(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if reboot, _ := rebootRequested(a); reboot {
+		// There is no Spec field for reboots, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.Reboot", true, false)
+	}
+}
+
+// rebootDBInstance reboots the supplied DB instance and returns a copy of the
+// resource with the reboot annotations removed and the reboot recorded in
+// Status.LastReboot. The error returned is nil on success, so that the
+// removal of the annotations is persisted.
+func (rm *resourceManager) rebootDBInstance(
+	ctx context.Context,
+	r *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rebootDBInstance")
+	defer func(err error) { exit(err) }(err)
+
+	_, failover := rebootRequested(r)
+	input := &svcsdk.RebootDBInstanceInput{
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+	}
+	if failover {
+		input.ForceFailover = &failover
+	}
+	resp, respErr := rm.sdkapi.RebootDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "RebootDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := r.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.RebootAnnotation)
+	delete(ko.Annotations, svcapitypes.RebootWithFailoverAnnotation)
+	now := metav1.Now()
+	ko.Status.LastReboot = &svcapitypes.RebootRecord{
+		RebootedAt:    &now,
+		ForceFailover: &failover,
+	}
+	ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "DB instance is being rebooted"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// promotionRequested returns true if the promote-read-replica annotation of
+// the supplied resource is set to "true".
+func promotionRequested(r *resource) bool {
+	return r.ko.Annotations[svcapitypes.PromoteReadReplicaAnnotation] == "true"
+}
+
+// comparePromoteReadReplica adds a difference to the supplied delta when the
+// promotion of the desired read replica is requested, so that the update
+// issues it.
+func comparePromoteReadReplica(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if promotionRequested(a) {
+		// There is no Spec field for promotions, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.PromoteReadReplica", true, false)
+	}
+}
+
+// promoteReadReplica promotes the supplied read replica to a standalone DB
+// instance, with the backup retention period and backup window of the Spec
+// since they only apply once the DB instance is promoted. It returns a copy
+// of the resource with the promote-read-replica annotation and the Spec fields
+// naming the source DB instance removed, so that the resource is reconciled
+// as a standalone DB instance from then on. The error returned is nil on
+// success, so that these removals are persisted.
+func (rm *resourceManager) promoteReadReplica(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.promoteReadReplica")
+	defer func(err error) { exit(err) }(err)
+
+	ko := desired.ko.DeepCopy()
+	// The DB instance may have been promoted already, for instance outside of
+	// the rds-controller, in which case only the resource is updated.
+	if latest.ko.Status.ReadReplicaSourceDBInstanceIdentifier != nil {
+		input := &svcsdk.PromoteReadReplicaInput{
+			DBInstanceIdentifier:  desired.ko.Spec.DBInstanceIdentifier,
+			BackupRetentionPeriod: desired.ko.Spec.BackupRetentionPeriod,
+			PreferredBackupWindow: desired.ko.Spec.PreferredBackupWindow,
+		}
+		resp, respErr := rm.sdkapi.PromoteReadReplicaWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "PromoteReadReplica", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	}
+	delete(ko.Annotations, svcapitypes.PromoteReadReplicaAnnotation)
+	clearReadReplicaSource(ko)
+	msg := "DB instance is being promoted"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// clearReadReplicaSource removes the Spec fields naming the source DB
+// instance of a read replica from the supplied DB instance.
+func clearReadReplicaSource(ko *svcapitypes.DBInstance) {
+	ko.Spec.SourceDBInstanceIdentifier = nil
+	ko.Spec.SourceDBInstanceRef = nil
+	ko.Spec.SourceRegion = nil
+	ko.Spec.PreSignedURL = nil
+	ko.Spec.ReplicaMode = nil
+}
+
+// switchoverRequested returns true if the switchover-read-replica annotation
+// of the supplied resource is set to "true".
+func switchoverRequested(r *resource) bool {
+	return r.ko.Annotations[svcapitypes.SwitchoverReadReplicaAnnotation] == "true"
+}
+
+// compareSwitchoverReadReplica adds a difference to the supplied delta when
+// the switchover of the desired read replica is requested, so that the
+// update issues it.
+func compareSwitchoverReadReplica(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if switchoverRequested(a) {
+		// There is no Spec field for switchovers, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.SwitchoverReadReplica", true, false)
+	}
+}
+
+// switchoverReadReplica switches the supplied read replica over to the
+// primary DB instance. It returns a copy of the resource with the
+// switchover-read-replica annotation and the Spec fields naming the source DB
+// instance removed, and with a Switchover condition reporting the switchover
+// in progress. The error returned is nil on success, so that these changes
+// are persisted.
+func (rm *resourceManager) switchoverReadReplica(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.switchoverReadReplica")
+	defer func(err error) { exit(err) }(err)
+
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.SwitchoverReadReplicaAnnotation)
+	if latest.ko.Status.ReadReplicaSourceDBInstanceIdentifier == nil {
+		// Only read replicas can be switched over
+		msg := "DB instance is not a read replica"
+		util.SetSwitchover(&resource{ko}, corev1.ConditionFalse, util.ReasonSwitchoverFailed, msg)
+		return &resource{ko}, nil
+	}
+	source := *latest.ko.Status.ReadReplicaSourceDBInstanceIdentifier
+	input := &svcsdk.SwitchoverReadReplicaInput{
+		DBInstanceIdentifier: desired.ko.Spec.DBInstanceIdentifier,
+	}
+	resp, respErr := rm.sdkapi.SwitchoverReadReplicaWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "SwitchoverReadReplica", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	clearReadReplicaSource(ko)
+	ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "switching over from primary DB instance " + source
+	util.SetSwitchover(&resource{ko}, corev1.ConditionUnknown, util.ReasonSwitchoverInProgress, msg)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// setSwitchoverProgress marks the switchover in progress of the supplied DB
+// instance as completed once the DB instance is an available primary DB
+// instance.
+func setSwitchoverProgress(r *resource) {
+	cond := util.GetSwitchover(r)
+	if cond == nil || cond.Reason == nil ||
+		*cond.Reason != util.ReasonSwitchoverInProgress {
+		return
+	}
+	if instanceAvailable(r) && r.ko.Status.ReadReplicaSourceDBInstanceIdentifier == nil {
+		msg := "DB instance is the primary DB instance"
+		util.SetSwitchover(r, corev1.ConditionTrue, util.ReasonSwitchoverCompleted, msg)
+	}
+}
+
+// multiAZConversionDeferred returns true if the supplied resource defers its
+// conversion to or from a Multi-AZ deployment to the maintenance window.
+func multiAZConversionDeferred(r *resource) bool {
+	timing := r.ko.Spec.ModificationTiming
+	return timing != nil && timing.MultiAZ != nil &&
+		*timing.MultiAZ == svcapitypes.ModificationTimingMaintenanceWindow
+}
+
+// multiAZConversionInProgress returns true if the supplied DB instance is
+// being converted to or from a Multi-AZ deployment.
+func multiAZConversionInProgress(r *resource) bool {
+	cond := util.GetMultiAZConversion(r)
+	return cond != nil && cond.Reason != nil &&
+		*cond.Reason == util.ReasonMultiAZConversionInProgress
+}
+
+// multiAZDeployment returns the name of the deployment a DB instance is in
+// when its MultiAZ field is set to the supplied value.
+func multiAZDeployment(multiAZ *bool) string {
+	if multiAZ != nil && *multiAZ {
+		return "Multi-AZ deployment"
+	}
+	return "Single-AZ deployment"
+}
+
+// deferredModifications returns the Spec fields whose modifications the
+// supplied resource defers to the maintenance window.
+func deferredModifications(r *resource) []string {
+	timing := r.ko.Spec.ModificationTiming
+	if timing == nil {
+		return nil
+	}
+	deferred := func(t *string) bool {
+		return t != nil && *t == svcapitypes.ModificationTimingMaintenanceWindow
+	}
+	var fields []string
+	if deferred(timing.EngineVersion) {
+		fields = append(fields, "Spec.EngineVersion")
+	}
+	if deferred(timing.InstanceClass) {
+		fields = append(fields, "Spec.DBInstanceClass")
+	}
+	if deferred(timing.MultiAZ) {
+		fields = append(fields, "Spec.MultiAZ")
+	}
+	if deferred(timing.Storage) {
+		fields = append(fields,
+			"Spec.AllocatedStorage",
+			"Spec.IOPS",
+			"Spec.StorageThroughput",
+			"Spec.StorageType",
+		)
+	}
+	return fields
+}
+
+// modificationsDeferred returns true if the supplied delta contains
+// modifications the desired resource defers to the maintenance window, and no
+// other modification issued through ModifyDBInstance.
+func modificationsDeferred(desired *resource, delta *ackcompare.Delta) bool {
+	deferred := deferredModifications(desired)
+	for _, field := range deferred {
+		if delta.DifferentAt(field) {
+			return !delta.DifferentExcept(
+				append(deferred, "Spec.Tags", "Spec.Reboot", "Spec.DesiredState")...,
+			)
+		}
+	}
+	return false
+}
+
+// excludeDeferredModifications removes the modifications the desired resource
+// defers to the maintenance window from the supplied ModifyDBInstance input.
+func excludeDeferredModifications(
+	desired *resource,
+	input *svcsdk.ModifyDBInstanceInput,
+) {
+	for _, field := range deferredModifications(desired) {
+		switch field {
+		case "Spec.AllocatedStorage":
+			input.AllocatedStorage = nil
+		case "Spec.DBInstanceClass":
+			input.DBInstanceClass = nil
+		case "Spec.EngineVersion":
+			input.EngineVersion = nil
+		case "Spec.IOPS":
+			input.Iops = nil
+		case "Spec.MultiAZ":
+			input.MultiAZ = nil
+		case "Spec.StorageThroughput":
+			input.StorageThroughput = nil
+		case "Spec.StorageType":
+			input.StorageType = nil
+		}
+	}
+}
+
+// deferModifications requests the modifications of the supplied delta, all
+// deferred by the desired resource, to be applied during the next maintenance
+// window of the DB instance. The upgrade family is the DB parameter group
+// family returned by validateEngineVersionUpgrade.
+func (rm *resourceManager) deferModifications(
+	ctx context.Context,
+	r *resource,
+	delta *ackcompare.Delta,
+	upgradeFamily string,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deferModifications")
+	defer func(err error) { exit(err) }(err)
+
+	applyImmediately := false
+	input := &svcsdk.ModifyDBInstanceInput{
+		ApplyImmediately:     &applyImmediately,
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+	}
+	if delta.DifferentAt("Spec.DBInstanceClass") {
+		input.DBInstanceClass = r.ko.Spec.DBInstanceClass
+	}
+	if delta.DifferentAt("Spec.EngineVersion") {
+		allowMajorVersionUpgrade := true
+		input.AllowMajorVersionUpgrade = &allowMajorVersionUpgrade
+		input.EngineVersion = r.ko.Spec.EngineVersion
+		input.DBParameterGroupName = majorVersionUpgradeParameterGroup(r, upgradeFamily)
+	}
+	if delta.DifferentAt("Spec.MultiAZ") {
+		input.MultiAZ = r.ko.Spec.MultiAZ
+	}
+	if delta.DifferentAt("Spec.AllocatedStorage") || delta.DifferentAt("Spec.IOPS") ||
+		delta.DifferentAt("Spec.StorageThroughput") || delta.DifferentAt("Spec.StorageType") {
+		input.AllocatedStorage = r.ko.Spec.AllocatedStorage
+		input.Iops = r.ko.Spec.IOPS
+		input.StorageThroughput = r.ko.Spec.StorageThroughput
+		input.StorageType = r.ko.Spec.StorageType
+	}
+	_, respErr := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := r.ko.DeepCopy()
+	msg := "DB instance modifications are applied during the next maintenance window"
+	if input.MultiAZ != nil {
+		conversionMsg := "DB instance is converted to a " + multiAZDeployment(r.ko.Spec.MultiAZ) +
+			" during the next maintenance window"
+		util.SetMultiAZConversion(&resource{ko}, corev1.ConditionFalse, util.ReasonMultiAZConversionPending, conversionMsg)
+	}
+	if input.EngineVersion != nil && upgradeFamily != "" {
+		setMajorVersionUpgradeStarted(&resource{ko}, true)
+	}
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// setMultiAZConversionStarted reports in the MultiAZConversion condition that
+// the supplied DB instance is being converted to the deployment of its
+// Spec.MultiAZ.
+func setMultiAZConversionStarted(r *resource) {
+	msg := "DB instance is being converted to a " + multiAZDeployment(r.ko.Spec.MultiAZ)
+	util.SetMultiAZConversion(r, corev1.ConditionUnknown, util.ReasonMultiAZConversionInProgress, msg)
+}
+
+// setMultiAZConversionProgress reports the progress of the conversion of the
+// supplied DB instance to or from a Multi-AZ deployment in the
+// MultiAZConversion condition.
+func setMultiAZConversionProgress(r *resource) {
+	pmv := r.ko.Status.PendingModifiedValues
+	cond := util.GetMultiAZConversion(r)
+	switch {
+	case pmv != nil && pmv.MultiAZ != nil:
+		deployment := multiAZDeployment(pmv.MultiAZ)
+		if instanceAvailable(r) && !multiAZConversionInProgress(r) {
+			msg := "DB instance is converted to a " + deployment +
+				" during the next maintenance window"
+			util.SetMultiAZConversion(r, corev1.ConditionFalse, util.ReasonMultiAZConversionPending, msg)
+			return
+		}
+		msg := "DB instance is being converted to a " + deployment
+		util.SetMultiAZConversion(r, corev1.ConditionUnknown, util.ReasonMultiAZConversionInProgress, msg)
+	case cond != nil && cond.Status != corev1.ConditionTrue && instanceAvailable(r):
+		msg := "DB instance was converted to a " + multiAZDeployment(r.ko.Spec.MultiAZ)
+		util.SetMultiAZConversion(r, corev1.ConditionTrue, util.ReasonMultiAZConversionCompleted, msg)
+	}
+}
+
+// validateRestoreToPointInTime returns a terminal error when the supplied
+// resource's point in time restore does not name exactly one source DB
+// instance and exactly one point in time.
+func validateRestoreToPointInTime(r *resource) error {
+	pitr := r.ko.Spec.RestoreToPointInTime
+	sources := 0
+	for _, source := range []*string{
+		pitr.SourceDBInstanceIdentifier,
+		pitr.SourceDBInstanceAutomatedBackupsARN,
+		pitr.SourceDBIResourceID,
+	} {
+		if source != nil {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return ackerr.NewTerminalError(errors.New(
+			"exactly one of sourceDBInstanceIdentifier, " +
+				"sourceDBInstanceAutomatedBackupsARN and sourceDBIResourceID " +
+				"must be set in restoreToPointInTime",
+		))
+	}
+	useLatest := pitr.UseLatestRestorableTime != nil && *pitr.UseLatestRestorableTime
+	if (pitr.RestoreTime != nil) == useLatest {
+		return ackerr.NewTerminalError(errors.New(
+			"exactly one of restoreTime and useLatestRestorableTime " +
+				"must be set in restoreToPointInTime",
+		))
+	}
+	return nil
+}
+
+// validateAutomatedBackupRestore returns an error when the automated backups
+// the supplied resource's point in time restore identifies by ARN or by
+// resource ID, which are the ones of a deleted DB instance when they were
+// retained, cannot be restored to the requested point in time. The automated
+// backups of a source DB instance identified by its identifier are checked by
+// RestoreDBInstanceToPointInTime itself.
+func (rm *resourceManager) validateAutomatedBackupRestore(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateAutomatedBackupRestore")
+	defer func() { exit(err) }()
+
+	pitr := r.ko.Spec.RestoreToPointInTime
+	if pitr.SourceDBInstanceAutomatedBackupsARN == nil && pitr.SourceDBIResourceID == nil {
+		return nil
+	}
+	resp, err := rm.sdkapi.DescribeDBInstanceAutomatedBackupsWithContext(
+		ctx,
+		&svcsdk.DescribeDBInstanceAutomatedBackupsInput{
+			DBInstanceAutomatedBackupsArn: pitr.SourceDBInstanceAutomatedBackupsARN,
+			DbiResourceId:                 pitr.SourceDBIResourceID,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBInstanceAutomatedBackups", err)
+	if err != nil {
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "DBInstanceAutomatedBackupNotFound" {
+			return ackerr.NewTerminalError(util.ErrAutomatedBackupNotFound)
+		}
+		return err
+	}
+	var restoreTime *time.Time
+	if pitr.RestoreTime != nil {
+		restoreTime = &pitr.RestoreTime.Time
+	}
+	return util.ValidateAutomatedBackupRestore(resp.DBInstanceAutomatedBackups, restoreTime)
+}
+
+// restoreDBInstanceToPointInTime creates the DB instance by restoring the
+// source DB instance of the supplied resource's Spec.RestoreToPointInTime.
+func (rm *resourceManager) restoreDBInstanceToPointInTime(
+	ctx context.Context,
+	r *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.restoreDBInstanceToPointInTime")
+	defer func(err error) { exit(err) }(err)
+
+	if err = validateRestoreToPointInTime(r); err != nil {
+		return nil, err
+	}
+	if err = rm.validateAutomatedBackupRestore(ctx, r); err != nil {
+		return nil, err
+	}
+	pitr := r.ko.Spec.RestoreToPointInTime
+	input := rm.newRestoreDBInstanceToPointInTimeInput(r)
+	input.TargetDBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
+	input.SourceDBInstanceIdentifier = pitr.SourceDBInstanceIdentifier
+	input.SourceDBInstanceAutomatedBackupsArn = pitr.SourceDBInstanceAutomatedBackupsARN
+	input.SourceDbiResourceId = pitr.SourceDBIResourceID
+	if pitr.RestoreTime != nil {
+		restoreTime := pitr.RestoreTime.Time
+		input.RestoreTime = &restoreTime
+	}
+	input.UseLatestRestorableTime = pitr.UseLatestRestorableTime
+
+	resp, respErr := rm.sdkapi.RestoreDBInstanceToPointInTimeWithContext(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "RestoreDBInstanceToPointInTime", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	desired := r.ko.Spec.DeepCopy()
+	rm.setResourceFromRestoreDBInstanceToPointInTimeOutput(r, resp)
+	rm.setStatusDefaults(r.ko)
+	keepModifiableSpecAfterRestore(&r.ko.Spec, desired)
+
+	// We expect the DB instance to be in 'creating' status since we just
+	// issued the call to create it, but I suppose it doesn't hurt to check
+	// here.
+	if instanceCreating(&resource{r.ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{r.ko}, corev1.ConditionFalse, nil, nil)
+	}
+	return &resource{r.ko}, nil
+}
+
+// keepModifiableSpecAfterRestore sets back the desired values of the fields
+// the RestoreDBInstanceToPointInTime API call does not support, which would
+// otherwise be overwritten by the values of the restored DB instance. Once
+// the restored DB instance is available, these fields are set with
+// ModifyDBInstance like any other modification.
+func keepModifiableSpecAfterRestore(
+	spec *svcapitypes.DBInstanceSpec,
+	desired *svcapitypes.DBInstanceSpec,
+) {
+	if desired.BackupRetentionPeriod != nil {
+		spec.BackupRetentionPeriod = desired.BackupRetentionPeriod
+	}
+	if desired.CACertificateIdentifier != nil {
+		spec.CACertificateIdentifier = desired.CACertificateIdentifier
+	}
+	if desired.EngineVersion != nil {
+		spec.EngineVersion = desired.EngineVersion
+	}
+	if desired.MonitoringInterval != nil {
+		spec.MonitoringInterval = desired.MonitoringInterval
+	}
+	if desired.MonitoringRoleARN != nil {
+		spec.MonitoringRoleARN = desired.MonitoringRoleARN
+	}
+	if desired.PerformanceInsightsEnabled != nil {
+		spec.PerformanceInsightsEnabled = desired.PerformanceInsightsEnabled
+	}
+	if desired.PerformanceInsightsKMSKeyID != nil {
+		spec.PerformanceInsightsKMSKeyID = desired.PerformanceInsightsKMSKeyID
+	}
+	if desired.PerformanceInsightsRetentionPeriod != nil {
+		spec.PerformanceInsightsRetentionPeriod = desired.PerformanceInsightsRetentionPeriod
+	}
+	if desired.PreferredBackupWindow != nil {
+		spec.PreferredBackupWindow = desired.PreferredBackupWindow
+	}
+	if desired.PreferredMaintenanceWindow != nil {
+		spec.PreferredMaintenanceWindow = desired.PreferredMaintenanceWindow
+	}
+	if desired.PromotionTier != nil {
+		spec.PromotionTier = desired.PromotionTier
+	}
+}
+
+// restoreDBInstanceFromS3 creates the DB instance by restoring the Amazon S3
+// backup of the supplied resource's Spec.S3Restore.
+func (rm *resourceManager) restoreDBInstanceFromS3(
+	ctx context.Context,
+	r *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.restoreDBInstanceFromS3")
+	defer func(err error) { exit(err) }(err)
+
+	input, err := rm.newRestoreDBInstanceFromS3Input(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if r.ko.Spec.MasterUserPasswordSecretARN != nil {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
+	s3Restore := r.ko.Spec.S3Restore
+	input.S3BucketName = s3Restore.S3BucketName
+	input.S3Prefix = s3Restore.S3Prefix
+	input.S3IngestionRoleArn = s3Restore.S3IngestionRoleARN
+	input.SourceEngine = s3Restore.SourceEngine
+	if input.SourceEngine == nil {
+		sourceEngine := DefaultS3RestoreSourceEngine
+		input.SourceEngine = &sourceEngine
+	}
+	input.SourceEngineVersion = s3Restore.SourceEngineVersion
+
+	resp, respErr := rm.sdkapi.RestoreDBInstanceFromS3WithContext(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "RestoreDBInstanceFromS3", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	rm.setResourceFromRestoreDBInstanceFromS3Output(r, resp)
+	rm.setStatusDefaults(r.ko)
+	// set the last-applied-secret-reference annotation on the DB instance
+	// resource.
+	setLastAppliedSecretReferenceAnnotation(r)
+
+	// We expect the DB instance to be in 'creating' status since we just
+	// issued the call to create it, but I suppose it doesn't hurt to check
+	// here.
+	if instanceCreating(&resource{r.ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{r.ko}, corev1.ConditionFalse, nil, nil)
+	}
+	return &resource{r.ko}, nil
+}
+
+// finalSnapshotPossible returns true if a final DB snapshot can be taken
+// before deleting the supplied DB instance. DB instances of a DB cluster are
+// backed up by the DB cluster snapshots and read replicas cannot have DB
+// snapshots.
+func finalSnapshotPossible(r *resource) bool {
+	if r.ko.Spec.DBClusterIdentifier != nil ||
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier != nil {
+		return false
+	}
+	if r.ko.Status.DBInstanceStatus == nil {
+		return true
+	}
+	dbis := *r.ko.Status.DBInstanceStatus
+	for _, s := range UnableToFinalSnapshotStatuses {
+		if dbis == s {
+			return false
+		}
+	}
+	return true
+}
+
+// validateDeletion returns a terminal error when the deletion policy of the
+// supplied resource is invalid or its final snapshot identifier template does
+// not render into a valid identifier.
+func validateDeletion(r *resource) error {
+	if _, err := util.DeletionPolicy(r.ko.Spec.DeletionPolicy); err != nil {
+		return err
+	}
+	if r.ko.Spec.FinalSnapshotIdentifierTemplate == nil || r.ko.Spec.DBInstanceIdentifier == nil {
+		return nil
+	}
+	_, err := util.FinalSnapshotIdentifier(
+		r.ko.Spec.FinalSnapshotIdentifierTemplate, r.ko,
+		*r.ko.Spec.DBInstanceIdentifier, time.Now(),
+	)
+	return err
+}
+
+// validateWindows returns a terminal error when the preferred backup or
+// maintenance window of the supplied resource is not valid, or when they
+// overlap.
+func validateWindows(r *resource) error {
+	return util.ValidateWindows(
+		r.ko.Spec.PreferredBackupWindow, r.ko.Spec.PreferredMaintenanceWindow,
+	)
+}
+
+// validateDomain returns a terminal error when the supplied resource joins
+// both an Amazon Web Services Managed Microsoft AD directory and a
+// self-managed Active Directory, or when its self-managed Active Directory is
+// incomplete.
+func validateDomain(r *resource) error {
+	return util.ValidateDomain(r.ko.Spec.Domain, util.SelfManagedDomain{
+		FQDN:          r.ko.Spec.DomainFqdn,
+		OU:            r.ko.Spec.DomainOu,
+		AuthSecretARN: r.ko.Spec.DomainAuthSecretARN,
+		DNSIPs:        r.ko.Spec.DomainDNSIPs,
+	})
+}
+
+// setDomainFromMembership sets the domain fields of the Spec of the supplied
+// latest DB instance that are set in the supplied desired DB instance from
+// the domain membership reported in its Status.DomainMemberships, so that
+// changes of the domain are applied. The fields are cleared when the DB
+// instance is not a member of a domain. The fields the desired DB instance
+// leaves unset, like the FQDN RDS reports for an Amazon Web Services Managed
+// Microsoft AD directory, are left untouched.
+func setDomainFromMembership(
+	desired *resource,
+	latest *resource,
+) {
+	membership := &svcapitypes.DomainMembership{}
+	if len(latest.ko.Status.DomainMemberships) > 0 {
+		membership = latest.ko.Status.DomainMemberships[0]
+	}
+	spec := &latest.ko.Spec
+	if desired.ko.Spec.Domain != nil {
+		spec.Domain = membership.Domain
+	}
+	if desired.ko.Spec.DomainIAMRoleName != nil {
+		spec.DomainIAMRoleName = membership.IAMRoleName
+	}
+	if desired.ko.Spec.DomainFqdn != nil {
+		spec.DomainFqdn = membership.FQDN
+	}
+	if desired.ko.Spec.DomainOu != nil {
+		spec.DomainOu = membership.OU
+	}
+	if desired.ko.Spec.DomainAuthSecretARN != nil {
+		spec.DomainAuthSecretARN = membership.AuthSecretARN
+	}
+	if desired.ko.Spec.DomainDNSIPs != nil {
+		spec.DomainDNSIPs = membership.DNSIPs
+	}
+}
+
+// domainChanged returns true if the supplied delta contains a difference in
+// the domain of the DB instance.
+func domainChanged(delta *ackcompare.Delta) bool {
+	return delta.DifferentAt("Spec.Domain") ||
+		delta.DifferentAt("Spec.DomainIAMRoleName") ||
+		delta.DifferentAt("Spec.DomainFqdn") ||
+		delta.DifferentAt("Spec.DomainOu") ||
+		delta.DifferentAt("Spec.DomainAuthSecretARN") ||
+		delta.DifferentAt("Spec.DomainDNSIPs")
+}
+
+// validateMasterUserSecret returns a terminal error when the master user
+// password of the supplied resource is set in more than one way, or copied to
+// a Secret without being managed in Secrets Manager.
+func validateMasterUserSecret(r *resource) error {
+	return util.ValidateMasterUserSecret(
+		r.ko.Spec.ManageMasterUserPassword,
+		r.ko.Spec.MasterUserPassword,
+		r.ko.Spec.MasterUserPasswordSecretARN,
+		r.ko.Spec.MasterUserSecretDestination,
+	)
+}
+
+// copyMasterUserSecret copies the master user password of the supplied
+// DB instance, managed by RDS in Secrets Manager, to the key of the Kubernetes
+// Secret set in Spec.MasterUserSecretDestination, unless the Secret already
+// holds it. Rotations of the password are copied on the next reconciliation
+// of the DB instance.
+func (rm *resourceManager) copyMasterUserSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.copyMasterUserSecret")
+	defer func(err error) { exit(err) }(err)
+
+	destination := r.ko.Spec.MasterUserSecretDestination
+	if destination == nil {
+		return nil
+	}
+	password, err := rm.getManagedMasterUserPassword(ctx, r)
+	if err != nil || password == "" {
+		return err
+	}
+	// The password is only ever copied to a Secret in the namespace of the
+	// resource, which the owner of the resource can already read.
+	ref := &v1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{
+			Name:      aws.StringValue(destination.Name),
+			Namespace: r.ko.Namespace,
+		},
+		Key: aws.StringValue(destination.Key),
+	}
+	if current, err := rm.rr.SecretValueFromReference(ctx, ref); err == nil && current == password {
+		return nil
+	}
+	return rm.rr.WriteToSecret(ctx, password, ref.Namespace, ref.Name, ref.Key)
+}
+
+// getManagedMasterUserPassword returns the master user password of the
+// supplied DB instance managed by RDS in Secrets Manager, or an empty string when
+// it is not managed in Secrets Manager or its secret is not active yet.
+func (rm *resourceManager) getManagedMasterUserPassword(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	secret := r.ko.Status.MasterUserSecret
+	if secret == nil || secret.SecretARN == nil ||
+		secret.SecretStatus == nil || *secret.SecretStatus != util.MasterUserSecretStatusActive {
+		return "", nil
+	}
+	resp, err := svcsdksecretsmanager.New(rm.sess).GetSecretValueWithContext(
+		ctx,
+		&svcsdksecretsmanager.GetSecretValueInput{
+			SecretId: secret.SecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "GetSecretValue", err)
+	if err != nil {
+		return "", err
+	}
+	return util.MasterUserSecretPassword(aws.StringValue(resp.SecretString))
+}
+
+// getMasterUserPasswordFromSecretARN returns the master user password of the
+// supplied DB instance held by the Secrets Manager secret set in
+// Spec.MasterUserPasswordSecretARN.
+func (rm *resourceManager) getMasterUserPasswordFromSecretARN(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	resp, err := svcsdksecretsmanager.New(rm.sess).GetSecretValueWithContext(
+		ctx,
+		&svcsdksecretsmanager.GetSecretValueInput{
+			SecretId: r.ko.Spec.MasterUserPasswordSecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "GetSecretValue", err)
+	if err != nil {
+		return "", err
+	}
+	return util.SecretStringPassword(aws.StringValue(resp.SecretString)), nil
+}
+
+// getMasterUserPasswordSecretVersionID returns the identifier of the current
+// version of the Secrets Manager secret set in Spec.MasterUserPasswordSecretARN
+// of the supplied DB instance.
+func (rm *resourceManager) getMasterUserPasswordSecretVersionID(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	resp, err := svcsdksecretsmanager.New(rm.sess).DescribeSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DescribeSecretInput{
+			SecretId: r.ko.Spec.MasterUserPasswordSecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", err)
+	if err != nil {
+		return "", err
+	}
+	return util.CurrentSecretVersionID(resp.VersionIdsToStages), nil
+}
+
+// validateMasterUserSecretRotation returns an ACK terminal error when the
+// rotation of the master user password of the supplied DB instance is not valid.
+func validateMasterUserSecretRotation(r *resource) error {
+	return util.ValidateMasterUserSecretRotation(
+		r.ko.Spec.ManageMasterUserPassword,
+		r.ko.Spec.MasterUserSecretRotation,
+	)
+}
+
+// setMasterUserSecretRotation records in the latest DB instance the last
+// rotation of its master user password managed by RDS in Secrets Manager and,
+// when it differs from the desired one, the rotation schedule of the secret,
+// as reported by DescribeSecret. The secret is only described when the
+// desired DB instance sets Spec.MasterUserSecretRotation.
+func (rm *resourceManager) setMasterUserSecretRotation(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	secret := latest.ko.Status.MasterUserSecret
+	if desired.ko.Spec.MasterUserSecretRotation == nil || secret == nil || secret.SecretARN == nil {
+		return nil
+	}
+	resp, err := svcsdksecretsmanager.New(rm.sess).DescribeSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DescribeSecretInput{
+			SecretId: secret.SecretARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", err)
+	if err != nil {
+		return err
+	}
+	if resp.LastRotatedDate != nil {
+		latest.ko.Status.MasterUserSecretLastRotatedDate = &metav1.Time{Time: *resp.LastRotatedDate}
+	} else {
+		latest.ko.Status.MasterUserSecretLastRotatedDate = nil
+	}
+	rotation := util.MasterUserSecretRotationFromRules(
+		resp.RotationRules, desired.ko.Spec.MasterUserSecretRotation.RotateImmediately,
+	)
+	// The desired rotation schedule is kept when the secret follows it, so
+	// that the way Secrets Manager reports it does not leak into the Spec
+	if util.MasterUserSecretRotationDiffers(desired.ko.Spec.MasterUserSecretRotation, rotation) {
+		latest.ko.Spec.MasterUserSecretRotation = rotation
+	}
+	return nil
+}
+
+// compareMasterUserSecretRotation adds a difference to the supplied delta when
+// the rotation schedule of the master user secret of the desired resource
+// differs from the one of the latest resource.
+func compareMasterUserSecretRotation(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.MasterUserSecretRotationDiffers(a.ko.Spec.MasterUserSecretRotation, b.ko.Spec.MasterUserSecretRotation) {
+		delta.Add("Spec.MasterUserSecretRotation", a.ko.Spec.MasterUserSecretRotation, b.ko.Spec.MasterUserSecretRotation)
+	}
+}
+
+// syncMasterUserSecretRotation applies the rotation schedule of the desired
+// DB instance to its master user secret managed by RDS in Secrets Manager.
+func (rm *resourceManager) syncMasterUserSecretRotation(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncMasterUserSecretRotation")
+	defer func(err error) { exit(err) }(err)
+
+	_, err = svcsdksecretsmanager.New(rm.sess).RotateSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.RotateSecretInput{
+			SecretId:      latest.ko.Status.MasterUserSecret.SecretARN,
+			RotationRules: util.MasterUserSecretRotationRules(desired.ko.Spec.MasterUserSecretRotation),
+			// The password is rotated immediately through RDS, see
+			// rotateMasterUserPassword
+			RotateImmediately: aws.Bool(false),
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "RotateSecret", err)
+	return err
+}
+
+// masterUserPasswordRotationRequested returns true if the supplied resource
+// requests to rotate its master user password immediately.
+func masterUserPasswordRotationRequested(r *resource) bool {
+	rotation := r.ko.Spec.MasterUserSecretRotation
+	return rotation != nil && rotation.RotateImmediately != nil && *rotation.RotateImmediately
+}
+
+// rotateMasterUserPassword immediately rotates the master user password of
+// the desired DB instance managed by RDS in Secrets Manager, through
+// ModifyDBInstance, and returns a copy of the resource reporting the rotation in
+// progress.
+func (rm *resourceManager) rotateMasterUserPassword(
+	ctx context.Context,
+	desired *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rotateMasterUserPassword")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.ModifyDBInstanceInput{
+		DBInstanceIdentifier:     desired.ko.Spec.DBInstanceIdentifier,
+		RotateMasterUserPassword: aws.Bool(true),
+		ApplyImmediately:         aws.Bool(true),
+	}
+	resp, respErr := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "Master user password is being rotated"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// writeConnectionSecret writes the connection details of the supplied DB instance
+// to the Kubernetes Secret set in Spec.ConnectionSecret, in the namespace of
+// the resource and owned by it, unless they did not change since they were
+// last written, and records their hash in Status.ConnectionSecretHash.
+func (rm *resourceManager) writeConnectionSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeConnectionSecret")
+	defer func(err error) { exit(err) }(err)
+
+	destination := r.ko.Spec.ConnectionSecret
+	if destination == nil || destination.Name == nil {
+		r.ko.Status.ConnectionSecretHash = nil
+		return nil
+	}
+	details, err := rm.getConnectionDetails(ctx, r)
+	if err != nil {
+		return err
+	}
+	details, err = util.RenderConnectionTemplates(details, destination.Templates)
+	if err != nil {
+		return err
+	}
+	hash := util.ConnectionDetailsHash(string(r.ko.UID), details)
+	if r.ko.Status.ConnectionSecretHash != nil && *r.ko.Status.ConnectionSecretHash == hash {
+		return nil
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBInstance"))
+	err = util.EnsureConnectionSecret(ctx, *owner, r.ko.Namespace, *destination.Name, details)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.ConnectionSecretHash = &hash
+	return nil
+}
+
+// getConnectionDetails returns the connection details of the supplied
+// DB instance, by connection Secret key, along with the master user password
+// when it is set from a Secret or a Secrets Manager secret, or managed in
+// Secrets Manager, and the RDS certificate bundle of its region.
+func (rm *resourceManager) getConnectionDetails(
+	ctx context.Context,
+	r *resource,
+) (map[string]string, error) {
+	var password string
+	var err error
+	switch {
+	case r.ko.Spec.MasterUserPassword != nil:
+		password, err = rm.rr.SecretValueFromReference(ctx, r.ko.Spec.MasterUserPassword)
+	case r.ko.Spec.MasterUserPasswordSecretARN != nil:
+		password, err = rm.getMasterUserPasswordFromSecretARN(ctx, r)
+	default:
+		password, err = rm.getManagedMasterUserPassword(ctx, r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var host *string
+	var port *int64
+	if r.ko.Status.Endpoint != nil {
+		host = r.ko.Status.Endpoint.Address
+		port = r.ko.Status.Endpoint.Port
+	}
+	details := util.ConnectionDetails(
+		host, port, r.ko.Spec.DBName, r.ko.Spec.MasterUsername, password,
+	)
+	// The connection details are still written when the RDS certificate
+	// bundle cannot be fetched, for instance without access to the internet
+	if bundle, err := util.CABundle(ctx, string(rm.awsRegion)); err == nil {
+		details[util.CABundleKey] = bundle
+	} else {
+		ackrtlog.FromContext(ctx).Info("unable to fetch the RDS certificate bundle", "error", err)
+	}
+	return details, nil
+}
+
+// syncCABundleConfigMap maintains the RDS certificate bundle of the region
+// of the supplied DB instance in the ConfigMap set in Spec.CABundleConfigMap.
+func (rm *resourceManager) syncCABundleConfigMap(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncCABundleConfigMap")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.CABundleConfigMap == nil {
+		return nil
+	}
+	bundle, err := util.CABundle(ctx, string(rm.awsRegion))
+	if err != nil {
+		return err
+	}
+	// The ConfigMap can be shared by several DB instances and DB clusters, so
+	// none of them controls it
+	owner := metav1.OwnerReference{
+		APIVersion: svcapitypes.GroupVersion.String(),
+		Kind:       "DBInstance",
+		Name:       r.ko.Name,
+		UID:        r.ko.UID,
+	}
+	return util.EnsureCABundleConfigMap(ctx, owner, r.ko.Namespace, *r.ko.Spec.CABundleConfigMap, bundle)
+}
+
+// writeServiceBindingSecret writes the connection details of the supplied
+// DB instance to its Service Binding Secret, once the DB instance has an
+// endpoint, and refers to the Secret in Status.Binding when
+// Spec.EnableServiceBinding is set.
+func (rm *resourceManager) writeServiceBindingSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeServiceBindingSecret")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.EnableServiceBinding == nil || !*r.ko.Spec.EnableServiceBinding {
+		r.ko.Status.Binding = nil
+		return nil
+	}
+	if r.ko.Status.Endpoint == nil || r.ko.Status.Endpoint.Address == nil {
+		return nil
+	}
+	details, err := rm.getConnectionDetails(ctx, r)
+	if err != nil {
+		return err
+	}
+	binding := util.ServiceBindingDetails(aws.StringValue(r.ko.Spec.Engine), details)
+	name := r.ko.Name + util.ServiceBindingSecretSuffix
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBInstance"))
+	if err = util.EnsureServiceBindingSecret(ctx, *owner, r.ko.Namespace, name, binding); err != nil {
+		return err
+	}
+	r.ko.Status.Binding = &svcapitypes.ServiceBinding{Name: &name}
+	return nil
+}
+
+// syncEndpointService points the Kubernetes ExternalName Service set in
+// Spec.EndpointService at the endpoint of the supplied DB instance, once the
+// DB instance has one.
+func (rm *resourceManager) syncEndpointService(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncEndpointService")
+	defer func(err error) { exit(err) }(err)
+
+	service := r.ko.Spec.EndpointService
+	if service == nil || r.ko.Status.Endpoint == nil || r.ko.Status.Endpoint.Address == nil {
+		return nil
+	}
+	name := r.ko.Name
+	if service.Name != nil {
+		name = *service.Name
+	}
+	owner := metav1.NewControllerRef(r.ko, svcapitypes.GroupVersion.WithKind("DBInstance"))
+	return util.EnsureExternalNameService(ctx, *owner, r.ko.Namespace, name, *r.ko.Status.Endpoint.Address)
+}
+
+// RDS returns the preferred backup and maintenance windows in their canonical
+// form, with lower case day abbreviations and two digit hours, like
+// mon:03:00-mon:03:30. Controller should treat a desired window that only
+// differs in form, like Monday:3:00-Monday:3:30, as the same.
+func reconcileWindows(
+	a *resource,
+	b *resource,
+) {
+	if a == nil || b == nil {
+		return
+	}
+	if a.ko.Spec.PreferredBackupWindow != nil && b.ko.Spec.PreferredBackupWindow != nil {
+		window, err := util.NormalizeBackupWindow(*a.ko.Spec.PreferredBackupWindow)
+		if err == nil && window == *b.ko.Spec.PreferredBackupWindow {
+			a.ko.Spec.PreferredBackupWindow = b.ko.Spec.PreferredBackupWindow
+		}
+	}
+	if a.ko.Spec.PreferredMaintenanceWindow != nil && b.ko.Spec.PreferredMaintenanceWindow != nil {
+		window, err := util.NormalizeMaintenanceWindow(*a.ko.Spec.PreferredMaintenanceWindow)
+		if err == nil && window == *b.ko.Spec.PreferredMaintenanceWindow {
+			a.ko.Spec.PreferredMaintenanceWindow = b.ko.Spec.PreferredMaintenanceWindow
+		}
+	}
+}
+
+// setFinalSnapshot makes the supplied DeleteDBInstance input take a final
+// DB snapshot when the deletion policy of the supplied resource says so.
+func setFinalSnapshot(r *resource, input *svcsdk.DeleteDBInstanceInput) error {
+	policy, _ := util.DeletionPolicy(r.ko.Spec.DeletionPolicy)
+	if policy != util.DeletionPolicySnapshot || !finalSnapshotPossible(r) {
+		return nil
+	}
+	identifier, err := util.FinalSnapshotIdentifier(
+		r.ko.Spec.FinalSnapshotIdentifierTemplate, r.ko,
+		*r.ko.Spec.DBInstanceIdentifier, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+	skip := false
+	input.SkipFinalSnapshot = &skip
+	input.FinalDBSnapshotIdentifier = &identifier
+	return nil
+}
+
+// recordFinalSnapshot emits an Event on the supplied resource naming the final
+// DB snapshot the supplied DeleteDBInstance input takes.
+func (rm *resourceManager) recordFinalSnapshot(r *resource, input *svcsdk.DeleteDBInstanceInput) {
+	if input.FinalDBSnapshotIdentifier != nil {
+		util.RecordFinalSnapshot(rm.eventRecorder, r.ko, *input.FinalDBSnapshotIdentifier)
+	}
+}
+
+// checkDeletionProtection returns nil when the deletion protection of the
+// supplied DB instance does not block its deletion. When its deletion protection
+// is enabled, it either disables it, if the
+// DisableDeletionProtectionAnnotation annotation is set to "true", or reports
+// the blocked deletion in the DeletionBlocked condition and an Event and
+// returns a requeue error.
+func (rm *resourceManager) checkDeletionProtection(
+	ctx context.Context,
+	r *resource,
+) error {
+	if r.ko.Spec.DeletionProtection == nil || !*r.ko.Spec.DeletionProtection ||
+		r.ko.Spec.DBClusterIdentifier != nil {
+		return nil
+	}
+	if r.ko.Annotations[svcapitypes.DisableDeletionProtectionAnnotation] == "true" {
+		return rm.disableDeletionProtection(ctx, r)
+	}
+	msg := "DB instance has deletion protection enabled, disable it or set the " +
+		svcapitypes.DisableDeletionProtectionAnnotation +
+		" annotation to \"true\" to delete it"
+	if util.GetDeletionBlocked(r) == nil {
+		util.RecordDeletionBlocked(rm.eventRecorder, r.ko, msg)
+	}
+	util.SetDeletionBlocked(r, msg)
+	return requeueWaitWhileDeletionProtected
+}
+
+// disableDeletionProtection disables the deletion protection of the supplied
+// DB instance.
+func (rm *resourceManager) disableDeletionProtection(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disableDeletionProtection")
+	defer func(err error) { exit(err) }(err)
+
+	applyImmediately := true
+	deletionProtection := false
+	input := &svcsdk.ModifyDBInstanceInput{
+		ApplyImmediately:     &applyImmediately,
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+		DeletionProtection:   &deletionProtection,
+	}
+	_, err = rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", err)
+	return err
+}
+
+// safetySnapshotRequired returns true if the desired DB instance requests a
+// safety snapshot before the modifications of the supplied delta, because they
+// upgrade its major engine version or change its storage type.
+func safetySnapshotRequired(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) bool {
+	if desired.ko.Spec.SafetySnapshot == nil || !*desired.ko.Spec.SafetySnapshot {
+		return false
+	}
+	if delta.DifferentAt("Spec.StorageType") {
+		return true
+	}
+	return delta.DifferentAt("Spec.EngineVersion") && desired.ko.Spec.Engine != nil &&
+		desired.ko.Spec.EngineVersion != nil && latest.ko.Spec.EngineVersion != nil &&
+		util.MajorEngineVersionUpgrade(
+			*desired.ko.Spec.Engine,
+			*latest.ko.Spec.EngineVersion,
+			*desired.ko.Spec.EngineVersion,
+		)
+}
+
+// syncSafetySnapshot returns nil once the safety snapshot of the supplied
+// DB instance is available, so that the modification can be issued. Until then,
+// it takes the snapshot, reports its progress in the SafetySnapshot condition
+// and returns a requeue error.
+func (rm *resourceManager) syncSafetySnapshot(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncSafetySnapshot")
+	defer func(err error) { exit(err) }(err)
+
+	cond := util.GetSafetySnapshot(r)
+	if r.ko.Status.SafetySnapshotIdentifier == nil || cond == nil ||
+		cond.Reason == nil || *cond.Reason != util.ReasonSafetySnapshotInProgress {
+		if err = rm.createSafetySnapshot(ctx, r); err != nil {
+			return err
+		}
+		return waitForSafetySnapshot(r)
+	}
+	input := &svcsdk.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: r.ko.Status.SafetySnapshotIdentifier,
+	}
+	resp, err := rm.sdkapi.DescribeDBSnapshotsWithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBSnapshots", err)
+	if err != nil {
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "DBSnapshotNotFound" {
+			// The snapshot was deleted before the modification was issued
+			if err = rm.createSafetySnapshot(ctx, r); err != nil {
+				return err
+			}
+			return waitForSafetySnapshot(r)
+		}
+		return err
+	}
+	for _, snapshot := range resp.DBSnapshots {
+		if snapshot.Status != nil && *snapshot.Status == "available" {
+			return nil
+		}
+	}
+	return waitForSafetySnapshot(r)
+}
+
+// waitForSafetySnapshot reports in the Synced condition of the supplied DB instance
+// that it waits for its safety snapshot and returns a requeue error.
+func waitForSafetySnapshot(r *resource) error {
+	msg := "DB instance cannot be modified until its safety snapshot is available"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return requeueWaitForSafetySnapshot
+}
+
+// createSafetySnapshot takes a manual DB snapshot of the supplied DB instance,
+// records its identifier in Status.SafetySnapshotIdentifier and reports it in
+// the SafetySnapshot condition.
+func (rm *resourceManager) createSafetySnapshot(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.createSafetySnapshot")
+	defer func(err error) { exit(err) }(err)
+
+	id := util.SafetySnapshotIdentifier(*r.ko.Spec.DBInstanceIdentifier, time.Now())
+	input := &svcsdk.CreateDBSnapshotInput{
+		DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+		DBSnapshotIdentifier: &id,
+	}
+	_, err = rm.sdkapi.CreateDBSnapshotWithContext(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateDBSnapshot", err)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.SafetySnapshotIdentifier = &id
+	msg := "DB snapshot " + id + " is being taken before the modification"
+	util.SetSafetySnapshot(r, corev1.ConditionUnknown, util.ReasonSafetySnapshotInProgress, msg)
+	return nil
+}
+
+// setSafetySnapshotCompleted reports in the SafetySnapshot condition of the
+// supplied DB instance that the modification waiting for its safety snapshot was
+// issued.
+func setSafetySnapshotCompleted(r *resource) {
+	cond := util.GetSafetySnapshot(r)
+	if cond == nil || cond.Reason == nil || *cond.Reason != util.ReasonSafetySnapshotInProgress ||
+		r.ko.Status.SafetySnapshotIdentifier == nil {
+		return
+	}
+	msg := "Modification issued after DB snapshot " + *r.ko.Status.SafetySnapshotIdentifier + " was taken"
+	util.SetSafetySnapshot(r, corev1.ConditionTrue, util.ReasonSafetySnapshotCompleted, msg)
+}
+
+// getPendingMaintenanceActions returns the maintenance actions pending for the
+// DB instance with the supplied ARN.
+func (rm *resourceManager) getPendingMaintenanceActions(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.PendingMaintenanceAction, error) {
+	resp, err := rm.sdkapi.DescribePendingMaintenanceActionsWithContext(
+		ctx,
+		&svcsdk.DescribePendingMaintenanceActionsInput{
+			ResourceIdentifier: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribePendingMaintenanceActions", err)
+	if err != nil {
+		return nil, err
+	}
+	return util.PendingMaintenanceActions(resp.PendingMaintenanceActions...), nil
+}
+
+// pendingMaintenanceActionRequested returns the name of the pending
+// maintenance action the apply-pending-maintenance-action annotation of the
+// supplied resource requests to apply, or an empty string.
+func pendingMaintenanceActionRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.ApplyPendingMaintenanceActionAnnotation]
+}
+
+// compareApplyPendingMaintenanceAction adds a difference to the supplied delta
+// when the desired resource requests to apply a pending maintenance action, so
+// that the update applies it.
+func compareApplyPendingMaintenanceAction(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if action := pendingMaintenanceActionRequested(a); action != "" {
+		// There is no Spec field for maintenance actions, but only
+		// differences in the Spec trigger an update.
+		delta.Add("Spec.ApplyPendingMaintenanceAction", action, nil)
+	}
+}
+
+// applyPendingMaintenanceAction immediately applies the pending maintenance
+// action requested by the apply-pending-maintenance-action annotation of the
+// desired DB instance and returns a copy of the resource with the annotation
+// removed. The error returned is nil on success, so that the removal of the
+// annotation is persisted.
+func (rm *resourceManager) applyPendingMaintenanceAction(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.applyPendingMaintenanceAction")
+	defer func(err error) { exit(err) }(err)
+
+	action, err := util.PendingMaintenanceAction(
+		latest.ko.Status.PendingMaintenanceActions,
+		pendingMaintenanceActionRequested(desired),
+	)
+	if err != nil {
+		return nil, err
+	}
+	optInType := util.MaintenanceOptInImmediate
+	input := &svcsdk.ApplyPendingMaintenanceActionInput{
+		ApplyAction:        action.Action,
+		OptInType:          &optInType,
+		ResourceIdentifier: (*string)(latest.ko.Status.ACKResourceMetadata.ARN),
+	}
+	resp, respErr := rm.sdkapi.ApplyPendingMaintenanceActionWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ApplyPendingMaintenanceAction", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.ApplyPendingMaintenanceActionAnnotation)
+	ko.Status.PendingMaintenanceActions = util.PendingMaintenanceActions(
+		resp.ResourcePendingMaintenanceActions,
+	)
+	msg := "Pending maintenance action " + *action.Action + " is being applied"
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// caCertificateRotationRequested returns the value of the
+// rotate-ca-certificate annotation of the supplied resource, or an empty
+// string.
+func caCertificateRotationRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.RotateCACertificateAnnotation]
+}
+
+// compareRotateCACertificate adds a difference to the supplied delta when the
+// desired resource requests to rotate its CA certificate, so that the update
+// rotates it.
+func compareRotateCACertificate(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if rotation := caCertificateRotationRequested(a); rotation != "" {
+		// There is no Spec field for CA certificate rotations, but only
+		// differences in the Spec trigger an update.
+		delta.Add("Spec.RotateCACertificate", rotation, nil)
+	}
+}
+
+// rotateCACertificate immediately rotates the CA certificate of the desired DB
+// instance to the one of its Spec when it differs from the current one, or
+// else to the default CA certificate of the region, restarting the DB
+// instance unless the rotate-ca-certificate annotation says otherwise. It
+// returns a copy of the resource with the annotation removed and the Spec set
+// to the new CA certificate. The error returned is nil on success, so that
+// these changes are persisted.
+func (rm *resourceManager) rotateCACertificate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rotateCACertificate")
+	defer func(err error) { exit(err) }(err)
+
+	restart, err := util.CACertificateRotationRestartRequested(caCertificateRotationRequested(desired))
+	if err != nil {
+		return nil, err
+	}
+	current := latest.ko.Spec.CACertificateIdentifier
+	target := desired.ko.Spec.CACertificateIdentifier
+	if target == nil || (current != nil && *target == *current) {
+		if target, err = rm.getDefaultCACertificate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.RotateCACertificateAnnotation)
+	// The DB instance may use the default CA certificate already, in which
+	// case there is nothing to rotate.
+	if target == nil || (current != nil && *target == *current) {
+		return &resource{ko}, nil
+	}
+	applyImmediately := true
+	input := &svcsdk.ModifyDBInstanceInput{
+		DBInstanceIdentifier:       desired.ko.Spec.DBInstanceIdentifier,
+		CACertificateIdentifier:    target,
+		CertificateRotationRestart: &restart,
+		ApplyImmediately:           &applyImmediately,
+	}
+	resp, respErr := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko.Spec.CACertificateIdentifier = target
+	ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "CA certificate is being rotated to " + *target
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// automationModeChangeRequested returns the value of the pause-automation
+// annotation of the supplied resource, or an empty string.
+func automationModeChangeRequested(r *resource) string {
+	return r.ko.Annotations[svcapitypes.PauseAutomationAnnotation]
+}
+
+// comparePauseAutomation adds a difference to the supplied delta when the
+// desired resource requests to pause or resume its RDS Custom automation, so
+// that the update changes the automation mode.
+func comparePauseAutomation(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if change := automationModeChangeRequested(a); change != "" {
+		// There is no Spec field for automation mode changes, but only
+		// differences in the Spec trigger an update.
+		delta.Add("Spec.PauseAutomation", change, nil)
+	}
+}
+
+// changeAutomationMode pauses or resumes the RDS Custom automation of the
+// desired DB instance as its pause-automation annotation requests. It returns
+// a copy of the resource with the annotation removed and the new automation
+// mode in its status. The error returned is nil on success, so that these
+// changes are persisted.
+func (rm *resourceManager) changeAutomationMode(
+	ctx context.Context,
+	desired *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.changeAutomationMode")
+	defer func(err error) { exit(err) }(err)
+
+	if desired.ko.Spec.Engine == nil || !util.IsCustomEngine(*desired.ko.Spec.Engine) {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w: only the automation of RDS Custom DB instances can be paused",
+			util.ErrInvalidAutomationPause,
+		))
+	}
+	mode, minutes, err := util.AutomationModeChange(automationModeChangeRequested(desired))
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.ModifyDBInstanceInput{
+		DBInstanceIdentifier:            desired.ko.Spec.DBInstanceIdentifier,
+		AutomationMode:                  &mode,
+		ResumeFullAutomationModeMinutes: minutes,
+	}
+	resp, respErr := rm.sdkapi.ModifyDBInstanceWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBInstance", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.PauseAutomationAnnotation)
+	ko.Status.AutomationMode = resp.DBInstance.AutomationMode
+	if resp.DBInstance.ResumeFullAutomationModeTime != nil {
+		ko.Status.ResumeFullAutomationModeTime = &metav1.Time{Time: *resp.DBInstance.ResumeFullAutomationModeTime}
+	} else {
+		ko.Status.ResumeFullAutomationModeTime = nil
+	}
+	ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	msg := "RDS Custom automation is being changed to " + mode
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// getDefaultCACertificate returns the identifier of the CA certificate RDS
+// uses for the DB instances created in the region.
+func (rm *resourceManager) getDefaultCACertificate(
+	ctx context.Context,
+) (*string, error) {
+	resp, err := rm.sdkapi.DescribeCertificatesWithContext(ctx, &svcsdk.DescribeCertificatesInput{})
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeCertificates", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DefaultCertificateForNewLaunches, nil
+}
+
+// setCACertificateExpiry reports in the CACertificateExpiring condition of the
+// supplied DB instance that its CA certificate expires soon or has expired,
+// and removes the condition otherwise. The condition is left untouched while
+// it is accurate, to keep its last transition time.
+func setCACertificateExpiry(r *resource) {
+	details := r.ko.Status.CertificateDetails
+	if details == nil || details.CAIdentifier == nil || details.ValidTill == nil {
+		util.ClearCACertificateExpiring(r)
+		return
+	}
+	reason := util.CACertificateExpiry(details.ValidTill.Time, time.Now())
+	if reason == "" {
+		util.ClearCACertificateExpiring(r)
+		return
+	}
+	verb := "expires"
+	if reason == util.ReasonCACertificateExpired {
+		verb = "expired"
+	}
+	msg := fmt.Sprintf(
+		"CA certificate %s %s on %s, rotate it with the %s annotation",
+		*details.CAIdentifier, verb, details.ValidTill.UTC().Format(time.RFC3339),
+		svcapitypes.RotateCACertificateAnnotation,
+	)
+	if c := util.GetCACertificateExpiring(r); c != nil && c.Reason != nil &&
+		*c.Reason == reason && c.Message != nil && *c.Message == msg {
+		return
+	}
+	util.SetCACertificateExpiring(r, reason, msg)
+}
+
+// validateModifications returns an ACK terminal error listing the valid
+// options when the DB instance class or storage modifications of the supplied
+// delta are not valid for the DB instance, as reported by
+// DescribeOrderableDBInstanceOptions and DescribeValidDBInstanceModifications,
+// rather than letting ModifyDBInstance reject them over and over.
+func (rm *resourceManager) validateModifications(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateModifications")
+	defer func(err error) { exit(err) }(err)
+
+	if delta.DifferentAt("Spec.DBInstanceClass") && desired.ko.Spec.DBInstanceClass != nil {
+		if err = rm.validateDBInstanceClassModification(ctx, desired, latest, delta); err != nil {
+			return err
+		}
+	}
+	// The storage of the DB instances of a DB cluster is managed by the DB
+	// cluster
+	if desired.ko.Spec.DBClusterIdentifier != nil ||
+		!delta.DifferentAt("Spec.AllocatedStorage") && !delta.DifferentAt("Spec.StorageType") &&
+			!delta.DifferentAt("Spec.IOPS") && !delta.DifferentAt("Spec.StorageThroughput") {
+		return nil
+	}
+	storageType := desired.ko.Spec.StorageType
+	if storageType == nil {
+		storageType = latest.ko.Spec.StorageType
+	}
+	allocatedStorage := desired.ko.Spec.AllocatedStorage
+	if allocatedStorage == nil {
+		allocatedStorage = latest.ko.Spec.AllocatedStorage
+	}
+	if storageType == nil || allocatedStorage == nil {
+		return nil
+	}
+	resp, err := rm.sdkapi.DescribeValidDBInstanceModificationsWithContext(
+		ctx,
+		&svcsdk.DescribeValidDBInstanceModificationsInput{
+			DBInstanceIdentifier: desired.ko.Spec.DBInstanceIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeValidDBInstanceModifications", err)
+	if err != nil {
+		return err
+	}
+	if resp.ValidDBInstanceModificationsMessage == nil {
+		return nil
+	}
+	modification := util.StorageModification{
+		StorageType:      *storageType,
+		AllocatedStorage: *allocatedStorage,
+	}
+	if delta.DifferentAt("Spec.IOPS") {
+		modification.IOPS = desired.ko.Spec.IOPS
+	}
+	if delta.DifferentAt("Spec.StorageThroughput") {
+		modification.StorageThroughput = desired.ko.Spec.StorageThroughput
+	}
+	return util.ValidateStorageModification(
+		resp.ValidDBInstanceModificationsMessage.Storage,
+		modification,
+	)
+}
+
+// validateDedicatedLogVolume returns an ACK terminal error when the supplied
+// DB instance enables a dedicated log volume that its engine, engine version,
+// DB instance class and storage type do not support, as reported by
+// DescribeOrderableDBInstanceOptions. Dedicated log volumes do not apply to the
+// DB instances of a DB cluster.
+func (rm *resourceManager) validateDedicatedLogVolume(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateDedicatedLogVolume")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.DedicatedLogVolume == nil || !*r.ko.Spec.DedicatedLogVolume ||
+		r.ko.Spec.DBClusterIdentifier != nil || r.ko.Spec.Engine == nil ||
+		r.ko.Spec.DBInstanceClass == nil {
+		return nil
+	}
+	options, err := rm.getOrderableDBInstanceOptions(ctx, r)
+	if err != nil {
+		return err
+	}
+	storageType := ""
+	if r.ko.Spec.StorageType != nil {
+		storageType = *r.ko.Spec.StorageType
+	}
+	return util.ValidateDedicatedLogVolume(options, storageType)
+}
+
+// validateIAMDatabaseAuthentication returns an ACK terminal error when the
+// supplied DB instance enables IAM database authentication that its engine,
+// engine version and DB instance class do not support, as reported by
+// DescribeOrderableDBInstanceOptions. The IAM database authentication of the
+// DB instances of a DB cluster is managed by the DB cluster.
+func (rm *resourceManager) validateIAMDatabaseAuthentication(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateIAMDatabaseAuthentication")
+	defer func(err error) { exit(err) }(err)
+
+	if r.ko.Spec.EnableIAMDatabaseAuthentication == nil || !*r.ko.Spec.EnableIAMDatabaseAuthentication ||
+		r.ko.Spec.DBClusterIdentifier != nil || r.ko.Spec.Engine == nil ||
+		r.ko.Spec.DBInstanceClass == nil {
+		return nil
+	}
+	options, err := rm.getOrderableDBInstanceOptions(ctx, r)
+	if err != nil {
+		return err
+	}
+	return util.ValidateIAMDatabaseAuthentication(options)
+}
+
+// getOrderableDBInstanceOptions returns the orderable options for the engine,
+// engine version and DB instance class of the supplied DB instance, as
+// reported by DescribeOrderableDBInstanceOptions.
+func (rm *resourceManager) getOrderableDBInstanceOptions(
+	ctx context.Context,
+	r *resource,
+) ([]*svcsdk.OrderableDBInstanceOption, error) {
+	input := &svcsdk.DescribeOrderableDBInstanceOptionsInput{
+		DBInstanceClass: r.ko.Spec.DBInstanceClass,
+		Engine:          r.ko.Spec.Engine,
+		EngineVersion:   r.ko.Spec.EngineVersion,
+	}
+	var options []*svcsdk.OrderableDBInstanceOption
+	err := rm.sdkapi.DescribeOrderableDBInstanceOptionsPagesWithContext(
+		ctx,
+		input,
+		func(page *svcsdk.DescribeOrderableDBInstanceOptionsOutput, _ bool) bool {
+			options = append(options, page.OrderableDBInstanceOptions...)
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeOrderableDBInstanceOptions", err)
+	return options, err
+}
+
+// validateDBInstanceClassModification returns an ACK terminal error listing
+// the orderable DB instance classes when the desired DB instance class is not
+// orderable for the engine and engine version of the DB instance.
+func (rm *resourceManager) validateDBInstanceClassModification(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) error {
+	engineVersion := latest.ko.Spec.EngineVersion
+	if delta.DifferentAt("Spec.EngineVersion") {
+		engineVersion = desired.ko.Spec.EngineVersion
+	}
+	input := &svcsdk.DescribeOrderableDBInstanceOptionsInput{
+		DBInstanceClass: desired.ko.Spec.DBInstanceClass,
+		Engine:          desired.ko.Spec.Engine,
+		EngineVersion:   engineVersion,
+	}
+	resp, err := rm.sdkapi.DescribeOrderableDBInstanceOptionsWithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeOrderableDBInstanceOptions", err)
+	if err != nil {
+		return err
+	}
+	if len(resp.OrderableDBInstanceOptions) > 0 {
+		return nil
+	}
+	// List the orderable DB instance classes only when the desired one is
+	// not, since there are many orderable options
+	input.DBInstanceClass = nil
+	var orderable []string
+	seen := map[string]bool{}
+	err = rm.sdkapi.DescribeOrderableDBInstanceOptionsPagesWithContext(
+		ctx,
+		input,
+		func(page *svcsdk.DescribeOrderableDBInstanceOptionsOutput, _ bool) bool {
+			for _, option := range page.OrderableDBInstanceOptions {
+				if option.DBInstanceClass != nil && !seen[*option.DBInstanceClass] {
+					seen[*option.DBInstanceClass] = true
+					orderable = append(orderable, *option.DBInstanceClass)
+				}
+			}
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeOrderableDBInstanceOptions", err)
+	if err != nil {
+		return err
+	}
+	return util.ValidateDBInstanceClassModification(*desired.ko.Spec.DBInstanceClass, orderable)
+}
+
+// validateEngineVersionUpgrade returns an ACK terminal error when the desired
+// engine version of the supplied delta is not a valid upgrade target of the
+// DB instance, as reported by DescribeDBEngineVersions, or when an upgrade of
+// the major engine version would leave the DB instance with a DB parameter
+// group of another family. It returns the DB parameter group family of the
+// target engine version when the major engine version is upgraded, or an
+// empty string.
+func (rm *resourceManager) validateEngineVersionUpgrade(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (family string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateEngineVersionUpgrade")
+	defer func(err error) { exit(err) }(err)
+
+	// The engine version of the DB instances of a DB cluster is managed by
+	// the DB cluster
+	if !delta.DifferentAt("Spec.EngineVersion") || desired.ko.Spec.DBClusterIdentifier != nil ||
+		desired.ko.Spec.Engine == nil || desired.ko.Spec.EngineVersion == nil ||
+		latest.ko.Spec.EngineVersion == nil {
+		return "", nil
+	}
+	current, err := rm.describeDBEngineVersion(ctx, *desired.ko.Spec.Engine, *latest.ko.Spec.EngineVersion)
+	if err != nil || current == nil {
+		return "", err
+	}
+	target, err := util.UpgradeTarget(current.ValidUpgradeTarget, *desired.ko.Spec.EngineVersion)
+	if err != nil {
+		return "", err
+	}
+	if target.IsMajorVersionUpgrade == nil || !*target.IsMajorVersionUpgrade {
+		return "", nil
+	}
+	upgraded, err := rm.describeDBEngineVersion(ctx, *desired.ko.Spec.Engine, *target.EngineVersion)
+	if err != nil || upgraded == nil || upgraded.DBParameterGroupFamily == nil {
+		return "", err
+	}
+	family = *upgraded.DBParameterGroupFamily
+	if desired.ko.Spec.DBParameterGroupName == nil {
+		return family, nil
+	}
+	resp, err := rm.sdkapi.DescribeDBParameterGroupsWithContext(
+		ctx,
+		&svcsdk.DescribeDBParameterGroupsInput{
+			DBParameterGroupName: desired.ko.Spec.DBParameterGroupName,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBParameterGroups", err)
+	if err != nil {
+		return "", err
+	}
+	for _, group := range resp.DBParameterGroups {
+		if group.DBParameterGroupFamily != nil && *group.DBParameterGroupFamily != family {
+			return "", ackerr.NewTerminalError(fmt.Errorf(
+				"DB parameter group %s of family %s cannot be used with engine version %s, "+
+					"set Spec.DBParameterGroupName to a DB parameter group of family %s",
+				*desired.ko.Spec.DBParameterGroupName, *group.DBParameterGroupFamily,
+				*target.EngineVersion, family,
+			))
+		}
+	}
+	return family, nil
+}
+
+// describeDBEngineVersion returns the supplied engine version of the supplied
+// engine, or nil if there is none.
+func (rm *resourceManager) describeDBEngineVersion(
+	ctx context.Context,
+	engine string,
+	engineVersion string,
+) (*svcsdk.DBEngineVersion, error) {
+	resp, err := rm.sdkapi.DescribeDBEngineVersionsWithContext(
+		ctx,
+		&svcsdk.DescribeDBEngineVersionsInput{
+			Engine:        &engine,
+			EngineVersion: &engineVersion,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBEngineVersions", err)
+	if err != nil || len(resp.DBEngineVersions) == 0 {
+		return nil, err
+	}
+	return resp.DBEngineVersions[0], nil
+}
+
+// majorVersionUpgradeParameterGroup returns the DB parameter group the DB
+// instance is switched to when its major engine version is upgraded to an
+// engine version of the supplied DB parameter group family: the default DB
+// parameter group of the family when the desired resource does not name one,
+// or nil.
+func majorVersionUpgradeParameterGroup(desired *resource, family string) *string {
+	if family == "" || desired.ko.Spec.DBParameterGroupName != nil {
+		return nil
+	}
+	group := "default." + family
+	return &group
+}
+
+// setMajorVersionUpgradeStarted reports in the MajorVersionUpgrade condition
+// that the supplied DB instance is being upgraded to its Spec.EngineVersion,
+// or will be during its next maintenance window when deferred is true.
+func setMajorVersionUpgradeStarted(r *resource, deferred bool) {
+	if deferred {
+		msg := "DB instance is upgraded to engine version " + *r.ko.Spec.EngineVersion +
+			" during the next maintenance window"
+		util.SetMajorVersionUpgrade(r, corev1.ConditionFalse, util.ReasonMajorVersionUpgradePending, msg)
+		return
+	}
+	msg := "DB instance is being upgraded to engine version " + *r.ko.Spec.EngineVersion
+	util.SetMajorVersionUpgrade(r, corev1.ConditionUnknown, util.ReasonMajorVersionUpgradeInProgress, msg)
+}
+
+// setMajorVersionUpgradeProgress reports the progress of the upgrade of the
+// major engine version of the latest DB instance to the engine version of the
+// desired one in the MajorVersionUpgrade condition. It must be called before
+// the Spec of the latest DB instance is reset to its pending modified values.
+func setMajorVersionUpgradeProgress(desired *resource, latest *resource) {
+	cond := util.GetMajorVersionUpgrade(latest)
+	if cond == nil || cond.Reason == nil ||
+		*cond.Reason == util.ReasonMajorVersionUpgradeCompleted ||
+		*cond.Reason == util.ReasonMajorVersionUpgradeFailed ||
+		desired.ko.Spec.EngineVersion == nil {
+		return
+	}
+	targetVersion := *desired.ko.Spec.EngineVersion
+	status := latest.ko.Status.DBInstanceStatus
+	if status != nil && *status == StatusUpgrading {
+		msg := "DB instance is being upgraded to engine version " + targetVersion
+		util.SetMajorVersionUpgrade(latest, corev1.ConditionUnknown, util.ReasonMajorVersionUpgradeInProgress, msg)
+		return
+	}
+	pmv := latest.ko.Status.PendingModifiedValues
+	if !instanceAvailable(latest) || pmv != nil && pmv.EngineVersion != nil {
+		return
+	}
+	if latest.ko.Spec.Engine != nil && latest.ko.Spec.EngineVersion != nil &&
+		!util.MajorEngineVersionUpgrade(*latest.ko.Spec.Engine, *latest.ko.Spec.EngineVersion, targetVersion) {
+		msg := "DB instance was upgraded to engine version " + *latest.ko.Spec.EngineVersion
+		util.SetMajorVersionUpgrade(latest, corev1.ConditionTrue, util.ReasonMajorVersionUpgradeCompleted, msg)
+		return
+	}
+	msg := "DB instance was not upgraded to engine version " + targetVersion +
+		", see the events of the DB instance"
+	util.SetMajorVersionUpgrade(latest, corev1.ConditionFalse, util.ReasonMajorVersionUpgradeFailed, msg)
+}
+
+// resolveEngineVersion returns the supplied engine version of the supplied
+// engine when it exists, or else the latest available engine version it is a
+// prefix of, like 15.4 for 15, so that the DB instance is created with the latest minor
+// engine version rather than the default one.
+func (rm *resourceManager) resolveEngineVersion(
+	ctx context.Context,
+	engine *string,
+	engineVersion *string,
+) (*string, error) {
+	if engine == nil || engineVersion == nil {
+		return engineVersion, nil
+	}
+	var versions []string
+	err := rm.sdkapi.DescribeDBEngineVersionsPagesWithContext(
+		ctx,
+		&svcsdk.DescribeDBEngineVersionsInput{
+			Engine: engine,
+		},
+		func(page *svcsdk.DescribeDBEngineVersionsOutput, _ bool) bool {
+			for _, v := range page.DBEngineVersions {
+				if v.EngineVersion != nil && (v.Status == nil || *v.Status == "available") {
+					versions = append(versions, *v.EngineVersion)
+				}
+			}
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBEngineVersions", err)
+	if err != nil {
+		return nil, err
+	}
+	if latest := util.LatestEngineVersion(versions, *engineVersion); latest != "" {
+		return &latest, nil
+	}
+	return engineVersion, nil
+}
+
+// validateNetworkType returns an ACK terminal error when the network type of
+// the desired DB instance is not valid or is not supported by its DB subnet
+// group. The network types supported by the current DB subnet group are
+// reported in the status of the latest DB instance, which is nil on creation.
+func (rm *resourceManager) validateNetworkType(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateNetworkType")
+	defer func(err error) { exit(err) }(err)
+
+	if desired.ko.Spec.NetworkType == nil {
+		return nil
+	}
+	subnetGroupName := ""
+	if desired.ko.Spec.DBSubnetGroupName != nil {
+		subnetGroupName = *desired.ko.Spec.DBSubnetGroupName
+	}
+	var supported []*string
+	if latest != nil && latest.ko.Status.DBSubnetGroup != nil &&
+		latest.ko.Status.DBSubnetGroup.DBSubnetGroupName != nil &&
+		(subnetGroupName == "" || subnetGroupName == *latest.ko.Status.DBSubnetGroup.DBSubnetGroupName) {
+		subnetGroupName = *latest.ko.Status.DBSubnetGroup.DBSubnetGroupName
+		supported = latest.ko.Status.DBSubnetGroup.SupportedNetworkTypes
+	} else if subnetGroupName != "" {
+		if supported, err = rm.getSupportedNetworkTypes(ctx, subnetGroupName); err != nil {
+			return err
+		}
+	}
+	return util.ValidateNetworkType(*desired.ko.Spec.NetworkType, subnetGroupName, supported)
+}
+
+// getSupportedNetworkTypes returns the network types supported by the DB
+// subnet group with the supplied name.
+func (rm *resourceManager) getSupportedNetworkTypes(
+	ctx context.Context,
+	subnetGroupName string,
+) ([]*string, error) {
+	resp, err := rm.sdkapi.DescribeDBSubnetGroupsWithContext(
+		ctx,
+		&svcsdk.DescribeDBSubnetGroupsInput{
+			DBSubnetGroupName: &subnetGroupName,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeDBSubnetGroups", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.DBSubnetGroups) == 0 {
+		return nil, nil
+	}
+	return resp.DBSubnetGroups[0].SupportedNetworkTypes, nil
+}
+
+// publicAccessibility returns how the DB instance is reachable when its
+// PubliclyAccessible field is set to the supplied value.
+func publicAccessibility(publiclyAccessible *bool) string {
+	if publiclyAccessible != nil && *publiclyAccessible {
+		return "publicly accessible"
+	}
+	return "private"
+}
+
+// validatePublicAccess returns an ACK terminal error when the VPC of the
+// supplied DB instance lacks what makes its DB instances publicly accessible,
+// rather than letting ModifyDBInstance reject the modification over and over.
+func (rm *resourceManager) validatePublicAccess(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validatePublicAccess")
+	defer func(err error) { exit(err) }(err)
+
+	subnetGroup := r.ko.Status.DBSubnetGroup
+	if subnetGroup == nil || subnetGroup.VPCID == nil {
+		return nil
+	}
+	vpc := util.VPCPublicAccess{VPCID: *subnetGroup.VPCID}
+	ec2api := svcsdkec2.New(rm.sess)
+	filterName := "attachment.vpc-id"
+	gateways, err := ec2api.DescribeInternetGatewaysWithContext(
+		ctx,
+		&svcsdkec2.DescribeInternetGatewaysInput{
+			Filters: []*svcsdkec2.Filter{{
+				Name:   &filterName,
+				Values: []*string{subnetGroup.VPCID},
+			}},
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeInternetGateways", err)
+	if err != nil {
+		return err
+	}
+	vpc.InternetGateway = len(gateways.InternetGateways) > 0
+	for _, attribute := range []string{
+		svcsdkec2.VpcAttributeNameEnableDnsSupport,
+		svcsdkec2.VpcAttributeNameEnableDnsHostnames,
+	} {
+		resp, err := ec2api.DescribeVpcAttributeWithContext(
+			ctx,
+			&svcsdkec2.DescribeVpcAttributeInput{
+				Attribute: &attribute,
+				VpcId:     subnetGroup.VPCID,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeVpcAttribute", err)
+		if err != nil {
+			return err
+		}
+		if resp.EnableDnsSupport != nil && resp.EnableDnsSupport.Value != nil {
+			vpc.DNSSupport = *resp.EnableDnsSupport.Value
+		}
+		if resp.EnableDnsHostnames != nil && resp.EnableDnsHostnames.Value != nil {
+			vpc.DNSHostnames = *resp.EnableDnsHostnames.Value
+		}
+	}
+	return util.ValidatePublicAccess(vpc)
+}
+
+// setPublicAccessStarted reports in the PublicAccess condition of the supplied
+// DB instance that its public accessibility is being changed.
+func setPublicAccessStarted(r *resource) {
+	msg := "DB instance is being made " + publicAccessibility(r.ko.Spec.PubliclyAccessible)
+	util.SetPublicAccess(r, corev1.ConditionUnknown, util.ReasonPublicAccessInProgress, msg)
+}
+
+// setPublicAccessProgress marks the change of the public accessibility of the
+// desired DB instance as completed once the latest DB instance is available
+// with the desired public accessibility, from when its endpoint resolves to
+// public or private IP addresses accordingly.
+func setPublicAccessProgress(desired *resource, latest *resource) {
+	cond := util.GetPublicAccess(latest)
+	if cond == nil || cond.Status == corev1.ConditionTrue || !instanceAvailable(latest) {
+		return
+	}
+	accessibility := publicAccessibility(latest.ko.Spec.PubliclyAccessible)
+	if accessibility != publicAccessibility(desired.ko.Spec.PubliclyAccessible) {
+		return
+	}
+	msg := "DB instance is " + accessibility
+	if endpoint := latest.ko.Status.Endpoint; endpoint != nil && endpoint.Address != nil {
+		msg += " at " + *endpoint.Address
+	}
+	util.SetPublicAccess(latest, corev1.ConditionTrue, util.ReasonPublicAccessCompleted, msg)
+}
+
+// setPortChangeStarted reports in the PortChange condition of the supplied DB
+// instance that its port is being changed.
+func setPortChangeStarted(r *resource) {
+	if r.ko.Spec.Port == nil {
+		return
+	}
+	msg := fmt.Sprintf("DB instance port is being changed to %d", *r.ko.Spec.Port)
+	util.SetPortChange(r, corev1.ConditionUnknown, util.ReasonPortChangeInProgress, msg)
+}
+
+// setPortChangeProgress marks the port change in progress of the supplied DB
+// instance as completed once its endpoint listens on the new port. Until
+// then, the resource is not synced so that it keeps being requeued, and
+// Status.Endpoint, which the FieldExports of the endpoint read, reports the
+// new port as soon as it is live.
+func setPortChangeProgress(r *resource) {
+	cond := util.GetPortChange(r)
+	if cond == nil || cond.Status == corev1.ConditionTrue || r.ko.Spec.Port == nil {
+		return
+	}
+	endpoint := r.ko.Status.Endpoint
+	if !instanceAvailable(r) || endpoint == nil || endpoint.Port == nil ||
+		*endpoint.Port != *r.ko.Spec.Port {
+		ackcondition.SetSynced(r, corev1.ConditionFalse, cond.Message, nil)
+		return
+	}
+	msg := fmt.Sprintf("DB instance endpoint listens on port %d", *endpoint.Port)
+	util.SetPortChange(r, corev1.ConditionTrue, util.ReasonPortChangeCompleted, msg)
+}
+
+// validateActivityStream returns an ACK terminal error when the activity
+// stream of the supplied DB instance is not supported.
+func validateActivityStream(r *resource) error {
+	return util.ValidateActivityStream(aws.StringValue(r.ko.Spec.Engine), false, r.ko.Spec.ActivityStream)
+}
+
+// activityStreamChanging returns true if the activity stream of the supplied
+// DB instance is being started or stopped.
+func activityStreamChanging(r *resource) bool {
+	return util.ActivityStreamChanging(r.ko.Status.ActivityStreamStatus)
+}
+
+// setActivityStream sets the activity stream of the supplied latest DB instance
+// from its status when the supplied desired one sets Spec.ActivityStream.
+func setActivityStream(desired *resource, latest *resource) {
+	if desired.ko.Spec.ActivityStream == nil {
+		return
+	}
+	latest.ko.Spec.ActivityStream = util.LatestActivityStream(
+		desired.ko.Spec.ActivityStream,
+		latest.ko.Status.ActivityStreamStatus,
+		latest.ko.Status.ActivityStreamMode,
+	)
+}
+
+// compareActivityStream adds a difference to the supplied delta when the
+// desired resource starts its activity stream while the latest one is
+// stopped, or the other way around, or starts it in another mode.
+func compareActivityStream(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.ActivityStreamDiffers(a.ko.Spec.ActivityStream, b.ko.Spec.ActivityStream) {
+		delta.Add("Spec.ActivityStream", a.ko.Spec.ActivityStream, b.ko.Spec.ActivityStream)
+	}
+}
+
+// syncActivityStream starts or stops the activity stream of the desired
+// DB instance as its Spec says. An activity stream started in another mode is
+// stopped, to be started again in the desired mode once stopped. It returns a
+// copy of the resource reporting the change in progress.
+func (rm *resourceManager) syncActivityStream(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncActivityStream")
+	defer func(err error) { exit(err) }(err)
+
+	if activityStreamChanging(latest) {
+		msg := "DB instance activity stream is being started or stopped"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileChangingActivityStream
+	}
+	resourceARN := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	stream := desired.ko.Spec.ActivityStream
+	ko := desired.ko.DeepCopy()
+	var msg string
+	if *stream.Enabled && !util.ActivityStreamStarted(latest.ko.Status.ActivityStreamStatus) {
+		input := &svcsdk.StartActivityStreamInput{
+			ResourceArn:                     resourceARN,
+			Mode:                            stream.Mode,
+			KmsKeyId:                        stream.KMSKeyID,
+			EngineNativeAuditFieldsIncluded: stream.EngineNativeAuditFieldsIncluded,
+			ApplyImmediately:                aws.Bool(true),
+		}
+		resp, respErr := rm.sdkapi.StartActivityStreamWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StartActivityStream", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.ActivityStreamStatus = resp.Status
+		ko.Status.ActivityStreamMode = resp.Mode
+		ko.Status.ActivityStreamKMSKeyID = resp.KmsKeyId
+		ko.Status.ActivityStreamKinesisStreamName = resp.KinesisStreamName
+		msg = "DB instance activity stream is being started"
+	} else {
+		input := &svcsdk.StopActivityStreamInput{
+			ResourceArn:      resourceARN,
+			ApplyImmediately: aws.Bool(true),
+		}
+		resp, respErr := rm.sdkapi.StopActivityStreamWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StopActivityStream", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		ko.Status.ActivityStreamStatus = resp.Status
+		msg = "DB instance activity stream is being stopped"
+	}
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// validateAutomatedBackupsReplication returns an ACK terminal error when the
+// replication of the automated backups of the supplied DB instance is not
+// valid.
+func (rm *resourceManager) validateAutomatedBackupsReplication(r *resource) error {
+	return util.ValidateAutomatedBackupsReplication(r.ko.Spec.AutomatedBackupsReplication, string(rm.awsRegion))
+}
+
+// destinationRegionAPI returns the RDS API of the supplied region the
+// automated backups of DB instances are replicated to, or the API of the
+// resource manager when the region is its own.
+func (rm *resourceManager) destinationRegionAPI(region string) svcsdkapi.RDSAPI {
+	if region == string(rm.awsRegion) {
+		return rm.sdkapi
+	}
+	return util.RegionalRDSAPI(rm.sess, region)
+}
+
+// setAutomatedBackupsReplication sets the replication of the automated
+// backups of the supplied latest DB instance, and the replicated automated
+// backups in its status, from the automated backups replicated to the
+// destination region of the supplied desired DB instance when it sets
+// Spec.AutomatedBackupsReplication.
+func (rm *resourceManager) setAutomatedBackupsReplication(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	replication := desired.ko.Spec.AutomatedBackupsReplication
+	if replication == nil || replication.DestinationRegion == nil {
+		return nil
+	}
+	region := *replication.DestinationRegion
+	replicationARN := util.AutomatedBackupsReplicationARN(
+		latest.ko.Status.DBInstanceAutomatedBackupsReplications, region,
+	)
+	latest.ko.Spec.AutomatedBackupsReplication = util.LatestAutomatedBackupsReplication(replication, replicationARN)
+	latest.ko.Status.AutomatedBackupsReplicationARN = replicationARN
+	latest.ko.Status.AutomatedBackupsReplicationRetentionPeriod = nil
+	latest.ko.Status.AutomatedBackupsReplicationStatus = nil
+	if replicationARN == nil {
+		return nil
+	}
+	resp, err := rm.destinationRegionAPI(region).DescribeDBInstanceAutomatedBackupsWithContext(
+		ctx,
+		&svcsdk.DescribeDBInstanceAutomatedBackupsInput{
+			DBInstanceAutomatedBackupsArn: replicationARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBInstanceAutomatedBackups", err)
+	if err != nil {
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "DBInstanceAutomatedBackupNotFound" {
+			return nil
+		}
+		return err
+	}
+	for _, backup := range resp.DBInstanceAutomatedBackups {
+		latest.ko.Status.AutomatedBackupsReplicationRetentionPeriod = backup.BackupRetentionPeriod
+		latest.ko.Status.AutomatedBackupsReplicationStatus = backup.Status
+		break
+	}
+	return nil
+}
+
+// compareAutomatedBackupsReplication adds a difference to the supplied delta
+// when the desired resource replicates its automated backups while the latest
+// one does not, or the other way around.
+func compareAutomatedBackupsReplication(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.AutomatedBackupsReplicationDiffers(a.ko.Spec.AutomatedBackupsReplication, b.ko.Spec.AutomatedBackupsReplication) {
+		delta.Add("Spec.AutomatedBackupsReplication", a.ko.Spec.AutomatedBackupsReplication, b.ko.Spec.AutomatedBackupsReplication)
+	}
+}
+
+// syncAutomatedBackupsReplication starts or stops the replication of the
+// automated backups of the desired DB instance to its destination region as
+// its Spec says. The replication is started and stopped from the destination
+// region. It returns a copy of the resource reporting the change in progress.
+func (rm *resourceManager) syncAutomatedBackupsReplication(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncAutomatedBackupsReplication")
+	defer func(err error) { exit(err) }(err)
+
+	replication := desired.ko.Spec.AutomatedBackupsReplication
+	api := rm.destinationRegionAPI(*replication.DestinationRegion)
+	sourceARN := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	ko := desired.ko.DeepCopy()
+	var backup *svcsdk.DBInstanceAutomatedBackup
+	var msg string
+	if *replication.Enabled {
+		input := &svcsdk.StartDBInstanceAutomatedBackupsReplicationInput{
+			SourceDBInstanceArn:   sourceARN,
+			BackupRetentionPeriod: replication.BackupRetentionPeriod,
+			KmsKeyId:              replication.KMSKeyID,
+		}
+		// The SDK generates the PreSignedUrl of the request from the region of
+		// the DB instance
+		input.SetSourceRegion(string(rm.awsRegion))
+		resp, respErr := api.StartDBInstanceAutomatedBackupsReplicationWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StartDBInstanceAutomatedBackupsReplication", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		backup = resp.DBInstanceAutomatedBackup
+		msg = "DB instance automated backups replication is being started"
+	} else {
+		input := &svcsdk.StopDBInstanceAutomatedBackupsReplicationInput{
+			SourceDBInstanceArn: sourceARN,
+		}
+		resp, respErr := api.StopDBInstanceAutomatedBackupsReplicationWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "StopDBInstanceAutomatedBackupsReplication", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		backup = resp.DBInstanceAutomatedBackup
+		msg = "DB instance automated backups replication is being stopped"
+	}
+	if backup != nil {
+		ko.Status.AutomatedBackupsReplicationARN = backup.DBInstanceAutomatedBackupsArn
+		ko.Status.AutomatedBackupsReplicationRetentionPeriod = backup.BackupRetentionPeriod
+		ko.Status.AutomatedBackupsReplicationStatus = backup.Status
+	}
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	return &resource{ko}, nil
+}
+
+// adoptionRequested returns true if the supplied resource requests the
+// adoption of an existing DB instance it did not adopt yet. An invalid
+// adoption policy is reported when the DB instance is read.
+func adoptionRequested(r *resource) bool {
+	adopt, _ := util.AdoptionRequested(r.ko.Annotations)
+	return adopt
+}
+
+// populateAdoptionFields sets the DB instance identifier of the supplied
+// resource, when it sets an adoption policy, to the identifier of its
+// adoption fields, so that the DB instance to adopt is read.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{"dbInstanceIdentifier": &r.ko.Spec.DBInstanceIdentifier},
+		util.AdoptionPolicyAdopt, util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
+
+// adoptDBInstance returns a copy of the supplied latest resource, which holds
+// the configuration of the DB instance being adopted, recorded as adopted.
+// Nothing is modified on adoption: the configuration of the DB instance
+// replaces the Spec of the resource.
+func adoptDBInstance(latest *resource) *resource {
+	ko := latest.ko.DeepCopy()
+	if ko.Annotations == nil {
+		ko.Annotations = map[string]string{}
+	}
+	util.SetAdopted(ko.Annotations)
+	return &resource{ko}
+}
+
+// validateCreation returns a terminal error when the supplied resource, which
+// is about to be created, requests the adoption of a DB instance that does not
+// exist, or lacks the fields required to create a DB instance. They are
+// optional in the CRD since they are read from RDS on adoption.
+func validateCreation(r *resource) error {
+	if adoptionRequested(r) {
+		return util.NewErrAdoptedResourceNotFound(
+			"DB instance", aws.StringValue(r.ko.Spec.DBInstanceIdentifier),
+		)
+	}
+	var missing []string
+	if r.ko.Spec.DBInstanceClass == nil {
+		missing = append(missing, "dbInstanceClass")
+	}
+	if r.ko.Spec.DBInstanceIdentifier == nil {
+		missing = append(missing, "dbInstanceIdentifier")
+	}
+	if r.ko.Spec.Engine == nil {
+		missing = append(missing, "engine")
+	}
+	if len(missing) > 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%s required to create a DB instance", strings.Join(missing, ", "),
+		))
+	}
+	return nil
+}
+
+// SetEventRecorder sets the recorder the resource managers produced by the
+// factory emit Kubernetes Events with. It implements the
+// svcresource.EventRecorderSetter interface.
+func (f *resourceManagerFactory) SetEventRecorder(recorder record.EventRecorder) {
+	f.Lock()
+	defer f.Unlock()
+	f.eventRecorder = recorder
+}
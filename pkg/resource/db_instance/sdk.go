@@ -61,6 +61,12 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -334,9 +340,9 @@ func (rm *resourceManager) sdkFind(
 			ko.Status.DBSubnetGroup = nil
 		}
 		if elem.DBSystemId != nil {
-			ko.Status.DBSystemID = elem.DBSystemId
+			ko.Spec.DBSystemID = elem.DBSystemId
 		} else {
-			ko.Status.DBSystemID = nil
+			ko.Spec.DBSystemID = nil
 		}
 		if elem.DbInstancePort != nil {
 			ko.Status.DBInstancePort = elem.DbInstancePort
@@ -348,6 +354,11 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Status.DBIResourceID = nil
 		}
+		if elem.DedicatedLogVolume != nil {
+			ko.Spec.DedicatedLogVolume = elem.DedicatedLogVolume
+		} else {
+			ko.Spec.DedicatedLogVolume = nil
+		}
 		if elem.DeletionProtection != nil {
 			ko.Spec.DeletionProtection = elem.DeletionProtection
 		} else {
@@ -357,6 +368,18 @@ func (rm *resourceManager) sdkFind(
 			f34 := []*svcapitypes.DomainMembership{}
 			for _, f34iter := range elem.DomainMemberships {
 				f34elem := &svcapitypes.DomainMembership{}
+				if f34iter.AuthSecretArn != nil {
+					f34elem.AuthSecretARN = f34iter.AuthSecretArn
+				}
+				if f34iter.DnsIps != nil {
+					f34elemf1 := []*string{}
+					for _, f34elemf1iter := range f34iter.DnsIps {
+						var f34elemf1elem string
+						f34elemf1elem = *f34elemf1iter
+						f34elemf1 = append(f34elemf1, &f34elemf1elem)
+					}
+					f34elem.DNSIPs = f34elemf1
+				}
 				if f34iter.Domain != nil {
 					f34elem.Domain = f34iter.Domain
 				}
@@ -366,6 +389,9 @@ func (rm *resourceManager) sdkFind(
 				if f34iter.IAMRoleName != nil {
 					f34elem.IAMRoleName = f34iter.IAMRoleName
 				}
+				if f34iter.OU != nil {
+					f34elem.OU = f34iter.OU
+				}
 				if f34iter.Status != nil {
 					f34elem.Status = f34iter.Status
 				}
@@ -550,6 +576,9 @@ func (rm *resourceManager) sdkFind(
 			if elem.PendingModifiedValues.DBSubnetGroupName != nil {
 				f56.DBSubnetGroupName = elem.PendingModifiedValues.DBSubnetGroupName
 			}
+			if elem.PendingModifiedValues.DedicatedLogVolume != nil {
+				f56.DedicatedLogVolume = elem.PendingModifiedValues.DedicatedLogVolume
+			}
 			if elem.PendingModifiedValues.EngineVersion != nil {
 				f56.EngineVersion = elem.PendingModifiedValues.EngineVersion
 			}
@@ -789,6 +818,25 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	// The Spec is reset to the pending values below, report the progress of
+	// a major engine version upgrade first.
+	setMajorVersionUpgradeProgress(r, &resource{ko})
+	// Spec.AllocatedStorage is reset to the pending value below, record the
+	// storage currently allocated to the DB instance first.
+	if ko.Spec.AllocatedStorage != nil {
+		allocatedStorage := *ko.Spec.AllocatedStorage
+		ko.Status.CurrentAllocatedStorage = &allocatedStorage
+	} else {
+		ko.Status.CurrentAllocatedStorage = nil
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil && r.ko.Spec.DBClusterIdentifier == nil {
+		// If the desired resource has IAM authentication explicitly enabled or
+		// disabled then update the spec of the latest resource with the value
+		// from the status. The IAM authentication of the DB instances of a DB
+		// cluster is managed by the DB cluster.
+		ko.Spec.EnableIAMDatabaseAuthentication = ko.Status.IAMDatabaseAuthenticationEnabled
+	}
+	setDomainFromMembership(r, &resource{ko})
 	// DescribeDBInstances returns an array of DBInstance structs that contains
 	// the *previously set* values for various mutable fields. This is
 	// problematic because it causes a "flopping" behaviour when the user has
@@ -829,17 +877,18 @@ func (rm *resourceManager) sdkFind(
 		if pmv.DBSubnetGroupName != nil {
 			ko.Spec.DBSubnetGroupName = pmv.DBSubnetGroupName
 		}
+		if pmv.DedicatedLogVolume != nil {
+			ko.Spec.DedicatedLogVolume = pmv.DedicatedLogVolume
+		}
 		if pmv.EngineVersion != nil {
 			ko.Spec.EngineVersion = pmv.EngineVersion
 		}
-		// NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.IAMDatabaseAuthenticationEnabled != nil {
-		//	ko.Spec.IAMDatabaseAuthenticationEnabled = pmv.IAMDatabaseAuthenticationEnabled
-		//}
-		// NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.Iops != nil {
-		//	ko.Spec.IOPS = pmv.Iops
-		//}
+		if pmv.IAMDatabaseAuthenticationEnabled != nil {
+			ko.Spec.EnableIAMDatabaseAuthentication = pmv.IAMDatabaseAuthenticationEnabled
+		}
+		if pmv.IOPS != nil {
+			ko.Spec.IOPS = pmv.IOPS
+		}
 		if pmv.LicenseModel != nil {
 			ko.Spec.LicenseModel = pmv.LicenseModel
 		}
@@ -880,11 +929,47 @@ func (rm *resourceManager) sdkFind(
 			return nil, err
 		}
 		ko.Spec.Tags = tags
+		pendingActions, err := rm.getPendingMaintenanceActions(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.PendingMaintenanceActions = pendingActions
+	}
+	setSwitchoverProgress(&resource{ko})
+	setMultiAZConversionProgress(&resource{ko})
+	setCACertificateExpiry(&resource{ko})
+	setPublicAccessProgress(r, &resource{ko})
+	setPortChangeProgress(&resource{ko})
+	rm.setMasterUserPasswordHash(ctx, &resource{ko})
+	if err = rm.setMasterUserSecretRotation(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	setActivityStream(r, &resource{ko})
+	if err = rm.setAutomatedBackupsReplication(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.copyMasterUserSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeConnectionSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeServiceBindingSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncCABundleConfigMap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncEndpointService(ctx, &resource{ko}); err != nil {
+		return nil, err
 	}
-	if !instanceAvailable(&resource{ko}) {
+	if !instanceAvailable(&resource{ko}) && !instanceStoppedAsDesired(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if activityStreamChanging(&resource{ko}) {
+		msg := "DB instance activity stream is being started or stopped"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
 	}
 	if len(r.ko.Spec.VPCSecurityGroupIDs) > 0 {
 		// If the desired resource has security groups specified then update the spec of the latest resource with the
@@ -944,11 +1029,66 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateCreation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateGP3Storage(desired); err != nil {
+		return nil, err
+	}
+	if err = validatePromotionTier(desired); err != nil {
+		return nil, err
+	}
+	if err = validateCharacterSets(desired); err != nil {
+		return nil, err
+	}
+	if err = validateCustomDBInstance(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDeletion(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWindows(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecret(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecretRotation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateActivityStream(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateAutomatedBackupsReplication(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDomain(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateDedicatedLogVolume(ctx, desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateNetworkType(ctx, desired, nil); err != nil {
+		return nil, err
+	}
 	// if request has DBSnapshotIdentifier spec, create request will call RestoreDBInstanceFromDBSnapshotWithContext
 	// instead of normal create api
 	if desired.ko.Spec.DBSnapshotIdentifier != nil {
 		return rm.restoreDbInstanceFromDbSnapshot(ctx, desired)
 	}
+	// if request has RestoreToPointInTime spec, create request will call RestoreDBInstanceToPointInTimeWithContext
+	// instead of normal create api
+	if desired.ko.Spec.RestoreToPointInTime != nil {
+		return rm.restoreDBInstanceToPointInTime(ctx, desired)
+	}
+	// if request has S3Restore spec, create request will call RestoreDBInstanceFromS3WithContext
+	// instead of normal create api
+	if desired.ko.Spec.S3Restore != nil {
+		return rm.restoreDBInstanceFromS3(ctx, desired)
+	}
 	// if request has SourceDBInstanceIdentifier spec, create request will call CreateDBInstanceReadReplicaWithContext
 	// instead of normal create api
 	if desired.ko.Spec.SourceDBInstanceIdentifier != nil {
@@ -959,6 +1099,20 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	// An engine version made of only its first components, like 15, is
+	// resolved to the latest minor engine version
+	if input.EngineVersion, err = rm.resolveEngineVersion(ctx, input.Engine, input.EngineVersion); err != nil {
+		return nil, err
+	}
+	// The master user password of the Secrets Manager secret set in
+	// Spec.MasterUserPasswordSecretARN is read when creating the DB instance
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
 
 	var resp *svcsdk.CreateDBInstanceOutput
 	_ = resp
@@ -1217,9 +1371,9 @@ func (rm *resourceManager) sdkCreate(
 		ko.Status.DBSubnetGroup = nil
 	}
 	if resp.DBInstance.DBSystemId != nil {
-		ko.Status.DBSystemID = resp.DBInstance.DBSystemId
+		ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
 	} else {
-		ko.Status.DBSystemID = nil
+		ko.Spec.DBSystemID = nil
 	}
 	if resp.DBInstance.DbInstancePort != nil {
 		ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
@@ -1231,6 +1385,11 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Status.DBIResourceID = nil
 	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		ko.Spec.DedicatedLogVolume = nil
+	}
 	if resp.DBInstance.DeletionProtection != nil {
 		ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
 	} else {
@@ -1240,6 +1399,18 @@ func (rm *resourceManager) sdkCreate(
 		f34 := []*svcapitypes.DomainMembership{}
 		for _, f34iter := range resp.DBInstance.DomainMemberships {
 			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
 			if f34iter.Domain != nil {
 				f34elem.Domain = f34iter.Domain
 			}
@@ -1249,6 +1420,9 @@ func (rm *resourceManager) sdkCreate(
 			if f34iter.IAMRoleName != nil {
 				f34elem.IAMRoleName = f34iter.IAMRoleName
 			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
 			if f34iter.Status != nil {
 				f34elem.Status = f34iter.Status
 			}
@@ -1433,6 +1607,9 @@ func (rm *resourceManager) sdkCreate(
 		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
 			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
 		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
 		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
 			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
 		}
@@ -1736,15 +1913,39 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.DBSubnetGroupName != nil {
 		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
 	}
+	if r.ko.Spec.DBSystemID != nil {
+		res.SetDBSystemId(*r.ko.Spec.DBSystemID)
+	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
 	if r.ko.Spec.DeletionProtection != nil {
 		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
 	}
 	if r.ko.Spec.Domain != nil {
 		res.SetDomain(*r.ko.Spec.Domain)
 	}
+	if r.ko.Spec.DomainAuthSecretARN != nil {
+		res.SetDomainAuthSecretArn(*r.ko.Spec.DomainAuthSecretARN)
+	}
+	if r.ko.Spec.DomainDNSIPs != nil {
+		f15 := []*string{}
+		for _, f15iter := range r.ko.Spec.DomainDNSIPs {
+			var f15elem string
+			f15elem = *f15iter
+			f15 = append(f15, &f15elem)
+		}
+		res.SetDomainDnsIps(f15)
+	}
+	if r.ko.Spec.DomainFqdn != nil {
+		res.SetDomainFqdn(*r.ko.Spec.DomainFqdn)
+	}
 	if r.ko.Spec.DomainIAMRoleName != nil {
 		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
 	}
+	if r.ko.Spec.DomainOu != nil {
+		res.SetDomainOu(*r.ko.Spec.DomainOu)
+	}
 	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
 		f18 := []*string{}
 		for _, f18iter := range r.ko.Spec.EnableCloudwatchLogsExports {
@@ -1914,6 +2115,9 @@ func (rm *resourceManager) sdkUpdate(
 		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
 		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
 	}
+	if delta.DifferentAt("Spec.Adoption") {
+		return adoptDBInstance(latest), nil
+	}
 	if instanceDeleting(latest) {
 		msg := "DB instance is currently being deleted"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
@@ -1924,6 +2128,44 @@ func (rm *resourceManager) sdkUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if _, err = desiredState(desired); err != nil {
+		return nil, err
+	}
+	if err = validateGP3Storage(desired); err != nil {
+		return nil, err
+	}
+	if err = validatePromotionTier(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDeletion(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWindows(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecret(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecretRotation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateActivityStream(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateAutomatedBackupsReplication(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDomain(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.DesiredState") && instanceStopped(latest) {
+		if *latest.ko.Status.DBInstanceStatus == StatusStopping {
+			msg := "DB instance cannot be started while in '" + StatusStopping + "' status"
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+			return desired, requeueWaitWhileStopping
+		}
+		return rm.startDBInstance(ctx, desired)
+	}
 	if instanceHasTerminalStatus(latest) {
 		msg := "DB instance is in '" + *latest.ko.Status.DBInstanceStatus + "' status"
 		ackcondition.SetTerminal(desired, corev1.ConditionTrue, &msg, nil)
@@ -1935,11 +2177,107 @@ func (rm *resourceManager) sdkUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if multiAZConversionInProgress(latest) {
+		msg := "DB instance cannot be modified until its Multi-AZ conversion completes"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileConvertingMultiAZ
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	// The rotation schedule of the master user secret is applied through
+	// Secrets Manager
+	if delta.DifferentAt("Spec.MasterUserSecretRotation") {
+		if err = rm.syncMasterUserSecretRotation(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		if masterUserPasswordRotationRequested(desired) {
+			return rm.rotateMasterUserPassword(ctx, desired)
+		}
+		if !delta.DifferentExcept("Spec.MasterUserSecretRotation", "Spec.Tags") {
+			return desired, nil
+		}
+	}
+	if delta.DifferentAt("Spec.ActivityStream") {
+		return rm.syncActivityStream(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.AutomatedBackupsReplication") {
+		return rm.syncAutomatedBackupsReplication(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.PauseAutomation") {
+		return rm.changeAutomationMode(ctx, desired)
+	}
+	if delta.DifferentAt("Spec.ApplyPendingMaintenanceAction") {
+		return rm.applyPendingMaintenanceAction(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.RotateCACertificate") {
+		return rm.rotateCACertificate(ctx, desired, latest)
+	}
+	// Promote the read replica before any other modification, since some of
+	// them, like enabling automated backups on some engines, only apply to a
+	// standalone DB instance.
+	if delta.DifferentAt("Spec.PromoteReadReplica") {
+		return rm.promoteReadReplica(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.SwitchoverReadReplica") {
+		return rm.switchoverReadReplica(ctx, desired, latest)
+	}
+	if err = rm.validateModifications(ctx, desired, latest, delta); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.DedicatedLogVolume") || delta.DifferentAt("Spec.DBInstanceClass") ||
+		delta.DifferentAt("Spec.EngineVersion") || delta.DifferentAt("Spec.StorageType") {
+		if err = rm.validateDedicatedLogVolume(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.EnableIAMDatabaseAuthentication") || delta.DifferentAt("Spec.DBInstanceClass") ||
+		delta.DifferentAt("Spec.EngineVersion") {
+		if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.NetworkType") || delta.DifferentAt("Spec.DBSubnetGroupName") {
+		if err = rm.validateNetworkType(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.PubliclyAccessible") &&
+		desired.ko.Spec.PubliclyAccessible != nil && *desired.ko.Spec.PubliclyAccessible {
+		if err = rm.validatePublicAccess(ctx, latest); err != nil {
+			return nil, err
+		}
+	}
+	upgradeFamily, err := rm.validateEngineVersionUpgrade(ctx, desired, latest, delta)
+	if err != nil {
+		return nil, err
+	}
+	if safetySnapshotRequired(desired, latest, delta) {
+		if err = rm.syncSafetySnapshot(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
+	// Modifications deferred to the maintenance window are requested on their
+	// own, once every other modification has been applied, since RDS applies
+	// pending modifications along with the ones applied immediately.
+	if modificationsDeferred(desired, delta) {
+		return rm.deferModifications(ctx, desired, delta, upgradeFamily)
+	}
+	// Reboot the DB instance once every other modification has been applied,
+	// so that the reboot also applies the modifications pending a reboot. A
+	// requested stop happens after the reboot.
+	if delta.DifferentAt("Spec.Reboot") &&
+		!delta.DifferentExcept("Spec.Reboot", "Spec.Tags", "Spec.DesiredState") {
+		return rm.rebootDBInstance(ctx, desired)
+	}
+	// Stop the DB instance once every other modification has been applied,
+	// since a stopped DB instance cannot be modified.
+	if delta.DifferentAt("Spec.DesiredState") &&
+		!delta.DifferentExcept("Spec.DesiredState", "Spec.Tags") {
+		return rm.stopDBInstance(ctx, desired)
+	}
 
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
@@ -1971,6 +2309,60 @@ func (rm *resourceManager) sdkUpdate(
 	if !delta.DifferentAt("Spec.NetworkType") {
 		input.NetworkType = nil
 	}
+	// The master user password of the Secrets Manager secret set in
+	// Spec.MasterUserPasswordSecretARN is only read when it changed
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil && delta.DifferentAt("Spec.MasterUserPassword") {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
+	// RDS only accepts the KMS key of the master user secret along with
+	// turning on the management of the master user password in Secrets
+	// Manager. So, if neither of them changed, exclude them from
+	// ModifyDBInstanceRequest
+	if !delta.DifferentAt("Spec.ManageMasterUserPassword") &&
+		!delta.DifferentAt("Spec.MasterUserSecretKMSKeyID") {
+		input.ManageMasterUserPassword = nil
+		input.MasterUserSecretKmsKeyId = nil
+	}
+
+	// The domain of the DB instance only needs to be part of a
+	// ModifyDBInstanceRequest that changes it
+	if !domainChanged(delta) {
+		input.Domain = nil
+		input.DomainIAMRoleName = nil
+		input.DomainFqdn = nil
+		input.DomainOu = nil
+		input.DomainAuthSecretArn = nil
+		input.DomainDnsIps = nil
+	}
+
+	// Modifications deferred to the maintenance window are requested on their
+	// own, see deferModifications, so exclude them from the
+	// ModifyDBInstanceRequest applied immediately
+	excludeDeferredModifications(desired, input)
+
+	// Switch the DB instance to the default DB parameter group of the family
+	// of the target engine version when upgrading its major engine version,
+	// unless the DB parameter group is set in the Spec
+	if group := majorVersionUpgradeParameterGroup(desired, upgradeFamily); group != nil && input.EngineVersion != nil {
+		input.DBParameterGroupName = group
+	}
+
+	// RDS returns the gp3 baseline IOPS and storage throughput as set, but
+	// rejects a ModifyDBInstanceRequest that contains them below the
+	// engine-specific minimum allocated storage. So, if neither of them nor
+	// the storage they apply to changed, exclude them from
+	// ModifyDBInstanceRequest
+	if !delta.DifferentAt("Spec.IOPS") &&
+		!delta.DifferentAt("Spec.StorageThroughput") &&
+		!delta.DifferentAt("Spec.StorageType") &&
+		!delta.DifferentAt("Spec.AllocatedStorage") {
+		input.Iops = nil
+		input.StorageThroughput = nil
+	}
 
 	// For dbInstance inside dbCluster, it's either aurora or
 	// multi-az cluster case, in either case, the below params
@@ -1982,6 +2374,7 @@ func (rm *resourceManager) sdkUpdate(
 		input.BackupRetentionPeriod = nil
 		input.PreferredBackupWindow = nil
 		input.DeletionProtection = nil
+		input.EnableIAMDatabaseAuthentication = nil
 	}
 
 	var resp *svcsdk.ModifyDBInstanceOutput
@@ -2241,9 +2634,9 @@ func (rm *resourceManager) sdkUpdate(
 		ko.Status.DBSubnetGroup = nil
 	}
 	if resp.DBInstance.DBSystemId != nil {
-		ko.Status.DBSystemID = resp.DBInstance.DBSystemId
+		ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
 	} else {
-		ko.Status.DBSystemID = nil
+		ko.Spec.DBSystemID = nil
 	}
 	if resp.DBInstance.DbInstancePort != nil {
 		ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
@@ -2255,6 +2648,11 @@ func (rm *resourceManager) sdkUpdate(
 	} else {
 		ko.Status.DBIResourceID = nil
 	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		ko.Spec.DedicatedLogVolume = nil
+	}
 	if resp.DBInstance.DeletionProtection != nil {
 		ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
 	} else {
@@ -2264,6 +2662,18 @@ func (rm *resourceManager) sdkUpdate(
 		f34 := []*svcapitypes.DomainMembership{}
 		for _, f34iter := range resp.DBInstance.DomainMemberships {
 			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
 			if f34iter.Domain != nil {
 				f34elem.Domain = f34iter.Domain
 			}
@@ -2273,6 +2683,9 @@ func (rm *resourceManager) sdkUpdate(
 			if f34iter.IAMRoleName != nil {
 				f34elem.IAMRoleName = f34iter.IAMRoleName
 			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
 			if f34iter.Status != nil {
 				f34elem.Status = f34iter.Status
 			}
@@ -2457,6 +2870,9 @@ func (rm *resourceManager) sdkUpdate(
 		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
 			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
 		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
 		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
 			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
 		}
@@ -2727,13 +3143,15 @@ func (rm *resourceManager) sdkUpdate(
 		if pmv.DBSubnetGroupName != nil {
 			ko.Spec.DBSubnetGroupName = pmv.DBSubnetGroupName
 		}
+		if pmv.DedicatedLogVolume != nil {
+			ko.Spec.DedicatedLogVolume = pmv.DedicatedLogVolume
+		}
 		if pmv.EngineVersion != nil {
 			ko.Spec.EngineVersion = pmv.EngineVersion
 		}
-		// NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.IAMDatabaseAuthenticationEnabled != nil {
-		//	ko.Spec.IAMDatabaseAuthenticationEnabled = pmv.IAMDatabaseAuthenticationEnabled
-		//}
+		if pmv.IAMDatabaseAuthenticationEnabled != nil {
+			ko.Spec.EnableIAMDatabaseAuthentication = pmv.IAMDatabaseAuthenticationEnabled
+		}
 		if pmv.Iops != nil {
 			ko.Spec.IOPS = pmv.Iops
 		}
@@ -2778,6 +3196,26 @@ func (rm *resourceManager) sdkUpdate(
 		// resource.
 		r := &resource{ko}
 		setLastAppliedSecretReferenceAnnotation(r)
+		// The new value of the Secret referenced by Spec.MasterUserPassword
+		// has been applied
+		ko.Status.MasterUserPasswordHash = latest.ko.Status.MasterUserPasswordHash
+		ko.Status.MasterUserPasswordSecretVersionID = latest.ko.Status.MasterUserPasswordSecretVersionID
+		if delta.DifferentAt("Spec.MultiAZ") && !multiAZConversionDeferred(desired) {
+			setMultiAZConversionStarted(r)
+		}
+		// ModifyDBInstance returns the previous public accessibility, which is
+		// not part of the pending modified values
+		if delta.DifferentAt("Spec.PubliclyAccessible") {
+			ko.Spec.PubliclyAccessible = desired.ko.Spec.PubliclyAccessible
+			setPublicAccessStarted(r)
+		}
+		if delta.DifferentAt("Spec.Port") {
+			setPortChangeStarted(r)
+		}
+		setSafetySnapshotCompleted(r)
+		if upgradeFamily != "" && input.EngineVersion != nil {
+			setMajorVersionUpgradeStarted(r, false)
+		}
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(r, corev1.ConditionFalse, nil, nil)
@@ -2830,15 +3268,36 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.DBSubnetGroupName != nil {
 		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
 	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
 	if r.ko.Spec.DeletionProtection != nil {
 		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
 	}
 	if r.ko.Spec.Domain != nil {
 		res.SetDomain(*r.ko.Spec.Domain)
 	}
+	if r.ko.Spec.DomainAuthSecretARN != nil {
+		res.SetDomainAuthSecretArn(*r.ko.Spec.DomainAuthSecretARN)
+	}
+	if r.ko.Spec.DomainDNSIPs != nil {
+		f15 := []*string{}
+		for _, f15iter := range r.ko.Spec.DomainDNSIPs {
+			var f15elem string
+			f15elem = *f15iter
+			f15 = append(f15, &f15elem)
+		}
+		res.SetDomainDnsIps(f15)
+	}
+	if r.ko.Spec.DomainFqdn != nil {
+		res.SetDomainFqdn(*r.ko.Spec.DomainFqdn)
+	}
 	if r.ko.Spec.DomainIAMRoleName != nil {
 		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
 	}
+	if r.ko.Spec.DomainOu != nil {
+		res.SetDomainOu(*r.ko.Spec.DomainOu)
+	}
 	if r.ko.Spec.EnableCustomerOwnedIP != nil {
 		res.SetEnableCustomerOwnedIp(*r.ko.Spec.EnableCustomerOwnedIP)
 	}
@@ -2966,15 +3425,25 @@ func (rm *resourceManager) sdkDelete(
 	if instanceDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.checkDeletionProtection(ctx, r); err != nil {
+		return r, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	if err = setFinalSnapshot(r, input); err != nil {
+		return nil, err
+	}
+
 	var resp *svcsdk.DeleteDBInstanceOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteDBInstanceWithContext(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteDBInstance", err)
+	if err == nil {
+		rm.recordFinalSnapshot(r, input)
+	}
 	return nil, err
 }
 
@@ -3117,6 +3586,15 @@ func (rm *resourceManager) getImmutableFieldChanges(
 	if delta.DifferentAt("Spec.AvailabilityZone") {
 		fields = append(fields, "AvailabilityZone")
 	}
+	if delta.DifferentAt("Spec.CharacterSetName") {
+		fields = append(fields, "CharacterSetName")
+	}
+	if delta.DifferentAt("Spec.DBSystemID") {
+		fields = append(fields, "DBSystemID")
+	}
+	if delta.DifferentAt("Spec.NcharCharacterSetName") {
+		fields = append(fields, "NcharCharacterSetName")
+	}
 
 	return fields
 }
@@ -3167,15 +3645,36 @@ func (rm *resourceManager) newRestoreDBInstanceFromDBSnapshotInput(
 	if r.ko.Spec.DBSubnetGroupName != nil {
 		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
 	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
 	if r.ko.Spec.DeletionProtection != nil {
 		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
 	}
 	if r.ko.Spec.Domain != nil {
 		res.SetDomain(*r.ko.Spec.Domain)
 	}
+	if r.ko.Spec.DomainAuthSecretARN != nil {
+		res.SetDomainAuthSecretArn(*r.ko.Spec.DomainAuthSecretARN)
+	}
+	if r.ko.Spec.DomainDNSIPs != nil {
+		f15 := []*string{}
+		for _, f15iter := range r.ko.Spec.DomainDNSIPs {
+			var f15elem string
+			f15elem = *f15iter
+			f15 = append(f15, &f15elem)
+		}
+		res.SetDomainDnsIps(f15)
+	}
+	if r.ko.Spec.DomainFqdn != nil {
+		res.SetDomainFqdn(*r.ko.Spec.DomainFqdn)
+	}
 	if r.ko.Spec.DomainIAMRoleName != nil {
 		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
 	}
+	if r.ko.Spec.DomainOu != nil {
+		res.SetDomainOu(*r.ko.Spec.DomainOu)
+	}
 	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
 		resf16 := []*string{}
 		for _, resf16iter := range r.ko.Spec.EnableCloudwatchLogsExports {
@@ -3524,9 +4023,9 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 		r.ko.Status.DBSubnetGroup = nil
 	}
 	if resp.DBInstance.DBSystemId != nil {
-		r.ko.Status.DBSystemID = resp.DBInstance.DBSystemId
+		r.ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
 	} else {
-		r.ko.Status.DBSystemID = nil
+		r.ko.Spec.DBSystemID = nil
 	}
 	if resp.DBInstance.DbInstancePort != nil {
 		r.ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
@@ -3538,6 +4037,11 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 	} else {
 		r.ko.Status.DBIResourceID = nil
 	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		r.ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		r.ko.Spec.DedicatedLogVolume = nil
+	}
 	if resp.DBInstance.DeletionProtection != nil {
 		r.ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
 	} else {
@@ -3547,6 +4051,18 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 		f34 := []*svcapitypes.DomainMembership{}
 		for _, f34iter := range resp.DBInstance.DomainMemberships {
 			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
 			if f34iter.Domain != nil {
 				f34elem.Domain = f34iter.Domain
 			}
@@ -3556,6 +4072,9 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 			if f34iter.IAMRoleName != nil {
 				f34elem.IAMRoleName = f34iter.IAMRoleName
 			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
 			if f34iter.Status != nil {
 				f34elem.Status = f34iter.Status
 			}
@@ -3740,6 +4259,9 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
 			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
 		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
 		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
 			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
 		}
@@ -4227,9 +4749,9 @@ func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
 		r.ko.Status.DBSubnetGroup = nil
 	}
 	if resp.DBInstance.DBSystemId != nil {
-		r.ko.Status.DBSystemID = resp.DBInstance.DBSystemId
+		r.ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
 	} else {
-		r.ko.Status.DBSystemID = nil
+		r.ko.Spec.DBSystemID = nil
 	}
 	if resp.DBInstance.DbInstancePort != nil {
 		r.ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
@@ -4241,6 +4763,11 @@ func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
 	} else {
 		r.ko.Status.DBIResourceID = nil
 	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		r.ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		r.ko.Spec.DedicatedLogVolume = nil
+	}
 	if resp.DBInstance.DeletionProtection != nil {
 		r.ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
 	} else {
@@ -4250,6 +4777,18 @@ func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
 		f34 := []*svcapitypes.DomainMembership{}
 		for _, f34iter := range resp.DBInstance.DomainMemberships {
 			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
 			if f34iter.Domain != nil {
 				f34elem.Domain = f34iter.Domain
 			}
@@ -4259,6 +4798,9 @@ func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
 			if f34iter.IAMRoleName != nil {
 				f34elem.IAMRoleName = f34iter.IAMRoleName
 			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
 			if f34iter.Status != nil {
 				f34elem.Status = f34iter.Status
 			}
@@ -4443,6 +4985,1810 @@ func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
 		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
 			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
 		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
+		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
+			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
+		}
+		if resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled != nil {
+			f56.IAMDatabaseAuthenticationEnabled = resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled
+		}
+		if resp.DBInstance.PendingModifiedValues.Iops != nil {
+			f56.IOPS = resp.DBInstance.PendingModifiedValues.Iops
+		}
+		if resp.DBInstance.PendingModifiedValues.LicenseModel != nil {
+			f56.LicenseModel = resp.DBInstance.PendingModifiedValues.LicenseModel
+		}
+		if resp.DBInstance.PendingModifiedValues.MasterUserPassword != nil {
+			f56.MasterUserPassword = resp.DBInstance.PendingModifiedValues.MasterUserPassword
+		}
+		if resp.DBInstance.PendingModifiedValues.MultiAZ != nil {
+			f56.MultiAZ = resp.DBInstance.PendingModifiedValues.MultiAZ
+		}
+		if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports != nil {
+			f56f13 := &svcapitypes.PendingCloudwatchLogsExports{}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable != nil {
+				f56f13f0 := []*string{}
+				for _, f56f13f0iter := range resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable {
+					var f56f13f0elem string
+					f56f13f0elem = *f56f13f0iter
+					f56f13f0 = append(f56f13f0, &f56f13f0elem)
+				}
+				f56f13.LogTypesToDisable = f56f13f0
+			}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable != nil {
+				f56f13f1 := []*string{}
+				for _, f56f13f1iter := range resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable {
+					var f56f13f1elem string
+					f56f13f1elem = *f56f13f1iter
+					f56f13f1 = append(f56f13f1, &f56f13f1elem)
+				}
+				f56f13.LogTypesToEnable = f56f13f1
+			}
+			f56.PendingCloudwatchLogsExports = f56f13
+		}
+		if resp.DBInstance.PendingModifiedValues.Port != nil {
+			f56.Port = resp.DBInstance.PendingModifiedValues.Port
+		}
+		if resp.DBInstance.PendingModifiedValues.ProcessorFeatures != nil {
+			f56f15 := []*svcapitypes.ProcessorFeature{}
+			for _, f56f15iter := range resp.DBInstance.PendingModifiedValues.ProcessorFeatures {
+				f56f15elem := &svcapitypes.ProcessorFeature{}
+				if f56f15iter.Name != nil {
+					f56f15elem.Name = f56f15iter.Name
+				}
+				if f56f15iter.Value != nil {
+					f56f15elem.Value = f56f15iter.Value
+				}
+				f56f15 = append(f56f15, f56f15elem)
+			}
+			f56.ProcessorFeatures = f56f15
+		}
+		if resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime != nil {
+			f56.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime}
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageThroughput != nil {
+			f56.StorageThroughput = resp.DBInstance.PendingModifiedValues.StorageThroughput
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageType != nil {
+			f56.StorageType = resp.DBInstance.PendingModifiedValues.StorageType
+		}
+		r.ko.Status.PendingModifiedValues = f56
+	} else {
+		r.ko.Status.PendingModifiedValues = nil
+	}
+	if resp.DBInstance.PerformanceInsightsEnabled != nil {
+		r.ko.Spec.PerformanceInsightsEnabled = resp.DBInstance.PerformanceInsightsEnabled
+	} else {
+		r.ko.Spec.PerformanceInsightsEnabled = nil
+	}
+	if resp.DBInstance.PerformanceInsightsKMSKeyId != nil {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = resp.DBInstance.PerformanceInsightsKMSKeyId
+	} else {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = nil
+	}
+	if resp.DBInstance.PerformanceInsightsRetentionPeriod != nil {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = resp.DBInstance.PerformanceInsightsRetentionPeriod
+	} else {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = nil
+	}
+	if resp.DBInstance.PreferredBackupWindow != nil {
+		r.ko.Spec.PreferredBackupWindow = resp.DBInstance.PreferredBackupWindow
+	} else {
+		r.ko.Spec.PreferredBackupWindow = nil
+	}
+	if resp.DBInstance.PreferredMaintenanceWindow != nil {
+		r.ko.Spec.PreferredMaintenanceWindow = resp.DBInstance.PreferredMaintenanceWindow
+	} else {
+		r.ko.Spec.PreferredMaintenanceWindow = nil
+	}
+	if resp.DBInstance.ProcessorFeatures != nil {
+		f62 := []*svcapitypes.ProcessorFeature{}
+		for _, f62iter := range resp.DBInstance.ProcessorFeatures {
+			f62elem := &svcapitypes.ProcessorFeature{}
+			if f62iter.Name != nil {
+				f62elem.Name = f62iter.Name
+			}
+			if f62iter.Value != nil {
+				f62elem.Value = f62iter.Value
+			}
+			f62 = append(f62, f62elem)
+		}
+		r.ko.Spec.ProcessorFeatures = f62
+	} else {
+		r.ko.Spec.ProcessorFeatures = nil
+	}
+	if resp.DBInstance.PromotionTier != nil {
+		r.ko.Spec.PromotionTier = resp.DBInstance.PromotionTier
+	} else {
+		r.ko.Spec.PromotionTier = nil
+	}
+	if resp.DBInstance.PubliclyAccessible != nil {
+		r.ko.Spec.PubliclyAccessible = resp.DBInstance.PubliclyAccessible
+	} else {
+		r.ko.Spec.PubliclyAccessible = nil
+	}
+	if resp.DBInstance.ReadReplicaDBClusterIdentifiers != nil {
+		f65 := []*string{}
+		for _, f65iter := range resp.DBInstance.ReadReplicaDBClusterIdentifiers {
+			var f65elem string
+			f65elem = *f65iter
+			f65 = append(f65, &f65elem)
+		}
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = f65
+	} else {
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaDBInstanceIdentifiers != nil {
+		f66 := []*string{}
+		for _, f66iter := range resp.DBInstance.ReadReplicaDBInstanceIdentifiers {
+			var f66elem string
+			f66elem = *f66iter
+			f66 = append(f66, &f66elem)
+		}
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = f66
+	} else {
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBClusterIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = resp.DBInstance.ReadReplicaSourceDBClusterIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.ReplicaMode != nil {
+		r.ko.Spec.ReplicaMode = resp.DBInstance.ReplicaMode
+	} else {
+		r.ko.Spec.ReplicaMode = nil
+	}
+	if resp.DBInstance.ResumeFullAutomationModeTime != nil {
+		r.ko.Status.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.ResumeFullAutomationModeTime}
+	} else {
+		r.ko.Status.ResumeFullAutomationModeTime = nil
+	}
+	if resp.DBInstance.SecondaryAvailabilityZone != nil {
+		r.ko.Status.SecondaryAvailabilityZone = resp.DBInstance.SecondaryAvailabilityZone
+	} else {
+		r.ko.Status.SecondaryAvailabilityZone = nil
+	}
+	if resp.DBInstance.StatusInfos != nil {
+		f72 := []*svcapitypes.DBInstanceStatusInfo{}
+		for _, f72iter := range resp.DBInstance.StatusInfos {
+			f72elem := &svcapitypes.DBInstanceStatusInfo{}
+			if f72iter.Message != nil {
+				f72elem.Message = f72iter.Message
+			}
+			if f72iter.Normal != nil {
+				f72elem.Normal = f72iter.Normal
+			}
+			if f72iter.Status != nil {
+				f72elem.Status = f72iter.Status
+			}
+			if f72iter.StatusType != nil {
+				f72elem.StatusType = f72iter.StatusType
+			}
+			f72 = append(f72, f72elem)
+		}
+		r.ko.Status.StatusInfos = f72
+	} else {
+		r.ko.Status.StatusInfos = nil
+	}
+	if resp.DBInstance.StorageEncrypted != nil {
+		r.ko.Spec.StorageEncrypted = resp.DBInstance.StorageEncrypted
+	} else {
+		r.ko.Spec.StorageEncrypted = nil
+	}
+	if resp.DBInstance.StorageThroughput != nil {
+		r.ko.Spec.StorageThroughput = resp.DBInstance.StorageThroughput
+	} else {
+		r.ko.Spec.StorageThroughput = nil
+	}
+	if resp.DBInstance.StorageType != nil {
+		r.ko.Spec.StorageType = resp.DBInstance.StorageType
+	} else {
+		r.ko.Spec.StorageType = nil
+	}
+	if resp.DBInstance.TdeCredentialArn != nil {
+		r.ko.Spec.TDECredentialARN = resp.DBInstance.TdeCredentialArn
+	} else {
+		r.ko.Spec.TDECredentialARN = nil
+	}
+	if resp.DBInstance.Timezone != nil {
+		r.ko.Spec.Timezone = resp.DBInstance.Timezone
+	} else {
+		r.ko.Spec.Timezone = nil
+	}
+	if resp.DBInstance.VpcSecurityGroups != nil {
+		f78 := []*svcapitypes.VPCSecurityGroupMembership{}
+		for _, f78iter := range resp.DBInstance.VpcSecurityGroups {
+			f78elem := &svcapitypes.VPCSecurityGroupMembership{}
+			if f78iter.Status != nil {
+				f78elem.Status = f78iter.Status
+			}
+			if f78iter.VpcSecurityGroupId != nil {
+				f78elem.VPCSecurityGroupID = f78iter.VpcSecurityGroupId
+			}
+			f78 = append(f78, f78elem)
+		}
+		r.ko.Status.VPCSecurityGroups = f78
+	} else {
+		r.ko.Status.VPCSecurityGroups = nil
+	}
+
+}
+
+// newRestoreDBInstanceToPointInTimeInput returns a RestoreDBInstanceToPointInTimeInput object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newRestoreDBInstanceToPointInTimeInput(
+	r *resource,
+) *svcsdk.RestoreDBInstanceToPointInTimeInput {
+	res := &svcsdk.RestoreDBInstanceToPointInTimeInput{}
+
+	if r.ko.Spec.AllocatedStorage != nil {
+		res.SetAllocatedStorage(*r.ko.Spec.AllocatedStorage)
+	}
+	if r.ko.Spec.AutoMinorVersionUpgrade != nil {
+		res.SetAutoMinorVersionUpgrade(*r.ko.Spec.AutoMinorVersionUpgrade)
+	}
+	if r.ko.Spec.AvailabilityZone != nil {
+		res.SetAvailabilityZone(*r.ko.Spec.AvailabilityZone)
+	}
+	if r.ko.Spec.BackupTarget != nil {
+		res.SetBackupTarget(*r.ko.Spec.BackupTarget)
+	}
+	if r.ko.Spec.CopyTagsToSnapshot != nil {
+		res.SetCopyTagsToSnapshot(*r.ko.Spec.CopyTagsToSnapshot)
+	}
+	if r.ko.Spec.CustomIAMInstanceProfile != nil {
+		res.SetCustomIamInstanceProfile(*r.ko.Spec.CustomIAMInstanceProfile)
+	}
+	if r.ko.Spec.DBInstanceClass != nil {
+		res.SetDBInstanceClass(*r.ko.Spec.DBInstanceClass)
+	}
+	if r.ko.Spec.DBName != nil {
+		res.SetDBName(*r.ko.Spec.DBName)
+	}
+	if r.ko.Spec.DBParameterGroupName != nil {
+		res.SetDBParameterGroupName(*r.ko.Spec.DBParameterGroupName)
+	}
+	if r.ko.Spec.DBSubnetGroupName != nil {
+		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
+	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
+	if r.ko.Spec.DeletionProtection != nil {
+		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
+	}
+	if r.ko.Spec.Domain != nil {
+		res.SetDomain(*r.ko.Spec.Domain)
+	}
+	if r.ko.Spec.DomainAuthSecretARN != nil {
+		res.SetDomainAuthSecretArn(*r.ko.Spec.DomainAuthSecretARN)
+	}
+	if r.ko.Spec.DomainDNSIPs != nil {
+		f15 := []*string{}
+		for _, f15iter := range r.ko.Spec.DomainDNSIPs {
+			var f15elem string
+			f15elem = *f15iter
+			f15 = append(f15, &f15elem)
+		}
+		res.SetDomainDnsIps(f15)
+	}
+	if r.ko.Spec.DomainFqdn != nil {
+		res.SetDomainFqdn(*r.ko.Spec.DomainFqdn)
+	}
+	if r.ko.Spec.DomainIAMRoleName != nil {
+		res.SetDomainIAMRoleName(*r.ko.Spec.DomainIAMRoleName)
+	}
+	if r.ko.Spec.DomainOu != nil {
+		res.SetDomainOu(*r.ko.Spec.DomainOu)
+	}
+	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
+		resf18 := []*string{}
+		for _, resf18iter := range r.ko.Spec.EnableCloudwatchLogsExports {
+			var resf18elem string
+			resf18elem = *resf18iter
+			resf18 = append(resf18, &resf18elem)
+		}
+		res.SetEnableCloudwatchLogsExports(resf18)
+	}
+	if r.ko.Spec.EnableCustomerOwnedIP != nil {
+		res.SetEnableCustomerOwnedIp(*r.ko.Spec.EnableCustomerOwnedIP)
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil {
+		res.SetEnableIAMDatabaseAuthentication(*r.ko.Spec.EnableIAMDatabaseAuthentication)
+	}
+	if r.ko.Spec.Engine != nil {
+		res.SetEngine(*r.ko.Spec.Engine)
+	}
+	if r.ko.Spec.IOPS != nil {
+		res.SetIops(*r.ko.Spec.IOPS)
+	}
+	if r.ko.Spec.LicenseModel != nil {
+		res.SetLicenseModel(*r.ko.Spec.LicenseModel)
+	}
+	if r.ko.Spec.MaxAllocatedStorage != nil {
+		res.SetMaxAllocatedStorage(*r.ko.Spec.MaxAllocatedStorage)
+	}
+	if r.ko.Spec.MultiAZ != nil {
+		res.SetMultiAZ(*r.ko.Spec.MultiAZ)
+	}
+	if r.ko.Spec.NetworkType != nil {
+		res.SetNetworkType(*r.ko.Spec.NetworkType)
+	}
+	if r.ko.Spec.OptionGroupName != nil {
+		res.SetOptionGroupName(*r.ko.Spec.OptionGroupName)
+	}
+	if r.ko.Spec.Port != nil {
+		res.SetPort(*r.ko.Spec.Port)
+	}
+	if r.ko.Spec.ProcessorFeatures != nil {
+		resf29 := []*svcsdk.ProcessorFeature{}
+		for _, resf29iter := range r.ko.Spec.ProcessorFeatures {
+			resf29elem := &svcsdk.ProcessorFeature{}
+			if resf29iter.Name != nil {
+				resf29elem.SetName(*resf29iter.Name)
+			}
+			if resf29iter.Value != nil {
+				resf29elem.SetValue(*resf29iter.Value)
+			}
+			resf29 = append(resf29, resf29elem)
+		}
+		res.SetProcessorFeatures(resf29)
+	}
+	if r.ko.Spec.PubliclyAccessible != nil {
+		res.SetPubliclyAccessible(*r.ko.Spec.PubliclyAccessible)
+	}
+	if r.ko.Spec.SourceDBInstanceIdentifier != nil {
+		res.SetSourceDBInstanceIdentifier(*r.ko.Spec.SourceDBInstanceIdentifier)
+	}
+	if r.ko.Spec.StorageThroughput != nil {
+		res.SetStorageThroughput(*r.ko.Spec.StorageThroughput)
+	}
+	if r.ko.Spec.StorageType != nil {
+		res.SetStorageType(*r.ko.Spec.StorageType)
+	}
+	if r.ko.Spec.Tags != nil {
+		resf37 := []*svcsdk.Tag{}
+		for _, resf37iter := range r.ko.Spec.Tags {
+			resf37elem := &svcsdk.Tag{}
+			if resf37iter.Key != nil {
+				resf37elem.SetKey(*resf37iter.Key)
+			}
+			if resf37iter.Value != nil {
+				resf37elem.SetValue(*resf37iter.Value)
+			}
+			resf37 = append(resf37, resf37elem)
+		}
+		res.SetTags(resf37)
+	}
+	if r.ko.Spec.TDECredentialARN != nil {
+		res.SetTdeCredentialArn(*r.ko.Spec.TDECredentialARN)
+	}
+	if r.ko.Spec.TDECredentialPassword != nil {
+		res.SetTdeCredentialPassword(*r.ko.Spec.TDECredentialPassword)
+	}
+	if r.ko.Spec.UseDefaultProcessorFeatures != nil {
+		res.SetUseDefaultProcessorFeatures(*r.ko.Spec.UseDefaultProcessorFeatures)
+	}
+	if r.ko.Spec.VPCSecurityGroupIDs != nil {
+		resf43 := []*string{}
+		for _, resf43iter := range r.ko.Spec.VPCSecurityGroupIDs {
+			var resf43elem string
+			resf43elem = *resf43iter
+			resf43 = append(resf43, &resf43elem)
+		}
+		res.SetVpcSecurityGroupIds(resf43)
+	}
+
+	return res
+}
+
+// setResourceFromRestoreDBInstanceToPointInTimeOutput sets a resource RestoreDBInstanceToPointInTimeOutput type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromRestoreDBInstanceToPointInTimeOutput(
+	r *resource,
+	resp *svcsdk.RestoreDBInstanceToPointInTimeOutput,
+) {
+
+	if resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded
+	} else {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = nil
+	}
+	if resp.DBInstance.ActivityStreamKinesisStreamName != nil {
+		r.ko.Status.ActivityStreamKinesisStreamName = resp.DBInstance.ActivityStreamKinesisStreamName
+	} else {
+		r.ko.Status.ActivityStreamKinesisStreamName = nil
+	}
+	if resp.DBInstance.ActivityStreamKmsKeyId != nil {
+		r.ko.Status.ActivityStreamKMSKeyID = resp.DBInstance.ActivityStreamKmsKeyId
+	} else {
+		r.ko.Status.ActivityStreamKMSKeyID = nil
+	}
+	if resp.DBInstance.ActivityStreamMode != nil {
+		r.ko.Status.ActivityStreamMode = resp.DBInstance.ActivityStreamMode
+	} else {
+		r.ko.Status.ActivityStreamMode = nil
+	}
+	if resp.DBInstance.ActivityStreamPolicyStatus != nil {
+		r.ko.Status.ActivityStreamPolicyStatus = resp.DBInstance.ActivityStreamPolicyStatus
+	} else {
+		r.ko.Status.ActivityStreamPolicyStatus = nil
+	}
+	if resp.DBInstance.ActivityStreamStatus != nil {
+		r.ko.Status.ActivityStreamStatus = resp.DBInstance.ActivityStreamStatus
+	} else {
+		r.ko.Status.ActivityStreamStatus = nil
+	}
+	if resp.DBInstance.AllocatedStorage != nil {
+		r.ko.Spec.AllocatedStorage = resp.DBInstance.AllocatedStorage
+	} else {
+		r.ko.Spec.AllocatedStorage = nil
+	}
+	if resp.DBInstance.AssociatedRoles != nil {
+		f7 := []*svcapitypes.DBInstanceRole{}
+		for _, f7iter := range resp.DBInstance.AssociatedRoles {
+			f7elem := &svcapitypes.DBInstanceRole{}
+			if f7iter.FeatureName != nil {
+				f7elem.FeatureName = f7iter.FeatureName
+			}
+			if f7iter.RoleArn != nil {
+				f7elem.RoleARN = f7iter.RoleArn
+			}
+			if f7iter.Status != nil {
+				f7elem.Status = f7iter.Status
+			}
+			f7 = append(f7, f7elem)
+		}
+		r.ko.Status.AssociatedRoles = f7
+	} else {
+		r.ko.Status.AssociatedRoles = nil
+	}
+	if resp.DBInstance.AutoMinorVersionUpgrade != nil {
+		r.ko.Spec.AutoMinorVersionUpgrade = resp.DBInstance.AutoMinorVersionUpgrade
+	} else {
+		r.ko.Spec.AutoMinorVersionUpgrade = nil
+	}
+	if resp.DBInstance.AutomaticRestartTime != nil {
+		r.ko.Status.AutomaticRestartTime = &metav1.Time{*resp.DBInstance.AutomaticRestartTime}
+	} else {
+		r.ko.Status.AutomaticRestartTime = nil
+	}
+	if resp.DBInstance.AutomationMode != nil {
+		r.ko.Status.AutomationMode = resp.DBInstance.AutomationMode
+	} else {
+		r.ko.Status.AutomationMode = nil
+	}
+	if resp.DBInstance.AvailabilityZone != nil {
+		r.ko.Spec.AvailabilityZone = resp.DBInstance.AvailabilityZone
+	} else {
+		r.ko.Spec.AvailabilityZone = nil
+	}
+	if resp.DBInstance.AwsBackupRecoveryPointArn != nil {
+		r.ko.Status.AWSBackupRecoveryPointARN = resp.DBInstance.AwsBackupRecoveryPointArn
+	} else {
+		r.ko.Status.AWSBackupRecoveryPointARN = nil
+	}
+	if resp.DBInstance.BackupRetentionPeriod != nil {
+		r.ko.Spec.BackupRetentionPeriod = resp.DBInstance.BackupRetentionPeriod
+	} else {
+		r.ko.Spec.BackupRetentionPeriod = nil
+	}
+	if resp.DBInstance.BackupTarget != nil {
+		r.ko.Spec.BackupTarget = resp.DBInstance.BackupTarget
+	} else {
+		r.ko.Spec.BackupTarget = nil
+	}
+	if resp.DBInstance.CACertificateIdentifier != nil {
+		r.ko.Spec.CACertificateIdentifier = resp.DBInstance.CACertificateIdentifier
+	} else {
+		r.ko.Spec.CACertificateIdentifier = nil
+	}
+	if resp.DBInstance.CertificateDetails != nil {
+		f16 := &svcapitypes.CertificateDetails{}
+		if resp.DBInstance.CertificateDetails.CAIdentifier != nil {
+			f16.CAIdentifier = resp.DBInstance.CertificateDetails.CAIdentifier
+		}
+		if resp.DBInstance.CertificateDetails.ValidTill != nil {
+			f16.ValidTill = &metav1.Time{*resp.DBInstance.CertificateDetails.ValidTill}
+		}
+		r.ko.Status.CertificateDetails = f16
+	} else {
+		r.ko.Status.CertificateDetails = nil
+	}
+	if resp.DBInstance.CharacterSetName != nil {
+		r.ko.Spec.CharacterSetName = resp.DBInstance.CharacterSetName
+	} else {
+		r.ko.Spec.CharacterSetName = nil
+	}
+	if resp.DBInstance.CopyTagsToSnapshot != nil {
+		r.ko.Spec.CopyTagsToSnapshot = resp.DBInstance.CopyTagsToSnapshot
+	} else {
+		r.ko.Spec.CopyTagsToSnapshot = nil
+	}
+	if resp.DBInstance.CustomIamInstanceProfile != nil {
+		r.ko.Spec.CustomIAMInstanceProfile = resp.DBInstance.CustomIamInstanceProfile
+	} else {
+		r.ko.Spec.CustomIAMInstanceProfile = nil
+	}
+	if resp.DBInstance.CustomerOwnedIpEnabled != nil {
+		r.ko.Status.CustomerOwnedIPEnabled = resp.DBInstance.CustomerOwnedIpEnabled
+	} else {
+		r.ko.Status.CustomerOwnedIPEnabled = nil
+	}
+	if resp.DBInstance.DBClusterIdentifier != nil {
+		r.ko.Spec.DBClusterIdentifier = resp.DBInstance.DBClusterIdentifier
+	} else {
+		r.ko.Spec.DBClusterIdentifier = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBInstance.DBInstanceArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBInstance.DBInstanceArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBInstance.DBInstanceAutomatedBackupsReplications != nil {
+		f23 := []*svcapitypes.DBInstanceAutomatedBackupsReplication{}
+		for _, f23iter := range resp.DBInstance.DBInstanceAutomatedBackupsReplications {
+			f23elem := &svcapitypes.DBInstanceAutomatedBackupsReplication{}
+			if f23iter.DBInstanceAutomatedBackupsArn != nil {
+				f23elem.DBInstanceAutomatedBackupsARN = f23iter.DBInstanceAutomatedBackupsArn
+			}
+			f23 = append(f23, f23elem)
+		}
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = f23
+	} else {
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = nil
+	}
+	if resp.DBInstance.DBInstanceClass != nil {
+		r.ko.Spec.DBInstanceClass = resp.DBInstance.DBInstanceClass
+	} else {
+		r.ko.Spec.DBInstanceClass = nil
+	}
+	if resp.DBInstance.DBInstanceIdentifier != nil {
+		r.ko.Spec.DBInstanceIdentifier = resp.DBInstance.DBInstanceIdentifier
+	} else {
+		r.ko.Spec.DBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.DBInstanceStatus != nil {
+		r.ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	} else {
+		r.ko.Status.DBInstanceStatus = nil
+	}
+	if resp.DBInstance.DBName != nil {
+		r.ko.Spec.DBName = resp.DBInstance.DBName
+	} else {
+		r.ko.Spec.DBName = nil
+	}
+	if resp.DBInstance.DBParameterGroups != nil {
+		f28 := []*svcapitypes.DBParameterGroupStatus_SDK{}
+		for _, f28iter := range resp.DBInstance.DBParameterGroups {
+			f28elem := &svcapitypes.DBParameterGroupStatus_SDK{}
+			if f28iter.DBParameterGroupName != nil {
+				f28elem.DBParameterGroupName = f28iter.DBParameterGroupName
+			}
+			if f28iter.ParameterApplyStatus != nil {
+				f28elem.ParameterApplyStatus = f28iter.ParameterApplyStatus
+			}
+			f28 = append(f28, f28elem)
+		}
+		r.ko.Status.DBParameterGroups = f28
+	} else {
+		r.ko.Status.DBParameterGroups = nil
+	}
+	if resp.DBInstance.DBSubnetGroup != nil {
+		f29 := &svcapitypes.DBSubnetGroup_SDK{}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn != nil {
+			f29.DBSubnetGroupARN = resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription != nil {
+			f29.DBSubnetGroupDescription = resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupName != nil {
+			f29.DBSubnetGroupName = resp.DBInstance.DBSubnetGroup.DBSubnetGroupName
+		}
+		if resp.DBInstance.DBSubnetGroup.SubnetGroupStatus != nil {
+			f29.SubnetGroupStatus = resp.DBInstance.DBSubnetGroup.SubnetGroupStatus
+		}
+		if resp.DBInstance.DBSubnetGroup.Subnets != nil {
+			f29f4 := []*svcapitypes.Subnet{}
+			for _, f29f4iter := range resp.DBInstance.DBSubnetGroup.Subnets {
+				f29f4elem := &svcapitypes.Subnet{}
+				if f29f4iter.SubnetAvailabilityZone != nil {
+					f29f4elemf0 := &svcapitypes.AvailabilityZone{}
+					if f29f4iter.SubnetAvailabilityZone.Name != nil {
+						f29f4elemf0.Name = f29f4iter.SubnetAvailabilityZone.Name
+					}
+					f29f4elem.SubnetAvailabilityZone = f29f4elemf0
+				}
+				if f29f4iter.SubnetIdentifier != nil {
+					f29f4elem.SubnetIdentifier = f29f4iter.SubnetIdentifier
+				}
+				if f29f4iter.SubnetOutpost != nil {
+					f29f4elemf2 := &svcapitypes.Outpost{}
+					if f29f4iter.SubnetOutpost.Arn != nil {
+						f29f4elemf2.ARN = f29f4iter.SubnetOutpost.Arn
+					}
+					f29f4elem.SubnetOutpost = f29f4elemf2
+				}
+				if f29f4iter.SubnetStatus != nil {
+					f29f4elem.SubnetStatus = f29f4iter.SubnetStatus
+				}
+				f29f4 = append(f29f4, f29f4elem)
+			}
+			f29.Subnets = f29f4
+		}
+		if resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes != nil {
+			f29f5 := []*string{}
+			for _, f29f5iter := range resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes {
+				var f29f5elem string
+				f29f5elem = *f29f5iter
+				f29f5 = append(f29f5, &f29f5elem)
+			}
+			f29.SupportedNetworkTypes = f29f5
+		}
+		if resp.DBInstance.DBSubnetGroup.VpcId != nil {
+			f29.VPCID = resp.DBInstance.DBSubnetGroup.VpcId
+		}
+		r.ko.Status.DBSubnetGroup = f29
+	} else {
+		r.ko.Status.DBSubnetGroup = nil
+	}
+	if resp.DBInstance.DBSystemId != nil {
+		r.ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
+	} else {
+		r.ko.Spec.DBSystemID = nil
+	}
+	if resp.DBInstance.DbInstancePort != nil {
+		r.ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
+	} else {
+		r.ko.Status.DBInstancePort = nil
+	}
+	if resp.DBInstance.DbiResourceId != nil {
+		r.ko.Status.DBIResourceID = resp.DBInstance.DbiResourceId
+	} else {
+		r.ko.Status.DBIResourceID = nil
+	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		r.ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		r.ko.Spec.DedicatedLogVolume = nil
+	}
+	if resp.DBInstance.DeletionProtection != nil {
+		r.ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
+	} else {
+		r.ko.Spec.DeletionProtection = nil
+	}
+	if resp.DBInstance.DomainMemberships != nil {
+		f34 := []*svcapitypes.DomainMembership{}
+		for _, f34iter := range resp.DBInstance.DomainMemberships {
+			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
+			if f34iter.Domain != nil {
+				f34elem.Domain = f34iter.Domain
+			}
+			if f34iter.FQDN != nil {
+				f34elem.FQDN = f34iter.FQDN
+			}
+			if f34iter.IAMRoleName != nil {
+				f34elem.IAMRoleName = f34iter.IAMRoleName
+			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
+			if f34iter.Status != nil {
+				f34elem.Status = f34iter.Status
+			}
+			f34 = append(f34, f34elem)
+		}
+		r.ko.Status.DomainMemberships = f34
+	} else {
+		r.ko.Status.DomainMemberships = nil
+	}
+	if resp.DBInstance.EnabledCloudwatchLogsExports != nil {
+		f35 := []*string{}
+		for _, f35iter := range resp.DBInstance.EnabledCloudwatchLogsExports {
+			var f35elem string
+			f35elem = *f35iter
+			f35 = append(f35, &f35elem)
+		}
+		r.ko.Status.EnabledCloudwatchLogsExports = f35
+	} else {
+		r.ko.Status.EnabledCloudwatchLogsExports = nil
+	}
+	if resp.DBInstance.Endpoint != nil {
+		f36 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.Endpoint.Address != nil {
+			f36.Address = resp.DBInstance.Endpoint.Address
+		}
+		if resp.DBInstance.Endpoint.HostedZoneId != nil {
+			f36.HostedZoneID = resp.DBInstance.Endpoint.HostedZoneId
+		}
+		if resp.DBInstance.Endpoint.Port != nil {
+			f36.Port = resp.DBInstance.Endpoint.Port
+		}
+		r.ko.Status.Endpoint = f36
+	} else {
+		r.ko.Status.Endpoint = nil
+	}
+	if resp.DBInstance.Engine != nil {
+		r.ko.Spec.Engine = resp.DBInstance.Engine
+	} else {
+		r.ko.Spec.Engine = nil
+	}
+	if resp.DBInstance.EngineVersion != nil {
+		r.ko.Spec.EngineVersion = resp.DBInstance.EngineVersion
+	} else {
+		r.ko.Spec.EngineVersion = nil
+	}
+	if resp.DBInstance.EnhancedMonitoringResourceArn != nil {
+		r.ko.Status.EnhancedMonitoringResourceARN = resp.DBInstance.EnhancedMonitoringResourceArn
+	} else {
+		r.ko.Status.EnhancedMonitoringResourceARN = nil
+	}
+	if resp.DBInstance.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBInstance.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBInstance.InstanceCreateTime != nil {
+		r.ko.Status.InstanceCreateTime = &metav1.Time{*resp.DBInstance.InstanceCreateTime}
+	} else {
+		r.ko.Status.InstanceCreateTime = nil
+	}
+	if resp.DBInstance.Iops != nil {
+		r.ko.Spec.IOPS = resp.DBInstance.Iops
+	} else {
+		r.ko.Spec.IOPS = nil
+	}
+	if resp.DBInstance.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBInstance.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBInstance.LatestRestorableTime != nil {
+		r.ko.Status.LatestRestorableTime = &metav1.Time{*resp.DBInstance.LatestRestorableTime}
+	} else {
+		r.ko.Status.LatestRestorableTime = nil
+	}
+	if resp.DBInstance.LicenseModel != nil {
+		r.ko.Spec.LicenseModel = resp.DBInstance.LicenseModel
+	} else {
+		r.ko.Spec.LicenseModel = nil
+	}
+	if resp.DBInstance.ListenerEndpoint != nil {
+		f46 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.ListenerEndpoint.Address != nil {
+			f46.Address = resp.DBInstance.ListenerEndpoint.Address
+		}
+		if resp.DBInstance.ListenerEndpoint.HostedZoneId != nil {
+			f46.HostedZoneID = resp.DBInstance.ListenerEndpoint.HostedZoneId
+		}
+		if resp.DBInstance.ListenerEndpoint.Port != nil {
+			f46.Port = resp.DBInstance.ListenerEndpoint.Port
+		}
+		r.ko.Status.ListenerEndpoint = f46
+	} else {
+		r.ko.Status.ListenerEndpoint = nil
+	}
+	if resp.DBInstance.MasterUserSecret != nil {
+		f47 := &svcapitypes.MasterUserSecret{}
+		if resp.DBInstance.MasterUserSecret.KmsKeyId != nil {
+			f47.KMSKeyID = resp.DBInstance.MasterUserSecret.KmsKeyId
+		}
+		if resp.DBInstance.MasterUserSecret.SecretArn != nil {
+			f47.SecretARN = resp.DBInstance.MasterUserSecret.SecretArn
+		}
+		if resp.DBInstance.MasterUserSecret.SecretStatus != nil {
+			f47.SecretStatus = resp.DBInstance.MasterUserSecret.SecretStatus
+		}
+		r.ko.Status.MasterUserSecret = f47
+	} else {
+		r.ko.Status.MasterUserSecret = nil
+	}
+	if resp.DBInstance.MasterUsername != nil {
+		r.ko.Spec.MasterUsername = resp.DBInstance.MasterUsername
+	} else {
+		r.ko.Spec.MasterUsername = nil
+	}
+	if resp.DBInstance.MaxAllocatedStorage != nil {
+		r.ko.Spec.MaxAllocatedStorage = resp.DBInstance.MaxAllocatedStorage
+	} else {
+		r.ko.Spec.MaxAllocatedStorage = nil
+	}
+	if resp.DBInstance.MonitoringInterval != nil {
+		r.ko.Spec.MonitoringInterval = resp.DBInstance.MonitoringInterval
+	} else {
+		r.ko.Spec.MonitoringInterval = nil
+	}
+	if resp.DBInstance.MonitoringRoleArn != nil {
+		r.ko.Spec.MonitoringRoleARN = resp.DBInstance.MonitoringRoleArn
+	} else {
+		r.ko.Spec.MonitoringRoleARN = nil
+	}
+	if resp.DBInstance.MultiAZ != nil {
+		r.ko.Spec.MultiAZ = resp.DBInstance.MultiAZ
+	} else {
+		r.ko.Spec.MultiAZ = nil
+	}
+	if resp.DBInstance.NcharCharacterSetName != nil {
+		r.ko.Spec.NcharCharacterSetName = resp.DBInstance.NcharCharacterSetName
+	} else {
+		r.ko.Spec.NcharCharacterSetName = nil
+	}
+	if resp.DBInstance.NetworkType != nil {
+		r.ko.Spec.NetworkType = resp.DBInstance.NetworkType
+	} else {
+		r.ko.Spec.NetworkType = nil
+	}
+	if resp.DBInstance.OptionGroupMemberships != nil {
+		f55 := []*svcapitypes.OptionGroupMembership{}
+		for _, f55iter := range resp.DBInstance.OptionGroupMemberships {
+			f55elem := &svcapitypes.OptionGroupMembership{}
+			if f55iter.OptionGroupName != nil {
+				f55elem.OptionGroupName = f55iter.OptionGroupName
+			}
+			if f55iter.Status != nil {
+				f55elem.Status = f55iter.Status
+			}
+			f55 = append(f55, f55elem)
+		}
+		r.ko.Status.OptionGroupMemberships = f55
+	} else {
+		r.ko.Status.OptionGroupMemberships = nil
+	}
+	if resp.DBInstance.PendingModifiedValues != nil {
+		f56 := &svcapitypes.PendingModifiedValues{}
+		if resp.DBInstance.PendingModifiedValues.AllocatedStorage != nil {
+			f56.AllocatedStorage = resp.DBInstance.PendingModifiedValues.AllocatedStorage
+		}
+		if resp.DBInstance.PendingModifiedValues.AutomationMode != nil {
+			f56.AutomationMode = resp.DBInstance.PendingModifiedValues.AutomationMode
+		}
+		if resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod != nil {
+			f56.BackupRetentionPeriod = resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod
+		}
+		if resp.DBInstance.PendingModifiedValues.CACertificateIdentifier != nil {
+			f56.CACertificateIdentifier = resp.DBInstance.PendingModifiedValues.CACertificateIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceClass != nil {
+			f56.DBInstanceClass = resp.DBInstance.PendingModifiedValues.DBInstanceClass
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier != nil {
+			f56.DBInstanceIdentifier = resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
+			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
+		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
+		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
+			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
+		}
+		if resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled != nil {
+			f56.IAMDatabaseAuthenticationEnabled = resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled
+		}
+		if resp.DBInstance.PendingModifiedValues.Iops != nil {
+			f56.IOPS = resp.DBInstance.PendingModifiedValues.Iops
+		}
+		if resp.DBInstance.PendingModifiedValues.LicenseModel != nil {
+			f56.LicenseModel = resp.DBInstance.PendingModifiedValues.LicenseModel
+		}
+		if resp.DBInstance.PendingModifiedValues.MasterUserPassword != nil {
+			f56.MasterUserPassword = resp.DBInstance.PendingModifiedValues.MasterUserPassword
+		}
+		if resp.DBInstance.PendingModifiedValues.MultiAZ != nil {
+			f56.MultiAZ = resp.DBInstance.PendingModifiedValues.MultiAZ
+		}
+		if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports != nil {
+			f56f13 := &svcapitypes.PendingCloudwatchLogsExports{}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable != nil {
+				f56f13f0 := []*string{}
+				for _, f56f13f0iter := range resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable {
+					var f56f13f0elem string
+					f56f13f0elem = *f56f13f0iter
+					f56f13f0 = append(f56f13f0, &f56f13f0elem)
+				}
+				f56f13.LogTypesToDisable = f56f13f0
+			}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable != nil {
+				f56f13f1 := []*string{}
+				for _, f56f13f1iter := range resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable {
+					var f56f13f1elem string
+					f56f13f1elem = *f56f13f1iter
+					f56f13f1 = append(f56f13f1, &f56f13f1elem)
+				}
+				f56f13.LogTypesToEnable = f56f13f1
+			}
+			f56.PendingCloudwatchLogsExports = f56f13
+		}
+		if resp.DBInstance.PendingModifiedValues.Port != nil {
+			f56.Port = resp.DBInstance.PendingModifiedValues.Port
+		}
+		if resp.DBInstance.PendingModifiedValues.ProcessorFeatures != nil {
+			f56f15 := []*svcapitypes.ProcessorFeature{}
+			for _, f56f15iter := range resp.DBInstance.PendingModifiedValues.ProcessorFeatures {
+				f56f15elem := &svcapitypes.ProcessorFeature{}
+				if f56f15iter.Name != nil {
+					f56f15elem.Name = f56f15iter.Name
+				}
+				if f56f15iter.Value != nil {
+					f56f15elem.Value = f56f15iter.Value
+				}
+				f56f15 = append(f56f15, f56f15elem)
+			}
+			f56.ProcessorFeatures = f56f15
+		}
+		if resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime != nil {
+			f56.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime}
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageThroughput != nil {
+			f56.StorageThroughput = resp.DBInstance.PendingModifiedValues.StorageThroughput
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageType != nil {
+			f56.StorageType = resp.DBInstance.PendingModifiedValues.StorageType
+		}
+		r.ko.Status.PendingModifiedValues = f56
+	} else {
+		r.ko.Status.PendingModifiedValues = nil
+	}
+	if resp.DBInstance.PerformanceInsightsEnabled != nil {
+		r.ko.Spec.PerformanceInsightsEnabled = resp.DBInstance.PerformanceInsightsEnabled
+	} else {
+		r.ko.Spec.PerformanceInsightsEnabled = nil
+	}
+	if resp.DBInstance.PerformanceInsightsKMSKeyId != nil {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = resp.DBInstance.PerformanceInsightsKMSKeyId
+	} else {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = nil
+	}
+	if resp.DBInstance.PerformanceInsightsRetentionPeriod != nil {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = resp.DBInstance.PerformanceInsightsRetentionPeriod
+	} else {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = nil
+	}
+	if resp.DBInstance.PreferredBackupWindow != nil {
+		r.ko.Spec.PreferredBackupWindow = resp.DBInstance.PreferredBackupWindow
+	} else {
+		r.ko.Spec.PreferredBackupWindow = nil
+	}
+	if resp.DBInstance.PreferredMaintenanceWindow != nil {
+		r.ko.Spec.PreferredMaintenanceWindow = resp.DBInstance.PreferredMaintenanceWindow
+	} else {
+		r.ko.Spec.PreferredMaintenanceWindow = nil
+	}
+	if resp.DBInstance.ProcessorFeatures != nil {
+		f62 := []*svcapitypes.ProcessorFeature{}
+		for _, f62iter := range resp.DBInstance.ProcessorFeatures {
+			f62elem := &svcapitypes.ProcessorFeature{}
+			if f62iter.Name != nil {
+				f62elem.Name = f62iter.Name
+			}
+			if f62iter.Value != nil {
+				f62elem.Value = f62iter.Value
+			}
+			f62 = append(f62, f62elem)
+		}
+		r.ko.Spec.ProcessorFeatures = f62
+	} else {
+		r.ko.Spec.ProcessorFeatures = nil
+	}
+	if resp.DBInstance.PromotionTier != nil {
+		r.ko.Spec.PromotionTier = resp.DBInstance.PromotionTier
+	} else {
+		r.ko.Spec.PromotionTier = nil
+	}
+	if resp.DBInstance.PubliclyAccessible != nil {
+		r.ko.Spec.PubliclyAccessible = resp.DBInstance.PubliclyAccessible
+	} else {
+		r.ko.Spec.PubliclyAccessible = nil
+	}
+	if resp.DBInstance.ReadReplicaDBClusterIdentifiers != nil {
+		f65 := []*string{}
+		for _, f65iter := range resp.DBInstance.ReadReplicaDBClusterIdentifiers {
+			var f65elem string
+			f65elem = *f65iter
+			f65 = append(f65, &f65elem)
+		}
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = f65
+	} else {
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaDBInstanceIdentifiers != nil {
+		f66 := []*string{}
+		for _, f66iter := range resp.DBInstance.ReadReplicaDBInstanceIdentifiers {
+			var f66elem string
+			f66elem = *f66iter
+			f66 = append(f66, &f66elem)
+		}
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = f66
+	} else {
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBClusterIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = resp.DBInstance.ReadReplicaSourceDBClusterIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.ReplicaMode != nil {
+		r.ko.Spec.ReplicaMode = resp.DBInstance.ReplicaMode
+	} else {
+		r.ko.Spec.ReplicaMode = nil
+	}
+	if resp.DBInstance.ResumeFullAutomationModeTime != nil {
+		r.ko.Status.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.ResumeFullAutomationModeTime}
+	} else {
+		r.ko.Status.ResumeFullAutomationModeTime = nil
+	}
+	if resp.DBInstance.SecondaryAvailabilityZone != nil {
+		r.ko.Status.SecondaryAvailabilityZone = resp.DBInstance.SecondaryAvailabilityZone
+	} else {
+		r.ko.Status.SecondaryAvailabilityZone = nil
+	}
+	if resp.DBInstance.StatusInfos != nil {
+		f72 := []*svcapitypes.DBInstanceStatusInfo{}
+		for _, f72iter := range resp.DBInstance.StatusInfos {
+			f72elem := &svcapitypes.DBInstanceStatusInfo{}
+			if f72iter.Message != nil {
+				f72elem.Message = f72iter.Message
+			}
+			if f72iter.Normal != nil {
+				f72elem.Normal = f72iter.Normal
+			}
+			if f72iter.Status != nil {
+				f72elem.Status = f72iter.Status
+			}
+			if f72iter.StatusType != nil {
+				f72elem.StatusType = f72iter.StatusType
+			}
+			f72 = append(f72, f72elem)
+		}
+		r.ko.Status.StatusInfos = f72
+	} else {
+		r.ko.Status.StatusInfos = nil
+	}
+	if resp.DBInstance.StorageEncrypted != nil {
+		r.ko.Spec.StorageEncrypted = resp.DBInstance.StorageEncrypted
+	} else {
+		r.ko.Spec.StorageEncrypted = nil
+	}
+	if resp.DBInstance.StorageThroughput != nil {
+		r.ko.Spec.StorageThroughput = resp.DBInstance.StorageThroughput
+	} else {
+		r.ko.Spec.StorageThroughput = nil
+	}
+	if resp.DBInstance.StorageType != nil {
+		r.ko.Spec.StorageType = resp.DBInstance.StorageType
+	} else {
+		r.ko.Spec.StorageType = nil
+	}
+	if resp.DBInstance.TdeCredentialArn != nil {
+		r.ko.Spec.TDECredentialARN = resp.DBInstance.TdeCredentialArn
+	} else {
+		r.ko.Spec.TDECredentialARN = nil
+	}
+	if resp.DBInstance.Timezone != nil {
+		r.ko.Spec.Timezone = resp.DBInstance.Timezone
+	} else {
+		r.ko.Spec.Timezone = nil
+	}
+	if resp.DBInstance.VpcSecurityGroups != nil {
+		f78 := []*svcapitypes.VPCSecurityGroupMembership{}
+		for _, f78iter := range resp.DBInstance.VpcSecurityGroups {
+			f78elem := &svcapitypes.VPCSecurityGroupMembership{}
+			if f78iter.Status != nil {
+				f78elem.Status = f78iter.Status
+			}
+			if f78iter.VpcSecurityGroupId != nil {
+				f78elem.VPCSecurityGroupID = f78iter.VpcSecurityGroupId
+			}
+			f78 = append(f78, f78elem)
+		}
+		r.ko.Status.VPCSecurityGroups = f78
+	} else {
+		r.ko.Status.VPCSecurityGroups = nil
+	}
+
+}
+
+// newRestoreDBInstanceFromS3Input returns a RestoreDBInstanceFromS3Input object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newRestoreDBInstanceFromS3Input(
+	ctx context.Context,
+	r *resource,
+) (*svcsdk.RestoreDBInstanceFromS3Input, error) {
+	res := &svcsdk.RestoreDBInstanceFromS3Input{}
+
+	if r.ko.Spec.AllocatedStorage != nil {
+		res.SetAllocatedStorage(*r.ko.Spec.AllocatedStorage)
+	}
+	if r.ko.Spec.AutoMinorVersionUpgrade != nil {
+		res.SetAutoMinorVersionUpgrade(*r.ko.Spec.AutoMinorVersionUpgrade)
+	}
+	if r.ko.Spec.AvailabilityZone != nil {
+		res.SetAvailabilityZone(*r.ko.Spec.AvailabilityZone)
+	}
+	if r.ko.Spec.BackupRetentionPeriod != nil {
+		res.SetBackupRetentionPeriod(*r.ko.Spec.BackupRetentionPeriod)
+	}
+	if r.ko.Spec.CopyTagsToSnapshot != nil {
+		res.SetCopyTagsToSnapshot(*r.ko.Spec.CopyTagsToSnapshot)
+	}
+	if r.ko.Spec.DBInstanceClass != nil {
+		res.SetDBInstanceClass(*r.ko.Spec.DBInstanceClass)
+	}
+	if r.ko.Spec.DBInstanceIdentifier != nil {
+		res.SetDBInstanceIdentifier(*r.ko.Spec.DBInstanceIdentifier)
+	}
+	if r.ko.Spec.DBName != nil {
+		res.SetDBName(*r.ko.Spec.DBName)
+	}
+	if r.ko.Spec.DBParameterGroupName != nil {
+		res.SetDBParameterGroupName(*r.ko.Spec.DBParameterGroupName)
+	}
+	if r.ko.Spec.DBSubnetGroupName != nil {
+		res.SetDBSubnetGroupName(*r.ko.Spec.DBSubnetGroupName)
+	}
+	if r.ko.Spec.DedicatedLogVolume != nil {
+		res.SetDedicatedLogVolume(*r.ko.Spec.DedicatedLogVolume)
+	}
+	if r.ko.Spec.DeletionProtection != nil {
+		res.SetDeletionProtection(*r.ko.Spec.DeletionProtection)
+	}
+	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
+		f18 := []*string{}
+		for _, f18iter := range r.ko.Spec.EnableCloudwatchLogsExports {
+			var f18elem string
+			f18elem = *f18iter
+			f18 = append(f18, &f18elem)
+		}
+		res.SetEnableCloudwatchLogsExports(f18)
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil {
+		res.SetEnableIAMDatabaseAuthentication(*r.ko.Spec.EnableIAMDatabaseAuthentication)
+	}
+	if r.ko.Spec.PerformanceInsightsEnabled != nil {
+		res.SetEnablePerformanceInsights(*r.ko.Spec.PerformanceInsightsEnabled)
+	}
+	if r.ko.Spec.Engine != nil {
+		res.SetEngine(*r.ko.Spec.Engine)
+	}
+	if r.ko.Spec.EngineVersion != nil {
+		res.SetEngineVersion(*r.ko.Spec.EngineVersion)
+	}
+	if r.ko.Spec.IOPS != nil {
+		res.SetIops(*r.ko.Spec.IOPS)
+	}
+	if r.ko.Spec.KMSKeyID != nil {
+		res.SetKmsKeyId(*r.ko.Spec.KMSKeyID)
+	}
+	if r.ko.Spec.LicenseModel != nil {
+		res.SetLicenseModel(*r.ko.Spec.LicenseModel)
+	}
+	if r.ko.Spec.ManageMasterUserPassword != nil {
+		res.SetManageMasterUserPassword(*r.ko.Spec.ManageMasterUserPassword)
+	}
+	if r.ko.Spec.MasterUserPassword != nil {
+		tmpSecret, err := rm.rr.SecretValueFromReference(ctx, r.ko.Spec.MasterUserPassword)
+		if err != nil {
+			return nil, ackrequeue.Needed(err)
+		}
+		if tmpSecret != "" {
+			res.SetMasterUserPassword(tmpSecret)
+		}
+	}
+	if r.ko.Spec.MasterUserSecretKMSKeyID != nil {
+		res.SetMasterUserSecretKmsKeyId(*r.ko.Spec.MasterUserSecretKMSKeyID)
+	}
+	if r.ko.Spec.MasterUsername != nil {
+		res.SetMasterUsername(*r.ko.Spec.MasterUsername)
+	}
+	if r.ko.Spec.MaxAllocatedStorage != nil {
+		res.SetMaxAllocatedStorage(*r.ko.Spec.MaxAllocatedStorage)
+	}
+	if r.ko.Spec.MonitoringInterval != nil {
+		res.SetMonitoringInterval(*r.ko.Spec.MonitoringInterval)
+	}
+	if r.ko.Spec.MonitoringRoleARN != nil {
+		res.SetMonitoringRoleArn(*r.ko.Spec.MonitoringRoleARN)
+	}
+	if r.ko.Spec.MultiAZ != nil {
+		res.SetMultiAZ(*r.ko.Spec.MultiAZ)
+	}
+	if r.ko.Spec.NetworkType != nil {
+		res.SetNetworkType(*r.ko.Spec.NetworkType)
+	}
+	if r.ko.Spec.OptionGroupName != nil {
+		res.SetOptionGroupName(*r.ko.Spec.OptionGroupName)
+	}
+	if r.ko.Spec.PerformanceInsightsKMSKeyID != nil {
+		res.SetPerformanceInsightsKMSKeyId(*r.ko.Spec.PerformanceInsightsKMSKeyID)
+	}
+	if r.ko.Spec.PerformanceInsightsRetentionPeriod != nil {
+		res.SetPerformanceInsightsRetentionPeriod(*r.ko.Spec.PerformanceInsightsRetentionPeriod)
+	}
+	if r.ko.Spec.Port != nil {
+		res.SetPort(*r.ko.Spec.Port)
+	}
+	if r.ko.Spec.PreferredBackupWindow != nil {
+		res.SetPreferredBackupWindow(*r.ko.Spec.PreferredBackupWindow)
+	}
+	if r.ko.Spec.PreferredMaintenanceWindow != nil {
+		res.SetPreferredMaintenanceWindow(*r.ko.Spec.PreferredMaintenanceWindow)
+	}
+	if r.ko.Spec.ProcessorFeatures != nil {
+		f43 := []*svcsdk.ProcessorFeature{}
+		for _, f43iter := range r.ko.Spec.ProcessorFeatures {
+			f43elem := &svcsdk.ProcessorFeature{}
+			if f43iter.Name != nil {
+				f43elem.SetName(*f43iter.Name)
+			}
+			if f43iter.Value != nil {
+				f43elem.SetValue(*f43iter.Value)
+			}
+			f43 = append(f43, f43elem)
+		}
+		res.SetProcessorFeatures(f43)
+	}
+	if r.ko.Spec.PubliclyAccessible != nil {
+		res.SetPubliclyAccessible(*r.ko.Spec.PubliclyAccessible)
+	}
+	if r.ko.Spec.StorageEncrypted != nil {
+		res.SetStorageEncrypted(*r.ko.Spec.StorageEncrypted)
+	}
+	if r.ko.Spec.StorageThroughput != nil {
+		res.SetStorageThroughput(*r.ko.Spec.StorageThroughput)
+	}
+	if r.ko.Spec.StorageType != nil {
+		res.SetStorageType(*r.ko.Spec.StorageType)
+	}
+	if r.ko.Spec.Tags != nil {
+		f49 := []*svcsdk.Tag{}
+		for _, f49iter := range r.ko.Spec.Tags {
+			f49elem := &svcsdk.Tag{}
+			if f49iter.Key != nil {
+				f49elem.SetKey(*f49iter.Key)
+			}
+			if f49iter.Value != nil {
+				f49elem.SetValue(*f49iter.Value)
+			}
+			f49 = append(f49, f49elem)
+		}
+		res.SetTags(f49)
+	}
+	if r.ko.Spec.UseDefaultProcessorFeatures != nil {
+		res.SetUseDefaultProcessorFeatures(*r.ko.Spec.UseDefaultProcessorFeatures)
+	}
+	if r.ko.Spec.VPCSecurityGroupIDs != nil {
+		f53 := []*string{}
+		for _, f53iter := range r.ko.Spec.VPCSecurityGroupIDs {
+			var f53elem string
+			f53elem = *f53iter
+			f53 = append(f53, &f53elem)
+		}
+		res.SetVpcSecurityGroupIds(f53)
+	}
+
+	return res, nil
+}
+
+// setResourceFromRestoreDBInstanceFromS3Output sets a resource RestoreDBInstanceFromS3Output type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromRestoreDBInstanceFromS3Output(
+	r *resource,
+	resp *svcsdk.RestoreDBInstanceFromS3Output,
+) {
+
+	if resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded
+	} else {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = nil
+	}
+	if resp.DBInstance.ActivityStreamKinesisStreamName != nil {
+		r.ko.Status.ActivityStreamKinesisStreamName = resp.DBInstance.ActivityStreamKinesisStreamName
+	} else {
+		r.ko.Status.ActivityStreamKinesisStreamName = nil
+	}
+	if resp.DBInstance.ActivityStreamKmsKeyId != nil {
+		r.ko.Status.ActivityStreamKMSKeyID = resp.DBInstance.ActivityStreamKmsKeyId
+	} else {
+		r.ko.Status.ActivityStreamKMSKeyID = nil
+	}
+	if resp.DBInstance.ActivityStreamMode != nil {
+		r.ko.Status.ActivityStreamMode = resp.DBInstance.ActivityStreamMode
+	} else {
+		r.ko.Status.ActivityStreamMode = nil
+	}
+	if resp.DBInstance.ActivityStreamPolicyStatus != nil {
+		r.ko.Status.ActivityStreamPolicyStatus = resp.DBInstance.ActivityStreamPolicyStatus
+	} else {
+		r.ko.Status.ActivityStreamPolicyStatus = nil
+	}
+	if resp.DBInstance.ActivityStreamStatus != nil {
+		r.ko.Status.ActivityStreamStatus = resp.DBInstance.ActivityStreamStatus
+	} else {
+		r.ko.Status.ActivityStreamStatus = nil
+	}
+	if resp.DBInstance.AllocatedStorage != nil {
+		r.ko.Spec.AllocatedStorage = resp.DBInstance.AllocatedStorage
+	} else {
+		r.ko.Spec.AllocatedStorage = nil
+	}
+	if resp.DBInstance.AssociatedRoles != nil {
+		f7 := []*svcapitypes.DBInstanceRole{}
+		for _, f7iter := range resp.DBInstance.AssociatedRoles {
+			f7elem := &svcapitypes.DBInstanceRole{}
+			if f7iter.FeatureName != nil {
+				f7elem.FeatureName = f7iter.FeatureName
+			}
+			if f7iter.RoleArn != nil {
+				f7elem.RoleARN = f7iter.RoleArn
+			}
+			if f7iter.Status != nil {
+				f7elem.Status = f7iter.Status
+			}
+			f7 = append(f7, f7elem)
+		}
+		r.ko.Status.AssociatedRoles = f7
+	} else {
+		r.ko.Status.AssociatedRoles = nil
+	}
+	if resp.DBInstance.AutoMinorVersionUpgrade != nil {
+		r.ko.Spec.AutoMinorVersionUpgrade = resp.DBInstance.AutoMinorVersionUpgrade
+	} else {
+		r.ko.Spec.AutoMinorVersionUpgrade = nil
+	}
+	if resp.DBInstance.AutomaticRestartTime != nil {
+		r.ko.Status.AutomaticRestartTime = &metav1.Time{*resp.DBInstance.AutomaticRestartTime}
+	} else {
+		r.ko.Status.AutomaticRestartTime = nil
+	}
+	if resp.DBInstance.AutomationMode != nil {
+		r.ko.Status.AutomationMode = resp.DBInstance.AutomationMode
+	} else {
+		r.ko.Status.AutomationMode = nil
+	}
+	if resp.DBInstance.AvailabilityZone != nil {
+		r.ko.Spec.AvailabilityZone = resp.DBInstance.AvailabilityZone
+	} else {
+		r.ko.Spec.AvailabilityZone = nil
+	}
+	if resp.DBInstance.AwsBackupRecoveryPointArn != nil {
+		r.ko.Status.AWSBackupRecoveryPointARN = resp.DBInstance.AwsBackupRecoveryPointArn
+	} else {
+		r.ko.Status.AWSBackupRecoveryPointARN = nil
+	}
+	if resp.DBInstance.BackupRetentionPeriod != nil {
+		r.ko.Spec.BackupRetentionPeriod = resp.DBInstance.BackupRetentionPeriod
+	} else {
+		r.ko.Spec.BackupRetentionPeriod = nil
+	}
+	if resp.DBInstance.BackupTarget != nil {
+		r.ko.Spec.BackupTarget = resp.DBInstance.BackupTarget
+	} else {
+		r.ko.Spec.BackupTarget = nil
+	}
+	if resp.DBInstance.CACertificateIdentifier != nil {
+		r.ko.Spec.CACertificateIdentifier = resp.DBInstance.CACertificateIdentifier
+	} else {
+		r.ko.Spec.CACertificateIdentifier = nil
+	}
+	if resp.DBInstance.CertificateDetails != nil {
+		f16 := &svcapitypes.CertificateDetails{}
+		if resp.DBInstance.CertificateDetails.CAIdentifier != nil {
+			f16.CAIdentifier = resp.DBInstance.CertificateDetails.CAIdentifier
+		}
+		if resp.DBInstance.CertificateDetails.ValidTill != nil {
+			f16.ValidTill = &metav1.Time{*resp.DBInstance.CertificateDetails.ValidTill}
+		}
+		r.ko.Status.CertificateDetails = f16
+	} else {
+		r.ko.Status.CertificateDetails = nil
+	}
+	if resp.DBInstance.CharacterSetName != nil {
+		r.ko.Spec.CharacterSetName = resp.DBInstance.CharacterSetName
+	} else {
+		r.ko.Spec.CharacterSetName = nil
+	}
+	if resp.DBInstance.CopyTagsToSnapshot != nil {
+		r.ko.Spec.CopyTagsToSnapshot = resp.DBInstance.CopyTagsToSnapshot
+	} else {
+		r.ko.Spec.CopyTagsToSnapshot = nil
+	}
+	if resp.DBInstance.CustomIamInstanceProfile != nil {
+		r.ko.Spec.CustomIAMInstanceProfile = resp.DBInstance.CustomIamInstanceProfile
+	} else {
+		r.ko.Spec.CustomIAMInstanceProfile = nil
+	}
+	if resp.DBInstance.CustomerOwnedIpEnabled != nil {
+		r.ko.Status.CustomerOwnedIPEnabled = resp.DBInstance.CustomerOwnedIpEnabled
+	} else {
+		r.ko.Status.CustomerOwnedIPEnabled = nil
+	}
+	if resp.DBInstance.DBClusterIdentifier != nil {
+		r.ko.Spec.DBClusterIdentifier = resp.DBInstance.DBClusterIdentifier
+	} else {
+		r.ko.Spec.DBClusterIdentifier = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBInstance.DBInstanceArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBInstance.DBInstanceArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBInstance.DBInstanceAutomatedBackupsReplications != nil {
+		f23 := []*svcapitypes.DBInstanceAutomatedBackupsReplication{}
+		for _, f23iter := range resp.DBInstance.DBInstanceAutomatedBackupsReplications {
+			f23elem := &svcapitypes.DBInstanceAutomatedBackupsReplication{}
+			if f23iter.DBInstanceAutomatedBackupsArn != nil {
+				f23elem.DBInstanceAutomatedBackupsARN = f23iter.DBInstanceAutomatedBackupsArn
+			}
+			f23 = append(f23, f23elem)
+		}
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = f23
+	} else {
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = nil
+	}
+	if resp.DBInstance.DBInstanceClass != nil {
+		r.ko.Spec.DBInstanceClass = resp.DBInstance.DBInstanceClass
+	} else {
+		r.ko.Spec.DBInstanceClass = nil
+	}
+	if resp.DBInstance.DBInstanceIdentifier != nil {
+		r.ko.Spec.DBInstanceIdentifier = resp.DBInstance.DBInstanceIdentifier
+	} else {
+		r.ko.Spec.DBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.DBInstanceStatus != nil {
+		r.ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	} else {
+		r.ko.Status.DBInstanceStatus = nil
+	}
+	if resp.DBInstance.DBName != nil {
+		r.ko.Spec.DBName = resp.DBInstance.DBName
+	} else {
+		r.ko.Spec.DBName = nil
+	}
+	if resp.DBInstance.DBParameterGroups != nil {
+		f28 := []*svcapitypes.DBParameterGroupStatus_SDK{}
+		for _, f28iter := range resp.DBInstance.DBParameterGroups {
+			f28elem := &svcapitypes.DBParameterGroupStatus_SDK{}
+			if f28iter.DBParameterGroupName != nil {
+				f28elem.DBParameterGroupName = f28iter.DBParameterGroupName
+			}
+			if f28iter.ParameterApplyStatus != nil {
+				f28elem.ParameterApplyStatus = f28iter.ParameterApplyStatus
+			}
+			f28 = append(f28, f28elem)
+		}
+		r.ko.Status.DBParameterGroups = f28
+	} else {
+		r.ko.Status.DBParameterGroups = nil
+	}
+	if resp.DBInstance.DBSubnetGroup != nil {
+		f29 := &svcapitypes.DBSubnetGroup_SDK{}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn != nil {
+			f29.DBSubnetGroupARN = resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription != nil {
+			f29.DBSubnetGroupDescription = resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupName != nil {
+			f29.DBSubnetGroupName = resp.DBInstance.DBSubnetGroup.DBSubnetGroupName
+		}
+		if resp.DBInstance.DBSubnetGroup.SubnetGroupStatus != nil {
+			f29.SubnetGroupStatus = resp.DBInstance.DBSubnetGroup.SubnetGroupStatus
+		}
+		if resp.DBInstance.DBSubnetGroup.Subnets != nil {
+			f29f4 := []*svcapitypes.Subnet{}
+			for _, f29f4iter := range resp.DBInstance.DBSubnetGroup.Subnets {
+				f29f4elem := &svcapitypes.Subnet{}
+				if f29f4iter.SubnetAvailabilityZone != nil {
+					f29f4elemf0 := &svcapitypes.AvailabilityZone{}
+					if f29f4iter.SubnetAvailabilityZone.Name != nil {
+						f29f4elemf0.Name = f29f4iter.SubnetAvailabilityZone.Name
+					}
+					f29f4elem.SubnetAvailabilityZone = f29f4elemf0
+				}
+				if f29f4iter.SubnetIdentifier != nil {
+					f29f4elem.SubnetIdentifier = f29f4iter.SubnetIdentifier
+				}
+				if f29f4iter.SubnetOutpost != nil {
+					f29f4elemf2 := &svcapitypes.Outpost{}
+					if f29f4iter.SubnetOutpost.Arn != nil {
+						f29f4elemf2.ARN = f29f4iter.SubnetOutpost.Arn
+					}
+					f29f4elem.SubnetOutpost = f29f4elemf2
+				}
+				if f29f4iter.SubnetStatus != nil {
+					f29f4elem.SubnetStatus = f29f4iter.SubnetStatus
+				}
+				f29f4 = append(f29f4, f29f4elem)
+			}
+			f29.Subnets = f29f4
+		}
+		if resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes != nil {
+			f29f5 := []*string{}
+			for _, f29f5iter := range resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes {
+				var f29f5elem string
+				f29f5elem = *f29f5iter
+				f29f5 = append(f29f5, &f29f5elem)
+			}
+			f29.SupportedNetworkTypes = f29f5
+		}
+		if resp.DBInstance.DBSubnetGroup.VpcId != nil {
+			f29.VPCID = resp.DBInstance.DBSubnetGroup.VpcId
+		}
+		r.ko.Status.DBSubnetGroup = f29
+	} else {
+		r.ko.Status.DBSubnetGroup = nil
+	}
+	if resp.DBInstance.DBSystemId != nil {
+		r.ko.Spec.DBSystemID = resp.DBInstance.DBSystemId
+	} else {
+		r.ko.Spec.DBSystemID = nil
+	}
+	if resp.DBInstance.DbInstancePort != nil {
+		r.ko.Status.DBInstancePort = resp.DBInstance.DbInstancePort
+	} else {
+		r.ko.Status.DBInstancePort = nil
+	}
+	if resp.DBInstance.DbiResourceId != nil {
+		r.ko.Status.DBIResourceID = resp.DBInstance.DbiResourceId
+	} else {
+		r.ko.Status.DBIResourceID = nil
+	}
+	if resp.DBInstance.DedicatedLogVolume != nil {
+		r.ko.Spec.DedicatedLogVolume = resp.DBInstance.DedicatedLogVolume
+	} else {
+		r.ko.Spec.DedicatedLogVolume = nil
+	}
+	if resp.DBInstance.DeletionProtection != nil {
+		r.ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
+	} else {
+		r.ko.Spec.DeletionProtection = nil
+	}
+	if resp.DBInstance.DomainMemberships != nil {
+		f34 := []*svcapitypes.DomainMembership{}
+		for _, f34iter := range resp.DBInstance.DomainMemberships {
+			f34elem := &svcapitypes.DomainMembership{}
+			if f34iter.AuthSecretArn != nil {
+				f34elem.AuthSecretARN = f34iter.AuthSecretArn
+			}
+			if f34iter.DnsIps != nil {
+				f34elemf1 := []*string{}
+				for _, f34elemf1iter := range f34iter.DnsIps {
+					var f34elemf1elem string
+					f34elemf1elem = *f34elemf1iter
+					f34elemf1 = append(f34elemf1, &f34elemf1elem)
+				}
+				f34elem.DNSIPs = f34elemf1
+			}
+			if f34iter.Domain != nil {
+				f34elem.Domain = f34iter.Domain
+			}
+			if f34iter.FQDN != nil {
+				f34elem.FQDN = f34iter.FQDN
+			}
+			if f34iter.IAMRoleName != nil {
+				f34elem.IAMRoleName = f34iter.IAMRoleName
+			}
+			if f34iter.OU != nil {
+				f34elem.OU = f34iter.OU
+			}
+			if f34iter.Status != nil {
+				f34elem.Status = f34iter.Status
+			}
+			f34 = append(f34, f34elem)
+		}
+		r.ko.Status.DomainMemberships = f34
+	} else {
+		r.ko.Status.DomainMemberships = nil
+	}
+	if resp.DBInstance.EnabledCloudwatchLogsExports != nil {
+		f35 := []*string{}
+		for _, f35iter := range resp.DBInstance.EnabledCloudwatchLogsExports {
+			var f35elem string
+			f35elem = *f35iter
+			f35 = append(f35, &f35elem)
+		}
+		r.ko.Status.EnabledCloudwatchLogsExports = f35
+	} else {
+		r.ko.Status.EnabledCloudwatchLogsExports = nil
+	}
+	if resp.DBInstance.Endpoint != nil {
+		f36 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.Endpoint.Address != nil {
+			f36.Address = resp.DBInstance.Endpoint.Address
+		}
+		if resp.DBInstance.Endpoint.HostedZoneId != nil {
+			f36.HostedZoneID = resp.DBInstance.Endpoint.HostedZoneId
+		}
+		if resp.DBInstance.Endpoint.Port != nil {
+			f36.Port = resp.DBInstance.Endpoint.Port
+		}
+		r.ko.Status.Endpoint = f36
+	} else {
+		r.ko.Status.Endpoint = nil
+	}
+	if resp.DBInstance.Engine != nil {
+		r.ko.Spec.Engine = resp.DBInstance.Engine
+	} else {
+		r.ko.Spec.Engine = nil
+	}
+	if resp.DBInstance.EngineVersion != nil {
+		r.ko.Spec.EngineVersion = resp.DBInstance.EngineVersion
+	} else {
+		r.ko.Spec.EngineVersion = nil
+	}
+	if resp.DBInstance.EnhancedMonitoringResourceArn != nil {
+		r.ko.Status.EnhancedMonitoringResourceARN = resp.DBInstance.EnhancedMonitoringResourceArn
+	} else {
+		r.ko.Status.EnhancedMonitoringResourceARN = nil
+	}
+	if resp.DBInstance.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBInstance.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBInstance.InstanceCreateTime != nil {
+		r.ko.Status.InstanceCreateTime = &metav1.Time{*resp.DBInstance.InstanceCreateTime}
+	} else {
+		r.ko.Status.InstanceCreateTime = nil
+	}
+	if resp.DBInstance.Iops != nil {
+		r.ko.Spec.IOPS = resp.DBInstance.Iops
+	} else {
+		r.ko.Spec.IOPS = nil
+	}
+	if resp.DBInstance.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBInstance.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBInstance.LatestRestorableTime != nil {
+		r.ko.Status.LatestRestorableTime = &metav1.Time{*resp.DBInstance.LatestRestorableTime}
+	} else {
+		r.ko.Status.LatestRestorableTime = nil
+	}
+	if resp.DBInstance.LicenseModel != nil {
+		r.ko.Spec.LicenseModel = resp.DBInstance.LicenseModel
+	} else {
+		r.ko.Spec.LicenseModel = nil
+	}
+	if resp.DBInstance.ListenerEndpoint != nil {
+		f46 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.ListenerEndpoint.Address != nil {
+			f46.Address = resp.DBInstance.ListenerEndpoint.Address
+		}
+		if resp.DBInstance.ListenerEndpoint.HostedZoneId != nil {
+			f46.HostedZoneID = resp.DBInstance.ListenerEndpoint.HostedZoneId
+		}
+		if resp.DBInstance.ListenerEndpoint.Port != nil {
+			f46.Port = resp.DBInstance.ListenerEndpoint.Port
+		}
+		r.ko.Status.ListenerEndpoint = f46
+	} else {
+		r.ko.Status.ListenerEndpoint = nil
+	}
+	if resp.DBInstance.MasterUserSecret != nil {
+		f47 := &svcapitypes.MasterUserSecret{}
+		if resp.DBInstance.MasterUserSecret.KmsKeyId != nil {
+			f47.KMSKeyID = resp.DBInstance.MasterUserSecret.KmsKeyId
+		}
+		if resp.DBInstance.MasterUserSecret.SecretArn != nil {
+			f47.SecretARN = resp.DBInstance.MasterUserSecret.SecretArn
+		}
+		if resp.DBInstance.MasterUserSecret.SecretStatus != nil {
+			f47.SecretStatus = resp.DBInstance.MasterUserSecret.SecretStatus
+		}
+		r.ko.Status.MasterUserSecret = f47
+	} else {
+		r.ko.Status.MasterUserSecret = nil
+	}
+	if resp.DBInstance.MasterUsername != nil {
+		r.ko.Spec.MasterUsername = resp.DBInstance.MasterUsername
+	} else {
+		r.ko.Spec.MasterUsername = nil
+	}
+	if resp.DBInstance.MaxAllocatedStorage != nil {
+		r.ko.Spec.MaxAllocatedStorage = resp.DBInstance.MaxAllocatedStorage
+	} else {
+		r.ko.Spec.MaxAllocatedStorage = nil
+	}
+	if resp.DBInstance.MonitoringInterval != nil {
+		r.ko.Spec.MonitoringInterval = resp.DBInstance.MonitoringInterval
+	} else {
+		r.ko.Spec.MonitoringInterval = nil
+	}
+	if resp.DBInstance.MonitoringRoleArn != nil {
+		r.ko.Spec.MonitoringRoleARN = resp.DBInstance.MonitoringRoleArn
+	} else {
+		r.ko.Spec.MonitoringRoleARN = nil
+	}
+	if resp.DBInstance.MultiAZ != nil {
+		r.ko.Spec.MultiAZ = resp.DBInstance.MultiAZ
+	} else {
+		r.ko.Spec.MultiAZ = nil
+	}
+	if resp.DBInstance.NcharCharacterSetName != nil {
+		r.ko.Spec.NcharCharacterSetName = resp.DBInstance.NcharCharacterSetName
+	} else {
+		r.ko.Spec.NcharCharacterSetName = nil
+	}
+	if resp.DBInstance.NetworkType != nil {
+		r.ko.Spec.NetworkType = resp.DBInstance.NetworkType
+	} else {
+		r.ko.Spec.NetworkType = nil
+	}
+	if resp.DBInstance.OptionGroupMemberships != nil {
+		f55 := []*svcapitypes.OptionGroupMembership{}
+		for _, f55iter := range resp.DBInstance.OptionGroupMemberships {
+			f55elem := &svcapitypes.OptionGroupMembership{}
+			if f55iter.OptionGroupName != nil {
+				f55elem.OptionGroupName = f55iter.OptionGroupName
+			}
+			if f55iter.Status != nil {
+				f55elem.Status = f55iter.Status
+			}
+			f55 = append(f55, f55elem)
+		}
+		r.ko.Status.OptionGroupMemberships = f55
+	} else {
+		r.ko.Status.OptionGroupMemberships = nil
+	}
+	if resp.DBInstance.PendingModifiedValues != nil {
+		f56 := &svcapitypes.PendingModifiedValues{}
+		if resp.DBInstance.PendingModifiedValues.AllocatedStorage != nil {
+			f56.AllocatedStorage = resp.DBInstance.PendingModifiedValues.AllocatedStorage
+		}
+		if resp.DBInstance.PendingModifiedValues.AutomationMode != nil {
+			f56.AutomationMode = resp.DBInstance.PendingModifiedValues.AutomationMode
+		}
+		if resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod != nil {
+			f56.BackupRetentionPeriod = resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod
+		}
+		if resp.DBInstance.PendingModifiedValues.CACertificateIdentifier != nil {
+			f56.CACertificateIdentifier = resp.DBInstance.PendingModifiedValues.CACertificateIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceClass != nil {
+			f56.DBInstanceClass = resp.DBInstance.PendingModifiedValues.DBInstanceClass
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier != nil {
+			f56.DBInstanceIdentifier = resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
+			f56.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
+		}
+		if resp.DBInstance.PendingModifiedValues.DedicatedLogVolume != nil {
+			f56.DedicatedLogVolume = resp.DBInstance.PendingModifiedValues.DedicatedLogVolume
+		}
 		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
 			f56.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
 		}
@@ -43,7 +43,28 @@ func newResourceDelta(
 		return delta
 	}
 	compareTags(delta, a, b)
+	compareParameterOverrides(delta, a, b)
 
+	if ackcompare.HasNilDifference(a.ko.Spec.BaseParameterGroupName, b.ko.Spec.BaseParameterGroupName) {
+		delta.Add("Spec.BaseParameterGroupName", a.ko.Spec.BaseParameterGroupName, b.ko.Spec.BaseParameterGroupName)
+	} else if a.ko.Spec.BaseParameterGroupName != nil && b.ko.Spec.BaseParameterGroupName != nil {
+		if *a.ko.Spec.BaseParameterGroupName != *b.ko.Spec.BaseParameterGroupName {
+			delta.Add("Spec.BaseParameterGroupName", a.ko.Spec.BaseParameterGroupName, b.ko.Spec.BaseParameterGroupName)
+		}
+	}
+	if !reflect.DeepEqual(a.ko.Spec.BaseParameterGroupRef, b.ko.Spec.BaseParameterGroupRef) {
+		delta.Add("Spec.BaseParameterGroupRef", a.ko.Spec.BaseParameterGroupRef, b.ko.Spec.BaseParameterGroupRef)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ClusterParameterGroupName, b.ko.Spec.ClusterParameterGroupName) {
+		delta.Add("Spec.ClusterParameterGroupName", a.ko.Spec.ClusterParameterGroupName, b.ko.Spec.ClusterParameterGroupName)
+	} else if a.ko.Spec.ClusterParameterGroupName != nil && b.ko.Spec.ClusterParameterGroupName != nil {
+		if *a.ko.Spec.ClusterParameterGroupName != *b.ko.Spec.ClusterParameterGroupName {
+			delta.Add("Spec.ClusterParameterGroupName", a.ko.Spec.ClusterParameterGroupName, b.ko.Spec.ClusterParameterGroupName)
+		}
+	}
+	if !reflect.DeepEqual(a.ko.Spec.ClusterParameterGroupRef, b.ko.Spec.ClusterParameterGroupRef) {
+		delta.Add("Spec.ClusterParameterGroupRef", a.ko.Spec.ClusterParameterGroupRef, b.ko.Spec.ClusterParameterGroupRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Description, b.ko.Spec.Description) {
 		delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
 	} else if a.ko.Spec.Description != nil && b.ko.Spec.Description != nil {
@@ -51,6 +72,20 @@ func newResourceDelta(
 			delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+		delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+	} else if a.ko.Spec.Engine != nil && b.ko.Spec.Engine != nil {
+		if *a.ko.Spec.Engine != *b.ko.Spec.Engine {
+			delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion) {
+		delta.Add("Spec.EngineVersion", a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion)
+	} else if a.ko.Spec.EngineVersion != nil && b.ko.Spec.EngineVersion != nil {
+		if *a.ko.Spec.EngineVersion != *b.ko.Spec.EngineVersion {
+			delta.Add("Spec.EngineVersion", a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Family, b.ko.Spec.Family) {
 		delta.Add("Spec.Family", a.ko.Spec.Family, b.ko.Spec.Family)
 	} else if a.ko.Spec.Family != nil && b.ko.Spec.Family != nil {
@@ -65,11 +100,11 @@ func newResourceDelta(
 			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		}
 	}
-	if len(a.ko.Spec.ParameterOverrides) != len(b.ko.Spec.ParameterOverrides) {
-		delta.Add("Spec.ParameterOverrides", a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides)
-	} else if len(a.ko.Spec.ParameterOverrides) > 0 {
-		if !ackcompare.MapStringStringPEqual(a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides) {
-			delta.Add("Spec.ParameterOverrides", a.ko.Spec.ParameterOverrides, b.ko.Spec.ParameterOverrides)
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceParameterGroupName, b.ko.Spec.SourceParameterGroupName) {
+		delta.Add("Spec.SourceParameterGroupName", a.ko.Spec.SourceParameterGroupName, b.ko.Spec.SourceParameterGroupName)
+	} else if a.ko.Spec.SourceParameterGroupName != nil && b.ko.Spec.SourceParameterGroupName != nil {
+		if *a.ko.Spec.SourceParameterGroupName != *b.ko.Spec.SourceParameterGroupName {
+			delta.Add("Spec.SourceParameterGroupName", a.ko.Spec.SourceParameterGroupName, b.ko.Spec.SourceParameterGroupName)
 		}
 	}
 
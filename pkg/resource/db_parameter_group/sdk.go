@@ -61,6 +61,15 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
+	if err = validateDriftPolicy(r); err != nil {
+		return nil, err
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -128,12 +137,23 @@ func (rm *resourceManager) sdkFind(
 	}
 	if ko.Spec.Name != nil {
 		groupName := ko.Spec.Name
-		params, paramStatuses, err := rm.getParameters(ctx, groupName)
+		params, paramStatuses, paramSources, err := rm.getParameters(ctx, groupName)
 		if err != nil {
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
 		ko.Status.ParameterOverrideStatuses = paramStatuses
+		ko.Status.ParameterSources = paramSources
+		setParameterDrift(ko)
+		rm.checkClusterParameterGroupConsistency(ctx, ko)
+		// The base parameter group's parameters are read on every
+		// reconciliation so that changes to the base are layered onto this
+		// parameter group too.
+		baseParams, err := rm.baseParameters(ctx, &resource{ko})
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.BaseParameterOverrides = baseParams
 	}
 
 	return &resource{ko}, nil
@@ -175,6 +195,20 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = rm.resolveFamily(ctx, desired); err != nil {
+		return nil, err
+	}
+	// Reject unknown or unmodifiable parameter overrides before creating the
+	// parameter group, rather than after the group has been created.
+	if err = rm.validateParameters(ctx, desired); err != nil {
+		return nil, err
+	}
+	// Seed the parameter group with the parameters of the source parameter
+	// group instead of creating an empty parameter group.
+	if desired.ko.Spec.SourceParameterGroupName != nil {
+		return rm.copyParameterGroup(ctx, desired)
+	}
+
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -215,9 +249,11 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
-	if err = rm.syncParameters(ctx, desired, nil); err != nil {
+	if err = rm.syncParameters(ctx, &resource{ko}, nil); err != nil {
 		return nil, err
 	}
+	// A new parameter group has all its parameters set to their defaults.
+	setLastResetAllParameters(&resource{ko})
 
 	return &resource{ko}, nil
 }
@@ -43,6 +43,8 @@ func newResourceDelta(
 		return delta
 	}
 
+	compareAuthSecrets(delta, a, b)
+
 	if len(a.ko.Spec.Auth) != len(b.ko.Spec.Auth) {
 		delta.Add("Spec.Auth", a.ko.Spec.Auth, b.ko.Spec.Auth)
 	} else if len(a.ko.Spec.Auth) > 0 {
@@ -102,6 +104,9 @@ func newResourceDelta(
 			delta.Add("Spec.VPCSecurityGroupIDs", a.ko.Spec.VPCSecurityGroupIDs, b.ko.Spec.VPCSecurityGroupIDs)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.VPCSecurityGroupRefs, b.ko.Spec.VPCSecurityGroupRefs) {
+		delta.Add("Spec.VPCSecurityGroupRefs", a.ko.Spec.VPCSecurityGroupRefs, b.ko.Spec.VPCSecurityGroupRefs)
+	}
 	if len(a.ko.Spec.VPCSubnetIDs) != len(b.ko.Spec.VPCSubnetIDs) {
 		delta.Add("Spec.VPCSubnetIDs", a.ko.Spec.VPCSubnetIDs, b.ko.Spec.VPCSubnetIDs)
 	} else if len(a.ko.Spec.VPCSubnetIDs) > 0 {
@@ -109,6 +114,9 @@ func newResourceDelta(
 			delta.Add("Spec.VPCSubnetIDs", a.ko.Spec.VPCSubnetIDs, b.ko.Spec.VPCSubnetIDs)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.VPCSubnetRefs, b.ko.Spec.VPCSubnetRefs) {
+		delta.Add("Spec.VPCSubnetRefs", a.ko.Spec.VPCSubnetRefs, b.ko.Spec.VPCSubnetRefs)
+	}
 
 	return delta
 }
@@ -19,11 +19,14 @@ import (
 	"fmt"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
-	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
@@ -232,3 +235,315 @@ func sdkTagsFromResourceTags(
 	}
 	return tags
 }
+
+// validateAuth returns an ACK terminal error when the auth settings of the
+// supplied DB proxy are not valid.
+func validateAuth(r *resource) error {
+	return util.ValidateProxyAuth(r.ko.Spec.Auth, r.ko.Spec.AuthSecrets)
+}
+
+// compareAuthSecrets adds a difference to the supplied delta when the Secrets
+// Manager secrets managed for the desired DB proxy are not the ones of its
+// Spec.AuthSecrets, or when a password read from its Kubernetes Secret
+// changed since it was last copied. Secrets are not watched: the change is
+// picked up on the next reconciliation of the DB proxy.
+func compareAuthSecrets(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if util.ProxyAuthSecretsDiffer(
+		desired.ko.Spec.AuthSecrets,
+		desired.ko.Status.ManagedAuthSecrets,
+		latest.ko.Status.ManagedAuthSecrets,
+	) {
+		delta.Add("Spec.AuthSecrets", desired.ko.Spec.AuthSecrets, latest.ko.Spec.AuthSecrets)
+	}
+}
+
+// setUnmanagedAuth removes from Spec.Auth of the supplied DB proxy the auth
+// settings of the Secrets Manager secrets managed for its Spec.AuthSecrets, so
+// that Spec.Auth compares with the desired one.
+func setUnmanagedAuth(r *resource) {
+	r.ko.Spec.Auth = util.UnmanagedProxyAuth(
+		r.ko.Spec.Auth,
+		util.ManagedProxyAuth(r.ko.Spec.AuthSecrets, r.ko.Status.ManagedAuthSecrets),
+	)
+}
+
+// managedSDKAuth returns the auth settings of the Secrets Manager secrets
+// managed for Spec.AuthSecrets of the supplied DB proxy, to be sent along with
+// the ones of Spec.Auth.
+func managedSDKAuth(r *resource) []*svcsdk.UserAuthConfig {
+	managed := util.ManagedProxyAuth(r.ko.Spec.AuthSecrets, r.ko.Status.ManagedAuthSecrets)
+	auth := make([]*svcsdk.UserAuthConfig, 0, len(managed))
+	for _, m := range managed {
+		auth = append(auth, &svcsdk.UserAuthConfig{
+			AuthScheme:             m.AuthScheme,
+			ClientPasswordAuthType: m.ClientPasswordAuthType,
+			Description:            m.Description,
+			IAMAuth:                m.IAMAuth,
+			SecretArn:              m.SecretARN,
+			UserName:               m.UserName,
+		})
+	}
+	return auth
+}
+
+// setAuthSecretPasswordHashes records in Status.ManagedAuthSecrets of the
+// supplied DB proxy a hash of the current passwords held by the Kubernetes
+// Secrets of its Spec.AuthSecrets. A hash is left untouched when the password
+// cannot be read.
+func (rm *resourceManager) setAuthSecretPasswordHashes(
+	ctx context.Context,
+	r *resource,
+) {
+	for _, st := range r.ko.Status.ManagedAuthSecrets {
+		s := authSecretForUser(r, aws.StringValue(st.UserName))
+		if s == nil {
+			continue
+		}
+		password, err := rm.rr.SecretValueFromReference(ctx, s.Password)
+		if err != nil {
+			continue
+		}
+		hash := util.SecretValueHash(string(r.ko.UID), password)
+		st.PasswordHash = &hash
+	}
+}
+
+// authSecretForUser returns the entry of Spec.AuthSecrets of the supplied DB
+// proxy for the supplied database user, or nil.
+func authSecretForUser(r *resource, userName string) *svcapitypes.DBProxyAuthSecret {
+	for _, s := range r.ko.Spec.AuthSecrets {
+		if aws.StringValue(s.UserName) == userName {
+			return s
+		}
+	}
+	return nil
+}
+
+// syncAuthSecrets copies the passwords of Spec.AuthSecrets of the supplied DB
+// proxy to the Secrets Manager secrets the controller manages for them,
+// creating the missing secrets and deleting the ones of removed entries, and
+// records the secrets in Status.ManagedAuthSecrets. Only the passwords that
+// changed since they were last copied are written.
+func (rm *resourceManager) syncAuthSecrets(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncAuthSecrets")
+	defer func() { exit(err) }()
+
+	previous := map[string]*svcapitypes.DBProxyAuthSecretStatus{}
+	for _, st := range r.ko.Status.ManagedAuthSecrets {
+		previous[aws.StringValue(st.UserName)] = st
+	}
+	statuses := []*svcapitypes.DBProxyAuthSecretStatus{}
+	for _, s := range r.ko.Spec.AuthSecrets {
+		userName := aws.StringValue(s.UserName)
+		password, err := rm.rr.SecretValueFromReference(ctx, s.Password)
+		if err != nil {
+			return err
+		}
+		value, err := util.ProxyAuthSecretString(userName, password)
+		if err != nil {
+			return err
+		}
+		hash := util.SecretValueHash(string(r.ko.UID), password)
+		st := previous[userName]
+		delete(previous, userName)
+		if st == nil || st.SecretARN == nil {
+			arn, err := rm.createAuthSecret(ctx, r, s, value)
+			if err != nil {
+				return err
+			}
+			st = &svcapitypes.DBProxyAuthSecretStatus{
+				UserName:  s.UserName,
+				SecretARN: arn,
+			}
+		} else if st.PasswordHash == nil || *st.PasswordHash != hash {
+			if err = rm.putAuthSecretValue(ctx, st.SecretARN, value); err != nil {
+				return err
+			}
+		}
+		st.PasswordHash = &hash
+		statuses = append(statuses, st)
+	}
+	for _, st := range previous {
+		if err = rm.deleteAuthSecret(ctx, st.SecretARN); err != nil {
+			return err
+		}
+	}
+	r.ko.Status.ManagedAuthSecrets = statuses
+	return nil
+}
+
+// createAuthSecret creates the Secrets Manager secret holding the supplied
+// value for the supplied entry of Spec.AuthSecrets of the supplied DB proxy,
+// tagged as created for the DB proxy, and returns its ARN. A secret the
+// controller created for the DB proxy earlier, left over by an earlier
+// attempt or deleted within its recovery window, is restored and updated
+// instead; any other secret with the same name is a terminal error.
+func (rm *resourceManager) createAuthSecret(
+	ctx context.Context,
+	r *resource,
+	s *svcapitypes.DBProxyAuthSecret,
+	value string,
+) (*string, error) {
+	sm := svcsdksecretsmanager.New(rm.sess)
+	proxyName := aws.StringValue(r.ko.Spec.Name)
+	name := util.ProxyAuthSecretName(proxyName, aws.StringValue(s.UserName))
+	resp, err := sm.CreateSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.CreateSecretInput{
+			Name: &name,
+			Description: aws.String(fmt.Sprintf(
+				"Credentials of the %s user of the %s DB proxy, managed by the ACK RDS controller",
+				aws.StringValue(s.UserName), proxyName,
+			)),
+			KmsKeyId:     s.KMSKeyID,
+			SecretString: &value,
+			Tags:         util.ProxyAuthSecretTags(proxyName),
+		},
+	)
+	rm.metrics.RecordAPICall("CREATE", "CreateSecret", err)
+	if err == nil {
+		return resp.ARN, nil
+	}
+	// A secret scheduled for deletion fails the creation with an
+	// InvalidRequestException rather than a ResourceExistsException
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok || (awsErr.Code() != svcsdksecretsmanager.ErrCodeResourceExistsException &&
+		awsErr.Code() != svcsdksecretsmanager.ErrCodeInvalidRequestException) {
+		return nil, err
+	}
+	createErr := err
+	describeResp, err := sm.DescribeSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DescribeSecretInput{
+			SecretId: &name,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", err)
+	if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == svcsdksecretsmanager.ErrCodeResourceNotFoundException {
+		return nil, createErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = util.ValidateProxyAuthSecretOwner(name, describeResp.Tags, proxyName); err != nil {
+		return nil, err
+	}
+	if describeResp.DeletedDate != nil {
+		_, err = sm.RestoreSecretWithContext(
+			ctx,
+			&svcsdksecretsmanager.RestoreSecretInput{
+				SecretId: describeResp.ARN,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RestoreSecret", err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = rm.putAuthSecretValue(ctx, describeResp.ARN, value); err != nil {
+		return nil, err
+	}
+	return describeResp.ARN, nil
+}
+
+// putAuthSecretValue writes the supplied value to the supplied Secrets
+// Manager secret.
+func (rm *resourceManager) putAuthSecretValue(
+	ctx context.Context,
+	arn *string,
+	value string,
+) error {
+	_, err := svcsdksecretsmanager.New(rm.sess).PutSecretValueWithContext(
+		ctx,
+		&svcsdksecretsmanager.PutSecretValueInput{
+			SecretId:     arn,
+			SecretString: &value,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "PutSecretValue", err)
+	return err
+}
+
+// deleteAuthSecrets deletes the Secrets Manager secrets managed for
+// Spec.AuthSecrets of the supplied DB proxy.
+func (rm *resourceManager) deleteAuthSecrets(
+	ctx context.Context,
+	r *resource,
+) error {
+	for _, st := range r.ko.Status.ManagedAuthSecrets {
+		if err := rm.deleteAuthSecret(ctx, st.SecretARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAuthSecret deletes the supplied Secrets Manager secret with a
+// recovery window of util.ProxyAuthSecretRecoveryWindowInDays, during which
+// the secret can be restored, and is restored by createAuthSecret when the
+// database user is added back. A secret already deleted is ignored.
+func (rm *resourceManager) deleteAuthSecret(
+	ctx context.Context,
+	arn *string,
+) error {
+	if arn == nil {
+		return nil
+	}
+	sm := svcsdksecretsmanager.New(rm.sess)
+	_, err := sm.DeleteSecretWithContext(
+		ctx,
+		&svcsdksecretsmanager.DeleteSecretInput{
+			SecretId:             arn,
+			RecoveryWindowInDays: aws.Int64(util.ProxyAuthSecretRecoveryWindowInDays),
+		},
+	)
+	rm.metrics.RecordAPICall("DELETE", "DeleteSecret", err)
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return err
+	}
+	switch awsErr.Code() {
+	case svcsdksecretsmanager.ErrCodeResourceNotFoundException:
+		return nil
+	case svcsdksecretsmanager.ErrCodeInvalidRequestException:
+		// A secret already scheduled for deletion cannot be deleted again
+		resp, describeErr := sm.DescribeSecretWithContext(
+			ctx,
+			&svcsdksecretsmanager.DescribeSecretInput{
+				SecretId: arn,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeSecret", describeErr)
+		if describeErr == nil && resp.DeletedDate != nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// populateAdoptionFields takes the name of the supplied DB proxy from its
+// adoption fields when it sets the adopt-or-create adoption policy, see
+// util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"name": &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -61,6 +61,12 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -213,6 +219,16 @@ func (rm *resourceManager) sdkFind(
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	}
+	rm.setAuthSecretPasswordHashes(ctx, &resource{ko})
+	setUnmanagedAuth(&resource{ko})
+	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
+		tags, err := rm.getTags(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
 	return &resource{ko}, nil
 }
 
@@ -252,10 +268,19 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateAuth(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.syncAuthSecrets(ctx, desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
+	// Spec.AuthSecrets are sent as auth settings of the Secrets Manager secrets
+	// managed for them, along with the ones of Spec.Auth.
+	input.Auth = append(input.Auth, managedSDKAuth(desired)...)
 
 	var resp *svcsdk.CreateDBProxyOutput
 	_ = resp
@@ -382,6 +407,7 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	setUnmanagedAuth(&resource{ko})
 	// We expect the DB proxy to be in 'creating' status since we just
 	// issued the call to create it, but I suppose it doesn't hurt to check
 	// here.
@@ -496,6 +522,10 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
 	if proxyDeleting(latest) {
 		msg := "DB proxy is currently being deleted"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
@@ -517,10 +547,34 @@ func (rm *resourceManager) sdkUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if err = validateAuth(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.AuthSecrets") {
+		if err = rm.syncAuthSecrets(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
 	}
+	// ModifyDBProxy names the VPC security groups SecurityGroups, so the
+	// generated code does not set them.
+	if delta.DifferentAt("Spec.VPCSecurityGroupIDs") {
+		input.SetSecurityGroups(desired.ko.Spec.VPCSecurityGroupIDs)
+	}
+	// Spec.AuthSecrets are sent as auth settings of the Secrets Manager secrets
+	// managed for them, along with the ones of Spec.Auth.
+	input.Auth = append(input.Auth, managedSDKAuth(desired)...)
 
 	var resp *svcsdk.ModifyDBProxyOutput
 	_ = resp
@@ -647,6 +701,7 @@ func (rm *resourceManager) sdkUpdate(
 	}
 
 	rm.setStatusDefaults(ko)
+	setUnmanagedAuth(&resource{ko})
 	// When ModifyDBProxy API is successful, it asynchronously
 	// updates the DBProxyStatus. Requeue to find the current
 	// DBProxy status and set Synced condition accordingly
@@ -725,6 +780,9 @@ func (rm *resourceManager) sdkDelete(
 	if proxyDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.deleteAuthSecrets(ctx, r); err != nil {
+		return nil, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
@@ -864,3 +922,18 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 		return false
 	}
 }
+
+// getImmutableFieldChanges returns list of immutable fields from the
+func (rm *resourceManager) getImmutableFieldChanges(
+	delta *ackcompare.Delta,
+) []string {
+	var fields []string
+	if delta.DifferentAt("Spec.EngineFamily") {
+		fields = append(fields, "EngineFamily")
+	}
+	if delta.DifferentAt("Spec.VPCSubnetIDs") {
+		fields = append(fields, "VPCSubnetIDs")
+	}
+
+	return fields
+}
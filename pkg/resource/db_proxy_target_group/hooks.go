@@ -0,0 +1,192 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_proxy_target_group
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusAvailable = "available"
+)
+
+// targetGroupSynced returns true if the supplied DB proxy target group is
+// available and none of its targets is still being registered
+func targetGroupSynced(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusAvailable &&
+		!util.ProxyTargetsRegistering(r.ko.Status.Targets)
+}
+
+// compareConnectionPoolConfig adds a difference to the supplied delta when the
+// desired connection pool configuration differs from the latest one. Only the
+// fields the desired configuration sets are compared.
+func compareConnectionPoolConfig(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if util.ConnectionPoolConfigDiffers(a.ko.Spec.ConnectionPoolConfig, b.ko.Spec.ConnectionPoolConfig) {
+		delta.Add("Spec.ConnectionPoolConfig", a.ko.Spec.ConnectionPoolConfig, b.ko.Spec.ConnectionPoolConfig)
+	}
+}
+
+// setTargets sets the targets of the supplied DB proxy target group, with
+// their health, in its Status, and sets the DB clusters and DB instances
+// registered with the target group in its Spec.
+func (rm *resourceManager) setTargets(
+	ctx context.Context,
+	ko *svcapitypes.DBProxyTargetGroup,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setTargets")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.DescribeDBProxyTargetsInput{
+		DBProxyName:     ko.Spec.DBProxyName,
+		TargetGroupName: ko.Spec.Name,
+	}
+	targets := []*svcapitypes.DBProxyTarget{}
+	err = rm.sdkapi.DescribeDBProxyTargetsPagesWithContext(
+		ctx, input,
+		func(page *svcsdk.DescribeDBProxyTargetsOutput, _ bool) bool {
+			for _, target := range page.Targets {
+				targets = append(targets, newDBProxyTarget(target))
+			}
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeDBProxyTargets", err)
+	if err != nil {
+		return err
+	}
+	ko.Status.Targets = targets
+	ko.Spec.DBClusterIdentifiers, ko.Spec.DBInstanceIdentifiers = util.ProxyTargetIdentifiers(targets)
+	return nil
+}
+
+// syncTargets registers the desired DB clusters and DB instances that are not
+// registered with the latest DB proxy target group, and deregisters the ones
+// that are no longer desired. Every desired target is registered when there
+// is no latest target group. Targets are deregistered first, since a target
+// group has a single target.
+func (rm *resourceManager) syncTargets(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTargets")
+	defer func() { exit(err) }()
+
+	var latestClusters, latestInstances []*string
+	if latest != nil {
+		latestClusters = latest.ko.Spec.DBClusterIdentifiers
+		latestInstances = latest.ko.Spec.DBInstanceIdentifiers
+	}
+	registerClusters, deregisterClusters := util.StringSetsDelta(
+		desired.ko.Spec.DBClusterIdentifiers, latestClusters,
+	)
+	registerInstances, deregisterInstances := util.StringSetsDelta(
+		desired.ko.Spec.DBInstanceIdentifiers, latestInstances,
+	)
+
+	if len(deregisterClusters) > 0 || len(deregisterInstances) > 0 {
+		rlog.Debug(
+			"deregistering targets from proxy target group",
+			"clusters", deregisterClusters, "instances", deregisterInstances,
+		)
+		_, err = rm.sdkapi.DeregisterDBProxyTargetsWithContext(
+			ctx,
+			&svcsdk.DeregisterDBProxyTargetsInput{
+				DBProxyName:           desired.ko.Spec.DBProxyName,
+				TargetGroupName:       desired.ko.Spec.Name,
+				DBClusterIdentifiers:  deregisterClusters,
+				DBInstanceIdentifiers: deregisterInstances,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "DeregisterDBProxyTargets", err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(registerClusters) > 0 || len(registerInstances) > 0 {
+		rlog.Debug(
+			"registering targets with proxy target group",
+			"clusters", registerClusters, "instances", registerInstances,
+		)
+		_, err = rm.sdkapi.RegisterDBProxyTargetsWithContext(
+			ctx,
+			&svcsdk.RegisterDBProxyTargetsInput{
+				DBProxyName:           desired.ko.Spec.DBProxyName,
+				TargetGroupName:       desired.ko.Spec.Name,
+				DBClusterIdentifiers:  registerClusters,
+				DBInstanceIdentifiers: registerInstances,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RegisterDBProxyTargets", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDBProxyTarget returns the DBProxyTarget representing the supplied
+// target of a DB proxy target group.
+func newDBProxyTarget(target *svcsdk.DBProxyTarget) *svcapitypes.DBProxyTarget {
+	res := &svcapitypes.DBProxyTarget{
+		Endpoint:         target.Endpoint,
+		Port:             target.Port,
+		RdsResourceID:    target.RdsResourceId,
+		Role:             target.Role,
+		TargetARN:        target.TargetArn,
+		TrackedClusterID: target.TrackedClusterId,
+		Type:             target.Type,
+	}
+	if target.TargetHealth != nil {
+		res.TargetHealth = &svcapitypes.TargetHealth{
+			Description: target.TargetHealth.Description,
+			Reason:      target.TargetHealth.Reason,
+			State:       target.TargetHealth.State,
+		}
+	}
+	return res
+}
+
+// populateAdoptionFields takes the dbProxyName and name of the supplied
+// target group from its adoption fields when it sets the adopt-or-create
+// adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"dbProxyName": &r.ko.Spec.DBProxyName,
+			"name":        &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
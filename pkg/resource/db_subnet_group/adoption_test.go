@@ -0,0 +1,174 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_subnet_group
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtcache "github.com/aws-controllers-k8s/runtime/pkg/runtime/cache"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// fakeRDSAPI serves the DB subnet group, when there is one, and records the
+// DB subnet group it creates and the modifications
+type fakeRDSAPI struct {
+	svcsdkapi.RDSAPI
+	dbSubnetGroup *svcsdk.DBSubnetGroup
+	created       bool
+	modified      *svcsdk.ModifyDBSubnetGroupInput
+}
+
+func (f *fakeRDSAPI) DescribeDBSubnetGroupsWithContext(
+	aws.Context, *svcsdk.DescribeDBSubnetGroupsInput, ...request.Option,
+) (*svcsdk.DescribeDBSubnetGroupsOutput, error) {
+	if f.dbSubnetGroup == nil {
+		return nil, awserr.New("DBSubnetGroupNotFoundFault", "not found", nil)
+	}
+	return &svcsdk.DescribeDBSubnetGroupsOutput{DBSubnetGroups: []*svcsdk.DBSubnetGroup{f.dbSubnetGroup}}, nil
+}
+
+func (f *fakeRDSAPI) CreateDBSubnetGroupWithContext(
+	_ aws.Context, input *svcsdk.CreateDBSubnetGroupInput, _ ...request.Option,
+) (*svcsdk.CreateDBSubnetGroupOutput, error) {
+	f.created = true
+	f.dbSubnetGroup = &svcsdk.DBSubnetGroup{
+		DBSubnetGroupArn:         aws.String("arn:aws:rds:us-west-2:123456789012:subgrp:subnets"),
+		DBSubnetGroupDescription: input.DBSubnetGroupDescription,
+		DBSubnetGroupName:        input.DBSubnetGroupName,
+	}
+	return &svcsdk.CreateDBSubnetGroupOutput{DBSubnetGroup: f.dbSubnetGroup}, nil
+}
+
+func (f *fakeRDSAPI) ModifyDBSubnetGroupWithContext(
+	_ aws.Context, input *svcsdk.ModifyDBSubnetGroupInput, _ ...request.Option,
+) (*svcsdk.ModifyDBSubnetGroupOutput, error) {
+	f.modified = input
+	group := *f.dbSubnetGroup
+	group.DBSubnetGroupDescription = input.DBSubnetGroupDescription
+	return &svcsdk.ModifyDBSubnetGroupOutput{DBSubnetGroup: &group}, nil
+}
+
+func (f *fakeRDSAPI) ListTagsForResourceWithContext(
+	aws.Context, *svcsdk.ListTagsForResourceInput, ...request.Option,
+) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{}, nil
+}
+
+func TestSyncAdoptOrCreate(t *testing.T) {
+	tests := []struct {
+		name          string
+		dbSubnetGroup *svcsdk.DBSubnetGroup
+		wantCreated   bool
+		wantModified  bool
+	}{
+		{
+			name: "existing DB subnet group",
+			dbSubnetGroup: &svcsdk.DBSubnetGroup{
+				DBSubnetGroupArn:         aws.String("arn:aws:rds:us-west-2:123456789012:subgrp:subnets"),
+				DBSubnetGroupDescription: aws.String("staging subnets"),
+				DBSubnetGroupName:        aws.String("subnets"),
+				Subnets:                  []*svcsdk.Subnet{{SubnetIdentifier: aws.String("subnet-1")}},
+			},
+			wantModified: true,
+		},
+		{name: "missing DB subnet group", wantCreated: true},
+	}
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &svcapitypes.DBSubnetGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "subnets", Namespace: "default",
+					Annotations: map[string]string{
+						svcapitypes.AdoptionPolicyAnnotation: util.AdoptionPolicyAdoptOrCreate,
+						svcapitypes.AdoptionFieldsAnnotation: `{"name": "subnets"}`,
+					},
+				},
+				Spec: svcapitypes.DBSubnetGroupSpec{
+					Description: aws.String("production subnets"),
+					SubnetIDs:   []*string{aws.String("subnet-1")},
+				},
+			}
+			kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ko).Build()
+			util.SetKubeClient(kc)
+			defer util.SetKubeClient(nil)
+
+			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))
+			rm, err := newResourceManager(
+				ackcfg.Config{}, logr.Discard(), ackmetrics.NewMetrics("rds"), nil,
+				sess, "123456789012", "us-west-2",
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sdkapi := &fakeRDSAPI{dbSubnetGroup: tt.dbSubnetGroup}
+			rm.sdkapi = sdkapi
+			sc := ackrt.NewServiceController("rds", "rds.services.k8s.aws", "rds", acktypes.VersionInfo{})
+			r := ackrt.NewReconcilerWithClient(
+				sc, kc, newResourceManagerFactory(), logr.Discard(), ackcfg.Config{},
+				ackmetrics.NewMetrics("rds"), ackrtcache.Caches{},
+			)
+
+			latest, err := r.Sync(context.TODO(), rm, &resource{ko.DeepCopy()})
+			if err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+			for _, cond := range latest.(*resource).ko.Status.Conditions {
+				if cond.Type == ackv1alpha1.ConditionTypeTerminal {
+					t.Fatalf("Sync() terminal condition: %s", aws.StringValue(cond.Message))
+				}
+			}
+			if sdkapi.created != tt.wantCreated {
+				t.Errorf("Sync() created = %v, want %v", sdkapi.created, tt.wantCreated)
+			}
+			if got := sdkapi.modified != nil; got != tt.wantModified {
+				t.Fatalf("Sync() modified = %v, want %v", got, tt.wantModified)
+			}
+			if tt.wantModified && aws.StringValue(sdkapi.modified.DBSubnetGroupDescription) != "production subnets" {
+				t.Errorf("Sync() modified description = %q, want the Spec description",
+					aws.StringValue(sdkapi.modified.DBSubnetGroupDescription))
+			}
+			stored := &svcapitypes.DBSubnetGroup{}
+			if err := kc.Get(context.TODO(), client.ObjectKeyFromObject(ko), stored); err != nil {
+				t.Fatal(err)
+			}
+			if !controllerutil.ContainsFinalizer(stored, finalizerString) {
+				t.Errorf("Sync() did not mark the DBSubnetGroup as managed")
+			}
+		})
+	}
+}
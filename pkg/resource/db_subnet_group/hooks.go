@@ -149,3 +149,22 @@ func sdkTagsFromResourceTags(
 	}
 	return tags
 }
+
+// populateAdoptionFields takes the name of the supplied DB subnet group from
+// its adoption fields when it sets the adopt-or-create adoption policy, see
+// util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"name": &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -0,0 +1,338 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package event_subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusCreating      = "creating"
+	StatusModifying     = "modifying"
+	StatusDeleting      = "deleting"
+	StatusActive        = "active"
+	StatusNoPermission  = "no-permission"
+	StatusTopicNotExist = "topic-not-exist"
+)
+
+var (
+	// ModifiableStatuses are the status strings of the event subscriptions
+	// that can be modified. An event subscription that lost access to its SNS
+	// topic can be modified to use another topic.
+	ModifiableStatuses = []string{
+		StatusActive,
+		StatusNoPermission,
+		StatusTopicNotExist,
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("Event subscription in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the event subscription cannot be modified until it reaches an
+// active status.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"Event subscription in '%s' state, cannot be modified until '%s'.",
+		status, StatusActive,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// subscriptionActive returns true if the supplied event subscription is in an
+// active status
+func subscriptionActive(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusActive
+}
+
+// subscriptionCreating returns true if the supplied event subscription is in
+// the process of being created
+func subscriptionCreating(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusCreating
+}
+
+// subscriptionDeleting returns true if the supplied event subscription is in
+// the process of being deleted
+func subscriptionDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// subscriptionModifiable returns true if the supplied event subscription is
+// in a status allowing it to be modified
+func subscriptionModifiable(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range ModifiableStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// compareEventCategories adds a difference to the delta if the supplied
+// resources have different event categories, regardless of their order
+func compareEventCategories(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if !util.EqualStringSets(a.ko.Spec.EventCategories, b.ko.Spec.EventCategories) {
+		delta.Add("Spec.EventCategories", a.ko.Spec.EventCategories, b.ko.Spec.EventCategories)
+	}
+}
+
+// compareSourceIDs adds a difference to the delta if the supplied resources
+// have different source IDs, regardless of their order
+func compareSourceIDs(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if !util.EqualStringSets(a.ko.Spec.SourceIDs, b.ko.Spec.SourceIDs) {
+		delta.Add("Spec.SourceIDs", a.ko.Spec.SourceIDs, b.ko.Spec.SourceIDs)
+	}
+}
+
+// validateEventSubscription returns an ACK terminal error when the sources
+// of the supplied event subscription are not consistent with its source type,
+// or when its event categories are not event categories of its source type.
+func (rm *resourceManager) validateEventSubscription(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateEventSubscription")
+	defer func() { exit(err) }()
+
+	if err = util.ValidateEventSubscriptionSources(&r.ko.Spec); err != nil {
+		return err
+	}
+	if len(r.ko.Spec.EventCategories) == 0 {
+		return nil
+	}
+	resp, err := rm.sdkapi.DescribeEventCategoriesWithContext(
+		ctx,
+		&svcsdk.DescribeEventCategoriesInput{
+			SourceType: r.ko.Spec.SourceType,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeEventCategories", err)
+	if err != nil {
+		return err
+	}
+	available := []string{}
+	for _, m := range resp.EventCategoriesMapList {
+		available = append(available, aws.StringValueSlice(m.EventCategories)...)
+	}
+	return util.ValidateEventCategories(r.ko.Spec.EventCategories, available)
+}
+
+// removeSourceIDs removes the source IDs of the supplied latest event
+// subscription missing from the supplied desired event subscription. Source
+// IDs are removed before the event subscription is modified, so that the
+// source type of the event subscription can be changed.
+func (rm *resourceManager) removeSourceIDs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.removeSourceIDs")
+	defer func() { exit(err) }()
+
+	_, toRemove := util.StringSetsDelta(desired.ko.Spec.SourceIDs, latest.ko.Spec.SourceIDs)
+	for _, id := range toRemove {
+		rlog.Debug("removing source ID from event subscription", "source_id", *id)
+		_, err = rm.sdkapi.RemoveSourceIdentifierFromSubscriptionWithContext(
+			ctx,
+			&svcsdk.RemoveSourceIdentifierFromSubscriptionInput{
+				SubscriptionName: desired.ko.Spec.Name,
+				SourceIdentifier: id,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveSourceIdentifierFromSubscription", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSourceIDs adds the source IDs of the supplied desired event subscription
+// missing from the supplied latest event subscription. Source IDs are added
+// after the event subscription is modified.
+func (rm *resourceManager) addSourceIDs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.addSourceIDs")
+	defer func() { exit(err) }()
+
+	toAdd, _ := util.StringSetsDelta(desired.ko.Spec.SourceIDs, latest.ko.Spec.SourceIDs)
+	for _, id := range toAdd {
+		rlog.Debug("adding source ID to event subscription", "source_id", *id)
+		_, err = rm.sdkapi.AddSourceIdentifierToSubscriptionWithContext(
+			ctx,
+			&svcsdk.AddSourceIdentifierToSubscriptionInput{
+				SubscriptionName: desired.ko.Spec.Name,
+				SourceIdentifier: id,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddSourceIdentifierToSubscription", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncTags keeps the resource's tags in sync. See the db_proxy package for
+// the differences between the RDS tagging APIs and the other AWS APIs.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+
+	toAdd, toDelete := util.ComputeTagsDelta(
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+
+	if len(toDelete) > 0 {
+		rlog.Debug("removing tags from event subscription", "tags", toDelete)
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: arn,
+				TagKeys:      toDelete,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	if len(toAdd) > 0 {
+		rlog.Debug("adding tags to event subscription", "tags", toAdd)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: arn,
+				Tags:         sdkTagsFromResourceTags(toAdd),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := rm.sdkapi.ListTagsForResourceWithContext(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
+// array.
+func sdkTagsFromResourceTags(
+	rTags []*svcapitypes.Tag,
+) []*svcsdk.Tag {
+	tags := make([]*svcsdk.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = &svcsdk.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// populateAdoptionFields takes the name of the supplied event subscription
+// from its adoption fields when it sets the adopt-or-create adoption policy,
+// see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"name": &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
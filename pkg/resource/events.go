@@ -0,0 +1,27 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resource
+
+import (
+	"k8s.io/client-go/tools/record"
+)
+
+// EventRecorderSetter is implemented by the resource manager factories whose
+// resource managers emit Kubernetes Events about the changes they make to AWS
+// resources.
+type EventRecorderSetter interface {
+	// SetEventRecorder sets the recorder the resource managers produced by
+	// the factory emit Events with.
+	SetEventRecorder(recorder record.EventRecorder)
+}
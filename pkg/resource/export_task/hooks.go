@@ -0,0 +1,120 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export_task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusStarting   = "STARTING"
+	StatusInProgress = "IN_PROGRESS"
+	StatusComplete   = "COMPLETE"
+	StatusCanceling  = "CANCELING"
+	StatusCanceled   = "CANCELED"
+	StatusFailed     = "FAILED"
+)
+
+var (
+	// FinishedStatuses are the status strings of the export tasks that are
+	// over, whether they exported the data or not.
+	FinishedStatuses = []string{
+		StatusComplete,
+		StatusCanceled,
+		StatusFailed,
+	}
+	// CancelableStatuses are the status strings of the export tasks that can
+	// be cancelled.
+	CancelableStatuses = []string{
+		StatusStarting,
+		StatusInProgress,
+	}
+)
+
+// exportHasStatus returns true if the supplied export task is in one of the
+// supplied statuses
+func exportHasStatus(r *resource, statuses []string) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range statuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// exportFinished returns true if the supplied export task is over
+func exportFinished(r *resource) bool {
+	return exportHasStatus(r, FinishedStatuses)
+}
+
+// exportFailed returns true if the supplied export task failed
+func exportFailed(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusFailed
+}
+
+// exportCancelable returns true if the supplied export task can be cancelled
+func exportCancelable(r *resource) bool {
+	return exportHasStatus(r, CancelableStatuses)
+}
+
+// customUpdate rejects the changes to the Spec of the export task, which
+// cannot be modified once it is started.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	return desired, nil
+}
+
+// populateAdoptionFields takes the exportTaskIdentifier of the supplied
+// export task from its adoption fields when it sets the adopt-or-create
+// adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"exportTaskIdentifier": &r.ko.Spec.ExportTaskIdentifier,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	compareFailover(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.DatabaseName, b.ko.Spec.DatabaseName) {
 		delta.Add("Spec.DatabaseName", a.ko.Spec.DatabaseName, b.ko.Spec.DatabaseName)
@@ -85,6 +86,9 @@ func newResourceDelta(
 			delta.Add("Spec.SourceDBClusterIdentifier", a.ko.Spec.SourceDBClusterIdentifier, b.ko.Spec.SourceDBClusterIdentifier)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.SourceDBClusterRef, b.ko.Spec.SourceDBClusterRef) {
+		delta.Add("Spec.SourceDBClusterRef", a.ko.Spec.SourceDBClusterRef, b.ko.Spec.SourceDBClusterRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.StorageEncrypted, b.ko.Spec.StorageEncrypted) {
 		delta.Add("Spec.StorageEncrypted", a.ko.Spec.StorageEncrypted, b.ko.Spec.StorageEncrypted)
 	} else if a.ko.Spec.StorageEncrypted != nil && b.ko.Spec.StorageEncrypted != nil {
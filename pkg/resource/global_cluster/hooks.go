@@ -0,0 +1,247 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package global_cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatusAvailable   = "available"
+	StatusCreating    = "creating"
+	StatusDeleting    = "deleting"
+	StatusFailingOver = "failing-over"
+	StatusModifying   = "modifying"
+
+	FailoverStatusPending     = "pending"
+	FailoverStatusFailingOver = "failing-over"
+	FailoverStatusCancelling  = "cancelling"
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("Global cluster in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the global cluster cannot be modified until it reaches an
+// available status and is not being failed over.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	msg := fmt.Sprintf(
+		"Global cluster in '%s' state, cannot be modified until '%s'.",
+		*r.ko.Status.Status, StatusAvailable,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// globalClusterDeleting returns true if the supplied global cluster is in the
+// process of being deleted
+func globalClusterDeleting(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusDeleting
+}
+
+// globalClusterSynced returns true if the supplied global cluster is
+// available and not being failed over
+func globalClusterSynced(r *resource) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == StatusAvailable &&
+		r.ko.Status.FailoverState == nil
+}
+
+// failoverRequested returns the secondary DB cluster the failover or
+// switchover annotations of the supplied resource name, and whether the
+// switchover annotation names it.
+func failoverRequested(r *resource) (target string, switchover bool) {
+	if target := r.ko.Annotations[svcapitypes.SwitchoverGlobalClusterAnnotation]; target != "" {
+		return target, true
+	}
+	return r.ko.Annotations[svcapitypes.FailoverGlobalClusterAnnotation], false
+}
+
+// compareFailover adds a difference to the supplied delta when the desired
+// resource requests to fail the global cluster over or switch it over, so
+// that the update issues it.
+func compareFailover(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if target, _ := failoverRequested(a); target != "" {
+		// There is no Spec field for failovers, but only differences in the
+		// Spec trigger an update.
+		delta.Add("Spec.Failover", target, nil)
+	}
+}
+
+// failoverGlobalCluster switches the desired global cluster over, or fails
+// it over, to the secondary DB cluster named by its annotations and returns a
+// copy of the resource with the annotations removed and the failover recorded
+// in Status.LastFailover. The error returned is nil on success, so that the
+// removal of the annotations is persisted.
+func (rm *resourceManager) failoverGlobalCluster(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.failoverGlobalCluster")
+	defer func(err error) { exit(err) }(err)
+
+	if desired.ko.Annotations[svcapitypes.SwitchoverGlobalClusterAnnotation] != "" &&
+		desired.ko.Annotations[svcapitypes.FailoverGlobalClusterAnnotation] != "" {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"the %s and %s annotations cannot be set together",
+			svcapitypes.SwitchoverGlobalClusterAnnotation, svcapitypes.FailoverGlobalClusterAnnotation,
+		))
+	}
+	target, switchover := failoverRequested(desired)
+	targetARN, err := util.GlobalClusterFailoverTarget(latest.ko.Status.GlobalClusterMembers, target)
+	if err != nil {
+		return nil, err
+	}
+	var globalCluster *svcsdk.GlobalCluster
+	if switchover {
+		input := &svcsdk.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   desired.ko.Spec.GlobalClusterIdentifier,
+			TargetDbClusterIdentifier: &targetARN,
+		}
+		resp, respErr := rm.sdkapi.SwitchoverGlobalClusterWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "SwitchoverGlobalCluster", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		globalCluster = resp.GlobalCluster
+	} else {
+		input := &svcsdk.FailoverGlobalClusterInput{
+			GlobalClusterIdentifier:   desired.ko.Spec.GlobalClusterIdentifier,
+			TargetDbClusterIdentifier: &targetARN,
+			AllowDataLoss:             aws.Bool(true),
+		}
+		resp, respErr := rm.sdkapi.FailoverGlobalClusterWithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "FailoverGlobalCluster", respErr)
+		if respErr != nil {
+			return nil, respErr
+		}
+		globalCluster = resp.GlobalCluster
+	}
+	ko := desired.ko.DeepCopy()
+	delete(ko.Annotations, svcapitypes.SwitchoverGlobalClusterAnnotation)
+	delete(ko.Annotations, svcapitypes.FailoverGlobalClusterAnnotation)
+	now := metav1.Now()
+	ko.Status.LastFailover = &svcapitypes.FailoverRecord{
+		TargetDBClusterARN: &targetARN,
+		Switchover:         &switchover,
+		FailedOverAt:       &now,
+	}
+	if globalCluster != nil {
+		ko.Status.Status = globalCluster.Status
+		if state := globalCluster.FailoverState; state != nil {
+			ko.Status.FailoverState = &svcapitypes.FailoverState{
+				FromDBClusterARN: state.FromDbClusterArn,
+				Status:           state.Status,
+				ToDBClusterARN:   state.ToDbClusterArn,
+			}
+		}
+	}
+	// The failover state of the global cluster may not be reported yet
+	if switchover {
+		msg := "Global cluster is being switched over to " + targetARN
+		util.SetSwitchover(&resource{ko}, corev1.ConditionUnknown, util.ReasonSwitchoverInProgress, msg)
+	} else {
+		msg := "Global cluster is being failed over to " + targetARN
+		util.SetFailover(&resource{ko}, corev1.ConditionUnknown, util.ReasonFailoverInProgress, msg)
+	}
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	return &resource{ko}, nil
+}
+
+// setFailoverProgress reports the progress of the last failover or
+// switchover of the supplied global cluster in the Failover or Switchover
+// condition. The failover is over once the global cluster is available and
+// no longer has a failover state, and completed when the target DB cluster
+// is then the primary cluster.
+func setFailoverProgress(r *resource) {
+	last := r.ko.Status.LastFailover
+	if last == nil || last.TargetDBClusterARN == nil {
+		return
+	}
+	operation := "failed over"
+	setCondition := util.SetFailover
+	inProgress, completed, failed := util.ReasonFailoverInProgress, util.ReasonFailoverCompleted, util.ReasonFailoverFailed
+	if aws.BoolValue(last.Switchover) {
+		operation = "switched over"
+		setCondition = util.SetSwitchover
+		inProgress, completed, failed = util.ReasonSwitchoverInProgress, util.ReasonSwitchoverCompleted, util.ReasonSwitchoverFailed
+	}
+	target := *last.TargetDBClusterARN
+	switch {
+	case r.ko.Status.FailoverState != nil &&
+		aws.StringValue(r.ko.Status.FailoverState.Status) == FailoverStatusCancelling:
+		msg := "Global cluster is no longer being " + operation + " to " + target + ": the operation is being cancelled"
+		setCondition(r, corev1.ConditionFalse, failed, msg)
+	case !globalClusterSynced(r):
+		msg := "Global cluster is being " + operation + " to " + target
+		if r.ko.Status.FailoverState != nil && r.ko.Status.FailoverState.Status != nil {
+			msg += " (" + *r.ko.Status.FailoverState.Status + ")"
+		}
+		setCondition(r, corev1.ConditionUnknown, inProgress, msg)
+	case util.GlobalClusterWriter(r.ko.Status.GlobalClusterMembers) == target:
+		msg := "Global cluster was " + operation + " to " + target
+		setCondition(r, corev1.ConditionTrue, completed, msg)
+	default:
+		msg := "Global cluster was not " + operation + " to " + target
+		setCondition(r, corev1.ConditionFalse, failed, msg)
+	}
+}
+
+// populateAdoptionFields takes the globalClusterIdentifier of the supplied
+// global cluster from its adoption fields when it sets the adopt-or-create
+// adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"globalClusterIdentifier": &r.ko.Spec.GlobalClusterIdentifier,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -0,0 +1,397 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package option_group
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// customUpdate synchronizes the tags and the options of the option group. The
+// other Spec fields of an option group cannot be modified.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	ko.Status.AppliedOptions = latest.ko.Status.AppliedOptions
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Options") {
+		if err = rm.syncOptions(ctx, &resource{ko}, latest); err != nil {
+			return nil, err
+		}
+	}
+	return &resource{ko}, nil
+}
+
+// compareOptions adds a difference to the supplied delta when the desired
+// options of the option group differ from the options applied to the latest
+// option group.
+func compareOptions(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	toInclude, toRemove := util.OptionsDelta(a.ko.Spec.Options, b.ko.Status.AppliedOptions)
+	if len(toInclude) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.Options", a.ko.Spec.Options, b.ko.Status.AppliedOptions)
+	}
+}
+
+// syncOptions modifies the options of the supplied option group to match its
+// Spec.Options, and records the resulting options in its
+// Status.AppliedOptions. The options missing from the Spec are removed before
+// the options of the Spec are added or modified, in a separate call, so that
+// an option can be replaced by an option conflicting with it. latest is nil
+// when the option group was just created.
+func (rm *resourceManager) syncOptions(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncOptions")
+	defer func() { exit(err) }()
+
+	var latestOptions []*svcapitypes.Option
+	if latest != nil {
+		latestOptions = latest.ko.Status.AppliedOptions
+	}
+	toInclude, toRemove := util.OptionsDelta(desired.ko.Spec.Options, latestOptions)
+	if err = rm.validateOptionVersions(ctx, desired, toInclude, latestOptions); err != nil {
+		return err
+	}
+
+	if len(toRemove) > 0 {
+		rlog.Debug("removing options from option group", "options", toRemove)
+		if err = rm.modifyOptionGroup(ctx, desired, &svcsdk.ModifyOptionGroupInput{
+			OptionGroupName:  desired.ko.Spec.Name,
+			OptionsToRemove:  toRemove,
+			ApplyImmediately: aws.Bool(true),
+		}); err != nil {
+			return err
+		}
+	}
+	if len(toInclude) > 0 {
+		rlog.Debug("adding options to option group", "options", toInclude)
+		if err = rm.modifyOptionGroup(ctx, desired, &svcsdk.ModifyOptionGroupInput{
+			OptionGroupName:  desired.ko.Spec.Name,
+			OptionsToInclude: sdkOptionConfigurations(toInclude),
+			ApplyImmediately: aws.Bool(true),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOptionVersions returns an ACK terminal error when one of the
+// supplied options to include in the supplied option group sets an option
+// version that is not available for the engine of the option group, or that
+// downgrades an option not supporting version downgrades. Changing the
+// version of an option upgrades the option in place, without removing it
+// from the option group.
+func (rm *resourceManager) validateOptionVersions(
+	ctx context.Context,
+	r *resource,
+	toInclude []*svcapitypes.OptionConfiguration,
+	latestOptions []*svcapitypes.Option,
+) error {
+	latestVersions := map[string]string{}
+	for _, o := range latestOptions {
+		if o.OptionName != nil && o.OptionVersion != nil {
+			latestVersions[*o.OptionName] = *o.OptionVersion
+		}
+	}
+	changedVersions := map[string]string{}
+	for _, o := range toInclude {
+		if o.OptionVersion != nil && *o.OptionVersion != latestVersions[*o.OptionName] {
+			changedVersions[*o.OptionName] = *o.OptionVersion
+		}
+	}
+	if len(changedVersions) == 0 {
+		return nil
+	}
+
+	input := &svcsdk.DescribeOptionGroupOptionsInput{
+		EngineName:         r.ko.Spec.EngineName,
+		MajorEngineVersion: r.ko.Spec.MajorEngineVersion,
+	}
+	for {
+		resp, err := rm.sdkapi.DescribeOptionGroupOptionsWithContext(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeOptionGroupOptions", err)
+		if err != nil {
+			return err
+		}
+		for _, ogo := range resp.OptionGroupOptions {
+			if ogo.Name == nil {
+				continue
+			}
+			version, ok := changedVersions[*ogo.Name]
+			if !ok {
+				continue
+			}
+			versions := make([]string, 0, len(ogo.OptionGroupOptionVersions))
+			for _, v := range ogo.OptionGroupOptionVersions {
+				versions = append(versions, aws.StringValue(v.Version))
+			}
+			if err = util.ValidateOptionVersion(
+				*ogo.Name, version, latestVersions[*ogo.Name], versions,
+				aws.BoolValue(ogo.SupportsOptionVersionDowngrade),
+			); err != nil {
+				return err
+			}
+		}
+		if util.IsLastPage(resp.Marker) {
+			return nil
+		}
+		input.Marker = resp.Marker
+	}
+}
+
+// modifyOptionGroup calls ModifyOptionGroup with the supplied input and
+// records the resulting options of the option group in the
+// Status.AppliedOptions of the supplied option group.
+func (rm *resourceManager) modifyOptionGroup(
+	ctx context.Context,
+	r *resource,
+	input *svcsdk.ModifyOptionGroupInput,
+) error {
+	resp, err := rm.sdkapi.ModifyOptionGroupWithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyOptionGroup", err)
+	if err != nil {
+		return err
+	}
+	if resp.OptionGroup != nil {
+		r.ko.Status.AppliedOptions = appliedOptions(resp.OptionGroup.Options)
+	}
+	return nil
+}
+
+// appliedOptions transforms a *svcsdk.Option array to a *svcapitypes.Option
+// array.
+func appliedOptions(
+	sdkOptions []*svcsdk.Option,
+) []*svcapitypes.Option {
+	options := make([]*svcapitypes.Option, 0, len(sdkOptions))
+	for _, o := range sdkOptions {
+		option := &svcapitypes.Option{
+			OptionDescription: o.OptionDescription,
+			OptionName:        o.OptionName,
+			OptionVersion:     o.OptionVersion,
+			Permanent:         o.Permanent,
+			Persistent:        o.Persistent,
+			Port:              o.Port,
+		}
+		for _, s := range o.OptionSettings {
+			option.OptionSettings = append(option.OptionSettings, &svcapitypes.OptionSetting{
+				AllowedValues: s.AllowedValues,
+				ApplyType:     s.ApplyType,
+				DataType:      s.DataType,
+				DefaultValue:  s.DefaultValue,
+				Description:   s.Description,
+				IsCollection:  s.IsCollection,
+				IsModifiable:  s.IsModifiable,
+				Name:          s.Name,
+				Value:         s.Value,
+			})
+		}
+		for _, m := range o.VpcSecurityGroupMemberships {
+			option.VPCSecurityGroupMemberships = append(option.VPCSecurityGroupMemberships, &svcapitypes.VPCSecurityGroupMembership{
+				Status:             m.Status,
+				VPCSecurityGroupID: m.VpcSecurityGroupId,
+			})
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// sdkOptionConfigurations transforms a *svcapitypes.OptionConfiguration array
+// to a *svcsdk.OptionConfiguration array.
+func sdkOptionConfigurations(
+	configs []*svcapitypes.OptionConfiguration,
+) []*svcsdk.OptionConfiguration {
+	sdkConfigs := make([]*svcsdk.OptionConfiguration, len(configs))
+	for i, c := range configs {
+		sdkConfigs[i] = &svcsdk.OptionConfiguration{
+			DBSecurityGroupMemberships:  c.DBSecurityGroupMemberships,
+			OptionName:                  c.OptionName,
+			OptionVersion:               c.OptionVersion,
+			Port:                        c.Port,
+			VpcSecurityGroupMemberships: c.VPCSecurityGroupMemberships,
+		}
+		for _, s := range c.OptionSettings {
+			sdkConfigs[i].OptionSettings = append(sdkConfigs[i].OptionSettings, &svcsdk.OptionSetting{
+				Name:  s.Name,
+				Value: s.Value,
+			})
+		}
+	}
+	return sdkConfigs
+}
+
+// syncTags keeps the resource's tags in sync
+//
+// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
+// following ways:
+//
+//  1. The names of the tagging API operations are different. Other APIs use the
+//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
+//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
+//     `RemoveTagsFromResource`.
+//
+//  2. Even though the name of the `ListTagsForResource` API call is the same,
+//     the structure of the input and the output are different from other APIs.
+//     For the input, instead of a `ResourceArn` field, RDS names the field
+//     `ResourceName`, but actually expects an ARN, not the proxy
+//     name.  This is the same for the `AddTagsToResource` and
+//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
+//     called `TagList` instead of `Tags` but is otherwise the same struct with
+//     a `Key` and `Value` member field.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+
+	toAdd, toDelete := util.ComputeTagsDelta(
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+
+	if len(toDelete) > 0 {
+		rlog.Debug("removing tags from option group", "tags", toDelete)
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: arn,
+				TagKeys:      toDelete,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	if len(toAdd) > 0 {
+		rlog.Debug("adding tags to option group", "tags", toAdd)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: arn,
+				Tags:         sdkTagsFromResourceTags(toAdd),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := rm.sdkapi.ListTagsForResourceWithContext(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
+// array.
+func sdkTagsFromResourceTags(
+	rTags []*svcapitypes.Tag,
+) []*svcsdk.Tag {
+	tags := make([]*svcsdk.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = &svcsdk.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// populateAdoptionFields takes the name of the supplied option group from its
+// adoption fields when it sets the adopt-or-create adoption policy, see
+// util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"name": &r.ko.Spec.Name,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
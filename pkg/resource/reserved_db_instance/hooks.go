@@ -0,0 +1,231 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package reserved_db_instance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	StatePaymentPending = "payment-pending"
+	StatePaymentFailed  = "payment-failed"
+	StateActive         = "active"
+	StateRetired        = "retired"
+)
+
+// reservationPaymentPending returns true if the payment of the supplied
+// reserved DB instance is being processed
+func reservationPaymentPending(r *resource) bool {
+	return r.ko.Status.State != nil && *r.ko.Status.State == StatePaymentPending
+}
+
+// reservationPaymentFailed returns true if the payment of the supplied
+// reserved DB instance failed
+func reservationPaymentFailed(r *resource) bool {
+	return r.ko.Status.State != nil && *r.ko.Status.State == StatePaymentFailed
+}
+
+// findReservedDBInstancesOffering returns the ID of the reserved DB instance
+// offering matching the DB instance class, duration, Multi-AZ, offering type
+// and product description of the supplied reserved DB instance, or a terminal
+// error when there is none or several of them.
+func (rm *resourceManager) findReservedDBInstancesOffering(
+	ctx context.Context,
+	r *resource,
+) (offeringID *string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findReservedDBInstancesOffering")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.DescribeReservedDBInstancesOfferingsInput{
+		DBInstanceClass:    r.ko.Spec.DBInstanceClass,
+		MultiAZ:            r.ko.Spec.MultiAZ,
+		OfferingType:       r.ko.Spec.OfferingType,
+		ProductDescription: r.ko.Spec.ProductDescription,
+	}
+	if r.ko.Spec.Duration != nil {
+		input.Duration = aws.String(strconv.FormatInt(*r.ko.Spec.Duration, 10))
+	}
+	var offerings []*svcsdk.ReservedDBInstancesOffering
+	err = rm.sdkapi.DescribeReservedDBInstancesOfferingsPagesWithContext(
+		ctx,
+		input,
+		func(page *svcsdk.DescribeReservedDBInstancesOfferingsOutput, _ bool) bool {
+			offerings = append(offerings, page.ReservedDBInstancesOfferings...)
+			return true
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeReservedDBInstancesOfferings", err)
+	if err != nil {
+		return nil, err
+	}
+	offering, err := util.ReservedDBInstancesOffering(
+		offerings, aws.StringValue(r.ko.Spec.ProductDescription),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return offering.ReservedDBInstancesOfferingId, nil
+}
+
+// customUpdate syncs the tags of the reserved DB instance, the only Spec
+// field of a reserved DB instance that can be modified.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if immutableFieldChanges := rm.getImmutableFieldChanges(delta); len(immutableFieldChanges) > 0 {
+		msg := fmt.Sprintf("Immutable Spec fields have been modified: %s", strings.Join(immutableFieldChanges, ","))
+		return nil, ackerr.NewTerminalError(fmt.Errorf(msg))
+	}
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	return &resource{ko}, nil
+}
+
+// syncTags keeps the resource's tags in sync. See the db_proxy package for
+// the differences between the RDS tagging APIs and the other AWS APIs.
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+
+	toAdd, toDelete := util.ComputeTagsDelta(
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+
+	if len(toDelete) > 0 {
+		rlog.Debug("removing tags from reserved DB instance", "tags", toDelete)
+		_, err = rm.sdkapi.RemoveTagsFromResourceWithContext(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: arn,
+				TagKeys:      toDelete,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	if len(toAdd) > 0 {
+		rlog.Debug("adding tags to reserved DB instance", "tags", toAdd)
+		_, err = rm.sdkapi.AddTagsToResourceWithContext(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: arn,
+				Tags:         sdkTagsFromResourceTags(toAdd),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := rm.sdkapi.ListTagsForResourceWithContext(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
+// array.
+func sdkTagsFromResourceTags(
+	rTags []*svcapitypes.Tag,
+) []*svcsdk.Tag {
+	tags := make([]*svcsdk.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = &svcsdk.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// populateAdoptionFields takes the reservedDBInstanceID of the supplied
+// reserved DB instance from its adoption fields when it sets the
+// adopt-or-create adoption policy, see util.PopulateAdoptionFields.
+func populateAdoptionFields(r *resource) error {
+	return util.PopulateAdoptionFields(
+		r.ko.Annotations,
+		map[string]**string{
+			"reservedDBInstanceID": &r.ko.Spec.ReservedDBInstanceID,
+		},
+		util.AdoptionPolicyAdoptOrCreate,
+	)
+}
+
+// markAdoptionManaged marks the supplied resource as managed when it sets an
+// adoption policy, so the runtime updates the AWS resource it adopts.
+func markAdoptionManaged(ctx context.Context, r *resource) error {
+	return util.MarkAdoptionManaged(ctx, r.ko, finalizerString)
+}
@@ -0,0 +1,143 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	ActivityStreamStatusStopped  = "stopped"
+	ActivityStreamStatusStarting = "starting"
+	ActivityStreamStatusStarted  = "started"
+	ActivityStreamStatusStopping = "stopping"
+)
+
+var (
+	ErrInvalidActivityStream = fmt.Errorf("invalid activity stream")
+)
+
+// ValidateActivityStream returns an ACK terminal error when the supplied
+// activity stream of a DB cluster, or of a DB instance when the supplied
+// cluster flag is false, with the supplied engine is not supported. Activity
+// streams are supported by Aurora DB clusters and by RDS for Oracle and
+// RDS for SQL Server DB instances, in the async mode except on Aurora
+// PostgreSQL.
+func ValidateActivityStream(
+	engine string,
+	cluster bool,
+	stream *svcapitypes.ActivityStream,
+) error {
+	if stream == nil || stream.Enabled == nil || !*stream.Enabled {
+		return nil
+	}
+	aurora := strings.HasPrefix(engine, "aurora")
+	switch {
+	case cluster && !aurora:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: only Aurora DB clusters support activity streams, not %s ones", ErrInvalidActivityStream, engine,
+		))
+	case !cluster && aurora:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: the activity stream of the DB instances of an Aurora DB cluster is set on the DB cluster",
+			ErrInvalidActivityStream,
+		))
+	case !cluster && !strings.HasPrefix(engine, "oracle") && !strings.HasPrefix(engine, "sqlserver"):
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: only RDS for Oracle and RDS for SQL Server DB instances support activity streams, not %s ones",
+			ErrInvalidActivityStream, engine,
+		))
+	}
+	if stream.Mode == nil || stream.KMSKeyID == nil {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: mode and kmsKeyID are required to start an activity stream", ErrInvalidActivityStream,
+		))
+	}
+	switch *stream.Mode {
+	case string(svcapitypes.ActivityStreamMode_async):
+	case string(svcapitypes.ActivityStreamMode_sync):
+		if engine != "aurora-postgresql" {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%w: only Aurora PostgreSQL supports the sync mode", ErrInvalidActivityStream,
+			))
+		}
+	default:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: mode must be async or sync, not %q", ErrInvalidActivityStream, *stream.Mode,
+		))
+	}
+	if stream.EngineNativeAuditFieldsIncluded != nil && *stream.EngineNativeAuditFieldsIncluded &&
+		!strings.HasPrefix(engine, "oracle") {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: only RDS for Oracle supports engineNativeAuditFieldsIncluded", ErrInvalidActivityStream,
+		))
+	}
+	return nil
+}
+
+// ActivityStreamStarted returns true if the supplied activity stream status
+// is started or starting.
+func ActivityStreamStarted(status *string) bool {
+	return status != nil &&
+		(*status == ActivityStreamStatusStarted || *status == ActivityStreamStatusStarting)
+}
+
+// ActivityStreamChanging returns true if the supplied activity stream status
+// is starting or stopping.
+func ActivityStreamChanging(status *string) bool {
+	return status != nil &&
+		(*status == ActivityStreamStatusStarting || *status == ActivityStreamStatusStopping)
+}
+
+// LatestActivityStream returns the activity stream reported by the supplied
+// status and mode, along with the settings of the supplied desired activity
+// stream that RDS does not report.
+func LatestActivityStream(
+	desired *svcapitypes.ActivityStream,
+	status *string,
+	mode *string,
+) *svcapitypes.ActivityStream {
+	latest := desired.DeepCopy()
+	started := ActivityStreamStarted(status)
+	latest.Enabled = &started
+	if started {
+		latest.Mode = mode
+	}
+	return latest
+}
+
+// ActivityStreamDiffers returns true if the supplied desired activity stream
+// is started while the supplied latest one is stopped, or the other way
+// around, or is started in another mode.
+func ActivityStreamDiffers(
+	desired *svcapitypes.ActivityStream,
+	latest *svcapitypes.ActivityStream,
+) bool {
+	if desired == nil || desired.Enabled == nil {
+		return false
+	}
+	if latest == nil || latest.Enabled == nil {
+		return true
+	}
+	if *desired.Enabled != *latest.Enabled {
+		return true
+	}
+	return *desired.Enabled && desired.Mode != nil &&
+		(latest.Mode == nil || *desired.Mode != *latest.Mode)
+}
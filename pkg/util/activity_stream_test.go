@@ -0,0 +1,151 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateActivityStream(t *testing.T) {
+	started := func(mode string) *svcapitypes.ActivityStream {
+		return &svcapitypes.ActivityStream{
+			Enabled:  aws.Bool(true),
+			Mode:     aws.String(mode),
+			KMSKeyID: aws.String("alias/das"),
+		}
+	}
+	tests := []struct {
+		name    string
+		engine  string
+		cluster bool
+		stream  *svcapitypes.ActivityStream
+		wantErr bool
+	}{
+		{"no activity stream", "mysql", false, nil, false},
+		{"stopped", "mysql", false, &svcapitypes.ActivityStream{Enabled: aws.Bool(false)}, false},
+		{"aurora postgresql sync", "aurora-postgresql", true, started("sync"), false},
+		{"aurora mysql async", "aurora-mysql", true, started("async"), false},
+		{"aurora mysql sync", "aurora-mysql", true, started("sync"), true},
+		{"oracle async", "oracle-ee", false, started("async"), false},
+		{"sqlserver async", "sqlserver-se", false, started("async"), false},
+		{"mysql DB instance", "mysql", false, started("async"), true},
+		{"Multi-AZ DB cluster", "postgres", true, started("async"), true},
+		{"Aurora DB instance", "aurora-postgresql", false, started("async"), true},
+		{"unknown mode", "aurora-postgresql", true, started("batch"), true},
+		{"no KMS key", "aurora-postgresql", true, &svcapitypes.ActivityStream{Enabled: aws.Bool(true), Mode: aws.String("async")}, true},
+		{
+			"engine-native audit fields on oracle",
+			"oracle-se2", false,
+			&svcapitypes.ActivityStream{
+				Enabled: aws.Bool(true), Mode: aws.String("async"), KMSKeyID: aws.String("alias/das"),
+				EngineNativeAuditFieldsIncluded: aws.Bool(true),
+			},
+			false,
+		},
+		{
+			"engine-native audit fields on sqlserver",
+			"sqlserver-ee", false,
+			&svcapitypes.ActivityStream{
+				Enabled: aws.Bool(true), Mode: aws.String("async"), KMSKeyID: aws.String("alias/das"),
+				EngineNativeAuditFieldsIncluded: aws.Bool(true),
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateActivityStream(tt.engine, tt.cluster, tt.stream)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidActivityStream) {
+				t.Errorf("ValidateActivityStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLatestActivityStream(t *testing.T) {
+	desired := &svcapitypes.ActivityStream{
+		Enabled:  aws.Bool(true),
+		Mode:     aws.String("sync"),
+		KMSKeyID: aws.String("alias/das"),
+	}
+	tests := []struct {
+		name   string
+		status *string
+		mode   *string
+		want   *svcapitypes.ActivityStream
+	}{
+		{
+			"started",
+			aws.String("started"), aws.String("async"),
+			&svcapitypes.ActivityStream{Enabled: aws.Bool(true), Mode: aws.String("async"), KMSKeyID: aws.String("alias/das")},
+		},
+		{
+			"starting",
+			aws.String("starting"), aws.String("sync"),
+			&svcapitypes.ActivityStream{Enabled: aws.Bool(true), Mode: aws.String("sync"), KMSKeyID: aws.String("alias/das")},
+		},
+		{
+			"stopped",
+			aws.String("stopped"), nil,
+			&svcapitypes.ActivityStream{Enabled: aws.Bool(false), Mode: aws.String("sync"), KMSKeyID: aws.String("alias/das")},
+		},
+		{
+			"never started",
+			nil, nil,
+			&svcapitypes.ActivityStream{Enabled: aws.Bool(false), Mode: aws.String("sync"), KMSKeyID: aws.String("alias/das")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.LatestActivityStream(desired, tt.status, tt.mode)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LatestActivityStream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActivityStreamDiffers(t *testing.T) {
+	stream := func(enabled bool, mode string) *svcapitypes.ActivityStream {
+		return &svcapitypes.ActivityStream{Enabled: aws.Bool(enabled), Mode: aws.String(mode)}
+	}
+	tests := []struct {
+		name    string
+		desired *svcapitypes.ActivityStream
+		latest  *svcapitypes.ActivityStream
+		want    bool
+	}{
+		{"no desired activity stream", nil, stream(true, "async"), false},
+		{"started", stream(true, "async"), stream(true, "async"), false},
+		{"stopped", stream(false, "async"), stream(false, "async"), false},
+		{"start", stream(true, "async"), stream(false, "async"), true},
+		{"stop", stream(false, "async"), stream(true, "async"), true},
+		{"other mode", stream(true, "sync"), stream(true, "async"), true},
+		{"mode of a stopped activity stream", stream(false, "sync"), stream(false, "async"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ActivityStreamDiffers(tt.desired, tt.latest); got != tt.want {
+				t.Errorf("ActivityStreamDiffers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// AdoptionPolicyAdopt makes the controller adopt an existing AWS resource
+	// instead of creating one, failing when there is none
+	AdoptionPolicyAdopt = "adopt"
+	// AdoptionPolicyAdoptOrCreate makes the controller adopt the AWS resource
+	// with the identifiers of the resource when it exists, converging it to
+	// the Spec, and create it otherwise
+	AdoptionPolicyAdoptOrCreate = "adopt-or-create"
+)
+
+var (
+	ErrInvalidAdoption         = fmt.Errorf("invalid adoption")
+	ErrAdoptedResourceNotFound = fmt.Errorf("resource to adopt not found")
+)
+
+// AdoptionPolicy returns the adoption policy set by the adoption-policy
+// annotation of the supplied annotations, empty when unset, or an ACK
+// terminal error when it is not one of the supplied policies supported by the
+// kind of the resource.
+func AdoptionPolicy(annotations map[string]string, supported ...string) (string, error) {
+	policy, ok := annotations[svcapitypes.AdoptionPolicyAnnotation]
+	if !ok {
+		return "", nil
+	}
+	for _, s := range supported {
+		if policy == s {
+			return policy, nil
+		}
+	}
+	return "", ackerr.NewTerminalError(fmt.Errorf(
+		"%w: unsupported adoption policy %q, expected one of %q",
+		ErrInvalidAdoption, policy, supported,
+	))
+}
+
+// AdoptionRequested returns true when the supplied annotations request the
+// adoption of an existing AWS resource, failing when there is none, that was
+// not adopted yet, or an ACK terminal error when the adoption policy is
+// neither AdoptionPolicyAdopt nor AdoptionPolicyAdoptOrCreate.
+func AdoptionRequested(annotations map[string]string) (bool, error) {
+	policy, err := AdoptionPolicy(annotations, AdoptionPolicyAdopt, AdoptionPolicyAdoptOrCreate)
+	if err != nil || policy != AdoptionPolicyAdopt {
+		return false, err
+	}
+	return !strings.EqualFold(annotations[ackv1alpha1.AnnotationAdopted], "true"), nil
+}
+
+// PopulateAdoptionFields sets the supplied Spec identifiers, keyed by the
+// name of their field, to the identifiers of the AWS resource to adopt when
+// the supplied annotations set one of the supplied adoption policies, so that
+// the AWS resource is read. It returns an ACK terminal error when the
+// adoption policy is not supported or an identifier is invalid.
+func PopulateAdoptionFields(
+	annotations map[string]string,
+	identifiers map[string]**string,
+	supported ...string,
+) error {
+	policy, err := AdoptionPolicy(annotations, supported...)
+	if err != nil || policy == "" {
+		return err
+	}
+	for field, spec := range identifiers {
+		id, err := AdoptionIdentifier(annotations, field, *spec)
+		if err != nil {
+			return err
+		}
+		*spec = id
+	}
+	return nil
+}
+
+// MarkAdoptionManaged adds the supplied finalizer, which marks resources as
+// managed by the ACK runtime, to the supplied resource when it sets an
+// adoption policy, and patches the resource with it. The runtime only marks
+// the resources it creates as managed, and fails the update of the resources
+// it finds unmanaged, so the AWS resource found for a resource setting an
+// adoption policy would never be adopted otherwise. It must be called once
+// the adoption policy is validated, before the AWS resource is read, and
+// leaves the resources being deleted untouched.
+func MarkAdoptionManaged(
+	ctx context.Context,
+	obj client.Object,
+	finalizer string,
+) error {
+	if _, ok := obj.GetAnnotations()[svcapitypes.AdoptionPolicyAnnotation]; !ok {
+		return nil
+	}
+	if !obj.GetDeletionTimestamp().IsZero() || controllerutil.ContainsFinalizer(obj, finalizer) {
+		return nil
+	}
+	if kubeClient != nil {
+		// The patched copy is updated with the stored resource, whose
+		// references are not resolved
+		patched := obj.DeepCopyObject().(client.Object)
+		controllerutil.AddFinalizer(patched, finalizer)
+		if err := kubeClient.Patch(ctx, patched, client.MergeFrom(obj)); err != nil {
+			return err
+		}
+	}
+	controllerutil.AddFinalizer(obj, finalizer)
+	return nil
+}
+
+// AdoptionIdentifier returns the identifier of the AWS resource to adopt: the
+// named field of the adoption-fields annotation of the supplied annotations
+// or, when it has none, the supplied Spec identifier. It returns an ACK
+// terminal error when the annotation is not a JSON object of strings, when
+// the identifiers of the annotation and the Spec differ, or when there is no
+// identifier.
+func AdoptionIdentifier(
+	annotations map[string]string,
+	field string,
+	specIdentifier *string,
+) (*string, error) {
+	var id *string
+	if value, ok := annotations[svcapitypes.AdoptionFieldsAnnotation]; ok {
+		fields := map[string]string{}
+		if err := json.Unmarshal([]byte(value), &fields); err != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"%w: adoption fields must be a JSON object of strings: %s",
+				ErrInvalidAdoption, err,
+			))
+		}
+		if v, ok := fields[field]; ok && v != "" {
+			id = &v
+		}
+	}
+	if id != nil && specIdentifier != nil && *id != *specIdentifier {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s %q of the adoption fields differs from %q of the Spec",
+			ErrInvalidAdoption, field, *id, *specIdentifier,
+		))
+	}
+	if id == nil {
+		id = specIdentifier
+	}
+	if id == nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s is required in the adoption fields or the Spec",
+			ErrInvalidAdoption, field,
+		))
+	}
+	return id, nil
+}
+
+// SetAdopted records in the supplied annotations, which must not be nil,
+// that the AWS resource was adopted.
+func SetAdopted(annotations map[string]string) {
+	annotations[ackv1alpha1.AnnotationAdopted] = "true"
+}
+
+// NewErrAdoptedResourceNotFound generates an ACK terminal error about the
+// AWS resource of the supplied kind and identifier to adopt, which does not
+// exist
+func NewErrAdoptedResourceNotFound(kind string, id string) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s %q does not exist", ErrAdoptedResourceNotFound, kind, id,
+	))
+}
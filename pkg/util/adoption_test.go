@@ -0,0 +1,244 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestAdoptionRequested(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+		wantErr     bool
+	}{
+		{name: "no annotations"},
+		{
+			name:        "adopt",
+			annotations: map[string]string{svcapitypes.AdoptionPolicyAnnotation: "adopt"},
+			want:        true,
+		},
+		{
+			name: "adopted",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: "adopt",
+				ackv1alpha1.AnnotationAdopted:        "true",
+			},
+		},
+		{
+			name:        "adopt or create",
+			annotations: map[string]string{svcapitypes.AdoptionPolicyAnnotation: "adopt-or-create"},
+		},
+		{
+			name:        "unknown policy",
+			annotations: map[string]string{svcapitypes.AdoptionPolicyAnnotation: "take-over"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.AdoptionRequested(tt.annotations)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidAdoption) {
+				t.Errorf("AdoptionRequested() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AdoptionRequested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdoptionIdentifier(t *testing.T) {
+	fields := func(value string) map[string]string {
+		return map[string]string{
+			svcapitypes.AdoptionPolicyAnnotation: "adopt",
+			svcapitypes.AdoptionFieldsAnnotation: value,
+		}
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        *string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "adoption fields",
+			annotations: fields(`{"dbInstanceIdentifier": "prod-db"}`),
+			want:        "prod-db",
+		},
+		{
+			name:        "spec",
+			annotations: map[string]string{svcapitypes.AdoptionPolicyAnnotation: "adopt"},
+			spec:        aws.String("prod-db"),
+			want:        "prod-db",
+		},
+		{
+			name:        "same in both",
+			annotations: fields(`{"dbInstanceIdentifier": "prod-db"}`),
+			spec:        aws.String("prod-db"),
+			want:        "prod-db",
+		},
+		{
+			name:        "different in both",
+			annotations: fields(`{"dbInstanceIdentifier": "prod-db"}`),
+			spec:        aws.String("staging-db"),
+			wantErr:     true,
+		},
+		{
+			name:        "other field",
+			annotations: fields(`{"dbClusterIdentifier": "prod-cluster"}`),
+			wantErr:     true,
+		},
+		{
+			name:        "invalid JSON",
+			annotations: fields(`dbInstanceIdentifier=prod-db`),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.AdoptionIdentifier(tt.annotations, "dbInstanceIdentifier", tt.spec)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidAdoption) {
+				t.Errorf("AdoptionIdentifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if aws.StringValue(got) != tt.want {
+				t.Errorf("AdoptionIdentifier() = %q, want %q", aws.StringValue(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestPopulateAdoptionFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        *string
+		supported   []string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:      "no adoption",
+			spec:      aws.String("params"),
+			supported: []string{util.AdoptionPolicyAdoptOrCreate},
+			want:      "params",
+		},
+		{
+			name: "adopt or create",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: "adopt-or-create",
+				svcapitypes.AdoptionFieldsAnnotation: `{"name": "params"}`,
+			},
+			supported: []string{util.AdoptionPolicyAdoptOrCreate},
+			want:      "params",
+		},
+		{
+			name: "unsupported policy",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: "adopt",
+				svcapitypes.AdoptionFieldsAnnotation: `{"name": "params"}`,
+			},
+			supported: []string{util.AdoptionPolicyAdoptOrCreate},
+			wantErr:   true,
+		},
+		{
+			name: "conflicting identifiers",
+			annotations: map[string]string{
+				svcapitypes.AdoptionPolicyAnnotation: "adopt-or-create",
+				svcapitypes.AdoptionFieldsAnnotation: `{"name": "params"}`,
+			},
+			spec:      aws.String("other-params"),
+			supported: []string{util.AdoptionPolicyAdoptOrCreate},
+			want:      "other-params",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			err := util.PopulateAdoptionFields(
+				tt.annotations, map[string]**string{"name": &spec}, tt.supported...,
+			)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidAdoption) {
+				t.Errorf("PopulateAdoptionFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if aws.StringValue(spec) != tt.want {
+				t.Errorf("PopulateAdoptionFields() identifier = %q, want %q", aws.StringValue(spec), tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkAdoptionManaged(t *testing.T) {
+	const finalizer = "finalizers.rds.services.k8s.aws/DBSubnetGroup"
+	adopt := map[string]string{svcapitypes.AdoptionPolicyAnnotation: "adopt-or-create"}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		deleting      bool
+		wantFinalizer bool
+	}{
+		{name: "no adoption policy"},
+		{name: "adoption policy", annotations: adopt, wantFinalizer: true},
+		{name: "being deleted", annotations: adopt, deleting: true},
+	}
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &svcapitypes.DBSubnetGroup{ObjectMeta: metav1.ObjectMeta{
+				Name: "subnets", Namespace: "default", Annotations: tt.annotations,
+			}}
+			if tt.deleting {
+				obj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				obj.Finalizers = []string{"example.com/protection"}
+			}
+			kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).Build()
+			util.SetKubeClient(kc)
+			defer util.SetKubeClient(nil)
+
+			if err := util.MarkAdoptionManaged(context.TODO(), obj, finalizer); err != nil {
+				t.Fatalf("MarkAdoptionManaged() error = %v", err)
+			}
+			if got := controllerutil.ContainsFinalizer(obj, finalizer); got != tt.wantFinalizer {
+				t.Errorf("MarkAdoptionManaged() finalizer = %v, want %v", got, tt.wantFinalizer)
+			}
+			stored := &svcapitypes.DBSubnetGroup{}
+			if err := kc.Get(context.TODO(), client.ObjectKeyFromObject(obj), stored); err != nil {
+				t.Fatal(err)
+			}
+			if got := controllerutil.ContainsFinalizer(stored, finalizer); got != tt.wantFinalizer {
+				t.Errorf("MarkAdoptionManaged() patched finalizer = %v, want %v", got, tt.wantFinalizer)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+)
+
+const (
+	AutomatedBackupStatusActive   = "active"
+	AutomatedBackupStatusCreating = "creating"
+	AutomatedBackupStatusRetained = "retained"
+)
+
+var (
+	ErrAutomatedBackupNotFound      = fmt.Errorf("automated backups not found")
+	ErrAutomatedBackupNotRestorable = fmt.Errorf("automated backups cannot be restored")
+)
+
+// ValidateAutomatedBackupRestore returns an error when the supplied automated
+// backups, returned by DescribeDBInstanceAutomatedBackups for the source of a
+// point in time restore, cannot be restored to the supplied restore time, or
+// to their latest restorable time when it is nil. The automated backups of a
+// deleted DB instance are retained, and are restored like the ones of an
+// existing DB instance. Automated backups that are still being created are
+// restored once their first snapshot is available, so a retryable error is
+// returned for them; an ACK terminal error is returned when the restore time
+// is out of their restore window.
+func ValidateAutomatedBackupRestore(
+	backups []*svcsdk.DBInstanceAutomatedBackup,
+	restoreTime *time.Time,
+) error {
+	if len(backups) == 0 {
+		return ackerr.NewTerminalError(ErrAutomatedBackupNotFound)
+	}
+	backup := backups[0]
+	if backup.Status != nil && *backup.Status == AutomatedBackupStatusCreating {
+		return fmt.Errorf(
+			"%w: their first snapshot is being created", ErrAutomatedBackupNotRestorable,
+		)
+	}
+	window := backup.RestoreWindow
+	if restoreTime == nil || window == nil {
+		return nil
+	}
+	if (window.EarliestTime != nil && restoreTime.Before(*window.EarliestTime)) ||
+		(window.LatestTime != nil && restoreTime.After(*window.LatestTime)) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: restoreTime %s is out of their restore window, from %s to %s",
+			ErrAutomatedBackupNotRestorable, restoreTime.UTC().Format(time.RFC3339),
+			formatWindowTime(window.EarliestTime), formatWindowTime(window.LatestTime),
+		))
+	}
+	return nil
+}
+
+// formatWindowTime formats the supplied bound of a restore window
+func formatWindowTime(t *time.Time) string {
+	if t == nil {
+		return "unknown"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,62 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateAutomatedBackupRestore(t *testing.T) {
+	earliest := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	latest := time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)
+	backup := func(status string) []*svcsdk.DBInstanceAutomatedBackup {
+		return []*svcsdk.DBInstanceAutomatedBackup{{
+			Status: aws.String(status),
+			RestoreWindow: &svcsdk.RestoreWindow{
+				EarliestTime: aws.Time(earliest),
+				LatestTime:   aws.Time(latest),
+			},
+		}}
+	}
+	tests := []struct {
+		name        string
+		backups     []*svcsdk.DBInstanceAutomatedBackup
+		restoreTime *time.Time
+		wantErr     error
+	}{
+		{"retained, latest restorable time", backup("retained"), nil, nil},
+		{"retained, in the restore window", backup("retained"), aws.Time(earliest.Add(time.Hour)), nil},
+		{"active, in the restore window", backup("active"), aws.Time(latest), nil},
+		{"before the restore window", backup("retained"), aws.Time(earliest.Add(-time.Hour)), util.ErrAutomatedBackupNotRestorable},
+		{"after the restore window", backup("active"), aws.Time(latest.Add(time.Hour)), util.ErrAutomatedBackupNotRestorable},
+		{"being created", backup("creating"), nil, util.ErrAutomatedBackupNotRestorable},
+		{"no restore window", []*svcsdk.DBInstanceAutomatedBackup{{Status: aws.String("retained")}}, aws.Time(latest), nil},
+		{"not found", nil, nil, util.ErrAutomatedBackupNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateAutomatedBackupRestore(tt.backups, tt.restoreTime)
+			if (tt.wantErr == nil) != (err == nil) || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Errorf("ValidateAutomatedBackupRestore() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
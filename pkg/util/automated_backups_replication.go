@@ -0,0 +1,113 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidAutomatedBackupsReplication = fmt.Errorf("invalid automated backups replication")
+)
+
+// ValidateAutomatedBackupsReplication returns an ACK terminal error when the
+// supplied replication of the automated backups of a DB instance in the
+// supplied region is not valid. The automated backups are replicated to
+// another region, and kept between 1 and 35 days there.
+func ValidateAutomatedBackupsReplication(
+	replication *svcapitypes.AutomatedBackupsReplication,
+	region string,
+) error {
+	if replication == nil || replication.Enabled == nil || !*replication.Enabled {
+		return nil
+	}
+	if replication.DestinationRegion == nil || *replication.DestinationRegion == "" {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: destinationRegion is required to replicate automated backups",
+			ErrInvalidAutomatedBackupsReplication,
+		))
+	}
+	if *replication.DestinationRegion == region {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: automated backups cannot be replicated to the region of the DB instance, %s",
+			ErrInvalidAutomatedBackupsReplication, region,
+		))
+	}
+	if period := replication.BackupRetentionPeriod; period != nil && (*period < 1 || *period > 35) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: backupRetentionPeriod must be between 1 and 35 days, not %d",
+			ErrInvalidAutomatedBackupsReplication, *period,
+		))
+	}
+	return nil
+}
+
+// AutomatedBackupsReplicationARN returns the ARN of the automated backups
+// replicated to the supplied region among the supplied replications of the
+// automated backups of a DB instance, or nil when they are not replicated to
+// that region.
+func AutomatedBackupsReplicationARN(
+	replications []*svcapitypes.DBInstanceAutomatedBackupsReplication,
+	region string,
+) *string {
+	for _, r := range replications {
+		if r == nil || r.DBInstanceAutomatedBackupsARN == nil {
+			continue
+		}
+		parsed, err := arn.Parse(*r.DBInstanceAutomatedBackupsARN)
+		if err != nil {
+			continue
+		}
+		if parsed.Region == region {
+			return r.DBInstanceAutomatedBackupsARN
+		}
+	}
+	return nil
+}
+
+// LatestAutomatedBackupsReplication returns the replication of the automated
+// backups of a DB instance to the destination region of the supplied desired
+// replication, enabled when the supplied ARN of the replicated automated
+// backups is set. The retention period and the KMS key are only applied when
+// the replication starts, they are the desired ones.
+func LatestAutomatedBackupsReplication(
+	desired *svcapitypes.AutomatedBackupsReplication,
+	replicationARN *string,
+) *svcapitypes.AutomatedBackupsReplication {
+	latest := desired.DeepCopy()
+	enabled := replicationARN != nil
+	latest.Enabled = &enabled
+	return latest
+}
+
+// AutomatedBackupsReplicationDiffers returns true if the supplied desired
+// replication of automated backups is enabled while the supplied latest one
+// is disabled, or the other way around.
+func AutomatedBackupsReplicationDiffers(
+	desired *svcapitypes.AutomatedBackupsReplication,
+	latest *svcapitypes.AutomatedBackupsReplication,
+) bool {
+	if desired == nil || desired.Enabled == nil {
+		return false
+	}
+	if latest == nil || latest.Enabled == nil {
+		return true
+	}
+	return *desired.Enabled != *latest.Enabled
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateAutomatedBackupsReplication(t *testing.T) {
+	replication := func(region string, period int64) *svcapitypes.AutomatedBackupsReplication {
+		return &svcapitypes.AutomatedBackupsReplication{
+			Enabled:               aws.Bool(true),
+			DestinationRegion:     aws.String(region),
+			BackupRetentionPeriod: aws.Int64(period),
+		}
+	}
+	tests := []struct {
+		name        string
+		replication *svcapitypes.AutomatedBackupsReplication
+		wantErr     bool
+	}{
+		{"no replication", nil, false},
+		{"disabled", &svcapitypes.AutomatedBackupsReplication{Enabled: aws.Bool(false)}, false},
+		{"other region", replication("us-east-1", 7), false},
+		{"no retention period", &svcapitypes.AutomatedBackupsReplication{Enabled: aws.Bool(true), DestinationRegion: aws.String("us-east-1")}, false},
+		{"no destination region", &svcapitypes.AutomatedBackupsReplication{Enabled: aws.Bool(true)}, true},
+		{"region of the DB instance", replication("us-west-2", 7), true},
+		{"retention period too short", replication("us-east-1", 0), true},
+		{"retention period too long", replication("us-east-1", 36), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateAutomatedBackupsReplication(tt.replication, "us-west-2")
+			if tt.wantErr != errors.Is(err, util.ErrInvalidAutomatedBackupsReplication) {
+				t.Errorf("ValidateAutomatedBackupsReplication() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAutomatedBackupsReplicationARN(t *testing.T) {
+	replicated := "arn:aws:rds:us-east-1:123456789012:auto-backup:ab-abcdefghijklmnopqrstuvwxyz"
+	replications := []*svcapitypes.DBInstanceAutomatedBackupsReplication{
+		{DBInstanceAutomatedBackupsARN: aws.String("not-an-arn")},
+		{DBInstanceAutomatedBackupsARN: aws.String(replicated)},
+	}
+	tests := []struct {
+		name         string
+		replications []*svcapitypes.DBInstanceAutomatedBackupsReplication
+		region       string
+		want         *string
+	}{
+		{"no replications", nil, "us-east-1", nil},
+		{"replicated to the region", replications, "us-east-1", aws.String(replicated)},
+		{"replicated to another region", replications, "eu-west-1", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.AutomatedBackupsReplicationARN(tt.replications, tt.region)
+			if aws.StringValue(got) != aws.StringValue(tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("AutomatedBackupsReplicationARN() = %v, want %v", aws.StringValue(got), aws.StringValue(tt.want))
+			}
+		})
+	}
+}
+
+func TestAutomatedBackupsReplicationDiffers(t *testing.T) {
+	desired := &svcapitypes.AutomatedBackupsReplication{
+		Enabled:           aws.Bool(true),
+		DestinationRegion: aws.String("us-east-1"),
+	}
+	tests := []struct {
+		name           string
+		desired        *svcapitypes.AutomatedBackupsReplication
+		replicationARN *string
+		want           bool
+	}{
+		{"no desired replication", nil, nil, false},
+		{"replicated", desired, aws.String("arn:aws:rds:us-east-1:123456789012:auto-backup:ab-1"), false},
+		{"start", desired, nil, true},
+		{
+			"stop",
+			&svcapitypes.AutomatedBackupsReplication{Enabled: aws.Bool(false), DestinationRegion: aws.String("us-east-1")},
+			aws.String("arn:aws:rds:us-east-1:123456789012:auto-backup:ab-1"),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var latest *svcapitypes.AutomatedBackupsReplication
+			if tt.desired != nil {
+				latest = util.LatestAutomatedBackupsReplication(tt.desired, tt.replicationARN)
+			}
+			if got := util.AutomatedBackupsReplicationDiffers(tt.desired, latest); got != tt.want {
+				t.Errorf("AutomatedBackupsReplicationDiffers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+const (
+	// BacktrackWindowMax is the longest backtrack window, in seconds, of an
+	// Aurora MySQL DB cluster
+	BacktrackWindowMax = 259200
+
+	// BacktrackStatusApplying is the status of a backtrack being applied
+	BacktrackStatusApplying = "applying"
+	// BacktrackStatusCompleted is the status of a backtrack once applied
+	BacktrackStatusCompleted = "completed"
+	// BacktrackStatusFailed is the status of a backtrack that failed
+	BacktrackStatusFailed = "failed"
+	// BacktrackStatusPending is the status of a backtrack not applied yet
+	BacktrackStatusPending = "pending"
+)
+
+var (
+	ErrInvalidBacktrack = fmt.Errorf("invalid backtrack")
+
+	// backtrackEngines are the engines of the DB clusters supporting
+	// backtracking
+	backtrackEngines = []string{"aurora", "aurora-mysql"}
+)
+
+// ValidateBacktrackWindow returns an ACK terminal error when the supplied
+// backtrack window of a DB cluster with the supplied engine is not valid:
+// only Aurora MySQL DB clusters can be backtracked, and the backtrack window
+// is between 0, which disables backtracking, and BacktrackWindowMax seconds.
+func ValidateBacktrackWindow(engine string, window *int64) error {
+	if window == nil || *window == 0 {
+		return nil
+	}
+	if !slices.Contains(backtrackEngines, engine) {
+		return backtrackError(fmt.Sprintf("%s DB clusters cannot be backtracked", engine))
+	}
+	if *window < 0 || *window > BacktrackWindowMax {
+		return backtrackError(
+			fmt.Sprintf("backtrack window %d is not between 0 and %d seconds", *window, BacktrackWindowMax),
+		)
+	}
+	return nil
+}
+
+// BacktrackTime returns the time, in RFC 3339 format, a DB cluster with the
+// supplied backtrack window and earliest backtrack time is requested to be
+// backtracked to, or an ACK terminal error when backtracking is not enabled
+// or the time is not between the earliest backtrack time and now.
+func BacktrackTime(
+	value string,
+	window *int64,
+	earliest *time.Time,
+	now time.Time,
+) (time.Time, error) {
+	if window == nil || *window == 0 {
+		return time.Time{}, backtrackError("backtracking is not enabled")
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, backtrackError(fmt.Sprintf("%q is not an RFC 3339 timestamp", value))
+	}
+	if t.After(now) {
+		return time.Time{}, backtrackError(fmt.Sprintf("%s is in the future", value))
+	}
+	if earliest != nil && t.Before(*earliest) {
+		return time.Time{}, backtrackError(
+			fmt.Sprintf("%s is before the earliest backtrack time %s", value, earliest.UTC().Format(time.RFC3339)),
+		)
+	}
+	return t, nil
+}
+
+func backtrackError(msg string) error {
+	return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidBacktrack, msg))
+}
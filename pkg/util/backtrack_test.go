@@ -0,0 +1,87 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateBacktrackWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		engine  string
+		window  *int64
+		wantErr bool
+	}{
+		{"no window", "aurora-postgresql", nil, false},
+		{"disabled", "aurora-postgresql", aws.Int64(0), false},
+		{"aurora-mysql", "aurora-mysql", aws.Int64(86400), false},
+		{"maximum", "aurora-mysql", aws.Int64(util.BacktrackWindowMax), false},
+		{"above maximum", "aurora-mysql", aws.Int64(util.BacktrackWindowMax + 1), true},
+		{"negative", "aurora-mysql", aws.Int64(-1), true},
+		{"aurora-postgresql", "aurora-postgresql", aws.Int64(3600), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateBacktrackWindow(tt.engine, tt.window)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidBacktrack) {
+				t.Errorf("ValidateBacktrackWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBacktrackTime(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	earliest := now.Add(-time.Hour)
+	tests := []struct {
+		name     string
+		value    string
+		window   *int64
+		earliest *time.Time
+		want     time.Time
+		wantErr  bool
+	}{
+		{"valid", "2024-03-05T14:00:00Z", aws.Int64(3600), &earliest, now.Add(-30 * time.Minute), false},
+		{"offset", "2024-03-05T15:00:00+01:00", aws.Int64(3600), &earliest, now.Add(-30 * time.Minute), false},
+		{"no earliest time", "2024-03-05T12:00:00Z", aws.Int64(3600), nil, now.Add(-150 * time.Minute), false},
+		{"not enabled", "2024-03-05T14:00:00Z", aws.Int64(0), &earliest, time.Time{}, true},
+		{"not a timestamp", "yesterday", aws.Int64(3600), &earliest, time.Time{}, true},
+		{"future", "2024-03-05T15:00:00Z", aws.Int64(3600), &earliest, time.Time{}, true},
+		{"before earliest", "2024-03-05T13:00:00Z", aws.Int64(3600), &earliest, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.BacktrackTime(tt.value, tt.window, tt.earliest, now)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidBacktrack) {
+					t.Errorf("BacktrackTime() error = %v, want %v", err, util.ErrInvalidBacktrack)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BacktrackTime() unexpected error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("BacktrackTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update
+
+// The keys of the Service Binding Secret of a DB instance or DB cluster that
+// are not keys of its connection Secret. The other well-known entries of the
+// Service Binding specification, host, port, username and password, are the
+// same as the connection Secret keys.
+const (
+	ServiceBindingTypeKey     = "type"
+	ServiceBindingProviderKey = "provider"
+	ServiceBindingDatabaseKey = "database"
+)
+
+const (
+	// ServiceBindingProvider is the provider of the Service Binding Secrets
+	ServiceBindingProvider = "aws"
+	// ServiceBindingSecretSuffix is appended to the name of a DB instance or
+	// DB cluster to name its Service Binding Secret
+	ServiceBindingSecretSuffix = "-binding"
+)
+
+// ServiceBindingType returns the Service Binding type of a database with the
+// supplied engine, like postgresql for both the postgres and
+// aurora-postgresql engines. Engines without a well-known type are their own
+// type.
+func ServiceBindingType(engine string) string {
+	switch {
+	case strings.Contains(engine, "postgres"):
+		return "postgresql"
+	case strings.Contains(engine, "mysql"):
+		return "mysql"
+	case strings.Contains(engine, "sqlserver"):
+		return "sqlserver"
+	case strings.Contains(engine, "oracle"):
+		return "oracle"
+	case strings.Contains(engine, "db2"):
+		return "db2"
+	}
+	return engine
+}
+
+// ServiceBindingDetails returns the entries of the Service Binding Secret of
+// a database with the supplied engine and connection details, by Secret key.
+func ServiceBindingDetails(
+	engine string,
+	details map[string]string,
+) map[string]string {
+	binding := map[string]string{
+		ServiceBindingTypeKey:     ServiceBindingType(engine),
+		ServiceBindingProviderKey: ServiceBindingProvider,
+	}
+	for key, value := range details {
+		if key == ConnectionSecretDBNameKey {
+			key = ServiceBindingDatabaseKey
+		}
+		binding[key] = value
+	}
+	return binding
+}
+
+// NewServiceBindingSecret returns a Kubernetes Secret with the supplied
+// namespace, name and entries, owned by the supplied owner so that it is
+// deleted along with it.
+func NewServiceBindingSecret(
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	binding map[string]string,
+) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Data: secretData(binding),
+	}
+}
+
+// EnsureServiceBindingSecret creates the Service Binding Secret with the
+// supplied namespace, name and entries, or updates its entries when it
+// already exists. It returns an ACK terminal error when the Secret exists but
+// is not owned by the supplied owner, so that Secrets the controller does not
+// manage are left untouched.
+func EnsureServiceBindingSecret(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	binding map[string]string,
+) error {
+	if kubeClient == nil {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		return kubeClient.Create(ctx, NewServiceBindingSecret(owner, namespace, name, binding))
+	}
+	if err != nil {
+		return err
+	}
+	if !OwnedBy(secret, owner.UID) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: secret %s/%s already exists", ErrNotOwned, namespace, name,
+		))
+	}
+	data := secretData(binding)
+	if reflect.DeepEqual(secret.Data, data) {
+		return nil
+	}
+	secret.Data = data
+	return kubeClient.Update(ctx, secret)
+}
+
+// secretData returns the data of a Kubernetes Secret holding the supplied
+// entries
+func secretData(entries map[string]string) map[string][]byte {
+	data := make(map[string][]byte, len(entries))
+	for key, value := range entries {
+		data[key] = []byte(value)
+	}
+	return data
+}
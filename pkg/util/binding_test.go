@@ -0,0 +1,84 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestServiceBindingType(t *testing.T) {
+	tests := []struct {
+		engine string
+		want   string
+	}{
+		{"postgres", "postgresql"},
+		{"aurora-postgresql", "postgresql"},
+		{"mysql", "mysql"},
+		{"aurora-mysql", "mysql"},
+		{"sqlserver-ee", "sqlserver"},
+		{"custom-sqlserver-se", "sqlserver"},
+		{"oracle-ee-cdb", "oracle"},
+		{"db2-se", "db2"},
+		{"mariadb", "mariadb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.engine, func(t *testing.T) {
+			if got := util.ServiceBindingType(tt.engine); got != tt.want {
+				t.Errorf("ServiceBindingType(%q) = %q, want %q", tt.engine, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceBindingDetails(t *testing.T) {
+	details := map[string]string{
+		"host":     "db.abcdefghijkl.us-west-2.rds.amazonaws.com",
+		"port":     "5432",
+		"dbname":   "app",
+		"username": "admin",
+		"password": "s3cr3t",
+	}
+	want := map[string]string{
+		"type":     "postgresql",
+		"provider": "aws",
+		"host":     "db.abcdefghijkl.us-west-2.rds.amazonaws.com",
+		"port":     "5432",
+		"database": "app",
+		"username": "admin",
+		"password": "s3cr3t",
+	}
+	if got := util.ServiceBindingDetails("postgres", details); !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceBindingDetails() = %v, want %v", got, want)
+	}
+}
+
+func TestNewServiceBindingSecret(t *testing.T) {
+	owner := metav1.OwnerReference{Kind: "DBCluster", Name: "db", UID: types.UID("uid-1")}
+	got := util.NewServiceBindingSecret(owner, "default", "db-binding", map[string]string{"type": "mysql"})
+	if got.Namespace != "default" || got.Name != "db-binding" {
+		t.Errorf("NewServiceBindingSecret() = %s/%s, want default/db-binding", got.Namespace, got.Name)
+	}
+	if string(got.Data["type"]) != "mysql" {
+		t.Errorf("NewServiceBindingSecret() type = %q, want %q", got.Data["type"], "mysql")
+	}
+	if !util.OwnedBy(got, owner.UID) {
+		t.Errorf("NewServiceBindingSecret() is not owned by %q", owner.UID)
+	}
+}
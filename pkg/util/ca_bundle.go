@@ -0,0 +1,204 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;update
+
+const (
+	// CABundleKey is the key of the RDS certificate bundle in the ConfigMaps
+	// the controller maintains it in and in the connection Secrets
+	CABundleKey = "ca.crt"
+	// CABundleRefreshPeriod is how long the RDS certificate bundle of a region
+	// is cached before it is fetched again
+	CABundleRefreshPeriod = 24 * time.Hour
+
+	// caBundleFetchTimeout is how long fetching the RDS certificate bundle of
+	// a region can take
+	caBundleFetchTimeout = 30 * time.Second
+	// caBundleMaxSize is the largest RDS certificate bundle read
+	caBundleMaxSize = 1 << 20
+)
+
+var (
+	ErrInvalidCABundle = fmt.Errorf("invalid RDS certificate bundle")
+
+	caBundles          = map[string]cachedCABundle{}
+	caBundlesMu        sync.Mutex
+	caBundleHTTPClient = &http.Client{Timeout: caBundleFetchTimeout}
+)
+
+type cachedCABundle struct {
+	bundle    string
+	fetchedAt time.Time
+}
+
+// CABundleURL returns the URL of the RDS certificate bundle holding the root
+// and intermediate certificates of the supplied region.
+func CABundleURL(region string) string {
+	if strings.HasPrefix(region, "cn-") {
+		return fmt.Sprintf("https://rds-truststore.s3.%s.amazonaws.com.cn/%s/%s-bundle.pem", region, region, region)
+	}
+	return fmt.Sprintf("https://truststore.pki.rds.amazonaws.com/%s/%s-bundle.pem", region, region)
+}
+
+// ValidateCABundle returns an error when the supplied RDS certificate bundle
+// does not hold only PEM encoded certificates.
+func ValidateCABundle(bundle string) error {
+	rest := []byte(bundle)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("%w: unexpected %s PEM block", ErrInvalidCABundle, block.Type)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("%w: no certificate", ErrInvalidCABundle)
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return fmt.Errorf("%w: trailing data", ErrInvalidCABundle)
+	}
+	return nil
+}
+
+// CABundle returns the RDS certificate bundle of the supplied region. The
+// bundle is fetched from CABundleURL and cached for CABundleRefreshPeriod.
+// The cached bundle is returned when it cannot be fetched again.
+func CABundle(ctx context.Context, region string) (string, error) {
+	caBundlesMu.Lock()
+	cached, ok := caBundles[region]
+	caBundlesMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < CABundleRefreshPeriod {
+		return cached.bundle, nil
+	}
+	bundle, err := fetchCABundle(ctx, CABundleURL(region))
+	if err != nil {
+		if ok {
+			return cached.bundle, nil
+		}
+		return "", err
+	}
+	caBundlesMu.Lock()
+	caBundles[region] = cachedCABundle{bundle: bundle, fetchedAt: time.Now()}
+	caBundlesMu.Unlock()
+	return bundle, nil
+}
+
+func fetchCABundle(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := caBundleHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, caBundleMaxSize))
+	if err != nil {
+		return "", err
+	}
+	bundle := string(body)
+	if err = ValidateCABundle(bundle); err != nil {
+		return "", err
+	}
+	return bundle, nil
+}
+
+// EnsureCABundleConfigMap creates the ConfigMap with the supplied namespace
+// and name holding the supplied RDS certificate bundle, or updates the bundle
+// when the ConfigMap already exists. Several DB instances and DB clusters can
+// share the ConfigMap, each of them owning it, so that it is deleted along
+// with the last of them. It returns an ACK terminal error when the ConfigMap
+// exists but is not owned by any DB instance or DB cluster, so that
+// ConfigMaps the controller does not manage are left untouched.
+func EnsureCABundleConfigMap(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	bundle string,
+) error {
+	if kubeClient == nil {
+		return nil
+	}
+	configMap := &corev1.ConfigMap{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, configMap)
+	if apierrors.IsNotFound(err) {
+		return kubeClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       namespace,
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+			Data: map[string]string{CABundleKey: bundle},
+		})
+	}
+	if err != nil {
+		return err
+	}
+	owned := OwnedBy(configMap, owner.UID)
+	if !owned && !ownedByAPIVersion(configMap, owner.APIVersion) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: configmap %s/%s already exists", ErrNotOwned, namespace, name,
+		))
+	}
+	if owned && configMap.Data[CABundleKey] == bundle {
+		return nil
+	}
+	if !owned {
+		configMap.OwnerReferences = append(configMap.OwnerReferences, owner)
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[CABundleKey] = bundle
+	return kubeClient.Update(ctx, configMap)
+}
+
+// ownedByAPIVersion returns true if the supplied Kubernetes object is owned by
+// an object of the supplied API version.
+func ownedByAPIVersion(obj metav1.Object, apiVersion string) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.APIVersion == apiVersion {
+			return true
+		}
+	}
+	return false
+}
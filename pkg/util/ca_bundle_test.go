@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestCABundleURL(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{"us-west-2", "https://truststore.pki.rds.amazonaws.com/us-west-2/us-west-2-bundle.pem"},
+		{"us-gov-west-1", "https://truststore.pki.rds.amazonaws.com/us-gov-west-1/us-gov-west-1-bundle.pem"},
+		{"cn-north-1", "https://rds-truststore.s3.cn-north-1.amazonaws.com.cn/cn-north-1/cn-north-1-bundle.pem"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			if got := util.CABundleURL(tt.region); got != tt.want {
+				t.Errorf("CABundleURL(%q) = %q, want %q", tt.region, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCABundle(t *testing.T) {
+	cert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("certificate")}))
+	key := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}))
+	tests := []struct {
+		name    string
+		bundle  string
+		wantErr bool
+	}{
+		{"one certificate", cert, false},
+		{"several certificates", cert + cert + "\n", false},
+		{"empty", "", true},
+		{"not PEM", "<html></html>", true},
+		{"private key", cert + key, true},
+		{"trailing data", cert + "<html></html>", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateCABundle(tt.bundle)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidCABundle) {
+					t.Errorf("ValidateCABundle() error = %v, want %v", err, util.ErrInvalidCABundle)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateCABundle() unexpected error = %v", err)
+			}
+		})
+	}
+}
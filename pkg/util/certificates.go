@@ -0,0 +1,72 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+const (
+	// CACertificateExpiryWarningPeriod is how long before the CA certificate
+	// of a DB instance expires the CACertificateExpiring condition is set
+	CACertificateExpiryWarningPeriod = 90 * 24 * time.Hour
+
+	// CACertificateRotationRestart is the value of the rotate-ca-certificate
+	// annotation rotating the CA certificate of a DB instance and restarting
+	// it, so that the new CA certificate is used right away
+	CACertificateRotationRestart = "true"
+	// CACertificateRotationNoRestart is the value of the
+	// rotate-ca-certificate annotation rotating the CA certificate of a DB
+	// instance without restarting it, the new CA certificate being used from
+	// the next restart on
+	CACertificateRotationNoRestart = "no-restart"
+)
+
+var (
+	ErrInvalidCACertificateRotation = fmt.Errorf("invalid CA certificate rotation")
+)
+
+// CACertificateRotationRestartRequested returns whether the DB instance is
+// restarted when its CA certificate is rotated as requested by the supplied
+// value of the rotate-ca-certificate annotation, or an ACK terminal error when
+// the value is not valid.
+func CACertificateRotationRestartRequested(value string) (bool, error) {
+	switch value {
+	case CACertificateRotationRestart:
+		return true, nil
+	case CACertificateRotationNoRestart:
+		return false, nil
+	}
+	return false, ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %q must be either %q or %q", ErrInvalidCACertificateRotation,
+		value, CACertificateRotationRestart, CACertificateRotationNoRestart,
+	))
+}
+
+// CACertificateExpiry returns the reason of the CACertificateExpiring
+// condition of a DB instance whose CA certificate is valid until the supplied
+// time, or an empty string when the CA certificate does not expire within
+// CACertificateExpiryWarningPeriod.
+func CACertificateExpiry(validTill time.Time, now time.Time) string {
+	switch {
+	case !validTill.After(now):
+		return ReasonCACertificateExpired
+	case validTill.Before(now.Add(CACertificateExpiryWarningPeriod)):
+		return ReasonCACertificateExpiring
+	}
+	return ""
+}
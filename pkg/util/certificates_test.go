@@ -0,0 +1,74 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestCACertificateRotationRestartRequested(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{"restart", "true", true, false},
+		{"no restart", "no-restart", false, false},
+		{"empty", "", false, true},
+		{"invalid", "false", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.CACertificateRotationRestartRequested(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidCACertificateRotation) {
+					t.Errorf("CACertificateRotationRestartRequested() error = %v, want %v", err, util.ErrInvalidCACertificateRotation)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CACertificateRotationRestartRequested() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CACertificateRotationRestartRequested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCACertificateExpiry(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		validTill time.Time
+		want      string
+	}{
+		{"valid", now.AddDate(1, 0, 0), ""},
+		{"expiring", now.AddDate(0, 2, 0), util.ReasonCACertificateExpiring},
+		{"expired", now.AddDate(0, 0, -1), util.ReasonCACertificateExpired},
+		{"expiring now", now, util.ReasonCACertificateExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.CACertificateExpiry(tt.validTill, now); got != tt.want {
+				t.Errorf("CACertificateExpiry() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"slices"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+var (
+	ErrInvalidClusterEndpoint = fmt.Errorf("invalid DB cluster endpoint")
+
+	// customEndpointTypes are the types of the custom endpoints of a DB
+	// cluster. Writer endpoints are managed by RDS.
+	customEndpointTypes = []string{"READER", "ANY"}
+)
+
+// ValidateCustomEndpoint returns an ACK terminal error when a custom endpoint
+// with the supplied type, static members and excluded members is not valid:
+// custom endpoints are READER or ANY endpoints, and their members are either
+// a list of static members or every eligible DB instance but the excluded
+// members.
+func ValidateCustomEndpoint(
+	endpointType string,
+	staticMembers []*string,
+	excludedMembers []*string,
+) error {
+	if !slices.Contains(customEndpointTypes, endpointType) {
+		return clusterEndpointError(
+			fmt.Sprintf("endpoint type %q is not one of %v", endpointType, customEndpointTypes),
+		)
+	}
+	if len(staticMembers) > 0 && len(excludedMembers) > 0 {
+		return clusterEndpointError("static members and excluded members cannot both be set")
+	}
+	return nil
+}
+
+func clusterEndpointError(msg string) error {
+	return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidClusterEndpoint, msg))
+}
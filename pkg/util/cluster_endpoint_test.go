@@ -0,0 +1,51 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateCustomEndpoint(t *testing.T) {
+	instances := aws.StringSlice([]string{"instance-1", "instance-2"})
+	tests := []struct {
+		name            string
+		endpointType    string
+		staticMembers   []*string
+		excludedMembers []*string
+		wantErr         bool
+	}{
+		{"reader", "READER", nil, nil, false},
+		{"any", "ANY", nil, nil, false},
+		{"static members", "READER", instances, nil, false},
+		{"excluded members", "ANY", nil, instances, false},
+		{"writer", "WRITER", nil, nil, true},
+		{"lowercase", "reader", nil, nil, true},
+		{"empty", "", nil, nil, true},
+		{"static and excluded members", "READER", instances[:1], instances[1:], true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateCustomEndpoint(tt.endpointType, tt.staticMembers, tt.excludedMembers)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidClusterEndpoint) {
+				t.Errorf("ValidateCustomEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
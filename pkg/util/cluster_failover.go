@@ -0,0 +1,105 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidClusterFailoverTarget = fmt.Errorf("invalid DB cluster failover target")
+)
+
+// ClusterFailoverTarget returns the identifier of the reader DB instance
+// among the supplied members of a DB cluster that the supplied target, a DB
+// instance identifier, names, or an empty string when the target is "true"
+// so that Amazon RDS picks the reader DB instance. It returns an ACK terminal
+// error listing the reader DB instances when the target names none of them.
+func ClusterFailoverTarget(
+	members []*svcapitypes.DBClusterMember,
+	target string,
+) (string, error) {
+	readers := make([]string, 0, len(members))
+	for _, member := range members {
+		identifier := aws.StringValue(member.DBInstanceIdentifier)
+		if identifier == "" {
+			continue
+		}
+		if identifier == target {
+			if aws.BoolValue(member.IsClusterWriter) {
+				return "", ackerr.NewTerminalError(fmt.Errorf(
+					"%w %q: already the writer DB instance", ErrInvalidClusterFailoverTarget, target,
+				))
+			}
+			return identifier, nil
+		}
+		if !aws.BoolValue(member.IsClusterWriter) {
+			readers = append(readers, identifier)
+		}
+	}
+	if len(readers) == 0 {
+		return "", ackerr.NewTerminalError(fmt.Errorf(
+			"%w %q: the DB cluster has no reader DB instances", ErrInvalidClusterFailoverTarget, target,
+		))
+	}
+	if target == "true" {
+		return "", nil
+	}
+	return "", ackerr.NewTerminalError(fmt.Errorf(
+		"%w %q: reader DB instances are %s",
+		ErrInvalidClusterFailoverTarget, target, strings.Join(readers, ", "),
+	))
+}
+
+// ClusterWriter returns the identifier of the writer DB instance among the
+// supplied members of a DB cluster, or an empty string.
+func ClusterWriter(members []*svcapitypes.DBClusterMember) string {
+	for _, member := range members {
+		if aws.BoolValue(member.IsClusterWriter) {
+			return aws.StringValue(member.DBInstanceIdentifier)
+		}
+	}
+	return ""
+}
+
+// DBInstanceIdentifier returns the DB instance identifier of the DBInstance
+// with the supplied namespace and name, or an empty string when there is no
+// such DBInstance.
+func DBInstanceIdentifier(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (string, error) {
+	if kubeClient == nil {
+		return "", nil
+	}
+	instance := &svcapitypes.DBInstance{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, instance)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(instance.Spec.DBInstanceIdentifier), nil
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestClusterFailoverTarget(t *testing.T) {
+	members := []*svcapitypes.DBClusterMember{
+		{DBInstanceIdentifier: aws.String("db-1"), IsClusterWriter: aws.Bool(true)},
+		{DBInstanceIdentifier: aws.String("db-2"), IsClusterWriter: aws.Bool(false)},
+		{DBInstanceIdentifier: aws.String("db-3"), IsClusterWriter: aws.Bool(false)},
+	}
+	writerOnly := members[:1]
+	tests := []struct {
+		name    string
+		members []*svcapitypes.DBClusterMember
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{"any reader", members, "true", "", false},
+		{"reader", members, "db-3", "db-3", false},
+		{"writer", members, "db-1", "", true},
+		{"unknown", members, "db-4", "", true},
+		{"no reader", writerOnly, "true", "", true},
+		{"no reader for target", writerOnly, "db-2", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.ClusterFailoverTarget(tt.members, tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClusterFailoverTarget() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, util.ErrInvalidClusterFailoverTarget) {
+				t.Errorf("ClusterFailoverTarget() error = %v, want ErrInvalidClusterFailoverTarget", err)
+			}
+			if got != tt.want {
+				t.Errorf("ClusterFailoverTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterWriter(t *testing.T) {
+	members := []*svcapitypes.DBClusterMember{
+		{DBInstanceIdentifier: aws.String("db-1"), IsClusterWriter: aws.Bool(false)},
+		{DBInstanceIdentifier: aws.String("db-2"), IsClusterWriter: aws.Bool(true)},
+	}
+	if got := util.ClusterWriter(members); got != "db-2" {
+		t.Errorf("ClusterWriter() = %q, want %q", got, "db-2")
+	}
+	if got := util.ClusterWriter(nil); got != "" {
+		t.Errorf("ClusterWriter() = %q, want empty", got)
+	}
+}
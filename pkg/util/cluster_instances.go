@@ -0,0 +1,297 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidClusterInstances = fmt.Errorf("invalid cluster instances")
+)
+
+// ValidateClusterInstances returns an ACK terminal error when the supplied
+// description of the DB instances of a DB cluster has no DB instance class
+// or a negative count.
+func ValidateClusterInstances(instances *svcapitypes.DBClusterInstances) error {
+	if instances == nil {
+		return nil
+	}
+	if instances.Count == nil || *instances.Count < 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: count must be zero or more", ErrInvalidClusterInstances,
+		))
+	}
+	if instances.DBInstanceClass == nil || *instances.DBInstanceClass == "" {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: dbInstanceClass must be set", ErrInvalidClusterInstances,
+		))
+	}
+	return nil
+}
+
+// ClusterInstanceName returns the name of the DBInstance, or the identifier
+// of the DB instance, at the supplied index among the DB instances of the DB
+// cluster with the supplied name or identifier.
+func ClusterInstanceName(clusterName string, index int) string {
+	return fmt.Sprintf("%s-%d", clusterName, index+1)
+}
+
+// NewClusterInstance returns the DBInstance at the supplied index among the
+// DB instances of the supplied DB cluster, owned by the supplied owner so
+// that it is deleted along with it. Its DB instance inherits the engine of the
+// DB cluster, and its ackv1alpha1.AnnotationDeletionPolicy annotation so that
+// it is retained along with a retained DB cluster, and gets its promotion
+// tier and Availability Zone from the index.
+func NewClusterInstance(
+	owner metav1.OwnerReference,
+	cluster *svcapitypes.DBCluster,
+	index int,
+) *svcapitypes.DBInstance {
+	instances := cluster.Spec.Instances
+	identifier := ClusterInstanceName(*cluster.Spec.DBClusterIdentifier, index)
+	instance := &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       cluster.Namespace,
+			Name:            ClusterInstanceName(cluster.Name, index),
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: svcapitypes.DBInstanceSpec{
+			DBClusterIdentifier:  cluster.Spec.DBClusterIdentifier,
+			DBInstanceClass:      instances.DBInstanceClass,
+			DBInstanceIdentifier: &identifier,
+			Engine:               cluster.Spec.Engine,
+		},
+	}
+	if policy, ok := cluster.Annotations[ackv1alpha1.AnnotationDeletionPolicy]; ok {
+		instance.Annotations = map[string]string{
+			ackv1alpha1.AnnotationDeletionPolicy: policy,
+		}
+	}
+	instance.Spec.PromotionTier = clusterInstancePromotionTier(instances, index)
+	if len(instances.AvailabilityZones) > 0 {
+		instance.Spec.AvailabilityZone = instances.AvailabilityZones[index%len(instances.AvailabilityZones)]
+	}
+	return instance
+}
+
+// clusterInstancePromotionTier returns the promotion tier of the DB instance
+// at the supplied index, or nil for the default one.
+func clusterInstancePromotionTier(
+	instances *svcapitypes.DBClusterInstances,
+	index int,
+) *int64 {
+	if index < len(instances.PromotionTiers) {
+		return instances.PromotionTiers[index]
+	}
+	return nil
+}
+
+// EnsureClusterInstances creates the DBInstances described by Spec.Instances
+// of the supplied DB cluster that do not exist, updates the DB instance class
+// and promotion tier of the existing ones, and deletes the DBInstances owned
+// by the supplied owner past the desired count. It returns an ACK terminal
+// error when one of the DBInstances exists but is not owned by the supplied
+// owner, so that DBInstances the controller does not manage are left
+// untouched.
+func EnsureClusterInstances(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	cluster *svcapitypes.DBCluster,
+) error {
+	if kubeClient == nil || cluster.Spec.Instances == nil {
+		return nil
+	}
+	if err := ValidateClusterInstances(cluster.Spec.Instances); err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for i := 0; i < int(*cluster.Spec.Instances.Count); i++ {
+		want := NewClusterInstance(owner, cluster, i)
+		desired[want.Name] = true
+		if err := ensureClusterInstance(ctx, owner, want); err != nil {
+			return err
+		}
+	}
+	owned, err := listClusterInstances(ctx, owner, cluster.Namespace)
+	if err != nil {
+		return err
+	}
+	for i := range owned {
+		if desired[owned[i].Name] || owned[i].DeletionTimestamp != nil {
+			continue
+		}
+		if err := kubeClient.Delete(ctx, &owned[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClusterInstancesInSync returns true if the DBInstances described by
+// Spec.Instances of the supplied DB cluster all exist, are owned by the
+// supplied owner and have the desired DB instance class, promotion tier and
+// deletion policy annotation, and the supplied owner owns no other DBInstance
+// that is not being deleted. Unlike EnsureClusterInstances, it leaves the
+// DBInstances untouched.
+func ClusterInstancesInSync(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	cluster *svcapitypes.DBCluster,
+) (bool, error) {
+	if kubeClient == nil || cluster.Spec.Instances == nil {
+		return true, nil
+	}
+	if err := ValidateClusterInstances(cluster.Spec.Instances); err != nil {
+		return false, nil
+	}
+	desired := map[string]bool{}
+	for i := 0; i < int(*cluster.Spec.Instances.Count); i++ {
+		want := NewClusterInstance(owner, cluster, i)
+		desired[want.Name] = true
+		instance := &svcapitypes.DBInstance{}
+		err := kubeClient.Get(ctx, types.NamespacedName{Namespace: want.Namespace, Name: want.Name}, instance)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !OwnedBy(instance, owner.UID) || !clusterInstanceInSync(instance, want) {
+			return false, nil
+		}
+	}
+	owned, err := listClusterInstances(ctx, owner, cluster.Namespace)
+	if err != nil {
+		return false, err
+	}
+	for i := range owned {
+		if !desired[owned[i].Name] && owned[i].DeletionTimestamp == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// clusterInstanceInSync returns true if the supplied DBInstance has the DB
+// instance class, promotion tier and deletion policy annotation of the
+// supplied desired one.
+func clusterInstanceInSync(instance *svcapitypes.DBInstance, want *svcapitypes.DBInstance) bool {
+	policy, hasPolicy := want.Annotations[ackv1alpha1.AnnotationDeletionPolicy]
+	current, hasCurrent := instance.Annotations[ackv1alpha1.AnnotationDeletionPolicy]
+	return equalStrings(instance.Spec.DBInstanceClass, want.Spec.DBInstanceClass) &&
+		equalInt64s(instance.Spec.PromotionTier, want.Spec.PromotionTier) &&
+		hasPolicy == hasCurrent && policy == current
+}
+
+// ensureClusterInstance creates the supplied DBInstance, or updates the DB
+// instance class, promotion tier and deletion policy annotation of the
+// existing one.
+func ensureClusterInstance(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	want *svcapitypes.DBInstance,
+) error {
+	instance := &svcapitypes.DBInstance{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: want.Namespace, Name: want.Name}, instance)
+	if apierrors.IsNotFound(err) {
+		return kubeClient.Create(ctx, want)
+	}
+	if err != nil {
+		return err
+	}
+	if !OwnedBy(instance, owner.UID) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: DBInstance %s/%s already exists", ErrNotOwned, want.Namespace, want.Name,
+		))
+	}
+	if clusterInstanceInSync(instance, want) {
+		return nil
+	}
+	instance.Spec.DBInstanceClass = want.Spec.DBInstanceClass
+	instance.Spec.PromotionTier = want.Spec.PromotionTier
+	if policy, ok := want.Annotations[ackv1alpha1.AnnotationDeletionPolicy]; ok {
+		if instance.Annotations == nil {
+			instance.Annotations = map[string]string{}
+		}
+		instance.Annotations[ackv1alpha1.AnnotationDeletionPolicy] = policy
+	} else {
+		delete(instance.Annotations, ackv1alpha1.AnnotationDeletionPolicy)
+	}
+	return kubeClient.Update(ctx, instance)
+}
+
+// DeleteClusterInstances deletes the DBInstances owned by the supplied owner
+// in the supplied namespace. It returns the number of those DBInstances that
+// still exist, including the ones being deleted.
+func DeleteClusterInstances(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+) (int, error) {
+	if kubeClient == nil {
+		return 0, nil
+	}
+	owned, err := listClusterInstances(ctx, owner, namespace)
+	if err != nil {
+		return 0, err
+	}
+	for i := range owned {
+		if owned[i].DeletionTimestamp != nil {
+			continue
+		}
+		if err := kubeClient.Delete(ctx, &owned[i]); client.IgnoreNotFound(err) != nil {
+			return 0, err
+		}
+	}
+	return len(owned), nil
+}
+
+// listClusterInstances returns the DBInstances owned by the supplied owner in
+// the supplied namespace.
+func listClusterInstances(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+) ([]svcapitypes.DBInstance, error) {
+	list := &svcapitypes.DBInstanceList{}
+	if err := kubeClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	owned := []svcapitypes.DBInstance{}
+	for _, instance := range list.Items {
+		if OwnedBy(&instance, owner.UID) {
+			owned = append(owned, instance)
+		}
+	}
+	return owned, nil
+}
+
+// equalInt64s returns true if the supplied integers are both nil or equal
+func equalInt64s(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,174 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateClusterInstances(t *testing.T) {
+	tests := []struct {
+		name      string
+		instances *svcapitypes.DBClusterInstances
+		wantErr   bool
+	}{
+		{"unset", nil, false},
+		{"valid", &svcapitypes.DBClusterInstances{Count: aws.Int64(2), DBInstanceClass: aws.String("db.r6g.large")}, false},
+		{"no instances", &svcapitypes.DBClusterInstances{Count: aws.Int64(0), DBInstanceClass: aws.String("db.r6g.large")}, false},
+		{"no count", &svcapitypes.DBClusterInstances{DBInstanceClass: aws.String("db.r6g.large")}, true},
+		{"negative count", &svcapitypes.DBClusterInstances{Count: aws.Int64(-1), DBInstanceClass: aws.String("db.r6g.large")}, true},
+		{"no instance class", &svcapitypes.DBClusterInstances{Count: aws.Int64(2)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateClusterInstances(tt.instances)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateClusterInstances() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, util.ErrInvalidClusterInstances) {
+				t.Errorf("ValidateClusterInstances() error = %v, want ErrInvalidClusterInstances", err)
+			}
+		})
+	}
+}
+
+func TestNewClusterInstance(t *testing.T) {
+	owner := metav1.OwnerReference{Kind: "DBCluster", Name: "cluster", UID: types.UID("uid-1")}
+	cluster := &svcapitypes.DBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "cluster",
+			Annotations: map[string]string{ackv1alpha1.AnnotationDeletionPolicy: "retain"},
+		},
+		Spec: svcapitypes.DBClusterSpec{
+			DBClusterIdentifier: aws.String("my-cluster"),
+			Engine:              aws.String("aurora-postgresql"),
+			Instances: &svcapitypes.DBClusterInstances{
+				Count:             aws.Int64(3),
+				DBInstanceClass:   aws.String("db.r6g.large"),
+				PromotionTiers:    []*int64{aws.Int64(0), aws.Int64(1)},
+				AvailabilityZones: []*string{aws.String("us-west-2a"), aws.String("us-west-2b")},
+			},
+		},
+	}
+	tests := []struct {
+		index      int
+		name       string
+		identifier string
+		tier       *int64
+		zone       string
+	}{
+		{0, "cluster-1", "my-cluster-1", aws.Int64(0), "us-west-2a"},
+		{1, "cluster-2", "my-cluster-2", aws.Int64(1), "us-west-2b"},
+		{2, "cluster-3", "my-cluster-3", nil, "us-west-2a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.NewClusterInstance(owner, cluster, tt.index)
+			if got.Namespace != "default" || got.Name != tt.name {
+				t.Errorf("NewClusterInstance() = %s/%s, want default/%s", got.Namespace, got.Name, tt.name)
+			}
+			if !util.OwnedBy(got, owner.UID) {
+				t.Errorf("NewClusterInstance() is not owned by %q", owner.UID)
+			}
+			if aws.StringValue(got.Spec.DBInstanceIdentifier) != tt.identifier {
+				t.Errorf("NewClusterInstance() identifier = %q, want %q", aws.StringValue(got.Spec.DBInstanceIdentifier), tt.identifier)
+			}
+			if aws.StringValue(got.Spec.DBClusterIdentifier) != "my-cluster" ||
+				aws.StringValue(got.Spec.Engine) != "aurora-postgresql" ||
+				aws.StringValue(got.Spec.DBInstanceClass) != "db.r6g.large" {
+				t.Errorf("NewClusterInstance() spec = %+v, want the cluster, engine and class of the DB cluster", got.Spec)
+			}
+			if got.Annotations[ackv1alpha1.AnnotationDeletionPolicy] != "retain" {
+				t.Errorf("NewClusterInstance() annotations = %v, want the deletion policy of the DB cluster", got.Annotations)
+			}
+			if (got.Spec.PromotionTier == nil) != (tt.tier == nil) ||
+				(tt.tier != nil && *got.Spec.PromotionTier != *tt.tier) {
+				t.Errorf("NewClusterInstance() promotion tier = %v, want %v", got.Spec.PromotionTier, tt.tier)
+			}
+			if aws.StringValue(got.Spec.AvailabilityZone) != tt.zone {
+				t.Errorf("NewClusterInstance() availability zone = %q, want %q", aws.StringValue(got.Spec.AvailabilityZone), tt.zone)
+			}
+		})
+	}
+}
+
+func TestClusterInstancesInSync(t *testing.T) {
+	owner := metav1.OwnerReference{Kind: "DBCluster", Name: "cluster", UID: types.UID("uid-1")}
+	cluster := &svcapitypes.DBCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"},
+		Spec: svcapitypes.DBClusterSpec{
+			DBClusterIdentifier: aws.String("my-cluster"),
+			Instances: &svcapitypes.DBClusterInstances{
+				Count:           aws.Int64(1),
+				DBInstanceClass: aws.String("db.r6g.large"),
+			},
+		},
+	}
+	member := func(name string, class string) client.Object {
+		instance := util.NewClusterInstance(owner, cluster, 0)
+		instance.Name = name
+		instance.Spec.DBInstanceClass = aws.String(class)
+		return instance
+	}
+	tests := []struct {
+		name    string
+		objects []client.Object
+		want    bool
+	}{
+		{"in sync", []client.Object{member("cluster-1", "db.r6g.large")}, true},
+		{"missing", nil, false},
+		{"other class", []client.Object{member("cluster-1", "db.r6g.xlarge")}, false},
+		{"past the count", []client.Object{member("cluster-1", "db.r6g.large"), member("cluster-2", "db.r6g.large")}, false},
+	}
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+			util.SetKubeClient(kc)
+			defer util.SetKubeClient(nil)
+
+			got, err := util.ClusterInstancesInSync(context.TODO(), owner, cluster)
+			if err != nil {
+				t.Fatalf("ClusterInstancesInSync() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ClusterInstancesInSync() = %v, want %v", got, tt.want)
+			}
+			list := &svcapitypes.DBInstanceList{}
+			if err := kc.List(context.TODO(), list); err != nil {
+				t.Fatal(err)
+			}
+			if len(list.Items) != len(tt.objects) {
+				t.Errorf("ClusterInstancesInSync() changed the DBInstances")
+			}
+		})
+	}
+}
@@ -0,0 +1,551 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionTypeParametersDrifted is the type of the condition set on
+	// parameter groups with the "Alert" drift policy whose parameters were
+	// modified outside of the controller
+	ConditionTypeParametersDrifted ackv1alpha1.ConditionType = "Drifted"
+	// ConditionTypeSwitchover is the type of the condition set on DB instances
+	// and global clusters reporting the progress of the switchover the
+	// controller issued because of the switchover-read-replica or
+	// switchover-global-cluster annotations
+	ConditionTypeSwitchover ackv1alpha1.ConditionType = "Switchover"
+	// ConditionTypeFailover is the type of the condition set on global
+	// clusters and DB clusters reporting the progress of the failover the
+	// controller issued because of the failover-global-cluster or
+	// failover-db-cluster annotations
+	ConditionTypeFailover ackv1alpha1.ConditionType = "Failover"
+	// ConditionTypeMultiAZConversion is the type of the condition set on DB
+	// instances reporting the progress of their conversion to or from a
+	// Multi-AZ deployment
+	ConditionTypeMultiAZConversion ackv1alpha1.ConditionType = "MultiAZConversion"
+	// ConditionTypeDeletionBlocked is the type of the condition set on DB
+	// instances and DB clusters whose deletion is blocked by their deletion
+	// protection
+	ConditionTypeDeletionBlocked ackv1alpha1.ConditionType = "DeletionBlocked"
+	// ConditionTypeSafetySnapshot is the type of the condition set on DB
+	// instances and DB clusters reporting the progress of the safety snapshot
+	// taken before a modification that is hard to roll back
+	ConditionTypeSafetySnapshot ackv1alpha1.ConditionType = "SafetySnapshot"
+	// ConditionTypeMajorVersionUpgrade is the type of the condition set on
+	// DB instances reporting the progress of the upgrade of their major
+	// engine version
+	ConditionTypeMajorVersionUpgrade ackv1alpha1.ConditionType = "MajorVersionUpgrade"
+	// ConditionTypeCACertificateExpiring is the type of the condition set on
+	// DB instances whose CA certificate expires soon or has expired
+	ConditionTypeCACertificateExpiring ackv1alpha1.ConditionType = "CACertificateExpiring"
+	// ConditionTypePublicAccess is the type of the condition set on DB
+	// instances reporting the progress of the change of their public
+	// accessibility
+	ConditionTypePublicAccess ackv1alpha1.ConditionType = "PublicAccess"
+	// ConditionTypePortChange is the type of the condition set on DB
+	// instances reporting the progress of the change of their port
+	ConditionTypePortChange ackv1alpha1.ConditionType = "PortChange"
+	// ConditionTypeBacktrack is the type of the condition set on DB clusters
+	// reporting the progress of the backtrack the controller issued because
+	// of the backtrack-to annotation
+	ConditionTypeBacktrack ackv1alpha1.ConditionType = "Backtrack"
+	// ConditionTypeRestoreVerified is the type of the condition set on
+	// restore verifications reporting the outcome of their last verification
+	ConditionTypeRestoreVerified ackv1alpha1.ConditionType = "RestoreVerified"
+
+	// DriftPolicyCorrect makes the controller overwrite parameters modified
+	// outside of the controller with their desired values. This is the
+	// default drift policy.
+	DriftPolicyCorrect = "Correct"
+	// DriftPolicyIgnore makes the controller leave parameters modified
+	// outside of the controller untouched until the desired parameters change
+	DriftPolicyIgnore = "Ignore"
+	// DriftPolicyAlert is like DriftPolicyIgnore, but the controller also
+	// reports the parameters that were modified outside of the controller
+	DriftPolicyAlert = "Alert"
+
+	// ReasonParameterConflict is the reason of the ACK.Advisory condition
+	// set on parameter groups holding values that conflict with the values of
+	// their counterpart parameter group
+	ReasonParameterConflict = "ParameterConflict"
+
+	// ReasonSwitchoverInProgress is the reason of the Switchover condition
+	// while the read replica is being switched over to the primary
+	ReasonSwitchoverInProgress = "InProgress"
+	// ReasonSwitchoverCompleted is the reason of the Switchover condition once
+	// the read replica became the primary
+	ReasonSwitchoverCompleted = "Completed"
+	// ReasonSwitchoverFailed is the reason of the Switchover condition when
+	// the switchover could not be issued
+	ReasonSwitchoverFailed = "Failed"
+
+	// ReasonFailoverInProgress is the reason of the Failover condition while
+	// the global cluster or DB cluster is being failed over
+	ReasonFailoverInProgress = "InProgress"
+	// ReasonFailoverCompleted is the reason of the Failover condition once the
+	// target DB cluster became the primary cluster, or the target DB instance
+	// the writer DB instance
+	ReasonFailoverCompleted = "Completed"
+	// ReasonFailoverFailed is the reason of the Failover condition when the
+	// failover was cancelled or did not promote its target
+	ReasonFailoverFailed = "Failed"
+
+	// ReasonMultiAZConversionPending is the reason of the MultiAZConversion
+	// condition while the conversion is deferred to the maintenance window
+	ReasonMultiAZConversionPending = "PendingMaintenanceWindow"
+	// ReasonMultiAZConversionInProgress is the reason of the
+	// MultiAZConversion condition while the DB instance is being converted
+	ReasonMultiAZConversionInProgress = "InProgress"
+	// ReasonMultiAZConversionCompleted is the reason of the MultiAZConversion
+	// condition once the DB instance was converted
+	ReasonMultiAZConversionCompleted = "Completed"
+
+	// ReasonDeletionProtection is the reason of the DeletionBlocked condition
+	// and Events when the deletion protection of the DB instance or DB
+	// cluster is enabled
+	ReasonDeletionProtection = "DeletionProtection"
+
+	// ReasonSafetySnapshotInProgress is the reason of the SafetySnapshot
+	// condition while the safety snapshot is being taken and the modification
+	// waits for it
+	ReasonSafetySnapshotInProgress = "InProgress"
+	// ReasonSafetySnapshotCompleted is the reason of the SafetySnapshot
+	// condition once the modification was issued after the safety snapshot
+	ReasonSafetySnapshotCompleted = "Completed"
+
+	// ReasonMajorVersionUpgradePending is the reason of the
+	// MajorVersionUpgrade condition while the upgrade is deferred to the
+	// maintenance window
+	ReasonMajorVersionUpgradePending = "PendingMaintenanceWindow"
+	// ReasonMajorVersionUpgradeInProgress is the reason of the
+	// MajorVersionUpgrade condition while the DB instance is being upgraded
+	ReasonMajorVersionUpgradeInProgress = "InProgress"
+	// ReasonMajorVersionUpgradeCompleted is the reason of the
+	// MajorVersionUpgrade condition once the DB instance was upgraded
+	ReasonMajorVersionUpgradeCompleted = "Completed"
+	// ReasonMajorVersionUpgradeFailed is the reason of the
+	// MajorVersionUpgrade condition when the DB instance is available again
+	// without having been upgraded
+	ReasonMajorVersionUpgradeFailed = "Failed"
+
+	// ReasonCACertificateExpiring is the reason of the CACertificateExpiring
+	// condition when the CA certificate expires within
+	// CACertificateExpiryWarningPeriod
+	ReasonCACertificateExpiring = "Expiring"
+	// ReasonCACertificateExpired is the reason of the CACertificateExpiring
+	// condition when the CA certificate has expired
+	ReasonCACertificateExpired = "Expired"
+
+	// ReasonPublicAccessInProgress is the reason of the PublicAccess condition
+	// while the public accessibility of the DB instance is being changed
+	ReasonPublicAccessInProgress = "InProgress"
+	// ReasonPublicAccessCompleted is the reason of the PublicAccess condition
+	// once the public accessibility of the DB instance was changed
+	ReasonPublicAccessCompleted = "Completed"
+
+	// ReasonPortChangeInProgress is the reason of the PortChange condition
+	// until the endpoint of the DB instance listens on the new port
+	ReasonPortChangeInProgress = "InProgress"
+	// ReasonPortChangeCompleted is the reason of the PortChange condition once
+	// the endpoint of the DB instance listens on the new port
+	ReasonPortChangeCompleted = "Completed"
+
+	// ReasonBacktrackInProgress is the reason of the Backtrack condition while
+	// the DB cluster is being backtracked
+	ReasonBacktrackInProgress = "InProgress"
+	// ReasonBacktrackCompleted is the reason of the Backtrack condition once
+	// the DB cluster was backtracked
+	ReasonBacktrackCompleted = "Completed"
+	// ReasonBacktrackFailed is the reason of the Backtrack condition when the
+	// backtrack failed
+	ReasonBacktrackFailed = "Failed"
+
+	// ReasonRestoreVerificationSucceeded is the reason of the RestoreVerified
+	// condition when the snapshot was restored and passed the health check
+	ReasonRestoreVerificationSucceeded = "Succeeded"
+	// ReasonRestoreVerificationFailed is the reason of the RestoreVerified
+	// condition when the snapshot could not be restored, or failed the health
+	// check, before the timeout
+	ReasonRestoreVerificationFailed = "Failed"
+)
+
+// SetParameterConflicts sets an ACK.Advisory condition on the supplied
+// resource listing the parameters whose values conflict with the values held
+// by the named counterpart parameter group. The condition is removed when
+// there are no conflicts.
+func SetParameterConflicts(
+	subject acktypes.ConditionManager,
+	counterpart string,
+	conflicts []string,
+) {
+	var conds []*ackv1alpha1.Condition
+	var existing *ackv1alpha1.Condition
+	for _, c := range subject.Conditions() {
+		if c.Type == ackv1alpha1.ConditionTypeAdvisory &&
+			c.Reason != nil && *c.Reason == ReasonParameterConflict {
+			existing = c
+			continue
+		}
+		conds = append(conds, c)
+	}
+	if len(conflicts) > 0 {
+		reason := ReasonParameterConflict
+		msg := fmt.Sprintf(
+			"parameters conflict with %s: %s",
+			counterpart, strings.Join(conflicts, ", "),
+		)
+		conds = append(conds, &ackv1alpha1.Condition{
+			Type:               ackv1alpha1.ConditionTypeAdvisory,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: transitionTime(existing, corev1.ConditionTrue),
+			Message:            &msg,
+			Reason:             &reason,
+		})
+	}
+	subject.ReplaceConditions(conds)
+}
+
+// SetParametersDrifted sets a Drifted condition on the supplied resource
+// listing the parameters that were modified outside of the controller. The
+// condition is removed when no parameters drifted.
+func SetParametersDrifted(
+	subject acktypes.ConditionManager,
+	drifted []string,
+) {
+	var conds []*ackv1alpha1.Condition
+	var existing *ackv1alpha1.Condition
+	for _, c := range subject.Conditions() {
+		if c.Type == ConditionTypeParametersDrifted {
+			existing = c
+			continue
+		}
+		conds = append(conds, c)
+	}
+	if len(drifted) > 0 {
+		msg := "parameters modified outside of the controller: " +
+			strings.Join(drifted, ", ")
+		conds = append(conds, &ackv1alpha1.Condition{
+			Type:               ConditionTypeParametersDrifted,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: transitionTime(existing, corev1.ConditionTrue),
+			Message:            &msg,
+		})
+	}
+	subject.ReplaceConditions(conds)
+}
+
+// GetSwitchover returns the Switchover condition of the supplied resource, or
+// nil if it has none.
+func GetSwitchover(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeSwitchover)
+}
+
+// SetSwitchover sets the Switchover condition of the supplied resource to the
+// supplied status, reason and message, replacing any existing one.
+func SetSwitchover(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeSwitchover, status, reason, msg)
+}
+
+// GetFailover returns the Failover condition of the supplied resource, or nil
+// if it has none.
+func GetFailover(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeFailover)
+}
+
+// SetFailover sets the Failover condition of the supplied resource to the
+// supplied status, reason and message, replacing any existing one.
+func SetFailover(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeFailover, status, reason, msg)
+}
+
+// GetMultiAZConversion returns the MultiAZConversion condition of the
+// supplied resource, or nil if it has none.
+func GetMultiAZConversion(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeMultiAZConversion)
+}
+
+// SetMultiAZConversion sets the MultiAZConversion condition of the supplied
+// resource to the supplied status, reason and message, replacing any existing
+// one.
+func SetMultiAZConversion(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeMultiAZConversion, status, reason, msg)
+}
+
+// GetDeletionBlocked returns the DeletionBlocked condition of the supplied
+// resource, or nil if it has none.
+func GetDeletionBlocked(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeDeletionBlocked)
+}
+
+// SetDeletionBlocked sets the DeletionBlocked condition of the supplied
+// resource with the supplied message, replacing any existing one.
+func SetDeletionBlocked(subject acktypes.ConditionManager, msg string) {
+	setCondition(subject, ConditionTypeDeletionBlocked, corev1.ConditionTrue, ReasonDeletionProtection, msg)
+}
+
+// GetSafetySnapshot returns the SafetySnapshot condition of the supplied
+// resource, or nil if it has none.
+func GetSafetySnapshot(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeSafetySnapshot)
+}
+
+// SetSafetySnapshot sets the SafetySnapshot condition of the supplied
+// resource to the supplied status, reason and message, replacing any existing
+// one.
+func SetSafetySnapshot(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeSafetySnapshot, status, reason, msg)
+}
+
+// GetMajorVersionUpgrade returns the MajorVersionUpgrade condition of the
+// supplied resource, or nil if it has none.
+func GetMajorVersionUpgrade(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeMajorVersionUpgrade)
+}
+
+// SetMajorVersionUpgrade sets the MajorVersionUpgrade condition of the
+// supplied resource to the supplied status, reason and message, replacing any
+// existing one.
+func SetMajorVersionUpgrade(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeMajorVersionUpgrade, status, reason, msg)
+}
+
+// GetCACertificateExpiring returns the CACertificateExpiring condition of the
+// supplied resource, or nil if it has none.
+func GetCACertificateExpiring(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeCACertificateExpiring)
+}
+
+// SetCACertificateExpiring sets the CACertificateExpiring condition of the
+// supplied resource with the supplied reason and message, replacing any
+// existing one.
+func SetCACertificateExpiring(subject acktypes.ConditionManager, reason string, msg string) {
+	setCondition(subject, ConditionTypeCACertificateExpiring, corev1.ConditionTrue, reason, msg)
+}
+
+// ClearCACertificateExpiring removes the CACertificateExpiring condition of
+// the supplied resource, if any.
+func ClearCACertificateExpiring(subject acktypes.ConditionManager) {
+	removeCondition(subject, ConditionTypeCACertificateExpiring)
+}
+
+// GetPublicAccess returns the PublicAccess condition of the supplied
+// resource, or nil if it has none.
+func GetPublicAccess(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypePublicAccess)
+}
+
+// SetPublicAccess sets the PublicAccess condition of the supplied resource to
+// the supplied status, reason and message, replacing any existing one.
+func SetPublicAccess(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypePublicAccess, status, reason, msg)
+}
+
+// GetPortChange returns the PortChange condition of the supplied resource, or
+// nil if it has none.
+func GetPortChange(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypePortChange)
+}
+
+// SetPortChange sets the PortChange condition of the supplied resource to the
+// supplied status, reason and message, replacing any existing one.
+func SetPortChange(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypePortChange, status, reason, msg)
+}
+
+// GetBacktrack returns the Backtrack condition of the supplied resource, or
+// nil if it has none.
+func GetBacktrack(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return getCondition(subject, ConditionTypeBacktrack)
+}
+
+// SetBacktrack sets the Backtrack condition of the supplied resource to the
+// supplied status, reason and message, replacing any existing one.
+func SetBacktrack(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	setCondition(subject, ConditionTypeBacktrack, status, reason, msg)
+}
+
+// SetReconcileConditions sets the ACK.ResourceSynced and ACK.Terminal
+// conditions among the supplied conditions of a resource that has no AWS
+// counterpart, like a SnapshotSchedule, reporting the supplied error of its
+// last reconciliation. The supplied message describes the resource when its
+// reconciliation succeeded.
+func SetReconcileConditions(
+	conds *[]*ackv1alpha1.Condition,
+	err error,
+	msg *string,
+) {
+	synced, terminal := corev1.ConditionTrue, corev1.ConditionFalse
+	var terminalMsg *string
+	if err != nil {
+		synced = corev1.ConditionFalse
+		m := err.Error()
+		msg = &m
+		var termErr *ackerr.TerminalError
+		if errors.As(err, &termErr) {
+			terminal = corev1.ConditionTrue
+			terminalMsg = msg
+		}
+	}
+	UpdateCondition(conds, ackv1alpha1.ConditionTypeResourceSynced, synced, nil, msg)
+	UpdateCondition(conds, ackv1alpha1.ConditionTypeTerminal, terminal, nil, terminalMsg)
+}
+
+// UpdateCondition sets the condition of the supplied type among the supplied
+// conditions to the supplied status, reason and message, adding it if
+// missing. Its transition time only changes along with its status.
+func UpdateCondition(
+	conds *[]*ackv1alpha1.Condition,
+	conditionType ackv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	reason *string,
+	msg *string,
+) {
+	var cond *ackv1alpha1.Condition
+	for _, c := range *conds {
+		if c.Type == conditionType {
+			cond = c
+		}
+	}
+	if cond == nil {
+		cond = &ackv1alpha1.Condition{Type: conditionType}
+		*conds = append(*conds, cond)
+	}
+	cond.LastTransitionTime = transitionTime(cond, status)
+	cond.Status = status
+	cond.Reason = reason
+	cond.Message = msg
+}
+
+// transitionTime returns the last transition time of the supplied existing
+// condition if it already has the supplied status, and now otherwise, so that
+// the transition time only changes along with the status of the condition.
+func transitionTime(
+	existing *ackv1alpha1.Condition,
+	status corev1.ConditionStatus,
+) *metav1.Time {
+	if existing != nil && existing.Status == status && existing.LastTransitionTime != nil {
+		return existing.LastTransitionTime
+	}
+	now := metav1.Now()
+	return &now
+}
+
+// getCondition returns the condition of the supplied type of the supplied
+// resource, or nil if it has none.
+func getCondition(
+	subject acktypes.ConditionManager,
+	conditionType ackv1alpha1.ConditionType,
+) *ackv1alpha1.Condition {
+	for _, c := range subject.Conditions() {
+		if c.Type == conditionType {
+			return c
+		}
+	}
+	return nil
+}
+
+// setCondition sets the condition of the supplied type of the supplied
+// resource to the supplied status, reason and message, replacing any existing
+// one.
+func setCondition(
+	subject acktypes.ConditionManager,
+	conditionType ackv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	reason string,
+	msg string,
+) {
+	var conds []*ackv1alpha1.Condition
+	for _, c := range subject.Conditions() {
+		if c.Type != conditionType {
+			conds = append(conds, c)
+		}
+	}
+	now := metav1.Now()
+	conds = append(conds, &ackv1alpha1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            &msg,
+		Reason:             &reason,
+	})
+	subject.ReplaceConditions(conds)
+}
+
+// removeCondition removes the condition of the supplied type of the supplied
+// resource, if any.
+func removeCondition(
+	subject acktypes.ConditionManager,
+	conditionType ackv1alpha1.ConditionType,
+) {
+	if getCondition(subject, conditionType) == nil {
+		return
+	}
+	var conds []*ackv1alpha1.Condition
+	for _, c := range subject.Conditions() {
+		if c.Type != conditionType {
+			conds = append(conds, c)
+		}
+	}
+	subject.ReplaceConditions(conds)
+}
@@ -0,0 +1,246 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// conditions is a minimal ConditionManager holding a set of conditions
+type conditions struct {
+	conds []*ackv1alpha1.Condition
+}
+
+func (c *conditions) Conditions() []*ackv1alpha1.Condition {
+	return c.conds
+}
+
+func (c *conditions) ReplaceConditions(conds []*ackv1alpha1.Condition) {
+	c.conds = conds
+}
+
+func TestSetParameterConflictsTransitionTime(t *testing.T) {
+	before := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	reason := util.ReasonParameterConflict
+	tests := []struct {
+		name      string
+		existing  *ackv1alpha1.Condition
+		conflicts []string
+		wantKept  bool
+		wantConds int
+	}{
+		{
+			name: "conflicts persist",
+			existing: &ackv1alpha1.Condition{
+				Type:               ackv1alpha1.ConditionTypeAdvisory,
+				Status:             corev1.ConditionTrue,
+				Reason:             &reason,
+				LastTransitionTime: &before,
+			},
+			conflicts: []string{"binlog_format"},
+			wantKept:  true,
+			wantConds: 1,
+		},
+		{
+			name:      "new conflicts",
+			conflicts: []string{"binlog_format"},
+			wantConds: 1,
+		},
+		{
+			name: "conflicts resolved",
+			existing: &ackv1alpha1.Condition{
+				Type:               ackv1alpha1.ConditionTypeAdvisory,
+				Status:             corev1.ConditionTrue,
+				Reason:             &reason,
+				LastTransitionTime: &before,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := &conditions{}
+			if tt.existing != nil {
+				subject.conds = []*ackv1alpha1.Condition{tt.existing}
+			}
+			util.SetParameterConflicts(subject, "cluster-params", tt.conflicts)
+			if len(subject.conds) != tt.wantConds {
+				t.Fatalf("SetParameterConflicts() set %d conditions, want %d", len(subject.conds), tt.wantConds)
+			}
+			if tt.wantConds == 0 {
+				return
+			}
+			kept := subject.conds[0].LastTransitionTime.Equal(&before)
+			if kept != tt.wantKept {
+				t.Errorf("SetParameterConflicts() kept transition time = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestSetParametersDriftedTransitionTime(t *testing.T) {
+	before := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name      string
+		existing  *ackv1alpha1.Condition
+		drifted   []string
+		wantKept  bool
+		wantConds int
+	}{
+		{
+			name: "drift persists",
+			existing: &ackv1alpha1.Condition{
+				Type:               util.ConditionTypeParametersDrifted,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: &before,
+			},
+			drifted:   []string{"max_connections"},
+			wantKept:  true,
+			wantConds: 1,
+		},
+		{
+			name:      "new drift",
+			drifted:   []string{"max_connections"},
+			wantConds: 1,
+		},
+		{
+			name: "drift corrected",
+			existing: &ackv1alpha1.Condition{
+				Type:               util.ConditionTypeParametersDrifted,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: &before,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := &conditions{}
+			if tt.existing != nil {
+				subject.conds = []*ackv1alpha1.Condition{tt.existing}
+			}
+			util.SetParametersDrifted(subject, tt.drifted)
+			if len(subject.conds) != tt.wantConds {
+				t.Fatalf("SetParametersDrifted() set %d conditions, want %d", len(subject.conds), tt.wantConds)
+			}
+			if tt.wantConds == 0 {
+				return
+			}
+			kept := subject.conds[0].LastTransitionTime.Equal(&before)
+			if kept != tt.wantKept {
+				t.Errorf("SetParametersDrifted() kept transition time = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestSetReconcileConditions(t *testing.T) {
+	synced := "Last snapshot: daily-20240101"
+	tests := []struct {
+		name         string
+		err          error
+		msg          *string
+		wantSynced   corev1.ConditionStatus
+		wantTerminal corev1.ConditionStatus
+		wantMsg      string
+	}{
+		{"synced", nil, &synced, corev1.ConditionTrue, corev1.ConditionFalse, synced},
+		{"synced without message", nil, nil, corev1.ConditionTrue, corev1.ConditionFalse, ""},
+		{"error", errors.New("throttled"), &synced, corev1.ConditionFalse, corev1.ConditionFalse, "throttled"},
+		{"terminal error", ackerr.NewTerminalError(errors.New("invalid")), nil, corev1.ConditionFalse, corev1.ConditionTrue, "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conds []*ackv1alpha1.Condition
+			util.SetReconcileConditions(&conds, tt.err, tt.msg)
+			if len(conds) != 2 {
+				t.Fatalf("SetReconcileConditions() set %d conditions, want 2", len(conds))
+			}
+			for _, c := range conds {
+				want := tt.wantSynced
+				if c.Type == ackv1alpha1.ConditionTypeTerminal {
+					want = tt.wantTerminal
+				}
+				if c.Status != want {
+					t.Errorf("SetReconcileConditions() %s status = %s, want %s", c.Type, c.Status, want)
+				}
+				if c.Type == ackv1alpha1.ConditionTypeResourceSynced && aws.StringValue(c.Message) != tt.wantMsg {
+					t.Errorf("SetReconcileConditions() message = %q, want %q", aws.StringValue(c.Message), tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateConditionTransitionTime(t *testing.T) {
+	before := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	reason := util.ReasonRestoreVerificationFailed
+	tests := []struct {
+		name     string
+		existing *ackv1alpha1.Condition
+		status   corev1.ConditionStatus
+		wantKept bool
+	}{
+		{
+			name: "same status",
+			existing: &ackv1alpha1.Condition{
+				Type:               util.ConditionTypeRestoreVerified,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: &before,
+			},
+			status:   corev1.ConditionFalse,
+			wantKept: true,
+		},
+		{
+			name: "status changed",
+			existing: &ackv1alpha1.Condition{
+				Type:               util.ConditionTypeRestoreVerified,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: &before,
+			},
+			status: corev1.ConditionFalse,
+		},
+		{
+			name:   "new condition",
+			status: corev1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conds []*ackv1alpha1.Condition
+			if tt.existing != nil {
+				conds = []*ackv1alpha1.Condition{tt.existing}
+			}
+			util.UpdateCondition(&conds, util.ConditionTypeRestoreVerified, tt.status, &reason, nil)
+			if len(conds) != 1 {
+				t.Fatalf("UpdateCondition() set %d conditions, want 1", len(conds))
+			}
+			if conds[0].Status != tt.status || aws.StringValue(conds[0].Reason) != reason {
+				t.Errorf("UpdateCondition() status = %s, reason = %v", conds[0].Status, aws.StringValue(conds[0].Reason))
+			}
+			kept := conds[0].LastTransitionTime.Equal(&before)
+			if kept != tt.wantKept {
+				t.Errorf("UpdateCondition() kept transition time = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
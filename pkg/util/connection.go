@@ -0,0 +1,225 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/template"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The keys of the connection Secret of a DB instance or DB cluster
+const (
+	ConnectionSecretHostKey     = "host"
+	ConnectionSecretPortKey     = "port"
+	ConnectionSecretDBNameKey   = "dbname"
+	ConnectionSecretUsernameKey = "username"
+	ConnectionSecretPasswordKey = "password"
+)
+
+// The keys of the connection Secret of a DB cluster only
+const (
+	ConnectionSecretWriterHostKey = "writerHost"
+	ConnectionSecretReaderHostKey = "readerHost"
+)
+
+var (
+	ErrInvalidConnectionTemplate = fmt.Errorf("invalid connection Secret template")
+
+	// secretKeyRegexp matches the valid keys of a Kubernetes Secret
+	secretKeyRegexp = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+)
+
+// ConnectionDetails returns the connection details written to the connection
+// Secret of a DB instance or DB cluster, by Secret key. The details that are
+// not known, like the endpoint of a DB instance being created, are left out.
+func ConnectionDetails(
+	host *string,
+	port *int64,
+	dbName *string,
+	username *string,
+	password string,
+) map[string]string {
+	details := map[string]string{}
+	if host != nil && *host != "" {
+		details[ConnectionSecretHostKey] = *host
+	}
+	if port != nil {
+		details[ConnectionSecretPortKey] = strconv.FormatInt(*port, 10)
+	}
+	if dbName != nil && *dbName != "" {
+		details[ConnectionSecretDBNameKey] = *dbName
+	}
+	if username != nil && *username != "" {
+		details[ConnectionSecretUsernameKey] = *username
+	}
+	if password != "" {
+		details[ConnectionSecretPasswordKey] = password
+	}
+	return details
+}
+
+// AddClusterEndpoints adds the writer and reader endpoints of a DB cluster to
+// the supplied connection details. The endpoints that are not known are left
+// out.
+func AddClusterEndpoints(
+	details map[string]string,
+	writerEndpoint *string,
+	readerEndpoint *string,
+) {
+	if writerEndpoint != nil && *writerEndpoint != "" {
+		details[ConnectionSecretWriterHostKey] = *writerEndpoint
+	}
+	if readerEndpoint != nil && *readerEndpoint != "" {
+		details[ConnectionSecretReaderHostKey] = *readerEndpoint
+	}
+}
+
+// RenderConnectionTemplates returns the supplied connection details along
+// with the keys rendered from the supplied templates, by Secret key. The
+// templates are Go templates executed over the connection details, the
+// details that are not known rendering empty. An ACK terminal error is
+// returned when a template is not valid or its key is not a valid Secret key
+// or is one of the keys of the connection details.
+func RenderConnectionTemplates(
+	details map[string]string,
+	templates map[string]*string,
+) (map[string]string, error) {
+	rendered := make(map[string]string, len(details)+len(templates))
+	for key, value := range details {
+		rendered[key] = value
+	}
+	// Render the templates in a stable order so their errors are stable
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data := map[string]string{
+		ConnectionSecretHostKey:     details[ConnectionSecretHostKey],
+		ConnectionSecretPortKey:     details[ConnectionSecretPortKey],
+		ConnectionSecretDBNameKey:   details[ConnectionSecretDBNameKey],
+		ConnectionSecretUsernameKey: details[ConnectionSecretUsernameKey],
+		ConnectionSecretPasswordKey: details[ConnectionSecretPasswordKey],
+		CABundleKey:                 details[CABundleKey],
+
+		ConnectionSecretWriterHostKey: details[ConnectionSecretWriterHostKey],
+		ConnectionSecretReaderHostKey: details[ConnectionSecretReaderHostKey],
+	}
+	for _, key := range keys {
+		if !secretKeyRegexp.MatchString(key) {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"%w: %q is not a valid Secret key", ErrInvalidConnectionTemplate, key,
+			))
+		}
+		if _, ok := data[key]; ok {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"%w: %q is already a key of the connection Secret", ErrInvalidConnectionTemplate, key,
+			))
+		}
+		if templates[key] == nil {
+			continue
+		}
+		tmpl, err := template.New(key).Option("missingkey=zero").Parse(*templates[key])
+		if err != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"%w: %q: %v", ErrInvalidConnectionTemplate, key, err,
+			))
+		}
+		var value bytes.Buffer
+		if err = tmpl.Execute(&value, data); err != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"%w: %q: %v", ErrInvalidConnectionTemplate, key, err,
+			))
+		}
+		rendered[key] = value.String()
+	}
+	return rendered, nil
+}
+
+// ConnectionDetailsHash returns a hash of the supplied connection details
+// keyed by the supplied key, see SecretValueHash.
+func ConnectionDetailsHash(key string, details map[string]string) string {
+	// Maps are marshalled with their keys sorted, so the same details always
+	// hash the same way
+	value, _ := json.Marshal(details)
+	return SecretValueHash(key, string(value))
+}
+
+// NewConnectionSecret returns a Kubernetes Secret with the supplied namespace,
+// name and connection details, owned by the supplied owner so that it is
+// deleted along with it.
+func NewConnectionSecret(
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	details map[string]string,
+) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Data: secretData(details),
+	}
+}
+
+// EnsureConnectionSecret creates the connection Secret with the supplied
+// namespace, name and connection details, or updates its details when it
+// already exists. It returns an ACK terminal error when the Secret exists but
+// is not owned by the supplied owner, so that Secrets the controller does not
+// manage are left untouched.
+func EnsureConnectionSecret(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	details map[string]string,
+) error {
+	if kubeClient == nil {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		return kubeClient.Create(ctx, NewConnectionSecret(owner, namespace, name, details))
+	}
+	if err != nil {
+		return err
+	}
+	if !OwnedBy(secret, owner.UID) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: secret %s/%s already exists", ErrNotOwned, namespace, name,
+		))
+	}
+	data := secretData(details)
+	if reflect.DeepEqual(secret.Data, data) {
+		return nil
+	}
+	secret.Data = data
+	return kubeClient.Update(ctx, secret)
+}
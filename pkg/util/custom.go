@@ -0,0 +1,90 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// CustomEnginePrefix prefixes the engines of the RDS Custom DB instances,
+	// like custom-oracle-ee or custom-sqlserver-se
+	CustomEnginePrefix = "custom-"
+
+	// AutomationResume is the value of the pause-automation annotation
+	// resuming the full automation of an RDS Custom DB instance
+	AutomationResume = "resume"
+	// MinAutomationPauseMinutes and MaxAutomationPauseMinutes bound the
+	// duration, in minutes, the automation of an RDS Custom DB instance is
+	// paused for
+	MinAutomationPauseMinutes = 60
+	MaxAutomationPauseMinutes = 1440
+)
+
+var (
+	ErrInvalidCustomDBInstance = fmt.Errorf("invalid RDS Custom DB instance")
+	ErrInvalidAutomationPause  = fmt.Errorf("invalid automation pause")
+)
+
+// IsCustomEngine returns true if the supplied engine is the engine of RDS
+// Custom DB instances.
+func IsCustomEngine(engine string) bool {
+	return strings.HasPrefix(engine, CustomEnginePrefix)
+}
+
+// ValidateCustomDBInstance returns an ACK terminal error when a DB instance
+// with the supplied engine and instance profile is not consistent: RDS Custom
+// DB instances require the instance profile of their EC2 instance, which
+// other DB instances cannot set.
+func ValidateCustomDBInstance(engine string, customIAMInstanceProfile *string) error {
+	custom := IsCustomEngine(engine)
+	switch {
+	case custom && customIAMInstanceProfile == nil:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: customIAMInstanceProfile is required by engine %s", ErrInvalidCustomDBInstance, engine,
+		))
+	case !custom && customIAMInstanceProfile != nil:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: customIAMInstanceProfile only applies to RDS Custom DB instances, not to engine %s",
+			ErrInvalidCustomDBInstance, engine,
+		))
+	}
+	return nil
+}
+
+// AutomationModeChange returns the automation mode, and the minutes after
+// which the full automation resumes when it is paused, requested by the
+// supplied value of the pause-automation annotation, or an ACK terminal error
+// when the value is neither AutomationResume nor a number of minutes between
+// MinAutomationPauseMinutes and MaxAutomationPauseMinutes.
+func AutomationModeChange(value string) (string, *int64, error) {
+	if value == AutomationResume {
+		return string(svcapitypes.AutomationMode_full), nil, nil
+	}
+	minutes, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || minutes < MinAutomationPauseMinutes || minutes > MaxAutomationPauseMinutes {
+		return "", nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %q must be either %q or a number of minutes between %d and %d",
+			ErrInvalidAutomationPause, value, AutomationResume,
+			MinAutomationPauseMinutes, MaxAutomationPauseMinutes,
+		))
+	}
+	return string(svcapitypes.AutomationMode_all_paused), &minutes, nil
+}
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateCustomDBInstance(t *testing.T) {
+	tests := []struct {
+		name    string
+		engine  string
+		profile *string
+		wantErr bool
+	}{
+		{"custom oracle", "custom-oracle-ee", aws.String("AWSRDSCustomInstanceProfile"), false},
+		{"custom sqlserver", "custom-sqlserver-se", aws.String("AWSRDSCustomInstanceProfile"), false},
+		{"custom without profile", "custom-oracle-ee", nil, true},
+		{"oracle", "oracle-ee", nil, false},
+		{"oracle with profile", "oracle-ee", aws.String("AWSRDSCustomInstanceProfile"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateCustomDBInstance(tt.engine, tt.profile)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidCustomDBInstance) {
+				t.Errorf("ValidateCustomDBInstance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAutomationModeChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		wantMode    string
+		wantMinutes *int64
+		wantErr     bool
+	}{
+		{"resume", "resume", "full", nil, false},
+		{"pause", "90", "all-paused", aws.Int64(90), false},
+		{"shortest pause", "60", "all-paused", aws.Int64(60), false},
+		{"longest pause", "1440", "all-paused", aws.Int64(1440), false},
+		{"pause too short", "59", "", nil, true},
+		{"pause too long", "1441", "", nil, true},
+		{"not a duration", "1h", "", nil, true},
+		{"true", "true", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, minutes, err := util.AutomationModeChange(tt.value)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidAutomationPause) {
+				t.Fatalf("AutomationModeChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mode != tt.wantMode || aws.Int64Value(minutes) != aws.Int64Value(tt.wantMinutes) {
+				t.Errorf("AutomationModeChange() = %s, %d, want %s, %d",
+					mode, aws.Int64Value(minutes), tt.wantMode, aws.Int64Value(tt.wantMinutes))
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Whether the DB instance or DB cluster of a deleted resource is deleted at
+// all is decided by the ACK runtime, from the ackv1alpha1.AnnotationDeletionPolicy
+// annotation or the --deletion-policy flag of the controller. The deletion
+// policies below only decide how it is deleted.
+const (
+	// DeletionPolicyDelete deletes the DB instance or DB cluster without a
+	// final snapshot. This is the default deletion policy.
+	DeletionPolicyDelete = "Delete"
+	// DeletionPolicySnapshot takes a final snapshot of the DB instance or DB
+	// cluster before deleting it
+	DeletionPolicySnapshot = "Snapshot"
+)
+
+var (
+	ErrInvalidDeletionPolicy          = fmt.Errorf("invalid deletion policy")
+	ErrInvalidFinalSnapshotIdentifier = fmt.Errorf("invalid final snapshot identifier")
+)
+
+// DeletionPolicy returns the supplied deletion policy of a DB instance or DB
+// cluster, defaulting to DeletionPolicyDelete, or an ACK terminal error when
+// it is neither DeletionPolicyDelete nor DeletionPolicySnapshot.
+func DeletionPolicy(policy *string) (string, error) {
+	if policy == nil {
+		return DeletionPolicyDelete, nil
+	}
+	switch *policy {
+	case DeletionPolicyDelete, DeletionPolicySnapshot:
+		return *policy, nil
+	}
+	return "", ackerr.NewTerminalError(fmt.Errorf(
+		"%w %q: expected %q or %q, set the %s annotation to %q to leave "+
+			"the AWS resource running",
+		ErrInvalidDeletionPolicy, *policy,
+		DeletionPolicyDelete, DeletionPolicySnapshot,
+		ackv1alpha1.AnnotationDeletionPolicy, ackv1alpha1.DeletionPolicyRetain,
+	))
+}
+
+// DefaultFinalSnapshotIdentifierTemplate is the template of the identifiers
+// of the final snapshots when none is supplied
+const DefaultFinalSnapshotIdentifierTemplate = "{identifier}-final-{timestamp}"
+
+// finalSnapshotIdentifierRegexp matches valid snapshot identifiers: letters,
+// digits and hyphens, starting with a letter, without two consecutive hyphens
+// nor a trailing hyphen.
+var finalSnapshotIdentifierRegexp = regexp.MustCompile(`^[A-Za-z](-?[A-Za-z0-9])*$`)
+
+// maxFinalSnapshotIdentifierLength is the maximum length of a snapshot
+// identifier
+const maxFinalSnapshotIdentifierLength = 255
+
+// FinalSnapshotIdentifier renders the supplied template, or
+// DefaultFinalSnapshotIdentifierTemplate when nil, into the identifier of the
+// final snapshot taken at the supplied time of the DB instance or DB cluster
+// with the supplied identifier, managed by the supplied object. The template
+// placeholders are:
+//
+//   - {name}: the name of the object
+//   - {namespace}: the namespace of the object
+//   - {identifier}: the identifier of the DB instance or DB cluster
+//   - {timestamp}: the UTC time of the snapshot, formatted as YYYYMMDDhhmmss
+//
+// It returns an ACK terminal error when the template does not render into a
+// valid snapshot identifier.
+func FinalSnapshotIdentifier(
+	template *string,
+	obj metav1.Object,
+	identifier string,
+	now time.Time,
+) (string, error) {
+	tmpl := DefaultFinalSnapshotIdentifierTemplate
+	if template != nil {
+		tmpl = *template
+	}
+	rendered := strings.NewReplacer(
+		"{name}", obj.GetName(),
+		"{namespace}", obj.GetNamespace(),
+		"{identifier}", identifier,
+		"{timestamp}", now.UTC().Format("20060102150405"),
+	).Replace(tmpl)
+	if len(rendered) > maxFinalSnapshotIdentifierLength ||
+		!finalSnapshotIdentifierRegexp.MatchString(rendered) {
+		return "", ackerr.NewTerminalError(fmt.Errorf(
+			"%w %q: template %q must render into at most %d letters, digits "+
+				"and hyphens, starting with a letter, without two consecutive "+
+				"hyphens nor a trailing hyphen",
+			ErrInvalidFinalSnapshotIdentifier, rendered, tmpl,
+			maxFinalSnapshotIdentifierLength,
+		))
+	}
+	return rendered, nil
+}
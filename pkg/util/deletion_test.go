@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *string
+		want    string
+		wantErr bool
+	}{
+		{"default", nil, util.DeletionPolicyDelete, false},
+		{"delete", aws.String("Delete"), util.DeletionPolicyDelete, false},
+		{"snapshot", aws.String("Snapshot"), util.DeletionPolicySnapshot, false},
+		{"retain", aws.String("Retain"), "", true},
+		{"lower case", aws.String("snapshot"), "", true},
+		{"unknown", aws.String("Archive"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.DeletionPolicy(tt.policy)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidDeletionPolicy) {
+					t.Errorf("DeletionPolicy() error = %v, want %v", err, util.ErrInvalidDeletionPolicy)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeletionPolicy() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DeletionPolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinalSnapshotIdentifier(t *testing.T) {
+	now := time.Date(2024, time.January, 15, 20, 4, 5, 0, time.FixedZone("CET", 3600))
+	obj := &metav1.ObjectMeta{Name: "orders", Namespace: "production"}
+	tests := []struct {
+		name     string
+		template *string
+		want     string
+		wantErr  bool
+	}{
+		{"default", nil, "my-db-final-20240115190405", false},
+		{"name", aws.String("{name}-final-{timestamp}"), "orders-final-20240115190405", false},
+		{"namespace", aws.String("{namespace}-{name}"), "production-orders", false},
+		{"constant", aws.String("last"), "last", false},
+		{"unknown placeholder", aws.String("{identifier}-{date}"), "", true},
+		{"starts with a digit", aws.String("{timestamp}-{identifier}"), "", true},
+		{"consecutive hyphens", aws.String("{identifier}--final"), "", true},
+		{"trailing hyphen", aws.String("{identifier}-"), "", true},
+		{"too long", aws.String(strings.Repeat("a", 256)), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.FinalSnapshotIdentifier(tt.template, obj, "my-db", now)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidFinalSnapshotIdentifier) {
+					t.Errorf("FinalSnapshotIdentifier() error = %v, want %v", err, util.ErrInvalidFinalSnapshotIdentifier)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FinalSnapshotIdentifier() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FinalSnapshotIdentifier() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"net"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+var (
+	ErrInvalidDomain = fmt.Errorf("invalid domain")
+)
+
+// SelfManagedDomain describes the self-managed Active Directory a DB instance
+// joins.
+type SelfManagedDomain struct {
+	FQDN          *string
+	OU            *string
+	AuthSecretARN *string
+	DNSIPs        []*string
+}
+
+// set returns whether any field of the self-managed domain is set.
+func (d SelfManagedDomain) set() bool {
+	return d.FQDN != nil || d.OU != nil || d.AuthSecretARN != nil || len(d.DNSIPs) > 0
+}
+
+// ValidateDomain returns an ACK terminal error when a DB instance joins both
+// the supplied Amazon Web Services Managed Microsoft AD directory and the
+// supplied self-managed Active Directory, or when the self-managed Active
+// Directory lacks its FQDN, organizational unit or authentication secret or
+// does not have the IPv4 addresses of two domain controllers.
+func ValidateDomain(directoryID *string, selfManaged SelfManagedDomain) error {
+	if !selfManaged.set() {
+		return nil
+	}
+	if directoryID != nil {
+		return invalidDomain(
+			"directory %s and self-managed Active Directory cannot both be joined",
+			*directoryID,
+		)
+	}
+	if selfManaged.FQDN == nil || selfManaged.OU == nil || selfManaged.AuthSecretARN == nil {
+		return invalidDomain(
+			"the FQDN, organizational unit and authentication secret of a self-managed Active Directory are required",
+		)
+	}
+	if len(selfManaged.DNSIPs) != 2 {
+		return invalidDomain(
+			"the IPv4 addresses of two domain controllers are required, got %d",
+			len(selfManaged.DNSIPs),
+		)
+	}
+	for _, ip := range selfManaged.DNSIPs {
+		if ip == nil || net.ParseIP(*ip).To4() == nil {
+			return invalidDomain("domain controller address %q is not an IPv4 address", aws.StringValue(ip))
+		}
+	}
+	return nil
+}
+
+// invalidDomain returns an ACK terminal error wrapping ErrInvalidDomain with
+// the supplied formatted message.
+func invalidDomain(format string, args ...interface{}) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s", ErrInvalidDomain, fmt.Sprintf(format, args...),
+	))
+}
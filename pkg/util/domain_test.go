@@ -0,0 +1,93 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateDomain(t *testing.T) {
+	selfManaged := util.SelfManagedDomain{
+		FQDN:          aws.String("corp.example.com"),
+		OU:            aws.String("OU=Databases,DC=corp,DC=example,DC=com"),
+		AuthSecretARN: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:ad-join-AbCdEf"),
+		DNSIPs:        []*string{aws.String("10.0.0.10"), aws.String("10.0.1.10")},
+	}
+	with := func(change func(d *util.SelfManagedDomain)) util.SelfManagedDomain {
+		d := selfManaged
+		change(&d)
+		return d
+	}
+	tests := []struct {
+		name        string
+		directoryID *string
+		selfManaged util.SelfManagedDomain
+		wantErr     bool
+	}{
+		{"no domain", nil, util.SelfManagedDomain{}, false},
+		{"managed directory", aws.String("d-1234567890"), util.SelfManagedDomain{}, false},
+		{"self-managed", nil, selfManaged, false},
+		{
+			"same domain controller twice",
+			nil,
+			with(func(d *util.SelfManagedDomain) {
+				d.DNSIPs = []*string{aws.String("10.0.0.10"), aws.String("10.0.0.10")}
+			}),
+			false,
+		},
+		{"both", aws.String("d-1234567890"), selfManaged, true},
+		{"no FQDN", nil, with(func(d *util.SelfManagedDomain) { d.FQDN = nil }), true},
+		{"no organizational unit", nil, with(func(d *util.SelfManagedDomain) { d.OU = nil }), true},
+		{"no authentication secret", nil, with(func(d *util.SelfManagedDomain) { d.AuthSecretARN = nil }), true},
+		{
+			"one domain controller",
+			nil,
+			with(func(d *util.SelfManagedDomain) { d.DNSIPs = []*string{aws.String("10.0.0.10")} }),
+			true,
+		},
+		{
+			"IPv6 domain controller",
+			nil,
+			with(func(d *util.SelfManagedDomain) {
+				d.DNSIPs = []*string{aws.String("10.0.0.10"), aws.String("fd00::10")}
+			}),
+			true,
+		},
+		{
+			"only DNS addresses",
+			nil,
+			util.SelfManagedDomain{DNSIPs: []*string{aws.String("10.0.0.10"), aws.String("10.0.1.10")}},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateDomain(tt.directoryID, tt.selfManaged)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidDomain) {
+					t.Errorf("ValidateDomain() error = %v, want %v", err, util.ErrInvalidDomain)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateDomain() unexpected error = %v", err)
+			}
+		})
+	}
+}
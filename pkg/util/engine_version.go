@@ -0,0 +1,119 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+)
+
+var (
+	ErrInvalidUpgradeTarget = fmt.Errorf("invalid engine version upgrade target")
+)
+
+// UpgradeTarget returns the upgrade target, among the supplied valid upgrade
+// targets returned by DescribeDBEngineVersions, matching the supplied engine
+// version, or an ACK terminal error listing the valid upgrade targets when
+// there is none. An engine version made of only its first components, like
+// 15, matches the upgrade targets it is a prefix of, like 15.4.
+func UpgradeTarget(
+	targets []*svcsdk.UpgradeTarget,
+	version string,
+) (*svcsdk.UpgradeTarget, error) {
+	valid := make([]string, 0, len(targets))
+	for _, target := range targets {
+		if target.EngineVersion == nil {
+			continue
+		}
+		if *target.EngineVersion == version ||
+			strings.HasPrefix(*target.EngineVersion, version+".") {
+			return target, nil
+		}
+		valid = append(valid, *target.EngineVersion)
+	}
+	if len(valid) == 0 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w %s: the engine version cannot be upgraded",
+			ErrInvalidUpgradeTarget, version,
+		))
+	}
+	return nil, ackerr.NewTerminalError(fmt.Errorf(
+		"%w %s: valid upgrade targets are %s",
+		ErrInvalidUpgradeTarget, version, strings.Join(valid, ", "),
+	))
+}
+
+// EngineVersionMatches returns true if the latest engine version of a
+// database of the supplied engine satisfies the desired engine version. The
+// desired engine version is satisfied by itself and by the engine versions it
+// is a prefix of, like 15.4 for 15. When minor engine versions are upgraded
+// automatically, it is also satisfied by the later minor engine versions of
+// the same major engine version.
+func EngineVersionMatches(
+	engine string,
+	desired string,
+	latest string,
+	autoMinorVersionUpgrade bool,
+) bool {
+	if latest == desired || strings.HasPrefix(latest, desired+".") {
+		return true
+	}
+	return autoMinorVersionUpgrade &&
+		!MajorEngineVersionUpgrade(engine, desired, latest) &&
+		CompareEngineVersions(latest, desired) > 0
+}
+
+// LatestEngineVersion returns the supplied engine version when it is one of
+// the supplied engine versions, or else the latest of the supplied engine
+// versions it is a prefix of, like 15.4 for 15. It returns an empty string
+// when there is none.
+func LatestEngineVersion(versions []string, version string) string {
+	latest := ""
+	for _, v := range versions {
+		if v == version {
+			return v
+		}
+		if strings.HasPrefix(v, version+".") &&
+			(latest == "" || CompareEngineVersions(v, latest) > 0) {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// CompareEngineVersions returns a negative number, zero or a positive number
+// when the first engine version is respectively before, the same as or after
+// the second one. The components of the engine versions are compared
+// numerically when they are both numbers, like 15.10 after 15.9.
+func CompareEngineVersions(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		ai, aErr := strconv.Atoi(as[i])
+		bi, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if ai != bi {
+				return ai - bi
+			}
+		case as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	return len(as) - len(bs)
+}
@@ -0,0 +1,131 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestUpgradeTarget(t *testing.T) {
+	targets := []*svcsdk.UpgradeTarget{
+		{EngineVersion: aws.String("14.10"), IsMajorVersionUpgrade: aws.Bool(false)},
+		{EngineVersion: aws.String("15.4"), IsMajorVersionUpgrade: aws.Bool(true)},
+		{EngineVersion: aws.String("16.1"), IsMajorVersionUpgrade: aws.Bool(true)},
+	}
+	tests := []struct {
+		name    string
+		targets []*svcsdk.UpgradeTarget
+		version string
+		want    string
+		wantErr bool
+	}{
+		{"minor", targets, "14.10", "14.10", false},
+		{"major", targets, "15.4", "15.4", false},
+		{"major prefix", targets, "16", "16.1", false},
+		{"not a prefix", targets, "1", "", true},
+		{"not a target", targets, "15.2", "", true},
+		{"no targets", nil, "15.4", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.UpgradeTarget(tt.targets, tt.version)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidUpgradeTarget) {
+					t.Errorf("UpgradeTarget() error = %v, want %v", err, util.ErrInvalidUpgradeTarget)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpgradeTarget() unexpected error = %v", err)
+			}
+			if *got.EngineVersion != tt.want {
+				t.Errorf("UpgradeTarget() = %q, want %q", *got.EngineVersion, tt.want)
+			}
+		})
+	}
+}
+
+func TestEngineVersionMatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		engine      string
+		desired     string
+		latest      string
+		autoUpgrade bool
+		want        bool
+	}{
+		{"same", "postgres", "15.4", "15.4", false, true},
+		{"major prefix", "postgres", "15", "15.4", false, true},
+		{"not a component prefix", "postgres", "1", "15.4", false, false},
+		{"mysql prefix", "mysql", "8.0", "8.0.35", false, true},
+		{"minor upgrade", "postgres", "15.4", "15.5", true, true},
+		{"minor upgrade not automatic", "postgres", "15.4", "15.5", false, false},
+		{"minor downgrade", "postgres", "15.5", "15.4", true, false},
+		{"major upgrade", "postgres", "15.4", "16.1", true, false},
+		{"mysql minor upgrade", "mysql", "8.0.32", "8.0.35", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.EngineVersionMatches(tt.engine, tt.desired, tt.latest, tt.autoUpgrade)
+			if got != tt.want {
+				t.Errorf("EngineVersionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatestEngineVersion(t *testing.T) {
+	versions := []string{"14.10", "15.2", "15.10", "15.4", "16.1"}
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{"exact", "15.4", "15.4"},
+		{"prefix", "15", "15.10"},
+		{"no match", "13", ""},
+		{"not a component prefix", "1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.LatestEngineVersion(versions, tt.version); got != tt.want {
+				t.Errorf("LatestEngineVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareEngineVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"15.10", "15.9", 1},
+		{"15.4", "15.4", 0},
+		{"8.0.mysql_aurora.3.04.0", "8.0.mysql_aurora.3.05.2", -1},
+		{"15", "15.4", -1},
+	}
+	for _, tt := range tests {
+		got := util.CompareEngineVersions(tt.a, tt.b)
+		if got > 0 && tt.want <= 0 || got < 0 && tt.want >= 0 || got == 0 && tt.want != 0 {
+			t.Errorf("CompareEngineVersions(%q, %q) = %d, want sign of %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
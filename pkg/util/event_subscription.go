@@ -0,0 +1,93 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// EventSourceTypeDBInstance is the source type of the event subscriptions
+	// to the events of DB instances
+	EventSourceTypeDBInstance = "db-instance"
+	// EventSourceTypeDBCluster is the source type of the event subscriptions
+	// to the events of DB clusters
+	EventSourceTypeDBCluster = "db-cluster"
+)
+
+var (
+	ErrInvalidEventSubscription = fmt.Errorf("invalid event subscription")
+	ErrUnknownEventCategory     = fmt.Errorf("unknown event category")
+)
+
+// ValidateEventSubscriptionSources returns an ACK terminal error when the
+// supplied event subscription Spec sets source IDs without a source type, or
+// references DB instances or DB clusters with a source type other than
+// db-instance or db-cluster respectively.
+func ValidateEventSubscriptionSources(spec *svcapitypes.EventSubscriptionSpec) error {
+	sourceType := ""
+	if spec.SourceType != nil {
+		sourceType = *spec.SourceType
+	}
+	if len(spec.SourceIDs) > 0 && sourceType == "" {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: sourceType must be set with sourceIDs",
+			ErrInvalidEventSubscription,
+		))
+	}
+	if len(spec.SourceDBInstanceRefs) > 0 && sourceType != EventSourceTypeDBInstance {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: sourceType must be %s with sourceDBInstanceRefs",
+			ErrInvalidEventSubscription, EventSourceTypeDBInstance,
+		))
+	}
+	if len(spec.SourceDBClusterRefs) > 0 && sourceType != EventSourceTypeDBCluster {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: sourceType must be %s with sourceDBClusterRefs",
+			ErrInvalidEventSubscription, EventSourceTypeDBCluster,
+		))
+	}
+	return nil
+}
+
+// ValidateEventCategories returns an ACK terminal error listing the supplied
+// event categories that are not one of the supplied available event
+// categories of the source type of an event subscription.
+func ValidateEventCategories(categories []*string, available []string) error {
+	known := make(map[string]bool, len(available))
+	for _, c := range available {
+		known[c] = true
+	}
+	var unknown []string
+	for _, c := range categories {
+		if c != nil && !known[*c] {
+			unknown = append(unknown, *c)
+		}
+	}
+	if len(unknown) > 0 {
+		// This is a terminal error because unless the user changes the event
+		// categories, RDS will keep rejecting them.
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s (available event categories: %s)",
+			ErrUnknownEventCategory, strings.Join(unknown, ", "),
+			strings.Join(available, ", "),
+		))
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateEventSubscriptionSources(t *testing.T) {
+	refs := []*ackv1alpha1.AWSResourceReferenceWrapper{{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("db")},
+	}}
+	tests := []struct {
+		name    string
+		spec    svcapitypes.EventSubscriptionSpec
+		wantErr bool
+	}{
+		{"no sources", svcapitypes.EventSubscriptionSpec{}, false},
+		{"source type only", svcapitypes.EventSubscriptionSpec{SourceType: aws.String("db-proxy")}, false},
+		{"source IDs with source type", svcapitypes.EventSubscriptionSpec{SourceType: aws.String("db-proxy"), SourceIDs: aws.StringSlice([]string{"proxy"})}, false},
+		{"source IDs without source type", svcapitypes.EventSubscriptionSpec{SourceIDs: aws.StringSlice([]string{"db"})}, true},
+		{"DB instance refs", svcapitypes.EventSubscriptionSpec{SourceType: aws.String("db-instance"), SourceDBInstanceRefs: refs}, false},
+		{"DB instance refs with cluster source type", svcapitypes.EventSubscriptionSpec{SourceType: aws.String("db-cluster"), SourceDBInstanceRefs: refs}, true},
+		{"DB cluster refs", svcapitypes.EventSubscriptionSpec{SourceType: aws.String("db-cluster"), SourceDBClusterRefs: refs}, false},
+		{"DB cluster refs without source type", svcapitypes.EventSubscriptionSpec{SourceDBClusterRefs: refs}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateEventSubscriptionSources(&tt.spec)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateEventSubscriptionSources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, util.ErrInvalidEventSubscription) {
+				t.Errorf("ValidateEventSubscriptionSources() error = %v, want %v", err, util.ErrInvalidEventSubscription)
+			}
+		})
+	}
+}
+
+func TestValidateEventCategories(t *testing.T) {
+	available := []string{"availability", "backup", "failover"}
+	tests := []struct {
+		name       string
+		categories []string
+		wantErr    bool
+	}{
+		{"no categories", nil, false},
+		{"known categories", []string{"backup", "failover"}, false},
+		{"unknown category", []string{"backup", "deletion"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateEventCategories(aws.StringSlice(tt.categories), available)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateEventCategories() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, util.ErrUnknownEventCategory) {
+				t.Errorf("ValidateEventCategories() error = %v, want %v", err, util.ErrUnknownEventCategory)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+const (
+	// ReasonParametersModified is the reason of the Events emitted when the
+	// controller adds or changes parameters of a parameter group
+	ReasonParametersModified = "ParametersModified"
+	// ReasonParametersReset is the reason of the Events emitted when the
+	// controller resets parameters of a parameter group to their defaults
+	ReasonParametersReset = "ParametersReset"
+	// ReasonFinalSnapshot is the reason of the Events emitted when the
+	// controller deletes a DB instance or DB cluster with a final snapshot
+	ReasonFinalSnapshot = "FinalSnapshot"
+)
+
+// RecordParametersModified emits an Event on the supplied object, with the
+// supplied recorder, listing the parameters that were added and changed by a
+// Modify API call. No Event is emitted when the recorder is nil.
+func RecordParametersModified(
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	added []string,
+	changed []string,
+) {
+	var parts []string
+	if len(added) > 0 {
+		parts = append(parts, "added parameters: "+strings.Join(added, ", "))
+	}
+	if len(changed) > 0 {
+		parts = append(parts, "changed parameters: "+strings.Join(changed, ", "))
+	}
+	if len(parts) == 0 {
+		return
+	}
+	recordEvent(recorder, obj, corev1.EventTypeNormal, ReasonParametersModified, strings.Join(parts, "; "))
+}
+
+// RecordParametersReset emits an Event on the supplied object, with the
+// supplied recorder, listing the parameters that were reset to their default
+// values by a Reset API call.
+func RecordParametersReset(
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	reset []string,
+) {
+	if len(reset) == 0 {
+		return
+	}
+	recordEvent(
+		recorder, obj, corev1.EventTypeNormal, ReasonParametersReset,
+		"reset parameters: "+strings.Join(reset, ", "),
+	)
+}
+
+// RecordFinalSnapshot emits an Event on the supplied object, with the supplied
+// recorder, naming the final snapshot taken before its DB instance or DB
+// cluster is deleted.
+func RecordFinalSnapshot(
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	identifier string,
+) {
+	recordEvent(
+		recorder, obj, corev1.EventTypeNormal, ReasonFinalSnapshot,
+		"deleting with final snapshot "+identifier,
+	)
+}
+
+// RecordDeletionBlocked emits a Warning Event on the supplied object, with the
+// supplied recorder, explaining why the deletion of its DB instance or DB
+// cluster is blocked.
+func RecordDeletionBlocked(
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	msg string,
+) {
+	recordEvent(recorder, obj, corev1.EventTypeWarning, ReasonDeletionProtection, msg)
+}
+
+func recordEvent(
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	eventType string,
+	reason string,
+	message string,
+) {
+	if recorder == nil {
+		return
+	}
+	recorder.Event(obj, eventType, reason, message)
+}
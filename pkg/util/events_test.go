@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"k8s.io/client-go/tools/record"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestRecordParametersModified(t *testing.T) {
+	tests := []struct {
+		name    string
+		added   []string
+		changed []string
+		want    string
+	}{
+		{"added and changed", []string{"max_connections"}, []string{"work_mem"},
+			"Normal ParametersModified added parameters: max_connections; changed parameters: work_mem"},
+		{"nothing modified", nil, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			util.RecordParametersModified(recorder, &svcapitypes.DBParameterGroup{}, tt.added, tt.changed)
+			var got string
+			select {
+			case got = <-recorder.Events:
+			default:
+			}
+			if got != tt.want {
+				t.Errorf("RecordParametersModified() event = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	// Without a recorder no Event is emitted
+	util.RecordParametersModified(nil, &svcapitypes.DBParameterGroup{}, []string{"max_connections"}, nil)
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	FleetAdoptionKindDBCluster  = "DBCluster"
+	FleetAdoptionKindDBInstance = "DBInstance"
+	// DefaultFleetAdoptionInterval is how often a fleet adoption lists the
+	// DB instances and DB clusters when its interval is unset
+	DefaultFleetAdoptionInterval = time.Hour
+	// minFleetAdoptionInterval keeps fleet adoptions from exhausting the
+	// DescribeDBInstances and DescribeDBClusters rate limits
+	minFleetAdoptionInterval = time.Minute
+	// ackNamespaceTagKey is the key of the tag ACK controllers set on the AWS
+	// resources they manage, holding the namespace of their resource
+	ackNamespaceTagKey = "services.k8s.aws/namespace"
+)
+
+var (
+	ErrInvalidFleetAdoption = fmt.Errorf("invalid fleet adoption")
+)
+
+// ValidateFleetAdoption returns an ACK terminal error when the supplied fleet
+// adoption has no target namespace or tag filters, a tag filter without key,
+// an unknown kind or an interval below one minute.
+func ValidateFleetAdoption(spec *svcapitypes.FleetAdoptionSpec) error {
+	if spec.TargetNamespace == nil || *spec.TargetNamespace == "" {
+		return newErrInvalidFleetAdoption("targetNamespace is required")
+	}
+	if len(spec.TagFilters) == 0 {
+		return newErrInvalidFleetAdoption("at least one tag filter is required")
+	}
+	for _, filter := range spec.TagFilters {
+		if filter == nil || filter.Key == nil || *filter.Key == "" {
+			return newErrInvalidFleetAdoption("tag filters require a key")
+		}
+	}
+	for _, kind := range spec.Kinds {
+		if kind == nil || (*kind != FleetAdoptionKindDBInstance && *kind != FleetAdoptionKindDBCluster) {
+			return newErrInvalidFleetAdoption(fmt.Sprintf(
+				"kinds must be %s or %s", FleetAdoptionKindDBInstance, FleetAdoptionKindDBCluster,
+			))
+		}
+	}
+	if spec.Interval != nil && spec.Interval.Duration < minFleetAdoptionInterval {
+		return newErrInvalidFleetAdoption("interval must be at least one minute")
+	}
+	return nil
+}
+
+// FleetAdoptionKinds returns the kinds of resources adopted by the supplied
+// fleet adoption
+func FleetAdoptionKinds(spec *svcapitypes.FleetAdoptionSpec) []string {
+	if len(spec.Kinds) == 0 {
+		return []string{FleetAdoptionKindDBInstance, FleetAdoptionKindDBCluster}
+	}
+	kinds := make([]string, 0, len(spec.Kinds))
+	for _, kind := range spec.Kinds {
+		kinds = append(kinds, *kind)
+	}
+	return kinds
+}
+
+// FleetAdoptionInterval returns how often the supplied fleet adoption lists
+// the DB instances and DB clusters
+func FleetAdoptionInterval(spec *svcapitypes.FleetAdoptionSpec) time.Duration {
+	if spec.Interval == nil {
+		return DefaultFleetAdoptionInterval
+	}
+	return spec.Interval.Duration
+}
+
+// FleetAdoptionMatches returns true if the supplied tags of a DB instance or
+// DB cluster match all the supplied tag filters, and do not mark it as
+// already managed by an ACK controller.
+func FleetAdoptionMatches(
+	filters []*svcapitypes.FleetAdoptionTagFilter,
+	tags []*svcsdk.Tag,
+) bool {
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if tag.Key != nil {
+			values[*tag.Key] = aws.StringValue(tag.Value)
+		}
+	}
+	if _, ok := values[ackNamespaceTagKey]; ok {
+		return false
+	}
+	for _, filter := range filters {
+		value, ok := values[*filter.Key]
+		if !ok {
+			return false
+		}
+		if len(filter.Values) > 0 && !containsString(filter.Values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// FleetAdoptedResourceName returns the name of the AdoptedResource adopting
+// the DB instance or DB cluster of the supplied kind and identifier. RDS
+// identifiers are unique per kind only, a DB cluster and one of its DB
+// instances may have the same.
+func FleetAdoptedResourceName(kind string, identifier string) string {
+	return strings.ToLower(kind) + "-" + identifier
+}
+
+// containsString returns true if the supplied values contain the supplied
+// value
+func containsString(values []*string, value string) bool {
+	for _, v := range values {
+		if v != nil && *v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func newErrInvalidFleetAdoption(msg string) error {
+	return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidFleetAdoption, msg))
+}
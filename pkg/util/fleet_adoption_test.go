@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateFleetAdoption(t *testing.T) {
+	filters := []*svcapitypes.FleetAdoptionTagFilter{{Key: aws.String("team")}}
+	tests := []struct {
+		name    string
+		spec    svcapitypes.FleetAdoptionSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: svcapitypes.FleetAdoptionSpec{
+				Kinds:           []*string{aws.String("DBCluster")},
+				TagFilters:      filters,
+				TargetNamespace: aws.String("databases"),
+			},
+		},
+		{
+			name:    "missing target namespace",
+			spec:    svcapitypes.FleetAdoptionSpec{TagFilters: filters},
+			wantErr: true,
+		},
+		{
+			name:    "missing tag filters",
+			spec:    svcapitypes.FleetAdoptionSpec{TargetNamespace: aws.String("databases")},
+			wantErr: true,
+		},
+		{
+			name: "tag filter without key",
+			spec: svcapitypes.FleetAdoptionSpec{
+				TagFilters:      []*svcapitypes.FleetAdoptionTagFilter{{Values: []*string{aws.String("payments")}}},
+				TargetNamespace: aws.String("databases"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown kind",
+			spec: svcapitypes.FleetAdoptionSpec{
+				Kinds:           []*string{aws.String("DBProxy")},
+				TagFilters:      filters,
+				TargetNamespace: aws.String("databases"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "interval too short",
+			spec: svcapitypes.FleetAdoptionSpec{
+				Interval:        &metav1.Duration{Duration: 10 * time.Second},
+				TagFilters:      filters,
+				TargetNamespace: aws.String("databases"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateFleetAdoption(&tt.spec)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidFleetAdoption) {
+				t.Errorf("ValidateFleetAdoption() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFleetAdoptionMatches(t *testing.T) {
+	filters := []*svcapitypes.FleetAdoptionTagFilter{
+		{Key: aws.String("team"), Values: []*string{aws.String("payments"), aws.String("billing")}},
+		{Key: aws.String("onboard")},
+	}
+	tag := func(key, value string) *svcsdk.Tag {
+		return &svcsdk.Tag{Key: aws.String(key), Value: aws.String(value)}
+	}
+	tests := []struct {
+		name string
+		tags []*svcsdk.Tag
+		want bool
+	}{
+		{
+			name: "all filters match",
+			tags: []*svcsdk.Tag{tag("team", "billing"), tag("onboard", "")},
+			want: true,
+		},
+		{
+			name: "value not matching",
+			tags: []*svcsdk.Tag{tag("team", "search"), tag("onboard", "yes")},
+		},
+		{
+			name: "key missing",
+			tags: []*svcsdk.Tag{tag("team", "payments")},
+		},
+		{
+			name: "already managed",
+			tags: []*svcsdk.Tag{
+				tag("team", "payments"), tag("onboard", "yes"),
+				tag("services.k8s.aws/namespace", "databases"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.FleetAdoptionMatches(filters, tt.tags); got != tt.want {
+				t.Errorf("FleetAdoptionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFleetAdoptionKinds(t *testing.T) {
+	tests := []struct {
+		name  string
+		kinds []*string
+		want  []string
+	}{
+		{
+			name: "default",
+			want: []string{"DBInstance", "DBCluster"},
+		},
+		{
+			name:  "clusters only",
+			kinds: []*string{aws.String("DBCluster")},
+			want:  []string{"DBCluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.FleetAdoptionKinds(&svcapitypes.FleetAdoptionSpec{Kinds: tt.kinds})
+			if len(got) != len(tt.want) {
+				t.Fatalf("FleetAdoptionKinds() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("FleetAdoptionKinds() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
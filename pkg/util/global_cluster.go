@@ -0,0 +1,76 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidFailoverTarget = fmt.Errorf("invalid global cluster failover target")
+)
+
+// GlobalClusterFailoverTarget returns the ARN of the secondary DB cluster
+// among the supplied members of a global cluster that the supplied target,
+// a DB cluster ARN or identifier, names, or an ACK terminal error listing the
+// secondary DB clusters when it names none of them.
+func GlobalClusterFailoverTarget(
+	members []*svcapitypes.GlobalClusterMember,
+	target string,
+) (string, error) {
+	secondaries := make([]string, 0, len(members))
+	for _, member := range members {
+		arn := aws.StringValue(member.DBClusterARN)
+		if arn == "" {
+			continue
+		}
+		if arn == target || strings.HasSuffix(arn, ":cluster:"+target) {
+			if aws.BoolValue(member.IsWriter) {
+				return "", ackerr.NewTerminalError(fmt.Errorf(
+					"%w %q: already the primary cluster", ErrInvalidFailoverTarget, target,
+				))
+			}
+			return arn, nil
+		}
+		if !aws.BoolValue(member.IsWriter) {
+			secondaries = append(secondaries, arn)
+		}
+	}
+	if len(secondaries) == 0 {
+		return "", ackerr.NewTerminalError(fmt.Errorf(
+			"%w %q: the global cluster has no secondary clusters", ErrInvalidFailoverTarget, target,
+		))
+	}
+	return "", ackerr.NewTerminalError(fmt.Errorf(
+		"%w %q: secondary clusters are %s",
+		ErrInvalidFailoverTarget, target, strings.Join(secondaries, ", "),
+	))
+}
+
+// GlobalClusterWriter returns the ARN of the primary DB cluster among the
+// supplied members of a global cluster, or an empty string.
+func GlobalClusterWriter(members []*svcapitypes.GlobalClusterMember) string {
+	for _, member := range members {
+		if aws.BoolValue(member.IsWriter) {
+			return aws.StringValue(member.DBClusterARN)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	primaryARN   = "arn:aws:rds:us-east-1:123456789012:cluster:primary"
+	secondaryARN = "arn:aws:rds:us-west-2:123456789012:cluster:secondary"
+)
+
+func TestGlobalClusterFailoverTarget(t *testing.T) {
+	members := []*svcapitypes.GlobalClusterMember{
+		{DBClusterARN: aws.String(primaryARN), IsWriter: aws.Bool(true)},
+		{DBClusterARN: aws.String(secondaryARN), IsWriter: aws.Bool(false)},
+	}
+	tests := []struct {
+		name    string
+		members []*svcapitypes.GlobalClusterMember
+		target  string
+		wantErr bool
+	}{
+		{"ARN", members, secondaryARN, false},
+		{"identifier", members, "secondary", false},
+		{"primary", members, "primary", true},
+		{"not a member", members, "other", true},
+		{"partial identifier", members, "ondary", true},
+		{"no secondaries", members[:1], "secondary", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.GlobalClusterFailoverTarget(tt.members, tt.target)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidFailoverTarget) {
+					t.Errorf("GlobalClusterFailoverTarget() error = %v, want %v", err, util.ErrInvalidFailoverTarget)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GlobalClusterFailoverTarget() unexpected error = %v", err)
+			}
+			if got != secondaryARN {
+				t.Errorf("GlobalClusterFailoverTarget() = %q, want %q", got, secondaryARN)
+			}
+		})
+	}
+}
+
+func TestGlobalClusterWriter(t *testing.T) {
+	members := []*svcapitypes.GlobalClusterMember{
+		{DBClusterARN: aws.String(secondaryARN), IsWriter: aws.Bool(false)},
+		{DBClusterARN: aws.String(primaryARN), IsWriter: aws.Bool(true)},
+	}
+	if got := util.GlobalClusterWriter(members); got != primaryARN {
+		t.Errorf("GlobalClusterWriter() = %q, want %q", got, primaryARN)
+	}
+	if got := util.GlobalClusterWriter(members[:1]); got != "" {
+		t.Errorf("GlobalClusterWriter() = %q, want empty", got)
+	}
+}
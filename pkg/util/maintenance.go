@@ -0,0 +1,93 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// MaintenanceOptInImmediate applies a pending maintenance action immediately
+const MaintenanceOptInImmediate = "immediate"
+
+var (
+	ErrPendingMaintenanceActionNotFound = fmt.Errorf("pending maintenance action not found")
+)
+
+// PendingMaintenanceActions returns the pending maintenance actions of the
+// supplied SDK resources pending maintenance actions, as reported by
+// DescribePendingMaintenanceActions and ApplyPendingMaintenanceAction.
+func PendingMaintenanceActions(
+	resources ...*svcsdk.ResourcePendingMaintenanceActions,
+) []*svcapitypes.PendingMaintenanceAction {
+	var actions []*svcapitypes.PendingMaintenanceAction
+	for _, res := range resources {
+		if res == nil {
+			continue
+		}
+		for _, details := range res.PendingMaintenanceActionDetails {
+			action := &svcapitypes.PendingMaintenanceAction{
+				Action:      details.Action,
+				Description: details.Description,
+				OptInStatus: details.OptInStatus,
+			}
+			if details.AutoAppliedAfterDate != nil {
+				action.AutoAppliedAfterDate = &metav1.Time{Time: *details.AutoAppliedAfterDate}
+			}
+			if details.CurrentApplyDate != nil {
+				action.CurrentApplyDate = &metav1.Time{Time: *details.CurrentApplyDate}
+			}
+			if details.ForcedApplyDate != nil {
+				action.ForcedApplyDate = &metav1.Time{Time: *details.ForcedApplyDate}
+			}
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// PendingMaintenanceAction returns the pending maintenance action with the
+// supplied name among the supplied pending maintenance actions, or an ACK
+// terminal error listing the pending maintenance actions when there is none.
+func PendingMaintenanceAction(
+	actions []*svcapitypes.PendingMaintenanceAction,
+	name string,
+) (*svcapitypes.PendingMaintenanceAction, error) {
+	pending := make([]string, 0, len(actions))
+	for _, action := range actions {
+		if action.Action == nil {
+			continue
+		}
+		if *action.Action == name {
+			return action, nil
+		}
+		pending = append(pending, *action.Action)
+	}
+	if len(pending) == 0 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"%w %q: no maintenance action is pending",
+			ErrPendingMaintenanceActionNotFound, name,
+		))
+	}
+	return nil, ackerr.NewTerminalError(fmt.Errorf(
+		"%w %q: pending maintenance actions are %s",
+		ErrPendingMaintenanceActionNotFound, name, strings.Join(pending, ", "),
+	))
+}
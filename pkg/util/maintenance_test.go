@@ -0,0 +1,87 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestPendingMaintenanceActions(t *testing.T) {
+	forced := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	got := util.PendingMaintenanceActions(
+		&svcsdk.ResourcePendingMaintenanceActions{
+			PendingMaintenanceActionDetails: []*svcsdk.PendingMaintenanceAction{
+				{
+					Action:          aws.String("system-update"),
+					Description:     aws.String("New Operating System update is available"),
+					ForcedApplyDate: &forced,
+				},
+				{Action: aws.String("ca-certificate-rotation")},
+			},
+		},
+		nil,
+	)
+	if len(got) != 2 {
+		t.Fatalf("PendingMaintenanceActions() returned %d actions, want 2", len(got))
+	}
+	if *got[0].Action != "system-update" || !got[0].ForcedApplyDate.Time.Equal(forced) ||
+		got[0].AutoAppliedAfterDate != nil {
+		t.Errorf("PendingMaintenanceActions()[0] = %+v", got[0])
+	}
+	if *got[1].Action != "ca-certificate-rotation" {
+		t.Errorf("PendingMaintenanceActions()[1].Action = %q, want %q", *got[1].Action, "ca-certificate-rotation")
+	}
+}
+
+func TestPendingMaintenanceAction(t *testing.T) {
+	actions := []*svcapitypes.PendingMaintenanceAction{
+		{Action: aws.String("system-update")},
+		{Action: aws.String("ca-certificate-rotation")},
+	}
+	tests := []struct {
+		name    string
+		actions []*svcapitypes.PendingMaintenanceAction
+		action  string
+		wantErr bool
+	}{
+		{"pending", actions, "ca-certificate-rotation", false},
+		{"not pending", actions, "db-upgrade", true},
+		{"nothing pending", nil, "system-update", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.PendingMaintenanceAction(tt.actions, tt.action)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrPendingMaintenanceActionNotFound) {
+					t.Errorf("PendingMaintenanceAction() error = %v, want %v", err, util.ErrPendingMaintenanceActionNotFound)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PendingMaintenanceAction() unexpected error = %v", err)
+			}
+			if *got.Action != tt.action {
+				t.Errorf("PendingMaintenanceAction().Action = %q, want %q", *got.Action, tt.action)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// MinRotationDays and MaxRotationDays bound the number of days between
+	// rotations of a Secrets Manager secret
+	MinRotationDays = 1
+	MaxRotationDays = 1000
+)
+
+var (
+	ErrInvalidMasterUserSecretRotation = fmt.Errorf("invalid master user secret rotation")
+)
+
+// ValidateMasterUserSecretRotation returns an ACK terminal error when the
+// supplied rotation is set without the master user password being managed in
+// Secrets Manager, as requested by the supplied manage flag, or when it does
+// not set exactly one of a number of days and a schedule expression.
+func ValidateMasterUserSecretRotation(
+	manage *bool,
+	rotation *svcapitypes.MasterUserSecretRotation,
+) error {
+	if rotation == nil {
+		return nil
+	}
+	if manage == nil || !*manage {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: the master user password can only be rotated when it is managed in Secrets Manager",
+			ErrInvalidMasterUserSecretRotation,
+		))
+	}
+	if (rotation.AutomaticallyAfterDays == nil) == (rotation.ScheduleExpression == nil) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: exactly one of automaticallyAfterDays and scheduleExpression must be set",
+			ErrInvalidMasterUserSecretRotation,
+		))
+	}
+	if days := rotation.AutomaticallyAfterDays; days != nil && (*days < MinRotationDays || *days > MaxRotationDays) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: automaticallyAfterDays must be between %d and %d, not %d",
+			ErrInvalidMasterUserSecretRotation, MinRotationDays, MaxRotationDays, *days,
+		))
+	}
+	return nil
+}
+
+// MasterUserSecretRotationRules returns the Secrets Manager rotation rules of
+// the supplied rotation.
+func MasterUserSecretRotationRules(
+	rotation *svcapitypes.MasterUserSecretRotation,
+) *svcsdksecretsmanager.RotationRulesType {
+	return &svcsdksecretsmanager.RotationRulesType{
+		AutomaticallyAfterDays: rotation.AutomaticallyAfterDays,
+		ScheduleExpression:     rotation.ScheduleExpression,
+		Duration:               rotation.Duration,
+	}
+}
+
+// MasterUserSecretRotationFromRules returns the rotation described by the
+// supplied Secrets Manager rotation rules, rotating immediately as the
+// supplied flag says, or nil when there are no rules.
+func MasterUserSecretRotationFromRules(
+	rules *svcsdksecretsmanager.RotationRulesType,
+	rotateImmediately *bool,
+) *svcapitypes.MasterUserSecretRotation {
+	if rules == nil {
+		return nil
+	}
+	return &svcapitypes.MasterUserSecretRotation{
+		AutomaticallyAfterDays: rules.AutomaticallyAfterDays,
+		ScheduleExpression:     rules.ScheduleExpression,
+		Duration:               rules.Duration,
+		RotateImmediately:      rotateImmediately,
+	}
+}
+
+// MasterUserSecretRotationDiffers returns true if the supplied desired
+// rotation schedule differs from the supplied latest one. Only the fields the
+// desired rotation sets are compared, since Secrets Manager may report a
+// schedule both as a number of days and as a rate() expression, along with
+// the window it picks.
+func MasterUserSecretRotationDiffers(
+	desired *svcapitypes.MasterUserSecretRotation,
+	latest *svcapitypes.MasterUserSecretRotation,
+) bool {
+	if desired == nil {
+		return false
+	}
+	if latest == nil {
+		return true
+	}
+	differs := func(a, b *string) bool {
+		return a != nil && (b == nil || *a != *b)
+	}
+	if desired.AutomaticallyAfterDays != nil &&
+		(latest.AutomaticallyAfterDays == nil || *desired.AutomaticallyAfterDays != *latest.AutomaticallyAfterDays) {
+		return true
+	}
+	return differs(desired.ScheduleExpression, latest.ScheduleExpression) ||
+		differs(desired.Duration, latest.Duration)
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateMasterUserSecretRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		manage   *bool
+		rotation *svcapitypes.MasterUserSecretRotation
+		wantErr  bool
+	}{
+		{"no rotation", nil, nil, false},
+		{"days", aws.Bool(true), &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(30)}, false},
+		{
+			"schedule expression",
+			aws.Bool(true),
+			&svcapitypes.MasterUserSecretRotation{ScheduleExpression: aws.String("cron(0 4 ? * SUN *)"), Duration: aws.String("3h")},
+			false,
+		},
+		{"not managed", nil, &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(30)}, true},
+		{"no schedule", aws.Bool(true), &svcapitypes.MasterUserSecretRotation{RotateImmediately: aws.Bool(true)}, true},
+		{
+			"days and schedule expression",
+			aws.Bool(true),
+			&svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(30), ScheduleExpression: aws.String("rate(30 days)")},
+			true,
+		},
+		{"too many days", aws.Bool(true), &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(1001)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateMasterUserSecretRotation(tt.manage, tt.rotation)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidMasterUserSecretRotation) {
+				t.Errorf("ValidateMasterUserSecretRotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMasterUserSecretRotationFromRules(t *testing.T) {
+	rules := &svcsdksecretsmanager.RotationRulesType{
+		AutomaticallyAfterDays: aws.Int64(7),
+		ScheduleExpression:     aws.String("rate(7 days)"),
+		Duration:               aws.String("4h"),
+	}
+	want := &svcapitypes.MasterUserSecretRotation{
+		AutomaticallyAfterDays: aws.Int64(7),
+		ScheduleExpression:     aws.String("rate(7 days)"),
+		Duration:               aws.String("4h"),
+		RotateImmediately:      aws.Bool(true),
+	}
+	if got := util.MasterUserSecretRotationFromRules(rules, aws.Bool(true)); !reflect.DeepEqual(got, want) {
+		t.Errorf("MasterUserSecretRotationFromRules() = %v, want %v", got, want)
+	}
+	if got := util.MasterUserSecretRotationFromRules(nil, aws.Bool(true)); got != nil {
+		t.Errorf("MasterUserSecretRotationFromRules(nil) = %v, want nil", got)
+	}
+}
+
+func TestMasterUserSecretRotationDiffers(t *testing.T) {
+	latest := &svcapitypes.MasterUserSecretRotation{
+		AutomaticallyAfterDays: aws.Int64(7),
+		ScheduleExpression:     aws.String("rate(7 days)"),
+		Duration:               aws.String("4h"),
+	}
+	tests := []struct {
+		name    string
+		desired *svcapitypes.MasterUserSecretRotation
+		latest  *svcapitypes.MasterUserSecretRotation
+		want    bool
+	}{
+		{"no desired rotation", nil, latest, false},
+		{"same days", &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(7)}, latest, false},
+		{"other days", &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(30)}, latest, true},
+		{"same schedule expression", &svcapitypes.MasterUserSecretRotation{ScheduleExpression: aws.String("rate(7 days)")}, latest, false},
+		{"other schedule expression", &svcapitypes.MasterUserSecretRotation{ScheduleExpression: aws.String("cron(0 4 ? * SUN *)")}, latest, true},
+		{
+			"other duration",
+			&svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(7), Duration: aws.String("2h")},
+			latest,
+			true,
+		},
+		{"rotate immediately is not compared", &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(7), RotateImmediately: aws.Bool(true)}, latest, false},
+		{"no latest rotation", &svcapitypes.MasterUserSecretRotation{AutomaticallyAfterDays: aws.Int64(7)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.MasterUserSecretRotationDiffers(tt.desired, tt.latest); got != tt.want {
+				t.Errorf("MasterUserSecretRotationDiffers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+)
+
+var (
+	ErrInvalidModification            = fmt.Errorf("invalid modification")
+	ErrDedicatedLogVolumeNotSupported = fmt.Errorf("dedicated log volume not supported")
+
+	ErrIAMDatabaseAuthenticationNotSupported = fmt.Errorf("IAM database authentication not supported")
+)
+
+// StorageModification describes the storage a DB instance is modified to.
+// IOPS and StorageThroughput are nil when they are not modified.
+type StorageModification struct {
+	StorageType       string
+	AllocatedStorage  int64
+	IOPS              *int64
+	StorageThroughput *int64
+}
+
+// ValidateStorageModification returns an ACK terminal error listing the valid
+// options when the supplied storage modification is not one of the supplied
+// valid storage options, as returned by DescribeValidDBInstanceModifications.
+func ValidateStorageModification(
+	options []*svcsdk.ValidStorageOptions,
+	modification StorageModification,
+) error {
+	var storageTypes []string
+	for _, option := range options {
+		if option.StorageType == nil {
+			continue
+		}
+		if *option.StorageType != modification.StorageType {
+			storageTypes = append(storageTypes, *option.StorageType)
+			continue
+		}
+		if !inRanges(option.StorageSize, modification.AllocatedStorage) {
+			return invalidModification(
+				"allocated storage %d GiB is not valid for storage type %s, valid allocated storage is %s GiB",
+				modification.AllocatedStorage, modification.StorageType,
+				formatRanges(option.StorageSize),
+			)
+		}
+		if modification.IOPS != nil && len(option.ProvisionedIops) > 0 &&
+			!inRanges(option.ProvisionedIops, *modification.IOPS) {
+			return invalidModification(
+				"%d IOPS are not valid for storage type %s, valid IOPS are %s",
+				*modification.IOPS, modification.StorageType,
+				formatRanges(option.ProvisionedIops),
+			)
+		}
+		if modification.StorageThroughput != nil && len(option.ProvisionedStorageThroughput) > 0 &&
+			!inRanges(option.ProvisionedStorageThroughput, *modification.StorageThroughput) {
+			return invalidModification(
+				"storage throughput %d MiBps is not valid for storage type %s, valid storage throughput is %s MiBps",
+				*modification.StorageThroughput, modification.StorageType,
+				formatRanges(option.ProvisionedStorageThroughput),
+			)
+		}
+		return nil
+	}
+	sort.Strings(storageTypes)
+	return invalidModification(
+		"storage type %s is not valid, valid storage types are %s",
+		modification.StorageType, strings.Join(storageTypes, ", "),
+	)
+}
+
+// ValidateDBInstanceClassModification returns an ACK terminal error listing
+// the supplied orderable DB instance classes when they do not include the
+// supplied DB instance class.
+func ValidateDBInstanceClassModification(class string, orderable []string) error {
+	for _, c := range orderable {
+		if c == class {
+			return nil
+		}
+	}
+	sorted := append([]string(nil), orderable...)
+	sort.Strings(sorted)
+	return invalidModification(
+		"DB instance class %s is not valid, valid DB instance classes are %s",
+		class, strings.Join(sorted, ", "),
+	)
+}
+
+// ValidateDedicatedLogVolume returns an ACK terminal error when none of the
+// supplied orderable options, as returned by DescribeOrderableDBInstanceOptions
+// for the engine, engine version and DB instance class of a DB instance,
+// supports a dedicated log volume with the supplied storage type. Any storage
+// type is accepted when the supplied one is empty, and no error is returned
+// when there are no orderable options, since the DB instance class is then
+// rejected on its own.
+func ValidateDedicatedLogVolume(
+	options []*svcsdk.OrderableDBInstanceOption,
+	storageType string,
+) error {
+	if len(options) == 0 {
+		return nil
+	}
+	var storageTypes []string
+	seen := map[string]bool{}
+	for _, option := range options {
+		if option.SupportsDedicatedLogVolume == nil || !*option.SupportsDedicatedLogVolume ||
+			option.StorageType == nil {
+			continue
+		}
+		if storageType == "" || *option.StorageType == storageType {
+			return nil
+		}
+		if !seen[*option.StorageType] {
+			seen[*option.StorageType] = true
+			storageTypes = append(storageTypes, *option.StorageType)
+		}
+	}
+	option := options[0]
+	if len(storageTypes) == 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w by engine %s version %s with DB instance class %s",
+			ErrDedicatedLogVolumeNotSupported, aws.StringValue(option.Engine),
+			aws.StringValue(option.EngineVersion), aws.StringValue(option.DBInstanceClass),
+		))
+	}
+	sort.Strings(storageTypes)
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w with storage type %s, supported storage types are %s",
+		ErrDedicatedLogVolumeNotSupported, storageType, strings.Join(storageTypes, ", "),
+	))
+}
+
+// ValidateIAMDatabaseAuthentication returns an ACK terminal error when none of
+// the supplied orderable options, as returned by
+// DescribeOrderableDBInstanceOptions for the engine and engine version of a DB
+// instance or DB cluster, supports IAM database authentication. No error is
+// returned when there are no orderable options, since the engine version is
+// then rejected on its own.
+func ValidateIAMDatabaseAuthentication(
+	options []*svcsdk.OrderableDBInstanceOption,
+) error {
+	if len(options) == 0 {
+		return nil
+	}
+	for _, option := range options {
+		if option.SupportsIAMDatabaseAuthentication != nil && *option.SupportsIAMDatabaseAuthentication {
+			return nil
+		}
+	}
+	option := options[0]
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w by engine %s version %s",
+		ErrIAMDatabaseAuthenticationNotSupported, aws.StringValue(option.Engine),
+		aws.StringValue(option.EngineVersion),
+	))
+}
+
+// invalidModification returns an ACK terminal error wrapping
+// ErrInvalidModification with the supplied formatted message.
+func invalidModification(format string, args ...interface{}) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s", ErrInvalidModification, fmt.Sprintf(format, args...),
+	))
+}
+
+// inRanges returns true if the supplied value is in one of the supplied
+// ranges, taking their steps into account.
+func inRanges(ranges []*svcsdk.Range, value int64) bool {
+	for _, r := range ranges {
+		if r.From == nil || r.To == nil || value < *r.From || value > *r.To {
+			continue
+		}
+		if r.Step == nil || *r.Step <= 1 || (value-*r.From)%*r.Step == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// formatRanges returns a human readable list of the supplied ranges.
+func formatRanges(ranges []*svcsdk.Range) string {
+	formatted := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		if r.From == nil || r.To == nil {
+			continue
+		}
+		s := fmt.Sprintf("%d-%d", *r.From, *r.To)
+		if *r.From == *r.To {
+			s = fmt.Sprintf("%d", *r.From)
+		}
+		if r.Step != nil && *r.Step > 1 {
+			s += fmt.Sprintf(" in steps of %d", *r.Step)
+		}
+		formatted = append(formatted, s)
+	}
+	return strings.Join(formatted, ", ")
+}
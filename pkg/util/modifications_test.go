@@ -0,0 +1,206 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateStorageModification(t *testing.T) {
+	options := []*svcsdk.ValidStorageOptions{
+		{
+			StorageType: aws.String("gp3"),
+			StorageSize: []*svcsdk.Range{
+				{From: aws.Int64(100), To: aws.Int64(65536), Step: aws.Int64(1)},
+			},
+			ProvisionedIops: []*svcsdk.Range{
+				{From: aws.Int64(12000), To: aws.Int64(64000), Step: aws.Int64(1)},
+			},
+			ProvisionedStorageThroughput: []*svcsdk.Range{
+				{From: aws.Int64(500), To: aws.Int64(4000), Step: aws.Int64(1)},
+			},
+		},
+		{
+			StorageType: aws.String("io1"),
+			StorageSize: []*svcsdk.Range{
+				{From: aws.Int64(100), To: aws.Int64(1000), Step: aws.Int64(100)},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		modification util.StorageModification
+		wantErr      string
+	}{
+		{
+			"valid",
+			util.StorageModification{StorageType: "gp3", AllocatedStorage: 500, IOPS: aws.Int64(12000)},
+			"",
+		},
+		{
+			"unchanged IOPS and throughput",
+			util.StorageModification{StorageType: "gp3", AllocatedStorage: 500},
+			"",
+		},
+		{
+			"invalid storage type",
+			util.StorageModification{StorageType: "gp2", AllocatedStorage: 500},
+			"valid storage types are gp3, io1",
+		},
+		{
+			"storage too small",
+			util.StorageModification{StorageType: "gp3", AllocatedStorage: 50},
+			"valid allocated storage is 100-65536 GiB",
+		},
+		{
+			"storage off step",
+			util.StorageModification{StorageType: "io1", AllocatedStorage: 150},
+			"valid allocated storage is 100-1000 in steps of 100 GiB",
+		},
+		{
+			"IOPS too low",
+			util.StorageModification{StorageType: "gp3", AllocatedStorage: 500, IOPS: aws.Int64(3000)},
+			"valid IOPS are 12000-64000",
+		},
+		{
+			"throughput too low",
+			util.StorageModification{StorageType: "gp3", AllocatedStorage: 500, StorageThroughput: aws.Int64(125)},
+			"valid storage throughput is 500-4000 MiBps",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateStorageModification(options, tt.modification)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateStorageModification() unexpected error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, util.ErrInvalidModification) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateStorageModification() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDBInstanceClassModification(t *testing.T) {
+	orderable := []string{"db.r6g.large", "db.m6g.large"}
+	if err := util.ValidateDBInstanceClassModification("db.m6g.large", orderable); err != nil {
+		t.Errorf("ValidateDBInstanceClassModification() unexpected error = %v", err)
+	}
+	err := util.ValidateDBInstanceClassModification("db.t2.micro", orderable)
+	want := "valid DB instance classes are db.m6g.large, db.r6g.large"
+	if !errors.Is(err, util.ErrInvalidModification) || !strings.Contains(err.Error(), want) {
+		t.Errorf("ValidateDBInstanceClassModification() error = %v, want %q", err, want)
+	}
+}
+
+func TestValidateDedicatedLogVolume(t *testing.T) {
+	option := func(storageType string, supported bool) *svcsdk.OrderableDBInstanceOption {
+		return &svcsdk.OrderableDBInstanceOption{
+			DBInstanceClass:            aws.String("db.m6i.large"),
+			Engine:                     aws.String("postgres"),
+			EngineVersion:              aws.String("16.1"),
+			StorageType:                aws.String(storageType),
+			SupportsDedicatedLogVolume: aws.Bool(supported),
+		}
+	}
+	options := []*svcsdk.OrderableDBInstanceOption{
+		option("gp2", false),
+		option("io1", true),
+		option("io2", true),
+	}
+	tests := []struct {
+		name        string
+		options     []*svcsdk.OrderableDBInstanceOption
+		storageType string
+		wantErr     string
+	}{
+		{"supported storage type", options, "io2", ""},
+		{"any storage type", options, "", ""},
+		{"no orderable options", nil, "io1", ""},
+		{"unsupported storage type", options, "gp2", "supported storage types are io1, io2"},
+		{
+			"unsupported DB instance class",
+			[]*svcsdk.OrderableDBInstanceOption{option("gp2", false), option("io1", false)},
+			"",
+			"by engine postgres version 16.1 with DB instance class db.m6i.large",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateDedicatedLogVolume(tt.options, tt.storageType)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateDedicatedLogVolume() unexpected error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, util.ErrDedicatedLogVolumeNotSupported) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateDedicatedLogVolume() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateIAMDatabaseAuthentication(t *testing.T) {
+	option := func(instanceClass string, supported bool) *svcsdk.OrderableDBInstanceOption {
+		return &svcsdk.OrderableDBInstanceOption{
+			DBInstanceClass:                   aws.String(instanceClass),
+			Engine:                            aws.String("mariadb"),
+			EngineVersion:                     aws.String("10.6.16"),
+			SupportsIAMDatabaseAuthentication: aws.Bool(supported),
+		}
+	}
+	tests := []struct {
+		name    string
+		options []*svcsdk.OrderableDBInstanceOption
+		wantErr string
+	}{
+		{"supported", []*svcsdk.OrderableDBInstanceOption{option("db.m6i.large", true)}, ""},
+		{
+			"supported by some DB instance classes",
+			[]*svcsdk.OrderableDBInstanceOption{option("db.t3.micro", false), option("db.m6i.large", true)},
+			"",
+		},
+		{"no orderable options", nil, ""},
+		{
+			"not supported",
+			[]*svcsdk.OrderableDBInstanceOption{option("db.t3.micro", false), option("db.m6i.large", false)},
+			"by engine mariadb version 10.6.16",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateIAMDatabaseAuthentication(tt.options)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateIAMDatabaseAuthentication() unexpected error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, util.ErrIAMDatabaseAuthenticationNotSupported) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateIAMDatabaseAuthentication() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"slices"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidMultiAZCluster = fmt.Errorf("invalid Multi-AZ DB cluster")
+
+	// multiAZClusterEngines are the engines of the Multi-AZ DB clusters, the
+	// RDS for MySQL and RDS for PostgreSQL DB clusters of one writer and two
+	// readable standby DB instances
+	multiAZClusterEngines = []string{"mysql", "postgres"}
+	// multiAZClusterStorageTypes are the storage types of the Multi-AZ DB
+	// clusters
+	multiAZClusterStorageTypes = []string{"io1", "io2", "gp3"}
+	// provisionedIOPSStorageTypes are the storage types requiring the amount
+	// of Provisioned IOPS
+	provisionedIOPSStorageTypes = []string{"io1", "io2"}
+)
+
+// MultiAZCluster returns true if a DB cluster with the supplied engine is a
+// Multi-AZ DB cluster rather than an Aurora DB cluster.
+func MultiAZCluster(engine string) bool {
+	return slices.Contains(multiAZClusterEngines, engine)
+}
+
+// ValidateMultiAZCluster returns an ACK terminal error when the supplied Spec
+// of a Multi-AZ DB cluster lacks its DB instance class, allocated storage,
+// storage type or, for the Provisioned IOPS storage types, its IOPS, or sets
+// up Aurora features: an engine mode other than provisioned, an Aurora
+// Serverless v2 scaling configuration or member DB instances, which RDS
+// creates itself. The Specs of Aurora DB clusters are valid.
+func ValidateMultiAZCluster(spec *svcapitypes.DBClusterSpec) error {
+	if spec.Engine == nil || !MultiAZCluster(*spec.Engine) {
+		return nil
+	}
+	invalid := func(msg string) error {
+		return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidMultiAZCluster, msg))
+	}
+	if spec.DBClusterInstanceClass == nil || *spec.DBClusterInstanceClass == "" {
+		return invalid("dbClusterInstanceClass must be set")
+	}
+	if spec.AllocatedStorage == nil {
+		return invalid("allocatedStorage must be set")
+	}
+	if spec.StorageType == nil {
+		return invalid("storageType must be set")
+	}
+	if !slices.Contains(multiAZClusterStorageTypes, *spec.StorageType) {
+		return invalid(fmt.Sprintf(
+			"storage type %q, expected one of %q", *spec.StorageType, multiAZClusterStorageTypes,
+		))
+	}
+	if slices.Contains(provisionedIOPSStorageTypes, *spec.StorageType) && spec.IOPS == nil {
+		return invalid(fmt.Sprintf("iops must be set with storage type %q", *spec.StorageType))
+	}
+	if spec.EngineMode != nil && *spec.EngineMode != "provisioned" {
+		return invalid(fmt.Sprintf("engine mode %q, expected provisioned", *spec.EngineMode))
+	}
+	if spec.ServerlessV2ScalingConfiguration != nil {
+		return invalid("serverlessV2ScalingConfiguration is only supported by Aurora")
+	}
+	if spec.Instances != nil {
+		return invalid("instances are only supported by Aurora, RDS creates the DB instances of Multi-AZ DB clusters")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateMultiAZCluster(t *testing.T) {
+	multiAZ := func(update func(*svcapitypes.DBClusterSpec)) *svcapitypes.DBClusterSpec {
+		spec := &svcapitypes.DBClusterSpec{
+			Engine:                 aws.String("postgres"),
+			DBClusterInstanceClass: aws.String("db.m6gd.large"),
+			AllocatedStorage:       aws.Int64(100),
+			StorageType:            aws.String("io1"),
+			IOPS:                   aws.Int64(1000),
+		}
+		update(spec)
+		return spec
+	}
+	tests := []struct {
+		name    string
+		spec    *svcapitypes.DBClusterSpec
+		wantErr bool
+	}{
+		{"aurora", &svcapitypes.DBClusterSpec{Engine: aws.String("aurora-postgresql")}, false},
+		{"aurora with instances", &svcapitypes.DBClusterSpec{
+			Engine:    aws.String("aurora-mysql"),
+			Instances: &svcapitypes.DBClusterInstances{Count: aws.Int64(2)},
+		}, false},
+		{"multi-az", multiAZ(func(*svcapitypes.DBClusterSpec) {}), false},
+		{"gp3 without iops", multiAZ(func(s *svcapitypes.DBClusterSpec) {
+			s.StorageType = aws.String("gp3")
+			s.IOPS = nil
+		}), false},
+		{"provisioned engine mode", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.EngineMode = aws.String("provisioned") }), false},
+		{"no instance class", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.DBClusterInstanceClass = nil }), true},
+		{"no allocated storage", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.AllocatedStorage = nil }), true},
+		{"no storage type", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.StorageType = nil }), true},
+		{"aurora storage type", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.StorageType = aws.String("aurora-iopt1") }), true},
+		{"io2 without iops", multiAZ(func(s *svcapitypes.DBClusterSpec) {
+			s.StorageType = aws.String("io2")
+			s.IOPS = nil
+		}), true},
+		{"serverless engine mode", multiAZ(func(s *svcapitypes.DBClusterSpec) { s.EngineMode = aws.String("serverless") }), true},
+		{"serverless v2", multiAZ(func(s *svcapitypes.DBClusterSpec) {
+			s.ServerlessV2ScalingConfiguration = &svcapitypes.ServerlessV2ScalingConfiguration{}
+		}), true},
+		{"instances", multiAZ(func(s *svcapitypes.DBClusterSpec) {
+			s.Instances = &svcapitypes.DBClusterInstances{Count: aws.Int64(3)}
+		}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateMultiAZCluster(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateMultiAZCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, util.ErrInvalidMultiAZCluster) {
+				t.Errorf("ValidateMultiAZCluster() error = %v, want ErrInvalidMultiAZCluster", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+const (
+	// NetworkTypeIPv4 is the network type of DB instances and DB clusters
+	// reachable over IPv4 only
+	NetworkTypeIPv4 = "IPV4"
+	// NetworkTypeDual is the network type of DB instances and DB clusters
+	// reachable over both IPv4 and IPv6
+	NetworkTypeDual = "DUAL"
+)
+
+var (
+	ErrInvalidNetworkType = fmt.Errorf("invalid network type")
+)
+
+// ValidateNetworkType returns an ACK terminal error when the supplied network
+// type is neither IPV4 nor DUAL, or when it is not one of the supplied network
+// types supported by the DB subnet group with the supplied name. The
+// supported network types are not checked when there are none.
+func ValidateNetworkType(
+	networkType string,
+	subnetGroupName string,
+	supported []*string,
+) error {
+	if networkType != NetworkTypeIPv4 && networkType != NetworkTypeDual {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %q must be either %s or %s",
+			ErrInvalidNetworkType, networkType, NetworkTypeIPv4, NetworkTypeDual,
+		))
+	}
+	if len(supported) == 0 {
+		return nil
+	}
+	types := make([]string, 0, len(supported))
+	for _, t := range supported {
+		if t == nil {
+			continue
+		}
+		if *t == networkType {
+			return nil
+		}
+		types = append(types, *t)
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: DB subnet group %s does not support network type %s, supported network types are %s",
+		ErrInvalidNetworkType, subnetGroupName, networkType, strings.Join(types, ", "),
+	))
+}
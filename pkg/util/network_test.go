@@ -0,0 +1,55 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateNetworkType(t *testing.T) {
+	ipv4Only := []*string{aws.String("IPV4")}
+	dualStack := []*string{aws.String("IPV4"), aws.String("DUAL")}
+	tests := []struct {
+		name        string
+		networkType string
+		supported   []*string
+		wantErr     bool
+	}{
+		{"ipv4", "IPV4", ipv4Only, false},
+		{"dual stack", "DUAL", dualStack, false},
+		{"unknown supported network types", "DUAL", nil, false},
+		{"dual stack not supported", "DUAL", ipv4Only, true},
+		{"invalid network type", "IPV6", dualStack, true},
+		{"lower case", "dual", dualStack, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateNetworkType(tt.networkType, "my-subnet-group", tt.supported)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidNetworkType) {
+					t.Errorf("ValidateNetworkType() error = %v, want %v", err, util.ErrInvalidNetworkType)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateNetworkType() unexpected error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrUnknownOptionVersion   = fmt.Errorf("unknown option version")
+	ErrOptionVersionDowngrade = fmt.Errorf("option version downgrade not supported")
+)
+
+// OptionsDelta returns the desired options of an option group that are
+// missing from the latest options of the option group or differ from them,
+// and the names of the latest options missing from the desired options.
+// Permanent options are never returned for removal. Only the fields set in
+// the desired options are compared, and the desired options present in the
+// latest options only hold their changed settings, see
+// GetOptionSettingsDifference. A changed option version is sent along with
+// the option, which upgrades the option in place.
+func OptionsDelta(
+	desired []*svcapitypes.OptionConfiguration,
+	latest []*svcapitypes.Option,
+) (toInclude []*svcapitypes.OptionConfiguration, toRemove []*string) {
+	latestByName := map[string]*svcapitypes.Option{}
+	for _, o := range latest {
+		if o.OptionName != nil {
+			latestByName[*o.OptionName] = o
+		}
+	}
+	desiredNames := map[string]bool{}
+	for _, d := range desired {
+		if d.OptionName == nil {
+			continue
+		}
+		desiredNames[*d.OptionName] = true
+		l, ok := latestByName[*d.OptionName]
+		if !ok {
+			toInclude = append(toInclude, d)
+			continue
+		}
+		changed, _ := GetOptionSettingsDifference(d.OptionSettings, l.OptionSettings)
+		if len(changed) > 0 || optionDiffers(d, l) {
+			include := *d
+			include.OptionSettings = changed
+			toInclude = append(toInclude, &include)
+		}
+	}
+	for _, l := range latest {
+		if l.OptionName == nil || desiredNames[*l.OptionName] {
+			continue
+		}
+		if l.Permanent != nil && *l.Permanent {
+			continue
+		}
+		toRemove = append(toRemove, l.OptionName)
+	}
+	return toInclude, toRemove
+}
+
+// optionDiffers returns true if a field other than the option settings set
+// in the supplied desired option differs from the supplied latest option.
+func optionDiffers(
+	desired *svcapitypes.OptionConfiguration,
+	latest *svcapitypes.Option,
+) bool {
+	if desired.OptionVersion != nil &&
+		(latest.OptionVersion == nil || *desired.OptionVersion != *latest.OptionVersion) {
+		return true
+	}
+	if desired.Port != nil &&
+		(latest.Port == nil || *desired.Port != *latest.Port) {
+		return true
+	}
+	if desired.VPCSecurityGroupMemberships != nil {
+		latestGroups := []*string{}
+		for _, m := range latest.VPCSecurityGroupMemberships {
+			latestGroups = append(latestGroups, m.VPCSecurityGroupID)
+		}
+		if !EqualStringSets(desired.VPCSecurityGroupMemberships, latestGroups) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOptionSettingsDifference compares two lists of option settings and
+// returns the settings of the "to" list whose value differs from the "from"
+// list or is missing from it, and the settings of the "to" list with the same
+// value in the "from" list. Settings without a value in the "to" list are
+// ignored, and the settings missing from the "to" list keep their value.
+//
+// Setting names are matched case-insensitively and setting values are
+// compared like parameter values, see NormalizeParameterValue.
+func GetOptionSettingsDifference(
+	to, from []*svcapitypes.OptionSetting,
+) (changed, unchanged []*svcapitypes.OptionSetting) {
+	fromByName := make(map[string]*svcapitypes.OptionSetting, len(from))
+	for _, s := range from {
+		if s.Name != nil {
+			fromByName[strings.ToLower(*s.Name)] = s
+		}
+	}
+	for _, s := range to {
+		if s.Name == nil || s.Value == nil {
+			continue
+		}
+		fromSetting, found := fromByName[strings.ToLower(*s.Name)]
+		if found && parameterValueEqual(s.Value, fromSetting.Value) {
+			unchanged = append(unchanged, s)
+		} else {
+			changed = append(changed, s)
+		}
+	}
+	return changed, unchanged
+}
+
+// ValidateOptionVersion returns an ACK terminal error when the supplied
+// version of the supplied option is not one of the supplied available
+// versions of the option, or when it is before the supplied latest version of
+// the option and the option does not support version downgrades. An empty
+// latest version means the option is not in the option group yet.
+func ValidateOptionVersion(
+	name string,
+	version string,
+	latestVersion string,
+	versions []string,
+	supportsDowngrade bool,
+) error {
+	found := false
+	for _, v := range versions {
+		if v == version {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// This is a terminal error because unless the user changes the
+		// version of this option, RDS will keep rejecting it.
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s %s (available versions: %s)",
+			ErrUnknownOptionVersion, name, version, strings.Join(versions, ", "),
+		))
+	}
+	if latestVersion != "" && !supportsDowngrade &&
+		CompareEngineVersions(version, latestVersion) < 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s %s to %s",
+			ErrOptionVersionDowngrade, name, latestVersion, version,
+		))
+	}
+	return nil
+}
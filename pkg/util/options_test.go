@@ -0,0 +1,251 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestOptionsDelta(t *testing.T) {
+	timezone := &svcapitypes.Option{
+		OptionName: aws.String("Timezone"),
+		Permanent:  aws.Bool(true),
+		OptionSettings: []*svcapitypes.OptionSetting{
+			{Name: aws.String("TIME_ZONE"), Value: aws.String("UTC")},
+		},
+	}
+	oem := &svcapitypes.Option{
+		OptionName: aws.String("OEM"),
+		Port:       aws.Int64(5500),
+		VPCSecurityGroupMemberships: []*svcapitypes.VPCSecurityGroupMembership{
+			{VPCSecurityGroupID: aws.String("sg-1"), Status: aws.String("active")},
+			{VPCSecurityGroupID: aws.String("sg-2"), Status: aws.String("active")},
+		},
+	}
+	tests := []struct {
+		name        string
+		desired     []*svcapitypes.OptionConfiguration
+		latest      []*svcapitypes.Option
+		wantInclude []string
+		wantRemove  []string
+	}{
+		{
+			name:    "no options",
+			desired: nil,
+			latest:  nil,
+		},
+		{
+			name:        "new option",
+			desired:     []*svcapitypes.OptionConfiguration{{OptionName: aws.String("OEM")}},
+			latest:      nil,
+			wantInclude: []string{"OEM"},
+		},
+		{
+			name:       "removed option",
+			desired:    nil,
+			latest:     []*svcapitypes.Option{oem},
+			wantRemove: []string{"OEM"},
+		},
+		{
+			name:    "permanent option is not removed",
+			desired: nil,
+			latest:  []*svcapitypes.Option{timezone},
+		},
+		{
+			name: "same option, unset fields ignored",
+			desired: []*svcapitypes.OptionConfiguration{{
+				OptionName:                  aws.String("OEM"),
+				VPCSecurityGroupMemberships: aws.StringSlice([]string{"sg-2", "sg-1"}),
+			}},
+			latest: []*svcapitypes.Option{oem},
+		},
+		{
+			name: "different port",
+			desired: []*svcapitypes.OptionConfiguration{{
+				OptionName: aws.String("OEM"),
+				Port:       aws.Int64(5501),
+			}},
+			latest:      []*svcapitypes.Option{oem},
+			wantInclude: []string{"OEM"},
+		},
+		{
+			name: "different security groups",
+			desired: []*svcapitypes.OptionConfiguration{{
+				OptionName:                  aws.String("OEM"),
+				VPCSecurityGroupMemberships: aws.StringSlice([]string{"sg-1"}),
+			}},
+			latest:      []*svcapitypes.Option{oem},
+			wantInclude: []string{"OEM"},
+		},
+		{
+			name: "different setting",
+			desired: []*svcapitypes.OptionConfiguration{{
+				OptionName: aws.String("Timezone"),
+				OptionSettings: []*svcapitypes.OptionSetting{
+					{Name: aws.String("TIME_ZONE"), Value: aws.String("Europe/Paris")},
+				},
+			}},
+			latest:      []*svcapitypes.Option{timezone},
+			wantInclude: []string{"Timezone"},
+		},
+		{
+			name: "replaced option",
+			desired: []*svcapitypes.OptionConfiguration{{
+				OptionName: aws.String("Timezone"),
+				OptionSettings: []*svcapitypes.OptionSetting{
+					{Name: aws.String("TIME_ZONE"), Value: aws.String("UTC")},
+				},
+			}, {
+				OptionName: aws.String("STATSPACK"),
+			}},
+			latest:      []*svcapitypes.Option{timezone, oem},
+			wantInclude: []string{"STATSPACK"},
+			wantRemove:  []string{"OEM"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toInclude, toRemove := util.OptionsDelta(tt.desired, tt.latest)
+			var gotInclude []string
+			for _, o := range toInclude {
+				gotInclude = append(gotInclude, *o.OptionName)
+			}
+			if !reflect.DeepEqual(gotInclude, tt.wantInclude) {
+				t.Errorf("OptionsDelta() toInclude = %v, want %v", gotInclude, tt.wantInclude)
+			}
+			var gotRemove []string
+			for _, name := range toRemove {
+				gotRemove = append(gotRemove, *name)
+			}
+			if !reflect.DeepEqual(gotRemove, tt.wantRemove) {
+				t.Errorf("OptionsDelta() toRemove = %v, want %v", gotRemove, tt.wantRemove)
+			}
+		})
+	}
+}
+
+func TestOptionsDeltaSendsChangedSettings(t *testing.T) {
+	latest := []*svcapitypes.Option{{
+		OptionName:    aws.String("APEX"),
+		OptionVersion: aws.String("19.2.v1"),
+		OptionSettings: []*svcapitypes.OptionSetting{
+			{Name: aws.String("A"), Value: aws.String("1")},
+			{Name: aws.String("B"), Value: aws.String("2")},
+		},
+	}}
+	desired := []*svcapitypes.OptionConfiguration{{
+		OptionName:    aws.String("APEX"),
+		OptionVersion: aws.String("20.1.v1"),
+		OptionSettings: []*svcapitypes.OptionSetting{
+			{Name: aws.String("a"), Value: aws.String("1")},
+			{Name: aws.String("B"), Value: aws.String("3")},
+		},
+	}}
+	toInclude, toRemove := util.OptionsDelta(desired, latest)
+	if len(toRemove) != 0 {
+		t.Errorf("OptionsDelta() toRemove = %v, want none", aws.StringValueSlice(toRemove))
+	}
+	if len(toInclude) != 1 {
+		t.Fatalf("OptionsDelta() toInclude = %v, want one option", toInclude)
+	}
+	if got := aws.StringValue(toInclude[0].OptionVersion); got != "20.1.v1" {
+		t.Errorf("OptionsDelta() option version = %s, want 20.1.v1", got)
+	}
+	settings := toInclude[0].OptionSettings
+	if len(settings) != 1 || *settings[0].Name != "B" || *settings[0].Value != "3" {
+		t.Errorf("OptionsDelta() option settings = %v, want B=3", settings)
+	}
+	if len(desired[0].OptionSettings) != 2 {
+		t.Errorf("OptionsDelta() modified the desired option settings")
+	}
+}
+
+func TestGetOptionSettingsDifference(t *testing.T) {
+	setting := func(name string, value *string) *svcapitypes.OptionSetting {
+		return &svcapitypes.OptionSetting{Name: aws.String(name), Value: value}
+	}
+	from := []*svcapitypes.OptionSetting{
+		setting("SQLNET.ENCRYPTION_SERVER", aws.String("REQUESTED")),
+		setting("FLAG", aws.String("TRUE")),
+		setting("UNSET", nil),
+	}
+	tests := []struct {
+		name          string
+		to            []*svcapitypes.OptionSetting
+		wantChanged   []string
+		wantUnchanged []string
+	}{
+		{"no settings", nil, nil, nil},
+		{"same value", []*svcapitypes.OptionSetting{setting("SQLNET.ENCRYPTION_SERVER", aws.String("REQUESTED"))}, nil, []string{"SQLNET.ENCRYPTION_SERVER"}},
+		{"case-insensitive name", []*svcapitypes.OptionSetting{setting("sqlnet.encryption_server", aws.String("REQUESTED"))}, nil, []string{"sqlnet.encryption_server"}},
+		{"normalized value", []*svcapitypes.OptionSetting{setting("FLAG", aws.String("1"))}, nil, []string{"FLAG"}},
+		{"changed value", []*svcapitypes.OptionSetting{setting("SQLNET.ENCRYPTION_SERVER", aws.String("REQUIRED"))}, []string{"SQLNET.ENCRYPTION_SERVER"}, nil},
+		{"unset value", []*svcapitypes.OptionSetting{setting("UNSET", aws.String("x"))}, []string{"UNSET"}, nil},
+		{"new setting", []*svcapitypes.OptionSetting{setting("NEW", aws.String("x"))}, []string{"NEW"}, nil},
+		{"no value", []*svcapitypes.OptionSetting{setting("FLAG", nil)}, nil, nil},
+	}
+	names := func(settings []*svcapitypes.OptionSetting) []string {
+		var got []string
+		for _, s := range settings {
+			got = append(got, *s.Name)
+		}
+		return got
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, unchanged := util.GetOptionSettingsDifference(tt.to, from)
+			if got := names(changed); !reflect.DeepEqual(got, tt.wantChanged) {
+				t.Errorf("GetOptionSettingsDifference() changed = %v, want %v", got, tt.wantChanged)
+			}
+			if got := names(unchanged); !reflect.DeepEqual(got, tt.wantUnchanged) {
+				t.Errorf("GetOptionSettingsDifference() unchanged = %v, want %v", got, tt.wantUnchanged)
+			}
+		})
+	}
+}
+
+func TestValidateOptionVersion(t *testing.T) {
+	versions := []string{"19.2.v1", "20.1.v1", "21.1.v1"}
+	tests := []struct {
+		name              string
+		version           string
+		latestVersion     string
+		supportsDowngrade bool
+		wantErr           error
+	}{
+		{"new option", "20.1.v1", "", false, nil},
+		{"upgrade", "21.1.v1", "19.2.v1", false, nil},
+		{"unknown version", "22.1.v1", "19.2.v1", false, util.ErrUnknownOptionVersion},
+		{"downgrade", "19.2.v1", "21.1.v1", false, util.ErrOptionVersionDowngrade},
+		{"supported downgrade", "19.2.v1", "21.1.v1", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateOptionVersion("APEX", tt.version, tt.latestVersion, versions, tt.supportsDowngrade)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("ValidateOptionVersion() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateOptionVersion() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -15,13 +15,30 @@ package util
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
+// DefaultParamMetaCacheTTL is the default amount of time the information
+// about the parameters of a parameter group family is cached for
+const DefaultParamMetaCacheTTL = 6 * time.Hour
+
 // ParamMeta stores metadata about a parameter in a parameter group
 type ParamMeta struct {
 	IsModifiable bool
 	IsDynamic    bool
+	// DataType is the data type of the parameter as reported by RDS, for
+	// instance "integer", "boolean", "string" or "list"
+	DataType string
+	// AllowedValues is the range or the comma-separated list of values the
+	// parameter accepts as reported by RDS, for instance "1-65535" or
+	// "ROW,STATEMENT,MIXED"
+	AllowedValues string
 }
 
 // MetaFetcher is the functor we pass to the paramMetaCache that allows it to
@@ -33,13 +50,20 @@ type MetaFetcher func(ctx context.Context, family string) (map[string]ParamMeta,
 // statically or dynamically defined (whether changes can be applied
 // immediately or pending a reboot) and whether a parameter is modifiable.
 //
-// Keeping things super simple for now and not adding any TTL or expiration
-// behaviour to the cache. Engine defaults are pretty static information...
+// Engine defaults are pretty static information, but RDS does add parameters
+// to a family from time to time (for instance when releasing a new minor
+// engine version), so the information cached for a family expires after TTL.
 type ParamMetaCache struct {
 	sync.RWMutex
 	Hits   uint64
 	Misses uint64
-	Cache  map[string]map[string]ParamMeta
+	// TTL is the amount of time after which the information cached for a
+	// family is considered stale and fetched again. A zero TTL means cached
+	// information never expires.
+	TTL   time.Duration
+	Cache map[string]map[string]ParamMeta
+	// loadedAt stores the time at which each family was last fetched
+	loadedAt map[string]time.Time
 }
 
 // Get retrieves the metadata for a named parameter group family and parameter
@@ -59,6 +83,9 @@ func (c *ParamMetaCache) Get(
 	// loadFamily might call a writeLock below
 	c.RLock()
 	metas, found = c.Cache[family]
+	if found && c.expired(family) {
+		found = false
+	}
 	c.RUnlock()
 
 	if !found {
@@ -69,6 +96,16 @@ func (c *ParamMetaCache) Get(
 		}
 	}
 	meta, found = metas[name]
+	if !found {
+		// RDS does not always use the same casing for parameter names as
+		// users do, so fall back to a case-insensitive match.
+		for metaName, m := range metas {
+			if strings.EqualFold(metaName, name) {
+				meta, found = m, true
+				break
+			}
+		}
+	}
 	if !found {
 		return nil, ErrUnknownParameter
 	}
@@ -89,6 +126,126 @@ func (c *ParamMetaCache) loadFamily(
 	}
 	c.Lock()
 	defer c.Unlock()
+	if c.loadedAt == nil {
+		c.loadedAt = map[string]time.Time{}
+	}
 	c.Cache[family] = familyMeta
+	c.loadedAt[family] = time.Now()
 	return familyMeta, nil
 }
+
+// expired returns true if the information cached for the supplied family is
+// older than the cache's TTL. Callers must hold at least a read lock.
+func (c *ParamMetaCache) expired(family string) bool {
+	if c.TTL == 0 {
+		return false
+	}
+	loadedAt, found := c.loadedAt[family]
+	return !found || time.Since(loadedAt) > c.TTL
+}
+
+// Validate ensures that each of the supplied parameters exists in the named
+// parameter group family and may be modified. It returns an ACK terminal
+// error for the first (in name order) parameter that does not satisfy these
+// conditions, so that invalid parameter overrides are rejected before calling
+// the RDS ModifyDBParameterGroup or ModifyDBClusterParameterGroup APIs.
+func (c *ParamMetaCache) Validate(
+	ctx context.Context,
+	family string,
+	params map[string]*string,
+	fetcher MetaFetcher,
+) error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		meta, err := c.Get(ctx, family, name, fetcher)
+		if err != nil {
+			if errors.Is(err, ErrUnknownParameter) {
+				return NewErrUnknownParameter(name)
+			}
+			return err
+		}
+		if !meta.IsModifiable {
+			return NewErrUnmodifiableParameter(name)
+		}
+		if value := params[name]; value != nil && !meta.Allows(*value) {
+			return NewErrInvalidParameterValue(name, *value, meta)
+		}
+	}
+	return nil
+}
+
+// Allows returns true if the supplied value is valid for the parameter's
+// data type and allowed values. Formulas (values like
+// "{DBInstanceClassMemory/2}") are evaluated by RDS and always allowed, as
+// are values of parameters whose allowed values are descriptions rather than
+// ranges or lists.
+func (m *ParamMeta) Allows(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "{") {
+		return true
+	}
+	switch m.DataType {
+	case "integer", "long":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return false
+		}
+	case "float":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return false
+		}
+	}
+	allowed := strings.Split(m.AllowedValues, ",")
+	for _, item := range allowed {
+		if !allowedValueRegexp.MatchString(strings.TrimSpace(item)) {
+			return true
+		}
+	}
+	values := []string{value}
+	if m.DataType == "list" {
+		values = strings.Split(value, ",")
+	}
+	for _, v := range values {
+		if !allowedValue(strings.TrimSpace(v), allowed) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowedValueRegexp matches the items of the allowed values RDS reports
+// that are either a literal value or a numeric range. Allowed values that
+// contain anything else (for instance a regular expression or a sentence)
+// are not validated.
+var allowedValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:/+-]+$`)
+
+// allowedRangeRegexp matches a numeric range of allowed values like
+// "-1-2147483647".
+var allowedRangeRegexp = regexp.MustCompile(`^(-?[0-9.]+)-(-?[0-9.]+)$`)
+
+// allowedValue returns true if the supplied value matches one of the supplied
+// allowed values items, either literally or by falling in a numeric range.
+func allowedValue(value string, allowed []string) bool {
+	for _, item := range allowed {
+		item = strings.TrimSpace(item)
+		if m := allowedRangeRegexp.FindStringSubmatch(item); m != nil {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			lo, loErr := strconv.ParseFloat(m[1], 64)
+			hi, hiErr := strconv.ParseFloat(m[2], 64)
+			if loErr == nil && hiErr == nil && v >= lo && v <= hi {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(NormalizeParameterValue(value), NormalizeParameterValue(item)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func newTestCache(ttl time.Duration) *util.ParamMetaCache {
+	return &util.ParamMetaCache{
+		TTL:   ttl,
+		Cache: map[string]map[string]util.ParamMeta{},
+	}
+}
+
+func TestParamMetaCacheValidate(t *testing.T) {
+	fetcher := func(ctx context.Context, family string) (map[string]util.ParamMeta, error) {
+		return map[string]util.ParamMeta{
+			"dynamic": {IsModifiable: true, IsDynamic: true},
+			"static":  {IsModifiable: true},
+			"fixed":   {},
+			"port":    {IsModifiable: true, DataType: "integer", AllowedValues: "1150-65535"},
+		}, nil
+	}
+	tests := []struct {
+		name    string
+		params  map[string]*string
+		wantErr error
+	}{
+		{
+			name:   "known and modifiable parameters",
+			params: map[string]*string{"dynamic": aws.String("1"), "static": aws.String("2")},
+		},
+		{
+			name:    "unknown parameter",
+			params:  map[string]*string{"dynamic": aws.String("1"), "nope": aws.String("2")},
+			wantErr: util.ErrUnknownParameter,
+		},
+		{
+			name:    "invalid parameter value",
+			params:  map[string]*string{"port": aws.String("80")},
+			wantErr: util.ErrInvalidParameterValue,
+		},
+		{
+			name:    "unmodifiable parameter",
+			params:  map[string]*string{"fixed": aws.String("1")},
+			wantErr: util.ErrUnmodifiableParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache(0)
+			err := c.Validate(context.TODO(), "family", tt.params, fetcher)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParamMetaCacheTTL(t *testing.T) {
+	calls := 0
+	fetcher := func(ctx context.Context, family string) (map[string]util.ParamMeta, error) {
+		calls++
+		return map[string]util.ParamMeta{"a": {IsModifiable: true}}, nil
+	}
+
+	c := newTestCache(0)
+	for i := 0; i < 3; i++ {
+		if _, err := c.Get(context.TODO(), "family", "a", fetcher); err != nil {
+			t.Fatalf("Get() unexpected error = %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 fetch without TTL, got %d", calls)
+	}
+
+	calls = 0
+	c = newTestCache(time.Nanosecond)
+	for i := 0; i < 3; i++ {
+		if _, err := c.Get(context.TODO(), "family", "a", fetcher); err != nil {
+			t.Fatalf("Get() unexpected error = %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 fetches with an expired TTL, got %d", calls)
+	}
+}
+
+func TestParamMetaCacheCaseInsensitiveGet(t *testing.T) {
+	fetcher := func(ctx context.Context, family string) (map[string]util.ParamMeta, error) {
+		return map[string]util.ParamMeta{
+			"max degree of parallelism": {IsModifiable: true, IsDynamic: true},
+		}, nil
+	}
+	c := newTestCache(0)
+	meta, err := c.Get(context.TODO(), "family", "Max Degree Of Parallelism", fetcher)
+	if err != nil {
+		t.Fatalf("Get() unexpected error = %v", err)
+	}
+	if !meta.IsModifiable || !meta.IsDynamic {
+		t.Errorf("Get() = %+v, want modifiable and dynamic", meta)
+	}
+}
+
+func TestParamMetaAllows(t *testing.T) {
+	tests := []struct {
+		name  string
+		meta  util.ParamMeta
+		value string
+		want  bool
+	}{
+		{"integer in range", util.ParamMeta{DataType: "integer", AllowedValues: "1-65535"}, "3306", true},
+		{"integer out of range", util.ParamMeta{DataType: "integer", AllowedValues: "1-65535"}, "70000", false},
+		{"negative range", util.ParamMeta{DataType: "integer", AllowedValues: "-1-2147483647"}, "-1", true},
+		{"not an integer", util.ParamMeta{DataType: "integer", AllowedValues: "1-65535"}, "ten", false},
+		{"integer without allowed values", util.ParamMeta{DataType: "integer"}, "10", true},
+		{"integer formula", util.ParamMeta{DataType: "integer", AllowedValues: "1-100"}, "{DBInstanceClassMemory/12582880}", true},
+		{"boolean", util.ParamMeta{DataType: "boolean", AllowedValues: "0,1"}, "ON", true},
+		{"invalid boolean", util.ParamMeta{DataType: "boolean", AllowedValues: "0,1"}, "2", false},
+		{"string in list", util.ParamMeta{DataType: "string", AllowedValues: "ROW,STATEMENT,MIXED"}, "row", true},
+		{"string not in list", util.ParamMeta{DataType: "string", AllowedValues: "ROW,STATEMENT,MIXED"}, "OFF", false},
+		{"list values", util.ParamMeta{DataType: "list", AllowedValues: "pg_stat_statements,pgaudit,pg_cron"}, "pg_stat_statements,pgaudit", true},
+		{"invalid list value", util.ParamMeta{DataType: "list", AllowedValues: "pg_stat_statements,pgaudit"}, "pgaudit,nope", false},
+		{"descriptive allowed values", util.ParamMeta{DataType: "string", AllowedValues: "Any valid time zone"}, "UTC", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.meta.Allows(tt.value); got != tt.want {
+				t.Errorf("Allows(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
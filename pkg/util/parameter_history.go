@@ -0,0 +1,51 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// MaxParameterApplyHistory is the number of parameter changes kept in
+	// the status of parameter group resources
+	MaxParameterApplyHistory = 10
+
+	ParameterOperationModify   = "modify"
+	ParameterOperationReset    = "reset"
+	ParameterOperationResetAll = "reset-all"
+)
+
+// AppendParameterApplyHistory returns the supplied history with a record of
+// the supplied operation on the named parameters appended to it. Only the
+// MaxParameterApplyHistory most recent records are kept.
+func AppendParameterApplyHistory(
+	history []*svcapitypes.ParameterApplyRecord,
+	operation string,
+	names []string,
+) []*svcapitypes.ParameterApplyRecord {
+	now := metav1.Now()
+	history = append(history, &svcapitypes.ParameterApplyRecord{
+		Operation:      aws.String(operation),
+		AppliedAt:      &now,
+		ParameterNames: aws.StringSlice(names),
+	})
+	if len(history) > MaxParameterApplyHistory {
+		history = history[len(history)-MaxParameterApplyHistory:]
+	}
+	return history
+}
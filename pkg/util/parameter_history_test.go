@@ -0,0 +1,45 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestAppendParameterApplyHistory(t *testing.T) {
+	var history []*svcapitypes.ParameterApplyRecord
+	for i := 0; i < util.MaxParameterApplyHistory+3; i++ {
+		history = util.AppendParameterApplyHistory(
+			history, util.ParameterOperationModify,
+			[]string{fmt.Sprintf("param%d", i)},
+		)
+	}
+	if len(history) != util.MaxParameterApplyHistory {
+		t.Fatalf("history has %d records, want %d", len(history), util.MaxParameterApplyHistory)
+	}
+	first := aws.StringValueSlice(history[0].ParameterNames)
+	if len(first) != 1 || first[0] != "param3" {
+		t.Errorf("oldest record has parameters %v, want [param3]", first)
+	}
+	last := history[len(history)-1]
+	if aws.StringValue(last.Operation) != util.ParameterOperationModify || last.AppliedAt == nil {
+		t.Errorf("newest record = %+v, want a timestamped modify operation", last)
+	}
+}
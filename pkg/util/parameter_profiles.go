@@ -0,0 +1,173 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+var (
+	ErrUnknownParameterProfile     = fmt.Errorf("unknown parameter profile")
+	ErrUnsupportedParameterProfile = fmt.Errorf("parameter profile is not supported by family")
+)
+
+// ParameterProfiles maps the name of a parameter profile to the curated
+// parameter values of that profile, keyed by the engine family prefix (for
+// instance "postgres" or "aurora-mysql") the values apply to.
+type ParameterProfiles map[string]map[string]map[string]string
+
+// DBParameterProfiles are the parameter profiles available to DB parameter
+// groups.
+var DBParameterProfiles = ParameterProfiles{
+	"pgaudit-logging": {
+		"postgres": {
+			"shared_preload_libraries": "pg_stat_statements,pgaudit",
+			"pgaudit.log":              "ddl,role",
+			"pgaudit.role":             "rds_pgaudit",
+		},
+		"aurora-postgresql": {
+			"shared_preload_libraries": "pg_stat_statements,pgaudit",
+			"pgaudit.log":              "ddl,role",
+			"pgaudit.role":             "rds_pgaudit",
+		},
+	},
+	"mysql-performance": {
+		"mysql": {
+			"performance_schema": "1",
+			"slow_query_log":     "1",
+			"long_query_time":    "2",
+			"log_output":         "FILE",
+		},
+		"aurora-mysql": {
+			"performance_schema": "1",
+			"slow_query_log":     "1",
+			"long_query_time":    "2",
+		},
+	},
+	"strict-tls": {
+		"postgres": {
+			"rds.force_ssl": "1",
+		},
+		"mysql": {
+			"require_secure_transport": "1",
+		},
+		"mariadb": {
+			"require_secure_transport": "1",
+		},
+		"sqlserver": {
+			"rds.force_ssl": "1",
+		},
+	},
+}
+
+// DBClusterParameterProfiles are the parameter profiles available to DB
+// cluster parameter groups. Aurora sets some parameters (like the TLS ones) at
+// the cluster level only, which is why these differ from
+// DBParameterProfiles.
+var DBClusterParameterProfiles = ParameterProfiles{
+	"pgaudit-logging": {
+		"aurora-postgresql": {
+			"shared_preload_libraries": "pg_stat_statements,pgaudit",
+			"pgaudit.log":              "ddl,role",
+			"pgaudit.role":             "rds_pgaudit",
+		},
+	},
+	"mysql-performance": {
+		"aurora-mysql": {
+			"slow_query_log":  "1",
+			"long_query_time": "2",
+			"log_output":      "FILE",
+		},
+	},
+	"strict-tls": {
+		"postgres": {
+			"rds.force_ssl": "1",
+		},
+		"mysql": {
+			"require_secure_transport": "1",
+		},
+		"aurora-postgresql": {
+			"rds.force_ssl": "1",
+		},
+		"aurora-mysql": {
+			"require_secure_transport": "ON",
+		},
+	},
+}
+
+// Expand returns the supplied parameter overrides merged with the parameters
+// of the named profile for the supplied engine family. Parameter overrides
+// always take precedence over the profile's values. When no profile is
+// named, the overrides are returned unchanged.
+func (p ParameterProfiles) Expand(
+	profile *string,
+	family string,
+	overrides map[string]*string,
+) (map[string]*string, error) {
+	if profile == nil || *profile == "" {
+		return overrides, nil
+	}
+	families, found := p[*profile]
+	if !found {
+		return nil, NewErrUnknownParameterProfile(*profile)
+	}
+	// Match the longest family prefix so that "aurora-postgresql15" never
+	// picks up the values meant for another engine.
+	prefixes := make([]string, 0, len(families))
+	for prefix := range families {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+	var values map[string]string
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(family, prefix) {
+			values = families[prefix]
+			break
+		}
+	}
+	if values == nil {
+		return nil, NewErrUnsupportedParameterProfile(*profile, family)
+	}
+	expanded := NewParameters(overrides)
+	for name, value := range values {
+		if _, _, found := expanded.Lookup(name); found {
+			continue
+		}
+		expanded[name] = Parameter{Value: aws.String(value)}
+	}
+	return expanded.Values(), nil
+}
+
+// NewErrUnknownParameterProfile generates an ACK terminal error about a
+// parameter profile that does not exist
+func NewErrUnknownParameterProfile(name string) error {
+	return ackerr.NewTerminalError(
+		fmt.Errorf("%w: %s", ErrUnknownParameterProfile, name),
+	)
+}
+
+// NewErrUnsupportedParameterProfile generates an ACK terminal error about a
+// parameter profile that has no parameters for the supplied engine family
+func NewErrUnsupportedParameterProfile(name string, family string) error {
+	return ackerr.NewTerminalError(
+		fmt.Errorf("%w: %s (%s)", ErrUnsupportedParameterProfile, name, family),
+	)
+}
@@ -0,0 +1,103 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestParameterProfilesExpand(t *testing.T) {
+	profiles := util.ParameterProfiles{
+		"tls": {
+			"postgres":          {"rds.force_ssl": "1"},
+			"aurora-postgresql": {"rds.force_ssl": "1", "ssl_min_protocol_version": "TLSv1.2"},
+		},
+	}
+	tests := []struct {
+		name      string
+		profile   *string
+		family    string
+		overrides map[string]*string
+		want      map[string]*string
+		wantErr   error
+	}{
+		{
+			name:      "no profile",
+			family:    "postgres15",
+			overrides: map[string]*string{"a": aws.String("1")},
+			want:      map[string]*string{"a": aws.String("1")},
+		},
+		{
+			name:      "profile merged with overrides",
+			profile:   aws.String("tls"),
+			family:    "postgres15",
+			overrides: map[string]*string{"a": aws.String("1")},
+			want: map[string]*string{
+				"a":             aws.String("1"),
+				"rds.force_ssl": aws.String("1"),
+			},
+		},
+		{
+			name:    "longest family prefix wins",
+			profile: aws.String("tls"),
+			family:  "aurora-postgresql15",
+			want: map[string]*string{
+				"rds.force_ssl":            aws.String("1"),
+				"ssl_min_protocol_version": aws.String("TLSv1.2"),
+			},
+		},
+		{
+			name:      "overrides take precedence",
+			profile:   aws.String("tls"),
+			family:    "postgres15",
+			overrides: map[string]*string{"RDS.Force_SSL": aws.String("0")},
+			want:      map[string]*string{"RDS.Force_SSL": aws.String("0")},
+		},
+		{
+			name:    "unknown profile",
+			profile: aws.String("nope"),
+			family:  "postgres15",
+			wantErr: util.ErrUnknownParameterProfile,
+		},
+		{
+			name:    "unsupported family",
+			profile: aws.String("tls"),
+			family:  "mysql8.0",
+			wantErr: util.ErrUnsupportedParameterProfile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := profiles.Expand(tt.profile, tt.family, tt.overrides)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Expand() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expand() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
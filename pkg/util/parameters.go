@@ -15,19 +15,113 @@ package util
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
-	"github.com/samber/lo"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+)
+
+const (
+	// MaxParametersPerCall is the maximum number of parameters that the RDS
+	// ModifyDBParameterGroup, ModifyDBClusterParameterGroup,
+	// ResetDBParameterGroup and ResetDBClusterParameterGroup API calls
+	// accept in a single request.
+	MaxParametersPerCall = 20
+	// MaxRecordsPerPage is the maximum number of parameters the RDS
+	// DescribeDBParameters, DescribeDBClusterParameters,
+	// DescribeEngineDefaultParameters and
+	// DescribeEngineDefaultClusterParameters API calls return in a single
+	// page. SQL Server and Oracle parameter groups hold several hundred
+	// parameters, so all the pages must be read.
+	MaxRecordsPerPage = 100
+	// ApplyTypeStatic is the apply type RDS reports for parameters whose
+	// changes only take effect after the database is rebooted
+	ApplyTypeStatic = "static"
 )
 
 var (
 	ErrUnknownParameter      = fmt.Errorf("unknown parameter")
 	ErrUnmodifiableParameter = fmt.Errorf("parameter is not modifiable")
+	ErrInvalidParameterValue = fmt.Errorf("invalid parameter value")
+	ErrUnknownDriftPolicy    = fmt.Errorf("unknown drift policy")
 )
 
+// Parameter holds the value of an element of a DB Parameter Group or a DB
+// Cluster Parameter Group along with the method RDS uses to apply a change to
+// that value ("immediate" or "pending-reboot").
+type Parameter struct {
+	Value       *string
+	ApplyMethod *string
+}
+
 // Parameters represents the elements of a DB Parameter Group
-// or a DB Cluster Parameter Group
-type Parameters map[string]*string
+// or a DB Cluster Parameter Group, keyed by parameter name
+type Parameters map[string]Parameter
+
+// NewParameters returns a Parameters map containing the supplied parameter
+// values. No apply method is set on the returned parameters.
+func NewParameters(values map[string]*string) Parameters {
+	params := make(Parameters, len(values))
+	for name, value := range values {
+		params[name] = Parameter{Value: value}
+	}
+	return params
+}
+
+// Values returns the map of parameter names to parameter values, dropping
+// the apply methods.
+func (p Parameters) Values() map[string]*string {
+	values := make(map[string]*string, len(p))
+	for name, param := range p {
+		values[name] = param.Value
+	}
+	return values
+}
+
+// Names returns the sorted names of the parameters.
+func (p Parameters) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the parameter with the supplied name, along with the name
+// under which it is stored. Parameter names are matched case-insensitively
+// because RDS does not always return parameter names with the same casing
+// users write them with (for instance in the SQL Server and Oracle families).
+func (p Parameters) Lookup(name string) (string, Parameter, bool) {
+	if param, found := p[name]; found {
+		return name, param, true
+	}
+	for key, param := range p {
+		if strings.EqualFold(key, name) {
+			return key, param, true
+		}
+	}
+	return "", Parameter{}, false
+}
+
+// IsLastPage returns true if the supplied pagination marker, as returned by an
+// RDS Describe API call, indicates there are no more pages to read. RDS
+// returns either no marker or an empty marker on the last page.
+func IsLastPage(marker *string) bool {
+	return marker == nil || *marker == ""
+}
+
+// ApplyMethodFromApplyType returns the apply method that must be used when
+// changing a parameter with the supplied apply type. Static parameters can
+// only be changed pending a reboot of the database while changes to dynamic
+// parameters are applied immediately.
+func ApplyMethodFromApplyType(applyType *string) string {
+	if applyType != nil && *applyType == ApplyTypeStatic {
+		return svcsdk.ApplyMethodPendingReboot
+	}
+	return svcsdk.ApplyMethodImmediate
+}
 
 // NewErrUnknownParameter generates an ACK terminal error about
 // an unknown parameter
@@ -51,57 +145,187 @@ func NewErrUnmodifiableParameter(name string) error {
 	)
 }
 
+// NewErrInvalidParameterValue generates an ACK terminal error about a
+// parameter value that is not valid for the parameter's data type or allowed
+// values
+func NewErrInvalidParameterValue(name string, value string, meta *ParamMeta) error {
+	// This is a terminal error because unless the user changes the value of
+	// this parameter, RDS will keep rejecting it.
+	return ackerr.NewTerminalError(
+		fmt.Errorf(
+			"%w: %s=%s (data type: %s, allowed values: %s)",
+			ErrInvalidParameterValue, name, value,
+			meta.DataType, meta.AllowedValues,
+		),
+	)
+}
+
 // GetParametersDifference compares two Parameters maps and returns the
 // parameters to add & update, the unchanged parameters, and
 // the parameters to remove
-// GetParametersDifference compares two Parameters maps and returns the
-// parameters to add & update, the unchanged parameters, and
-// the parameters to remove
+//
+// A parameter present in both maps is considered updated when its value
+// differs or when both maps carry an apply method for it and those apply
+// methods differ. A missing apply method means "any apply method".
+//
+// Parameter names are matched case-insensitively. The added and unchanged
+// parameters are keyed by their name in the "to" map and the removed
+// parameters by their name in the "from" map, so that the casing supplied by
+// the user is preserved in API calls.
 func GetParametersDifference(
 	to, from Parameters,
 ) (added, unchanged, removed Parameters) {
-	// we need to convert the tag tuples to a comparable interface type
-	fromPairs := lo.ToPairs(from)
-	toPairs := lo.ToPairs(to)
+	added = Parameters{}
+	unchanged = Parameters{}
+	removed = Parameters{}
 
-	left, right := lo.Difference(fromPairs, toPairs)
-	middle := lo.Intersect(fromPairs, toPairs)
-
-	removed_common := lo.FromPairs(left)
-	added = lo.FromPairs(right)
+	fromByName := make(map[string]Parameter, len(from))
+	for name, fromParam := range from {
+		fromByName[strings.ToLower(name)] = fromParam
+	}
+	toNames := make(map[string]bool, len(to))
+	for name, toParam := range to {
+		toNames[strings.ToLower(name)] = true
+		fromParam, found := fromByName[strings.ToLower(name)]
+		if found && parameterEqual(toParam, fromParam) {
+			unchanged[name] = toParam
+		} else {
+			added[name] = toParam
+		}
+	}
+	for name, fromParam := range from {
+		if !toNames[strings.ToLower(name)] {
+			removed[name] = fromParam
+		}
+	}
+	return added, unchanged, removed
+}
 
-	left_map := lo.Keys(removed_common)
-	right_map := lo.Keys(added)
-	result := lo.Interleave(left_map, right_map)
-	common := lo.FindDuplicates(result)
-	removed = lo.OmitByKeys(removed_common, common)
+// parameterEqual returns true if the supplied parameters have equivalent
+// values and compatible apply methods
+func parameterEqual(a, b Parameter) bool {
+	if !parameterValueEqual(a.Value, b.Value) {
+		return false
+	}
+	if a.ApplyMethod == nil || b.ApplyMethod == nil {
+		return true
+	}
+	return *a.ApplyMethod == *b.ApplyMethod
+}
 
-	unchanged = lo.FromPairs(middle)
+// parameterValueEqual returns true if the supplied parameter values are both
+// nil or are equivalent once normalized
+func parameterValueEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return NormalizeParameterValue(*a) == NormalizeParameterValue(*b)
+}
 
-	return added, unchanged, removed
+// NormalizeParameterValue returns the canonical form of a parameter value,
+// used to compare the values users put in their manifests with the values
+// returned by DescribeDBParameters and DescribeDBClusterParameters. Without
+// this, values such as "ON" and "1" or "{DBInstanceClassMemory * 3/4}" and
+// "{DBInstanceClassMemory*3/4}" show up as a perpetual difference and the
+// parameter group gets modified on every reconciliation.
+//
+// The normalization:
+//
+//   - removes leading and trailing whitespace,
+//   - maps the boolean spellings accepted by the database engines ("on",
+//     "true", "yes", "off", "false", "no", in any case) to "1" and "0",
+//   - removes whitespace from formula expressions ("{...}").
+func NormalizeParameterValue(value string) string {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "1", "on", "true", "yes":
+		return "1"
+	case "0", "off", "false", "no":
+		return "0"
+	}
+	if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+		return strings.Join(strings.Fields(value), "")
+	}
+	return value
 }
 
 // ChunkParameters splits a supplied map of parameters into multiple
-// slices of maps of parameters of a given size.
+// slices of maps of parameters of a given size. Parameters are placed into
+// chunks in parameter name order so that the same input always produces the
+// same chunks, which keeps retried API calls idempotent. A chunkSize of zero
+// or less defaults to MaxParametersPerCall.
 func ChunkParameters(
 	input Parameters,
 	chunkSize int,
 ) []Parameters {
+	if chunkSize <= 0 {
+		chunkSize = MaxParametersPerCall
+	}
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	var chunks []Parameters
-	chunk := Parameters{}
-	idx := 0
-	for k, v := range input {
-		if idx < chunkSize {
-			chunk[k] = v
-			idx++
-		} else {
-			// reset the chunker
-			chunks = append(chunks, chunk)
-			chunk = Parameters{}
-			idx = 0
+	for start := 0; start < len(names); start += chunkSize {
+		end := start + chunkSize
+		if end > len(names) {
+			end = len(names)
+		}
+		chunk := make(Parameters, end-start)
+		for _, name := range names[start:end] {
+			chunk[name] = input[name]
 		}
+		chunks = append(chunks, chunk)
 	}
-	chunks = append(chunks, chunk)
-
 	return chunks
 }
+
+// ConflictingParameters returns the sorted names of the parameters that are
+// set in both of the supplied parameter groups with different values. Names
+// are matched case-insensitively and values are compared in their normalized
+// form.
+func ConflictingParameters(a, b map[string]*string) []string {
+	other := NewParameters(b)
+	var conflicts []string
+	for name, value := range a {
+		_, param, found := other.Lookup(name)
+		if found && !parameterValueEqual(value, param.Value) {
+			conflicts = append(conflicts, name)
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+// DriftedParameters returns the sorted names of the parameters whose actual
+// values differ from the values the controller last applied, including
+// parameters that were added or removed outside of the controller.
+func DriftedParameters(actual, applied map[string]*string) []string {
+	added, _, removed := GetParametersDifference(
+		NewParameters(actual), NewParameters(applied),
+	)
+	drifted := append(added.Names(), removed.Names()...)
+	sort.Strings(drifted)
+	return drifted
+}
+
+// ValidateDriftPolicy returns an ACK terminal error if the supplied drift
+// policy is set to anything but DriftPolicyCorrect, DriftPolicyIgnore or
+// DriftPolicyAlert.
+func ValidateDriftPolicy(policy *string) error {
+	if policy == nil {
+		return nil
+	}
+	switch *policy {
+	case DriftPolicyCorrect, DriftPolicyIgnore, DriftPolicyAlert:
+		return nil
+	}
+	// This is a terminal error because the policy is not read back from AWS:
+	// it only changes when the user fixes their manifest.
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s, expected %q, %q or %q", ErrUnknownDriftPolicy, *policy,
+		DriftPolicyCorrect, DriftPolicyIgnore, DriftPolicyAlert,
+	))
+}
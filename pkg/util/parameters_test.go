@@ -0,0 +1,382 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+var (
+	immediate     = aws.String(svcsdk.ApplyMethodImmediate)
+	pendingReboot = aws.String(svcsdk.ApplyMethodPendingReboot)
+)
+
+func TestGetParametersDifference(t *testing.T) {
+	type args struct {
+		to   util.Parameters
+		from util.Parameters
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantAdded     util.Parameters
+		wantUnchanged util.Parameters
+		wantRemoved   util.Parameters
+	}{
+		{
+			name:          "empty maps",
+			args:          args{},
+			wantAdded:     util.Parameters{},
+			wantUnchanged: util.Parameters{},
+			wantRemoved:   util.Parameters{},
+		},
+		{
+			name: "only added parameters",
+			args: args{
+				to: util.Parameters{
+					"a": {Value: aws.String("1")},
+				},
+			},
+			wantAdded: util.Parameters{
+				"a": {Value: aws.String("1")},
+			},
+			wantUnchanged: util.Parameters{},
+			wantRemoved:   util.Parameters{},
+		},
+		{
+			name: "only removed parameters",
+			args: args{
+				from: util.Parameters{
+					"a": {Value: aws.String("1")},
+				},
+			},
+			wantAdded:     util.Parameters{},
+			wantUnchanged: util.Parameters{},
+			wantRemoved: util.Parameters{
+				"a": {Value: aws.String("1")},
+			},
+		},
+		{
+			name: "added, updated, unchanged and removed parameters",
+			args: args{
+				to: util.Parameters{
+					"a": {Value: aws.String("1")},
+					"b": {Value: aws.String("2")},
+					"c": {Value: aws.String("3")},
+				},
+				from: util.Parameters{
+					"b": {Value: aws.String("2")},
+					"c": {Value: aws.String("4")},
+					"d": {Value: aws.String("5")},
+				},
+			},
+			wantAdded: util.Parameters{
+				"a": {Value: aws.String("1")},
+				"c": {Value: aws.String("3")},
+			},
+			wantUnchanged: util.Parameters{
+				"b": {Value: aws.String("2")},
+			},
+			wantRemoved: util.Parameters{
+				"d": {Value: aws.String("5")},
+			},
+		},
+		{
+			name: "equivalent values are unchanged",
+			args: args{
+				to: util.Parameters{
+					"a": {Value: aws.String("ON")},
+					"b": {Value: aws.String("{DBInstanceClassMemory * 3/4}")},
+				},
+				from: util.Parameters{
+					"a": {Value: aws.String("1")},
+					"b": {Value: aws.String("{DBInstanceClassMemory*3/4}")},
+				},
+			},
+			wantAdded: util.Parameters{},
+			wantUnchanged: util.Parameters{
+				"a": {Value: aws.String("ON")},
+				"b": {Value: aws.String("{DBInstanceClassMemory * 3/4}")},
+			},
+			wantRemoved: util.Parameters{},
+		},
+		{
+			name: "parameter names are matched case-insensitively",
+			args: args{
+				to: util.Parameters{
+					"Max Degree Of Parallelism":      {Value: aws.String("2")},
+					"cost threshold for parallelism": {Value: aws.String("50")},
+				},
+				from: util.Parameters{
+					"max degree of parallelism":      {Value: aws.String("2")},
+					"Cost Threshold For Parallelism": {Value: aws.String("5")},
+					"Fill Factor (%)":                {Value: aws.String("80")},
+				},
+			},
+			wantAdded: util.Parameters{
+				"cost threshold for parallelism": {Value: aws.String("50")},
+			},
+			wantUnchanged: util.Parameters{
+				"Max Degree Of Parallelism": {Value: aws.String("2")},
+			},
+			wantRemoved: util.Parameters{
+				"Fill Factor (%)": {Value: aws.String("80")},
+			},
+		},
+		{
+			name: "apply method change only",
+			args: args{
+				to: util.Parameters{
+					"a": {Value: aws.String("1"), ApplyMethod: immediate},
+				},
+				from: util.Parameters{
+					"a": {Value: aws.String("1"), ApplyMethod: pendingReboot},
+				},
+			},
+			wantAdded: util.Parameters{
+				"a": {Value: aws.String("1"), ApplyMethod: immediate},
+			},
+			wantUnchanged: util.Parameters{},
+			wantRemoved:   util.Parameters{},
+		},
+		{
+			name: "missing apply method matches any apply method",
+			args: args{
+				to: util.Parameters{
+					"a": {Value: aws.String("1")},
+				},
+				from: util.Parameters{
+					"a": {Value: aws.String("1"), ApplyMethod: pendingReboot},
+				},
+			},
+			wantAdded: util.Parameters{},
+			wantUnchanged: util.Parameters{
+				"a": {Value: aws.String("1")},
+			},
+			wantRemoved: util.Parameters{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAdded, gotUnchanged, gotRemoved := util.GetParametersDifference(tt.args.to, tt.args.from)
+			if !reflect.DeepEqual(gotAdded, tt.wantAdded) {
+				t.Errorf("GetParametersDifference() gotAdded = %v, want %v", gotAdded, tt.wantAdded)
+			}
+			if !reflect.DeepEqual(gotUnchanged, tt.wantUnchanged) {
+				t.Errorf("GetParametersDifference() gotUnchanged = %v, want %v", gotUnchanged, tt.wantUnchanged)
+			}
+			if !reflect.DeepEqual(gotRemoved, tt.wantRemoved) {
+				t.Errorf("GetParametersDifference() gotRemoved = %v, want %v", gotRemoved, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestChunkParameters(t *testing.T) {
+	input := util.Parameters{}
+	for _, name := range []string{"e", "b", "d", "a", "c"} {
+		input[name] = util.Parameter{Value: aws.String(name)}
+	}
+	tests := []struct {
+		name      string
+		input     util.Parameters
+		chunkSize int
+		want      []util.Parameters
+	}{
+		{
+			name:      "empty input",
+			input:     util.Parameters{},
+			chunkSize: 2,
+			want:      nil,
+		},
+		{
+			name:      "chunks sorted by name without dropping boundary entries",
+			input:     input,
+			chunkSize: 2,
+			want: []util.Parameters{
+				{"a": input["a"], "b": input["b"]},
+				{"c": input["c"], "d": input["d"]},
+				{"e": input["e"]},
+			},
+		},
+		{
+			name:      "single chunk",
+			input:     input,
+			chunkSize: 5,
+			want:      []util.Parameters{input},
+		},
+		{
+			name:      "default chunk size",
+			input:     input,
+			chunkSize: 0,
+			want:      []util.Parameters{input},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.ChunkParameters(tt.input, tt.chunkSize)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkParametersDefaultSize(t *testing.T) {
+	input := util.Parameters{}
+	for i := 0; i < 2*util.MaxParametersPerCall+1; i++ {
+		input[fmt.Sprintf("param%03d", i)] = util.Parameter{Value: aws.String("1")}
+	}
+	chunks := util.ChunkParameters(input, 0)
+	if len(chunks) != 3 {
+		t.Fatalf("ChunkParameters() returned %d chunks, want 3", len(chunks))
+	}
+	total := 0
+	for _, chunk := range chunks {
+		if len(chunk) > util.MaxParametersPerCall {
+			t.Errorf("chunk has %d parameters, want at most %d", len(chunk), util.MaxParametersPerCall)
+		}
+		total += len(chunk)
+	}
+	if total != len(input) {
+		t.Errorf("chunks contain %d parameters, want %d", total, len(input))
+	}
+}
+
+func TestNormalizeParameterValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain value", "utf8mb4", "utf8mb4"},
+		{"trailing whitespace", "utf8mb4 \n", "utf8mb4"},
+		{"case is preserved for non boolean values", "READ-COMMITTED", "READ-COMMITTED"},
+		{"true", "true", "1"},
+		{"ON", "ON", "1"},
+		{"on", "on", "1"},
+		{"one", "1", "1"},
+		{"OFF", "OFF", "0"},
+		{"false", "False", "0"},
+		{"zero", "0", "0"},
+		{"formula", "{DBInstanceClassMemory*3/4}", "{DBInstanceClassMemory*3/4}"},
+		{"formula with whitespace", " { DBInstanceClassMemory * 3 / 4 } ", "{DBInstanceClassMemory*3/4}"},
+		{"number", "100", "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.NormalizeParameterValue(tt.value); got != tt.want {
+				t.Errorf("NormalizeParameterValue(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConflictingParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		a    map[string]*string
+		b    map[string]*string
+		want []string
+	}{
+		{
+			name: "no common parameters",
+			a:    map[string]*string{"a": aws.String("1")},
+			b:    map[string]*string{"b": aws.String("2")},
+			want: nil,
+		},
+		{
+			name: "equivalent values do not conflict",
+			a:    map[string]*string{"binlog_format": aws.String("ROW"), "log_bin_trust_function_creators": aws.String("ON")},
+			b:    map[string]*string{"binlog_format": aws.String("ROW"), "Log_Bin_Trust_Function_Creators": aws.String("1")},
+			want: nil,
+		},
+		{
+			name: "conflicting values",
+			a:    map[string]*string{"binlog_format": aws.String("ROW"), "time_zone": aws.String("UTC"), "c": aws.String("1")},
+			b:    map[string]*string{"binlog_format": aws.String("MIXED"), "Time_Zone": aws.String("US/Pacific")},
+			want: []string{"binlog_format", "time_zone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ConflictingParameters(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConflictingParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsLastPage(t *testing.T) {
+	if !util.IsLastPage(nil) {
+		t.Errorf("IsLastPage(nil) = false, want true")
+	}
+	if !util.IsLastPage(aws.String("")) {
+		t.Errorf(`IsLastPage("") = false, want true`)
+	}
+	if util.IsLastPage(aws.String("marker")) {
+		t.Errorf(`IsLastPage("marker") = true, want false`)
+	}
+}
+
+func TestDriftedParameters(t *testing.T) {
+	applied := map[string]*string{
+		"binlog_format":   aws.String("ROW"),
+		"max_connections": aws.String("100"),
+		"time_zone":       aws.String("UTC"),
+	}
+	actual := map[string]*string{
+		"binlog_format":   aws.String("ROW"),
+		"max_connections": aws.String("500"),
+		"wait_timeout":    aws.String("60"),
+	}
+	want := []string{"max_connections", "time_zone", "wait_timeout"}
+	if got := util.DriftedParameters(actual, applied); !reflect.DeepEqual(got, want) {
+		t.Errorf("DriftedParameters() = %v, want %v", got, want)
+	}
+	if got := util.DriftedParameters(applied, applied); len(got) != 0 {
+		t.Errorf("DriftedParameters() = %v, want no drift", got)
+	}
+}
+
+func TestValidateDriftPolicy(t *testing.T) {
+	tests := []struct {
+		policy  *string
+		wantErr bool
+	}{
+		{policy: nil},
+		{policy: aws.String(util.DriftPolicyCorrect)},
+		{policy: aws.String(util.DriftPolicyIgnore)},
+		{policy: aws.String(util.DriftPolicyAlert)},
+		{policy: aws.String("alert"), wantErr: true},
+		{policy: aws.String(""), wantErr: true},
+	}
+	for _, tt := range tests {
+		err := util.ValidateDriftPolicy(tt.policy)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateDriftPolicy(%v) = %v, want error %v", aws.StringValue(tt.policy), err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, util.ErrUnknownDriftPolicy) {
+			t.Errorf("ValidateDriftPolicy(%v) = %v, want ErrUnknownDriftPolicy", aws.StringValue(tt.policy), err)
+		}
+	}
+}
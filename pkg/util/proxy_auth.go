@@ -0,0 +1,232 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// ProxyAuthSchemeSecrets is the authentication scheme of the DB proxy auth
+	// settings reading the credentials of a database user from a Secrets
+	// Manager secret
+	ProxyAuthSchemeSecrets = "SECRETS"
+	// ProxyAuthSecretTagKey is the key of the tag the controller sets, to
+	// the name of the DB proxy, on the Secrets Manager secrets it creates for
+	// the auth secrets of DB proxies. A secret without it is never taken
+	// over.
+	ProxyAuthSecretTagKey = "rds.services.k8s.aws/db-proxy"
+	// ProxyAuthSecretRecoveryWindowInDays is the number of days the Secrets
+	// Manager secrets deleted by the controller for the auth secrets of DB
+	// proxies can be restored for.
+	ProxyAuthSecretRecoveryWindowInDays = 7
+)
+
+var (
+	ErrInvalidProxyAuth      = fmt.Errorf("invalid DB proxy auth")
+	ErrProxyAuthSecretExists = fmt.Errorf("Secrets Manager secret already exists")
+)
+
+// ProxyAuthSecretName returns the name of the Secrets Manager secret the
+// controller manages for the supplied database user of the supplied DB proxy.
+func ProxyAuthSecretName(proxyName string, userName string) string {
+	return fmt.Sprintf("rds-proxy/%s/%s", proxyName, userName)
+}
+
+// ProxyAuthSecretTags returns the tags of the Secrets Manager secrets the
+// controller creates for the auth secrets of the supplied DB proxy.
+func ProxyAuthSecretTags(proxyName string) []*svcsdksecretsmanager.Tag {
+	return []*svcsdksecretsmanager.Tag{{
+		Key:   aws.String(ProxyAuthSecretTagKey),
+		Value: aws.String(proxyName),
+	}}
+}
+
+// ValidateProxyAuthSecretOwner returns an ACK terminal error when the
+// supplied tags of an existing Secrets Manager secret, with the supplied
+// name, do not mark it as created by the controller for the supplied DB
+// proxy, so that a secret created by someone else is not overwritten.
+// Tagging the secret lets the controller manage it.
+func ValidateProxyAuthSecretOwner(
+	name string,
+	tags []*svcsdksecretsmanager.Tag,
+	proxyName string,
+) error {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == ProxyAuthSecretTagKey && aws.StringValue(tag.Value) == proxyName {
+			return nil
+		}
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s was not created by the controller for the %s DB proxy, "+
+			"tag it %s=%s for the controller to manage it",
+		ErrProxyAuthSecretExists, name, proxyName, ProxyAuthSecretTagKey, proxyName,
+	))
+}
+
+// ProxyAuthSecretString returns the value of a Secrets Manager secret read by
+// a DB proxy for the supplied database user and password: a JSON document
+// with the username and password of the user.
+func ProxyAuthSecretString(userName string, password string) (string, error) {
+	b, err := json.Marshal(map[string]string{
+		"username": userName,
+		"password": password,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ValidateProxyAuth returns an ACK terminal error when neither the supplied
+// auth settings nor the supplied auth secrets are set, or when the auth
+// secrets do not set a user name and password or set a user name more than
+// once.
+func ValidateProxyAuth(
+	auth []*svcapitypes.UserAuthConfig,
+	secrets []*svcapitypes.DBProxyAuthSecret,
+) error {
+	if len(auth) == 0 && len(secrets) == 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: at least one of auth and authSecrets must be set",
+			ErrInvalidProxyAuth,
+		))
+	}
+	userNames := map[string]bool{}
+	for _, s := range secrets {
+		if s.UserName == nil || s.Password == nil {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%w: auth secrets must set a user name and a password",
+				ErrInvalidProxyAuth,
+			))
+		}
+		if userNames[*s.UserName] {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%w: user %q is set by more than one auth secret",
+				ErrInvalidProxyAuth, *s.UserName,
+			))
+		}
+		userNames[*s.UserName] = true
+	}
+	return nil
+}
+
+// ManagedProxyAuth returns the auth settings of the supplied auth secrets
+// whose Secrets Manager secret is recorded in the supplied statuses.
+func ManagedProxyAuth(
+	secrets []*svcapitypes.DBProxyAuthSecret,
+	statuses []*svcapitypes.DBProxyAuthSecretStatus,
+) []*svcapitypes.UserAuthConfig {
+	arns := map[string]*string{}
+	for _, st := range statuses {
+		if st.UserName != nil && st.SecretARN != nil {
+			arns[*st.UserName] = st.SecretARN
+		}
+	}
+	auth := []*svcapitypes.UserAuthConfig{}
+	for _, s := range secrets {
+		arn, ok := arns[aws.StringValue(s.UserName)]
+		if !ok {
+			continue
+		}
+		auth = append(auth, &svcapitypes.UserAuthConfig{
+			AuthScheme:             aws.String(ProxyAuthSchemeSecrets),
+			ClientPasswordAuthType: s.ClientPasswordAuthType,
+			Description:            s.Description,
+			IAMAuth:                s.IAMAuth,
+			SecretARN:              arn,
+			UserName:               s.UserName,
+		})
+	}
+	return auth
+}
+
+// UnmanagedProxyAuth returns the supplied auth settings of a DB proxy without
+// the ones matching the supplied managed auth settings. The fields a managed
+// auth setting does not set are not compared, since RDS reports their
+// defaults. Auth settings of managed secrets that do not match are kept, so
+// that they show up as differences with the desired auth settings.
+func UnmanagedProxyAuth(
+	auth []*svcapitypes.UserAuthConfig,
+	managed []*svcapitypes.UserAuthConfig,
+) []*svcapitypes.UserAuthConfig {
+	if len(managed) == 0 {
+		return auth
+	}
+	matches := func(a, m *svcapitypes.UserAuthConfig) bool {
+		differs := func(mv, av *string) bool {
+			return mv != nil && aws.StringValue(av) != *mv
+		}
+		return aws.StringValue(a.SecretARN) == aws.StringValue(m.SecretARN) &&
+			!differs(m.AuthScheme, a.AuthScheme) &&
+			!differs(m.ClientPasswordAuthType, a.ClientPasswordAuthType) &&
+			!differs(m.Description, a.Description) &&
+			!differs(m.IAMAuth, a.IAMAuth) &&
+			!differs(m.UserName, a.UserName)
+	}
+	unmanaged := []*svcapitypes.UserAuthConfig{}
+	for _, a := range auth {
+		isManaged := false
+		for _, m := range managed {
+			if matches(a, m) {
+				isManaged = true
+				break
+			}
+		}
+		if !isManaged {
+			unmanaged = append(unmanaged, a)
+		}
+	}
+	return unmanaged
+}
+
+// ProxyAuthSecretsDiffer returns true if the Secrets Manager secrets recorded
+// in the supplied applied statuses are not the ones of the supplied auth
+// secrets, or if a password hash of the supplied current statuses differs
+// from the applied one, meaning the Kubernetes Secret holding the password
+// changed.
+func ProxyAuthSecretsDiffer(
+	secrets []*svcapitypes.DBProxyAuthSecret,
+	applied []*svcapitypes.DBProxyAuthSecretStatus,
+	current []*svcapitypes.DBProxyAuthSecretStatus,
+) bool {
+	appliedHashes := map[string]*string{}
+	for _, st := range applied {
+		if st.UserName != nil && st.SecretARN != nil {
+			appliedHashes[*st.UserName] = st.PasswordHash
+		}
+	}
+	if len(appliedHashes) != len(secrets) {
+		return true
+	}
+	for _, s := range secrets {
+		if _, ok := appliedHashes[aws.StringValue(s.UserName)]; !ok {
+			return true
+		}
+	}
+	for _, st := range current {
+		appliedHash := appliedHashes[aws.StringValue(st.UserName)]
+		if appliedHash != nil && st.PasswordHash != nil && *appliedHash != *st.PasswordHash {
+			return true
+		}
+	}
+	return false
+}
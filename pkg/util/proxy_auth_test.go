@@ -0,0 +1,207 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestProxyAuthSecretString(t *testing.T) {
+	got, err := util.ProxyAuthSecretString("app", `pa"ss`)
+	if err != nil {
+		t.Fatalf("ProxyAuthSecretString() unexpected error = %v", err)
+	}
+	password, err := util.MasterUserSecretPassword(got)
+	if err != nil || password != `pa"ss` {
+		t.Errorf("ProxyAuthSecretString() = %q, holds password %q, error %v", got, password, err)
+	}
+}
+
+func TestValidateProxyAuth(t *testing.T) {
+	password := &ackv1alpha1.SecretKeyReference{Key: "password"}
+	auth := []*svcapitypes.UserAuthConfig{{SecretARN: aws.String("arn")}}
+	secret := func(user string) *svcapitypes.DBProxyAuthSecret {
+		return &svcapitypes.DBProxyAuthSecret{UserName: aws.String(user), Password: password}
+	}
+	tests := []struct {
+		name    string
+		auth    []*svcapitypes.UserAuthConfig
+		secrets []*svcapitypes.DBProxyAuthSecret
+		wantErr bool
+	}{
+		{"auth only", auth, nil, false},
+		{"secrets only", nil, []*svcapitypes.DBProxyAuthSecret{secret("app")}, false},
+		{"auth and secrets", auth, []*svcapitypes.DBProxyAuthSecret{secret("app"), secret("admin")}, false},
+		{"neither", nil, nil, true},
+		{"no password", nil, []*svcapitypes.DBProxyAuthSecret{{UserName: aws.String("app")}}, true},
+		{"no user name", nil, []*svcapitypes.DBProxyAuthSecret{{Password: password}}, true},
+		{"duplicate user", nil, []*svcapitypes.DBProxyAuthSecret{secret("app"), secret("app")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateProxyAuth(tt.auth, tt.secrets)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateProxyAuth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, util.ErrInvalidProxyAuth) {
+				t.Errorf("ValidateProxyAuth() error = %v, want %v", err, util.ErrInvalidProxyAuth)
+			}
+		})
+	}
+}
+
+func TestValidateProxyAuthSecretOwner(t *testing.T) {
+	tag := func(key, value string) *svcsdksecretsmanager.Tag {
+		return &svcsdksecretsmanager.Tag{Key: aws.String(key), Value: aws.String(value)}
+	}
+	tests := []struct {
+		name    string
+		tags    []*svcsdksecretsmanager.Tag
+		wantErr bool
+	}{
+		{"created for the proxy", util.ProxyAuthSecretTags("proxy"), false},
+		{"among other tags", []*svcsdksecretsmanager.Tag{tag("team", "db"), tag(util.ProxyAuthSecretTagKey, "proxy")}, false},
+		{"untagged", nil, true},
+		{"created for another proxy", util.ProxyAuthSecretTags("other"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateProxyAuthSecretOwner("rds-proxy/proxy/app", tt.tags, "proxy")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateProxyAuthSecretOwner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, util.ErrProxyAuthSecretExists) {
+				t.Errorf("ValidateProxyAuthSecretOwner() error = %v, want %v", err, util.ErrProxyAuthSecretExists)
+			}
+		})
+	}
+}
+
+func TestManagedProxyAuth(t *testing.T) {
+	secrets := []*svcapitypes.DBProxyAuthSecret{
+		{UserName: aws.String("app"), IAMAuth: aws.String("DISABLED")},
+		{UserName: aws.String("admin")},
+	}
+	statuses := []*svcapitypes.DBProxyAuthSecretStatus{
+		{UserName: aws.String("app"), SecretARN: aws.String("arn-app")},
+		{UserName: aws.String("old"), SecretARN: aws.String("arn-old")},
+	}
+	got := util.ManagedProxyAuth(secrets, statuses)
+	if len(got) != 1 {
+		t.Fatalf("ManagedProxyAuth() returned %d auth settings, want 1", len(got))
+	}
+	if aws.StringValue(got[0].SecretARN) != "arn-app" ||
+		aws.StringValue(got[0].AuthScheme) != util.ProxyAuthSchemeSecrets ||
+		aws.StringValue(got[0].IAMAuth) != "DISABLED" {
+		t.Errorf("ManagedProxyAuth() = %+v", got[0])
+	}
+}
+
+func TestUnmanagedProxyAuth(t *testing.T) {
+	managed := []*svcapitypes.UserAuthConfig{{
+		AuthScheme: aws.String("SECRETS"),
+		SecretARN:  aws.String("arn-app"),
+		UserName:   aws.String("app"),
+		IAMAuth:    aws.String("REQUIRED"),
+	}}
+	user := &svcapitypes.UserAuthConfig{AuthScheme: aws.String("SECRETS"), SecretARN: aws.String("arn-user")}
+	tests := []struct {
+		name    string
+		auth    []*svcapitypes.UserAuthConfig
+		managed []*svcapitypes.UserAuthConfig
+		want    []string
+	}{
+		{"no managed auth", []*svcapitypes.UserAuthConfig{user}, nil, []string{"arn-user"}},
+		{
+			"managed auth with defaults removed",
+			[]*svcapitypes.UserAuthConfig{user, {
+				AuthScheme:             aws.String("SECRETS"),
+				SecretARN:              aws.String("arn-app"),
+				UserName:               aws.String("app"),
+				IAMAuth:                aws.String("REQUIRED"),
+				ClientPasswordAuthType: aws.String("MYSQL_NATIVE_PASSWORD"),
+			}},
+			managed,
+			[]string{"arn-user"},
+		},
+		{
+			"managed auth with other settings kept",
+			[]*svcapitypes.UserAuthConfig{user, {
+				AuthScheme: aws.String("SECRETS"),
+				SecretARN:  aws.String("arn-app"),
+				UserName:   aws.String("app"),
+				IAMAuth:    aws.String("DISABLED"),
+			}},
+			managed,
+			[]string{"arn-user", "arn-app"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.UnmanagedProxyAuth(tt.auth, tt.managed)
+			arns := []string{}
+			for _, a := range got {
+				arns = append(arns, aws.StringValue(a.SecretARN))
+			}
+			if len(arns) != len(tt.want) {
+				t.Fatalf("UnmanagedProxyAuth() = %v, want %v", arns, tt.want)
+			}
+			for i := range arns {
+				if arns[i] != tt.want[i] {
+					t.Errorf("UnmanagedProxyAuth() = %v, want %v", arns, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestProxyAuthSecretsDiffer(t *testing.T) {
+	secrets := []*svcapitypes.DBProxyAuthSecret{{UserName: aws.String("app")}}
+	status := func(user string, hash string) *svcapitypes.DBProxyAuthSecretStatus {
+		return &svcapitypes.DBProxyAuthSecretStatus{
+			UserName:     aws.String(user),
+			SecretARN:    aws.String("arn-" + user),
+			PasswordHash: aws.String(hash),
+		}
+	}
+	tests := []struct {
+		name    string
+		secrets []*svcapitypes.DBProxyAuthSecret
+		applied []*svcapitypes.DBProxyAuthSecretStatus
+		current []*svcapitypes.DBProxyAuthSecretStatus
+		want    bool
+	}{
+		{"no secrets", nil, nil, nil, false},
+		{"in sync", secrets, []*svcapitypes.DBProxyAuthSecretStatus{status("app", "h1")}, []*svcapitypes.DBProxyAuthSecretStatus{status("app", "h1")}, false},
+		{"secret not created", secrets, nil, nil, true},
+		{"secret of removed user", nil, []*svcapitypes.DBProxyAuthSecretStatus{status("app", "h1")}, nil, true},
+		{"other user", secrets, []*svcapitypes.DBProxyAuthSecretStatus{status("admin", "h1")}, nil, true},
+		{"password changed", secrets, []*svcapitypes.DBProxyAuthSecretStatus{status("app", "h1")}, []*svcapitypes.DBProxyAuthSecretStatus{status("app", "h2")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ProxyAuthSecretsDiffer(tt.secrets, tt.applied, tt.current); got != tt.want {
+				t.Errorf("ProxyAuthSecretsDiffer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
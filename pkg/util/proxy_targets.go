@@ -0,0 +1,102 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// ProxyTargetTypeInstance is the type of the targets of a DB proxy that
+	// are DB instances, registered on their own or as members of a DB cluster.
+	ProxyTargetTypeInstance = "RDS_INSTANCE"
+	// ProxyTargetTypeTrackedCluster is the type of the targets of a DB proxy
+	// that are DB clusters.
+	ProxyTargetTypeTrackedCluster = "TRACKED_CLUSTER"
+	// ProxyTargetTypeServerlessEndpoint is the type of the targets of a DB
+	// proxy that are Aurora Serverless v1 DB clusters.
+	ProxyTargetTypeServerlessEndpoint = "RDS_SERVERLESS_ENDPOINT"
+	// ProxyTargetStateRegistering is the state of the health of the targets
+	// of a DB proxy that are being registered.
+	ProxyTargetStateRegistering = "REGISTERING"
+)
+
+// ProxyTargetIdentifiers returns the identifiers of the DB clusters and of the
+// DB instances registered with a DB proxy target group, given its targets.
+// The DB instances that are members of a registered DB cluster are targets
+// too, but were not registered on their own, so they are not returned.
+func ProxyTargetIdentifiers(
+	targets []*svcapitypes.DBProxyTarget,
+) (clusters []*string, instances []*string) {
+	for _, target := range targets {
+		identifier := aws.StringValue(target.RdsResourceID)
+		if identifier == "" {
+			continue
+		}
+		switch aws.StringValue(target.Type) {
+		case ProxyTargetTypeTrackedCluster, ProxyTargetTypeServerlessEndpoint:
+			clusters = append(clusters, aws.String(identifier))
+		case ProxyTargetTypeInstance:
+			if target.TrackedClusterID == nil {
+				instances = append(instances, aws.String(identifier))
+			}
+		}
+	}
+	return clusters, instances
+}
+
+// ProxyTargetsRegistering returns true if any of the supplied targets of a DB
+// proxy target group is still being registered.
+func ProxyTargetsRegistering(targets []*svcapitypes.DBProxyTarget) bool {
+	for _, target := range targets {
+		if target.TargetHealth != nil &&
+			aws.StringValue(target.TargetHealth.State) == ProxyTargetStateRegistering {
+			return true
+		}
+	}
+	return false
+}
+
+// ConnectionPoolConfigDiffers returns true if the supplied desired connection
+// pool configuration of a DB proxy target group differs from the supplied
+// latest one. Only the fields the desired configuration sets are compared,
+// since RDS reports a default value for each of the fields left unset.
+func ConnectionPoolConfigDiffers(
+	desired *svcapitypes.ConnectionPoolConfiguration,
+	latest *svcapitypes.ConnectionPoolConfiguration,
+) bool {
+	if desired == nil {
+		return false
+	}
+	if latest == nil {
+		return true
+	}
+	differs := func(a, b *int64) bool {
+		return a != nil && (b == nil || *a != *b)
+	}
+	if differs(desired.ConnectionBorrowTimeout, latest.ConnectionBorrowTimeout) ||
+		differs(desired.MaxConnectionsPercent, latest.MaxConnectionsPercent) ||
+		differs(desired.MaxIdleConnectionsPercent, latest.MaxIdleConnectionsPercent) {
+		return true
+	}
+	if desired.InitQuery != nil &&
+		(latest.InitQuery == nil || *desired.InitQuery != *latest.InitQuery) {
+		return true
+	}
+	return desired.SessionPinningFilters != nil &&
+		!ackcompare.SliceStringPEqual(desired.SessionPinningFilters, latest.SessionPinningFilters)
+}
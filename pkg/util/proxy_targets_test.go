@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestProxyTargetIdentifiers(t *testing.T) {
+	tests := []struct {
+		name          string
+		targets       []*svcapitypes.DBProxyTarget
+		wantClusters  []string
+		wantInstances []string
+	}{
+		{"no targets", nil, nil, nil},
+		{
+			"DB instance",
+			[]*svcapitypes.DBProxyTarget{
+				{RdsResourceID: aws.String("instance-1"), Type: aws.String("RDS_INSTANCE")},
+			},
+			nil,
+			[]string{"instance-1"},
+		},
+		{
+			"DB cluster and its members",
+			[]*svcapitypes.DBProxyTarget{
+				{RdsResourceID: aws.String("cluster-1"), Type: aws.String("TRACKED_CLUSTER"), TrackedClusterID: aws.String("cluster-1")},
+				{RdsResourceID: aws.String("instance-1"), Type: aws.String("RDS_INSTANCE"), TrackedClusterID: aws.String("cluster-1")},
+				{RdsResourceID: aws.String("instance-2"), Type: aws.String("RDS_INSTANCE"), TrackedClusterID: aws.String("cluster-1")},
+			},
+			[]string{"cluster-1"},
+			nil,
+		},
+		{
+			"serverless endpoint",
+			[]*svcapitypes.DBProxyTarget{
+				{RdsResourceID: aws.String("cluster-1"), Type: aws.String("RDS_SERVERLESS_ENDPOINT")},
+			},
+			[]string{"cluster-1"},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters, instances := util.ProxyTargetIdentifiers(tt.targets)
+			if got := aws.StringValueSlice(clusters); !slices.Equal(got, tt.wantClusters) {
+				t.Errorf("ProxyTargetIdentifiers() clusters = %v, want %v", got, tt.wantClusters)
+			}
+			if got := aws.StringValueSlice(instances); !slices.Equal(got, tt.wantInstances) {
+				t.Errorf("ProxyTargetIdentifiers() instances = %v, want %v", got, tt.wantInstances)
+			}
+		})
+	}
+}
+
+func TestProxyTargetsRegistering(t *testing.T) {
+	target := func(state string) *svcapitypes.DBProxyTarget {
+		return &svcapitypes.DBProxyTarget{
+			TargetHealth: &svcapitypes.TargetHealth{State: aws.String(state)},
+		}
+	}
+	tests := []struct {
+		name    string
+		targets []*svcapitypes.DBProxyTarget
+		want    bool
+	}{
+		{"no targets", nil, false},
+		{"no health", []*svcapitypes.DBProxyTarget{{}}, false},
+		{"available", []*svcapitypes.DBProxyTarget{target("AVAILABLE")}, false},
+		{"unavailable", []*svcapitypes.DBProxyTarget{target("UNAVAILABLE")}, false},
+		{"registering", []*svcapitypes.DBProxyTarget{target("AVAILABLE"), target("REGISTERING")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ProxyTargetsRegistering(tt.targets); got != tt.want {
+				t.Errorf("ProxyTargetsRegistering() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectionPoolConfigDiffers(t *testing.T) {
+	latest := &svcapitypes.ConnectionPoolConfiguration{
+		ConnectionBorrowTimeout:   aws.Int64(120),
+		InitQuery:                 aws.String(""),
+		MaxConnectionsPercent:     aws.Int64(100),
+		MaxIdleConnectionsPercent: aws.Int64(50),
+		SessionPinningFilters:     []*string{},
+	}
+	tests := []struct {
+		name    string
+		desired *svcapitypes.ConnectionPoolConfiguration
+		latest  *svcapitypes.ConnectionPoolConfiguration
+		want    bool
+	}{
+		{"no desired configuration", nil, latest, false},
+		{"same max connections", &svcapitypes.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(100)}, latest, false},
+		{"other max connections", &svcapitypes.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(90)}, latest, true},
+		{"other borrow timeout", &svcapitypes.ConnectionPoolConfiguration{ConnectionBorrowTimeout: aws.Int64(60)}, latest, true},
+		{"other init query", &svcapitypes.ConnectionPoolConfiguration{InitQuery: aws.String("SET x=1")}, latest, true},
+		{"same session pinning filters", &svcapitypes.ConnectionPoolConfiguration{SessionPinningFilters: []*string{}}, latest, false},
+		{
+			"other session pinning filters",
+			&svcapitypes.ConnectionPoolConfiguration{SessionPinningFilters: aws.StringSlice([]string{"EXCLUDE_VARIABLE_SETS"})},
+			latest,
+			true,
+		},
+		{"no latest configuration", &svcapitypes.ConnectionPoolConfiguration{MaxConnectionsPercent: aws.Int64(100)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ConnectionPoolConfigDiffers(tt.desired, tt.latest); got != tt.want {
+				t.Errorf("ConnectionPoolConfigDiffers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
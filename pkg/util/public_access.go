@@ -0,0 +1,60 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+var (
+	ErrPublicAccessNotSupported = fmt.Errorf("public access not supported")
+)
+
+// VPCPublicAccess describes what makes the DB instances of a VPC reachable
+// from outside of it.
+type VPCPublicAccess struct {
+	VPCID string
+	// InternetGateway is true when an internet gateway is attached to the VPC
+	InternetGateway bool
+	// DNSSupport and DNSHostnames are true when the enableDnsSupport and
+	// enableDnsHostnames attributes of the VPC are enabled
+	DNSSupport   bool
+	DNSHostnames bool
+}
+
+// ValidatePublicAccess returns an ACK terminal error listing what the supplied
+// VPC lacks for its DB instances to be publicly accessible: an attached
+// internet gateway as well as DNS resolution and DNS hostnames enabled.
+func ValidatePublicAccess(vpc VPCPublicAccess) error {
+	var missing []string
+	if !vpc.InternetGateway {
+		missing = append(missing, "an attached internet gateway")
+	}
+	if !vpc.DNSSupport {
+		missing = append(missing, "DNS resolution enabled")
+	}
+	if !vpc.DNSHostnames {
+		missing = append(missing, "DNS hostnames enabled")
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: VPC %s needs %s", ErrPublicAccessNotSupported, vpc.VPCID,
+		strings.Join(missing, ", "),
+	))
+}
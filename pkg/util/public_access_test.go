@@ -0,0 +1,60 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidatePublicAccess(t *testing.T) {
+	tests := []struct {
+		name    string
+		vpc     util.VPCPublicAccess
+		wantErr string
+	}{
+		{
+			"public VPC",
+			util.VPCPublicAccess{VPCID: "vpc-1", InternetGateway: true, DNSSupport: true, DNSHostnames: true},
+			"",
+		},
+		{
+			"no internet gateway",
+			util.VPCPublicAccess{VPCID: "vpc-1", DNSSupport: true, DNSHostnames: true},
+			"VPC vpc-1 needs an attached internet gateway",
+		},
+		{
+			"no DNS",
+			util.VPCPublicAccess{VPCID: "vpc-1", InternetGateway: true},
+			"needs DNS resolution enabled, DNS hostnames enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidatePublicAccess(tt.vpc)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePublicAccess() unexpected error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, util.ErrPublicAccessNotSupported) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidatePublicAccess() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
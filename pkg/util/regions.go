@@ -0,0 +1,30 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+)
+
+// RegionalRDSAPI returns the RDS API of the supplied region, for the calls a
+// resource manager makes outside of its own region. The API is built from the
+// supplied session of the resource manager, so that it shares its
+// credentials, retries and endpoint configuration, and only overrides its
+// region.
+func RegionalRDSAPI(sess *session.Session, region string) svcsdkapi.RDSAPI {
+	return svcsdk.New(sess, aws.NewConfig().WithRegion(region))
+}
@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+)
+
+var (
+	ErrReservedOfferingNotFound  = fmt.Errorf("no reserved DB instance offering matches the Spec")
+	ErrAmbiguousReservedOffering = fmt.Errorf("several reserved DB instance offerings match the Spec")
+)
+
+// ReservedDBInstancesOffering returns the only offering, among the supplied
+// offerings returned by DescribeReservedDBInstancesOfferings, with the
+// supplied product description, or an ACK terminal error when there is none
+// or several of them. DescribeReservedDBInstancesOfferings matches product
+// descriptions partially, like aurora-mysql for mysql, so the offerings are
+// filtered on the whole product description. An empty product description
+// matches every offering.
+func ReservedDBInstancesOffering(
+	offerings []*svcsdk.ReservedDBInstancesOffering,
+	productDescription string,
+) (*svcsdk.ReservedDBInstancesOffering, error) {
+	var matching []*svcsdk.ReservedDBInstancesOffering
+	for _, offering := range offerings {
+		if productDescription != "" &&
+			(offering.ProductDescription == nil || *offering.ProductDescription != productDescription) {
+			continue
+		}
+		matching = append(matching, offering)
+	}
+	switch len(matching) {
+	case 0:
+		return nil, ackerr.NewTerminalError(ErrReservedOfferingNotFound)
+	case 1:
+		return matching[0], nil
+	}
+	ids := make([]string, 0, len(matching))
+	for _, offering := range matching {
+		if offering.ReservedDBInstancesOfferingId != nil {
+			ids = append(ids, *offering.ReservedDBInstancesOfferingId)
+		}
+	}
+	return nil, ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s, set Spec.ReservedDBInstancesOfferingID",
+		ErrAmbiguousReservedOffering, strings.Join(ids, ", "),
+	))
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestReservedDBInstancesOffering(t *testing.T) {
+	mysql := &svcsdk.ReservedDBInstancesOffering{
+		ProductDescription:            aws.String("mysql"),
+		ReservedDBInstancesOfferingId: aws.String("mysql-offering"),
+	}
+	auroraMySQL := &svcsdk.ReservedDBInstancesOffering{
+		ProductDescription:            aws.String("aurora-mysql"),
+		ReservedDBInstancesOfferingId: aws.String("aurora-mysql-offering"),
+	}
+	tests := []struct {
+		name               string
+		offerings          []*svcsdk.ReservedDBInstancesOffering
+		productDescription string
+		want               string
+		wantErr            error
+	}{
+		{"single", []*svcsdk.ReservedDBInstancesOffering{mysql}, "mysql", "mysql-offering", nil},
+		{"partial match", []*svcsdk.ReservedDBInstancesOffering{mysql, auroraMySQL}, "mysql", "mysql-offering", nil},
+		{"any product", []*svcsdk.ReservedDBInstancesOffering{auroraMySQL}, "", "aurora-mysql-offering", nil},
+		{"ambiguous", []*svcsdk.ReservedDBInstancesOffering{mysql, auroraMySQL}, "", "", util.ErrAmbiguousReservedOffering},
+		{"other product", []*svcsdk.ReservedDBInstancesOffering{auroraMySQL}, "mysql", "", util.ErrReservedOfferingNotFound},
+		{"no offerings", nil, "mysql", "", util.ErrReservedOfferingNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.ReservedDBInstancesOffering(tt.offerings, tt.productDescription)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ReservedDBInstancesOffering() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReservedDBInstancesOffering() unexpected error = %v", err)
+			}
+			if *got.ReservedDBInstancesOfferingId != tt.want {
+				t.Errorf("ReservedDBInstancesOffering() = %q, want %q", *got.ReservedDBInstancesOfferingId, tt.want)
+			}
+		})
+	}
+}
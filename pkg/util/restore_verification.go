@@ -0,0 +1,125 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// DefaultRestoreVerificationTimeout is how long a restore verification may
+	// take when its timeout is unset
+	DefaultRestoreVerificationTimeout = 2 * time.Hour
+	// snapshotStatusAvailable is the status of the DB snapshots that can be
+	// restored
+	snapshotStatusAvailable = "available"
+)
+
+var (
+	ErrInvalidRestoreVerification = fmt.Errorf("invalid restore verification")
+)
+
+// ValidateRestoreVerification returns an ACK terminal error when the supplied
+// restore verification cannot be evaluated: its schedule, snapshot selector,
+// timeout or health check are invalid.
+func ValidateRestoreVerification(spec *svcapitypes.RestoreVerificationSpec) error {
+	if _, _, err := LoadCronSchedule(spec.Schedule, spec.TimeZone); err != nil {
+		return newErrInvalidRestoreVerification(err.Error())
+	}
+	if spec.DBInstanceClass == nil || *spec.DBInstanceClass == "" {
+		return newErrInvalidRestoreVerification("dbInstanceClass is required")
+	}
+	if spec.SnapshotSelector == nil {
+		return newErrInvalidRestoreVerification("snapshotSelector is required")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(spec.SnapshotSelector); err != nil {
+		return newErrInvalidRestoreVerification(fmt.Sprintf("invalid snapshotSelector: %s", err))
+	}
+	if spec.Timeout != nil && spec.Timeout.Duration <= 0 {
+		return newErrInvalidRestoreVerification("timeout must be positive")
+	}
+	if hc := spec.HealthCheck; hc != nil {
+		if hc.Image == nil || *hc.Image == "" {
+			return newErrInvalidRestoreVerification("healthCheck requires an image")
+		}
+		if ref := hc.PasswordSecretRef; ref != nil && (ref.Name == "" || ref.Key == "") {
+			return newErrInvalidRestoreVerification("healthCheck passwordSecretRef requires a name and a key")
+		}
+	}
+	return nil
+}
+
+// RestoreVerificationTimes returns the most recent time of the schedule of
+// the supplied restore verification after last and not after now, which is
+// nil when no verification is due, and the next time of the schedule after
+// now.
+func RestoreVerificationTimes(
+	spec *svcapitypes.RestoreVerificationSpec,
+	last time.Time,
+	now time.Time,
+) (*time.Time, time.Time, error) {
+	schedule, loc, err := LoadCronSchedule(spec.Schedule, spec.TimeZone)
+	if err != nil {
+		return nil, time.Time{}, newErrInvalidRestoreVerification(err.Error())
+	}
+	due, next := schedule.Times(loc, last, now)
+	return due, next, nil
+}
+
+// RestoreVerificationTimeout returns how long a verification of the supplied
+// restore verification may take.
+func RestoreVerificationTimeout(spec *svcapitypes.RestoreVerificationSpec) time.Duration {
+	if spec.Timeout == nil {
+		return DefaultRestoreVerificationTimeout
+	}
+	return spec.Timeout.Duration
+}
+
+// LatestAvailableSnapshot returns the most recent of the supplied DBSnapshot
+// resources whose DB snapshot is available, or nil if none is. Snapshots are
+// ordered by the time RDS took them, falling back to the creation time of the
+// resource.
+func LatestAvailableSnapshot(snapshots []svcapitypes.DBSnapshot) *svcapitypes.DBSnapshot {
+	var latest *svcapitypes.DBSnapshot
+	var latestTime time.Time
+	for i := range snapshots {
+		s := &snapshots[i]
+		if s.Status.Status == nil || *s.Status.Status != snapshotStatusAvailable ||
+			s.Spec.DBSnapshotIdentifier == nil {
+			continue
+		}
+		t := s.CreationTimestamp.Time
+		if s.Status.SnapshotCreateTime != nil {
+			t = s.Status.SnapshotCreateTime.Time
+		}
+		if latest == nil || t.After(latestTime) {
+			latest, latestTime = s, t
+		}
+	}
+	return latest
+}
+
+// newErrInvalidRestoreVerification generates an ACK terminal error about a
+// restore verification that cannot be evaluated
+func newErrInvalidRestoreVerification(msg string) error {
+	return ackerr.NewTerminalError(
+		fmt.Errorf("%w: %s", ErrInvalidRestoreVerification, msg),
+	)
+}
@@ -0,0 +1,235 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateRestoreVerification(t *testing.T) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{svcapitypes.SnapshotScheduleLabel: "nightly"},
+	}
+	tests := []struct {
+		name    string
+		spec    svcapitypes.RestoreVerificationSpec
+		wantErr bool
+	}{
+		{
+			name: "without health check",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("0 6 * * 0"),
+				SnapshotSelector: selector,
+			},
+		},
+		{
+			name: "with health check",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("0 6 * * 0"),
+				TimeZone:         aws.String("Europe/Paris"),
+				SnapshotSelector: selector,
+				Timeout:          &metav1.Duration{Duration: time.Hour},
+				HealthCheck: &svcapitypes.RestoreHealthCheck{
+					Image:   aws.String("postgres:16"),
+					Command: []*string{aws.String("pg_isready")},
+					PasswordSecretRef: &ackv1alpha1.SecretKeyReference{
+						SecretReference: corev1.SecretReference{Name: "db-password"},
+						Key:             "password",
+					},
+				},
+			},
+		},
+		{
+			name: "no instance class",
+			spec: svcapitypes.RestoreVerificationSpec{
+				Schedule:         aws.String("0 6 * * 0"),
+				SnapshotSelector: selector,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no snapshot selector",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass: aws.String("db.t3.micro"),
+				Schedule:        aws.String("0 6 * * 0"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid snapshot selector",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass: aws.String("db.t3.micro"),
+				Schedule:        aws.String("0 6 * * 0"),
+				SnapshotSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "tier", Operator: "Near"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cron expression",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("weekly"),
+				SnapshotSelector: selector,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timeout",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("0 6 * * 0"),
+				SnapshotSelector: selector,
+				Timeout:          &metav1.Duration{Duration: -time.Hour},
+			},
+			wantErr: true,
+		},
+		{
+			name: "health check without image",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("0 6 * * 0"),
+				SnapshotSelector: selector,
+				HealthCheck:      &svcapitypes.RestoreHealthCheck{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "password secret without key",
+			spec: svcapitypes.RestoreVerificationSpec{
+				DBInstanceClass:  aws.String("db.t3.micro"),
+				Schedule:         aws.String("0 6 * * 0"),
+				SnapshotSelector: selector,
+				HealthCheck: &svcapitypes.RestoreHealthCheck{
+					Image: aws.String("postgres:16"),
+					PasswordSecretRef: &ackv1alpha1.SecretKeyReference{
+						SecretReference: corev1.SecretReference{Name: "db-password"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateRestoreVerification(&tt.spec)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidRestoreVerification) {
+				t.Errorf("ValidateRestoreVerification() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLatestAvailableSnapshot(t *testing.T) {
+	day := time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)
+	snapshot := func(name string, status string, created time.Time, taken *time.Time) svcapitypes.DBSnapshot {
+		s := svcapitypes.DBSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Spec:   svcapitypes.DBSnapshotSpec{DBSnapshotIdentifier: aws.String(name)},
+			Status: svcapitypes.DBSnapshotStatus{Status: aws.String(status)},
+		}
+		if taken != nil {
+			s.Status.SnapshotCreateTime = &metav1.Time{Time: *taken}
+		}
+		return s
+	}
+	earlier := day.Add(-48 * time.Hour)
+	tests := []struct {
+		name      string
+		snapshots []svcapitypes.DBSnapshot
+		want      string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "latest available",
+			snapshots: []svcapitypes.DBSnapshot{
+				snapshot("monday", "available", day, nil),
+				snapshot("tuesday", "available", day.Add(24*time.Hour), nil),
+				snapshot("wednesday", "creating", day.Add(48*time.Hour), nil),
+			},
+			want: "tuesday",
+		},
+		{
+			name: "snapshot time wins over creation time",
+			snapshots: []svcapitypes.DBSnapshot{
+				snapshot("adopted", "available", day.Add(time.Hour), &earlier),
+				snapshot("monday", "available", day, nil),
+			},
+			want: "monday",
+		},
+		{
+			name: "none available",
+			snapshots: []svcapitypes.DBSnapshot{
+				snapshot("monday", "failed", day, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.LatestAvailableSnapshot(tt.snapshots)
+			gotName := ""
+			if got != nil {
+				gotName = got.Name
+			}
+			if gotName != tt.want {
+				t.Errorf("LatestAvailableSnapshot() = %q, want %q", gotName, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestoreVerificationTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		spec svcapitypes.RestoreVerificationSpec
+		want time.Duration
+	}{
+		{
+			name: "default",
+			want: util.DefaultRestoreVerificationTimeout,
+		},
+		{
+			name: "set",
+			spec: svcapitypes.RestoreVerificationSpec{Timeout: &metav1.Duration{Duration: 30 * time.Minute}},
+			want: 30 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.RestoreVerificationTimeout(&tt.spec); got != tt.want {
+				t.Errorf("RestoreVerificationTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the time zone database so that schedules can be evaluated in any
+	// time zone regardless of the controller image.
+	_ "time/tzdata"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidSchedule = fmt.Errorf("invalid stop/start schedule")
+)
+
+// ScheduleLookback is how far back in time the last scheduled start or stop
+// is searched for. It is longer than the 7 days after which RDS automatically
+// starts a stopped DB instance or DB cluster so that such a restart is always
+// followed by a new stop while the schedule says so.
+const ScheduleLookback = 8 * 24 * time.Hour
+
+// CronScheduleHorizon is how far back in time a missed time of a schedule,
+// and how far ahead its next time, is searched for by CronSchedule.Times
+const CronScheduleHorizon = 366 * 24 * time.Hour
+
+// maxScheduledNameLength keeps the names returned by ScheduledResourceName,
+// made of the owner name and a 12 digits timestamp, within the 63 characters
+// of DB instance and DB cluster snapshot identifiers
+const maxScheduledNameLength = 50
+
+// cronFieldBounds are the minimum and maximum values of the minute, hour, day
+// of month, month and day of week fields of a cron expression.
+var cronFieldBounds = [5][2]int{
+	{0, 59},
+	{0, 23},
+	{1, 31},
+	{1, 12},
+	{0, 7},
+}
+
+// CronSchedule is a parsed cron expression made of the standard five fields
+// (minute, hour, day of month, month and day of week). Each field is either
+// "*" or a comma separated list of values, ranges ("1-5") and steps ("*/15",
+// "0-30/10").
+type CronSchedule struct {
+	fields [5]map[int]bool
+	// domAny and dowAny are true when the day of month and day of week fields
+	// are "*". As in cron, when both fields are restricted a time matches if
+	// either of them matches.
+	domAny bool
+	dowAny bool
+}
+
+// ParseCronSchedule parses the supplied five field cron expression
+func ParseCronSchedule(expr string) (*CronSchedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFieldBounds) {
+		return nil, fmt.Errorf(
+			"%q: expected %d fields, got %d",
+			expr, len(cronFieldBounds), len(parts),
+		)
+	}
+	s := &CronSchedule{
+		domAny: parts[2] == "*",
+		dowAny: parts[4] == "*",
+	}
+	for i, part := range parts {
+		values, err := parseCronField(part, cronFieldBounds[i][0], cronFieldBounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", expr, err)
+		}
+		s.fields[i] = values
+	}
+	// Both 0 and 7 are Sunday
+	if s.fields[4][7] {
+		s.fields[4][0] = true
+	}
+	return s, nil
+}
+
+// parseCronField returns the set of values of a single cron expression field
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		rng, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			var err error
+			rng = item[:i]
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", item)
+			}
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value in %q", item)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid value in %q", item)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("%q is out of range [%d-%d]", item, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+// Matches returns true if the supplied time, truncated to the minute, is one
+// of the times of the schedule
+func (s *CronSchedule) Matches(t time.Time) bool {
+	return s.fields[3][int(t.Month())] && s.dayMatches(t) &&
+		s.fields[1][t.Hour()] && s.fields[0][t.Minute()]
+}
+
+// dayMatches returns true if the day of the supplied time matches the day of
+// month and day of week fields of the schedule
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	dom := s.fields[2][t.Day()]
+	dow := s.fields[4][int(t.Weekday())]
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dow
+	case s.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// Last returns the most recent time of the schedule that is not after the
+// supplied time and not older than the supplied lookback duration, or false
+// when there is no such time.
+//
+// Rather than testing every minute, the search skips whole months, days and
+// hours that do not match the schedule, to the last minute before them.
+func (s *CronSchedule) Last(now time.Time, lookback time.Duration) (time.Time, bool) {
+	t := now.Truncate(time.Minute)
+	oldest := now.Add(-lookback)
+	loc := t.Location()
+	for !t.Before(oldest) {
+		y, m, d := t.Date()
+		switch {
+		case !s.fields[3][int(m)]:
+			t = time.Date(y, m, 1, 0, 0, 0, 0, loc).Add(-time.Minute)
+		case !s.dayMatches(t):
+			t = time.Date(y, m, d, 0, 0, 0, 0, loc).Add(-time.Minute)
+		case !s.fields[1][t.Hour()]:
+			t = time.Date(y, m, d, t.Hour(), 0, 0, 0, loc).Add(-time.Minute)
+		case !s.fields[0][t.Minute()]:
+			t = t.Add(-time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Next returns the first time of the schedule after the supplied time and not
+// later than the supplied lookahead duration, or false when there is no such
+// time.
+//
+// Rather than testing every minute, the search skips whole months, days and
+// hours that do not match the schedule, to the first minute after them.
+func (s *CronSchedule) Next(now time.Time, lookahead time.Duration) (time.Time, bool) {
+	t := now.Truncate(time.Minute).Add(time.Minute)
+	latest := now.Add(lookahead)
+	loc := t.Location()
+	for !t.After(latest) {
+		y, m, d := t.Date()
+		next := t.Add(time.Minute)
+		switch {
+		case !s.fields[3][int(m)]:
+			next = time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			next = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		case !s.fields[1][t.Hour()]:
+			next = time.Date(y, m, d, t.Hour()+1, 0, 0, 0, loc)
+		case !s.fields[0][t.Minute()]:
+		default:
+			return t, true
+		}
+		// time.Date may normalize a time skipped by a daylight saving time
+		// change to an earlier time; never search backwards.
+		if !next.After(t) {
+			next = t.Add(time.Minute)
+		}
+		t = next
+	}
+	return time.Time{}, false
+}
+
+// Times returns the most recent time of the schedule after last and not after
+// now, which is nil when no time of the schedule passed since last, and the
+// next time of the schedule after now. Both are evaluated in the supplied time
+// zone. When the schedule has no time within CronScheduleHorizon, the next
+// time returned is the end of the horizon.
+func (s *CronSchedule) Times(
+	loc *time.Location,
+	last time.Time,
+	now time.Time,
+) (*time.Time, time.Time) {
+	now = now.In(loc)
+	next, ok := s.Next(now, CronScheduleHorizon)
+	if !ok {
+		next = now.Add(CronScheduleHorizon)
+	}
+	lookback := now.Sub(last)
+	if lookback > CronScheduleHorizon {
+		lookback = CronScheduleHorizon
+	}
+	if lookback <= 0 {
+		return nil, next
+	}
+	due, ok := s.Last(now, lookback)
+	if !ok || !due.After(last) {
+		return nil, next
+	}
+	return &due, next
+}
+
+// LoadCronSchedule parses the supplied cron expression and loads the supplied
+// IANA time zone in which it is evaluated, defaulting to UTC.
+func LoadCronSchedule(expr *string, timeZone *string) (*CronSchedule, *time.Location, error) {
+	if expr == nil {
+		return nil, nil, fmt.Errorf("schedule is required")
+	}
+	schedule, err := ParseCronSchedule(*expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc := time.UTC
+	if timeZone != nil && *timeZone != "" {
+		if loc, err = time.LoadLocation(*timeZone); err != nil {
+			return nil, nil, err
+		}
+	}
+	return schedule, loc, nil
+}
+
+// ScheduledResourceName returns the name, and RDS identifier, of the resource
+// the named owner, like a SnapshotSchedule, creates for the supplied time of
+// its schedule. The name is a valid DB instance, DB snapshot and DB cluster
+// snapshot identifier: it starts with a letter, doesn't contain two
+// consecutive hyphens and is at most 63 characters long.
+func ScheduledResourceName(owner string, t time.Time) string {
+	name := strings.ReplaceAll(owner, ".", "-")
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "s" + name
+	}
+	if len(name) > maxScheduledNameLength {
+		name = name[:maxScheduledNameLength]
+	}
+	name = strings.TrimRight(name, "-")
+	return name + "-" + t.UTC().Format("200601021504")
+}
+
+// ScheduledStop returns whether the supplied schedule wants the DB instance or
+// DB cluster stopped at the supplied time: true when the last scheduled stop
+// is more recent than the last scheduled start. The second return value is
+// false when the schedule has no opinion, because there is no schedule or no
+// start nor stop happened during the last ScheduleLookback.
+func ScheduledStop(
+	schedule *svcapitypes.StopStartSchedule,
+	now time.Time,
+) (bool, bool, error) {
+	if schedule == nil {
+		return false, false, nil
+	}
+	loc := time.UTC
+	if schedule.TimeZone != nil && *schedule.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(*schedule.TimeZone); err != nil {
+			return false, false, NewErrInvalidSchedule(err)
+		}
+	}
+	now = now.In(loc)
+	var lastStart, lastStop time.Time
+	var started, stopped bool
+	if schedule.Start != nil {
+		start, err := ParseCronSchedule(*schedule.Start)
+		if err != nil {
+			return false, false, NewErrInvalidSchedule(err)
+		}
+		lastStart, started = start.Last(now, ScheduleLookback)
+	}
+	if schedule.Stop != nil {
+		stop, err := ParseCronSchedule(*schedule.Stop)
+		if err != nil {
+			return false, false, NewErrInvalidSchedule(err)
+		}
+		lastStop, stopped = stop.Last(now, ScheduleLookback)
+	}
+	switch {
+	case started && stopped:
+		return lastStop.After(lastStart), true, nil
+	case stopped:
+		return true, true, nil
+	case started:
+		return false, true, nil
+	}
+	return false, false, nil
+}
+
+// NextScheduledStopStart returns the next time after now the supplied
+// schedule stops or starts the DB instance or DB cluster, within
+// CronScheduleHorizon. nil is returned when there is no schedule, or no such
+// time, or the schedule cannot be evaluated, which ScheduledStop reports.
+func NextScheduledStopStart(
+	schedule *svcapitypes.StopStartSchedule,
+	now time.Time,
+) *time.Time {
+	if schedule == nil {
+		return nil
+	}
+	var next *time.Time
+	for _, expr := range []*string{schedule.Start, schedule.Stop} {
+		if expr == nil {
+			continue
+		}
+		cron, loc, err := LoadCronSchedule(expr, schedule.TimeZone)
+		if err != nil {
+			return nil
+		}
+		t, ok := cron.Next(now.In(loc), CronScheduleHorizon)
+		if ok && (next == nil || t.Before(*next)) {
+			next = &t
+		}
+	}
+	return next
+}
+
+// NewErrInvalidSchedule generates an ACK terminal error about a stop/start
+// schedule that cannot be evaluated
+func NewErrInvalidSchedule(err error) error {
+	return ackerr.NewTerminalError(
+		fmt.Errorf("%w: %v", ErrInvalidSchedule, err),
+	)
+}
@@ -0,0 +1,310 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	// Monday 2024-01-15
+	monday := time.Date(2024, time.January, 15, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		expr    string
+		time    time.Time
+		want    bool
+		wantErr bool
+	}{
+		{"every minute", "* * * * *", monday, true, false},
+		{"weekday list", "0 20 * * 1,3,5", monday, true, false},
+		{"weekday range", "0 20 * * 1-5", monday.AddDate(0, 0, 5), false, false},
+		{"sunday as 7", "0 20 * * 7", monday.AddDate(0, 0, 6), true, false},
+		{"step", "*/15 * * * *", monday.Add(45 * time.Minute), true, false},
+		{"step not matching", "*/15 * * * *", monday.Add(50 * time.Minute), false, false},
+		{"day of month or day of week", "0 20 1 * 1", monday, true, false},
+		{"month", "0 20 * 2 *", monday, false, false},
+		{"too few fields", "0 20 * *", monday, false, true},
+		{"out of range", "0 24 * * *", monday, false, true},
+		{"not a number", "0 eight * * *", monday, false, true},
+		{"invalid step", "*/0 * * * *", monday, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := util.ParseCronSchedule(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseCronSchedule(%q) expected an error", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCronSchedule(%q) unexpected error = %v", tt.expr, err)
+			}
+			if got := s.Matches(tt.time); got != tt.want {
+				t.Errorf("Matches(%v) = %v, want %v", tt.time, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronScheduleLastNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// lastMinute and nextMinute test every minute, as a reference
+	lastMinute := func(s *util.CronSchedule, now time.Time, lookback time.Duration) (time.Time, bool) {
+		for t := now.Truncate(time.Minute); !t.Before(now.Add(-lookback)); t = t.Add(-time.Minute) {
+			if s.Matches(t) {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	nextMinute := func(s *util.CronSchedule, now time.Time, lookahead time.Duration) (time.Time, bool) {
+		for t := now.Truncate(time.Minute).Add(time.Minute); !t.After(now.Add(lookahead)); t = t.Add(time.Minute) {
+			if s.Matches(t) {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	exprs := []string{
+		"* * * * *",
+		"0 20 * * 1-5",
+		"*/15 2 * * *",
+		"30 1 * * 0",
+		"0 0 29 2 *",
+		"0 12 1,15 * 5",
+		"0 20 1 1 *",
+	}
+	times := []time.Time{
+		time.Date(2024, time.January, 15, 12, 0, 30, 0, time.UTC),
+		time.Date(2024, time.February, 29, 23, 59, 0, 0, time.UTC),
+		time.Date(2024, time.December, 31, 23, 30, 0, 0, time.UTC),
+		// Around the daylight saving time changes in New York
+		time.Date(2024, time.March, 10, 1, 45, 0, 0, newYork),
+		time.Date(2024, time.November, 3, 1, 30, 0, 0, newYork),
+	}
+	for _, expr := range exprs {
+		s, err := util.ParseCronSchedule(expr)
+		if err != nil {
+			t.Fatalf("ParseCronSchedule(%q) unexpected error = %v", expr, err)
+		}
+		for _, now := range times {
+			for _, horizon := range []time.Duration{time.Hour, 40 * 24 * time.Hour} {
+				got, gotOK := s.Last(now, horizon)
+				want, wantOK := lastMinute(s, now, horizon)
+				if gotOK != wantOK || !got.Equal(want) {
+					t.Errorf("%q.Last(%v, %v) = (%v, %v), want (%v, %v)", expr, now, horizon, got, gotOK, want, wantOK)
+				}
+				got, gotOK = s.Next(now, horizon)
+				want, wantOK = nextMinute(s, now, horizon)
+				if gotOK != wantOK || !got.Equal(want) {
+					t.Errorf("%q.Next(%v, %v) = (%v, %v), want (%v, %v)", expr, now, horizon, got, gotOK, want, wantOK)
+				}
+			}
+		}
+	}
+}
+
+func TestNextScheduledStopStart(t *testing.T) {
+	now := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		schedule *svcapitypes.StopStartSchedule
+		want     *time.Time
+	}{
+		{
+			name: "no schedule",
+		},
+		{
+			name: "next stop",
+			schedule: &svcapitypes.StopStartSchedule{
+				Start: aws.String("0 8 * * 1-5"),
+				Stop:  aws.String("0 20 * * 1-5"),
+			},
+			want: aws.Time(time.Date(2024, time.January, 15, 20, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "next start in a time zone",
+			schedule: &svcapitypes.StopStartSchedule{
+				Start:    aws.String("0 8 * * 1-5"),
+				TimeZone: aws.String("America/New_York"),
+			},
+			// 08:00 in New York is 13:00 UTC
+			want: aws.Time(time.Date(2024, time.January, 15, 13, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "invalid cron expression",
+			schedule: &svcapitypes.StopStartSchedule{Stop: aws.String("at night")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.NextScheduledStopStart(tt.schedule, now)
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("NextScheduledStopStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduledStop(t *testing.T) {
+	weeknights := &svcapitypes.StopStartSchedule{
+		Start: aws.String("0 8 * * 1-5"),
+		Stop:  aws.String("0 20 * * 1-5"),
+	}
+	tests := []struct {
+		name          string
+		schedule      *svcapitypes.StopStartSchedule
+		now           time.Time
+		wantStop      bool
+		wantScheduled bool
+		wantErr       error
+	}{
+		{
+			name: "no schedule",
+			now:  time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "during the day",
+			schedule:      weeknights,
+			now:           time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+			wantScheduled: true,
+		},
+		{
+			name:          "at night",
+			schedule:      weeknights,
+			now:           time.Date(2024, time.January, 15, 23, 0, 0, 0, time.UTC),
+			wantStop:      true,
+			wantScheduled: true,
+		},
+		{
+			name:          "at the time of the stop",
+			schedule:      weeknights,
+			now:           time.Date(2024, time.January, 15, 20, 0, 30, 0, time.UTC),
+			wantStop:      true,
+			wantScheduled: true,
+		},
+		{
+			name:          "during the weekend",
+			schedule:      weeknights,
+			now:           time.Date(2024, time.January, 20, 12, 0, 0, 0, time.UTC),
+			wantStop:      true,
+			wantScheduled: true,
+		},
+		{
+			name: "time zone",
+			schedule: &svcapitypes.StopStartSchedule{
+				Start:    weeknights.Start,
+				Stop:     weeknights.Stop,
+				TimeZone: aws.String("America/New_York"),
+			},
+			// 23:00 UTC is 18:00 in New York
+			now:           time.Date(2024, time.January, 15, 23, 0, 0, 0, time.UTC),
+			wantScheduled: true,
+		},
+		{
+			name:          "stop only, stopped again after an automatic restart",
+			schedule:      &svcapitypes.StopStartSchedule{Stop: aws.String("0 20 1 1 *")},
+			now:           time.Date(2024, time.January, 8, 21, 0, 0, 0, time.UTC),
+			wantStop:      true,
+			wantScheduled: true,
+		},
+		{
+			name:     "nothing happened during the lookback",
+			schedule: &svcapitypes.StopStartSchedule{Stop: aws.String("0 20 1 1 *")},
+			now:      time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "invalid cron expression",
+			schedule: &svcapitypes.StopStartSchedule{Stop: aws.String("at night")},
+			now:      time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+			wantErr:  util.ErrInvalidSchedule,
+		},
+		{
+			name: "invalid time zone",
+			schedule: &svcapitypes.StopStartSchedule{
+				Stop:     weeknights.Stop,
+				TimeZone: aws.String("Nowhere/Special"),
+			},
+			now:     time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+			wantErr: util.ErrInvalidSchedule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stop, scheduled, err := util.ScheduledStop(tt.schedule, tt.now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ScheduledStop() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ScheduledStop() unexpected error = %v", err)
+			}
+			if stop != tt.wantStop || scheduled != tt.wantScheduled {
+				t.Errorf(
+					"ScheduledStop() = (%v, %v), want (%v, %v)",
+					stop, scheduled, tt.wantStop, tt.wantScheduled,
+				)
+			}
+		})
+	}
+}
+
+func TestScheduledResourceName(t *testing.T) {
+	at := time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		owner string
+		want  string
+	}{
+		{"simple", "nightly", "nightly-202401150300"},
+		{"dots", "db.nightly", "db-nightly-202401150300"},
+		{"consecutive hyphens", "db--nightly", "db-nightly-202401150300"},
+		{"leading digit", "1db", "s1db-202401150300"},
+		{
+			"long name",
+			"a-very-long-snapshot-schedule-name-for-a-production-database",
+			"a-very-long-snapshot-schedule-name-for-a-productio-202401150300",
+		},
+		{
+			"truncated on a hyphen",
+			"a-very-long-snapshot-schedule-name-for-the-maindb-fleet",
+			"a-very-long-snapshot-schedule-name-for-the-maindb-202401150300",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.ScheduledResourceName(tt.owner, at)
+			if got != tt.want {
+				t.Errorf("ScheduledResourceName() = %s, want %s", got, tt.want)
+			}
+			if len(got) > 63 {
+				t.Errorf("ScheduledResourceName() is %d characters long", len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// MasterUserSecretStatusActive is the status of a secret RDS manages in
+	// Secrets Manager for the master user once it can be used
+	MasterUserSecretStatusActive = "active"
+	// SecretVersionStageCurrent is the staging label of the current version
+	// of a Secrets Manager secret
+	SecretVersionStageCurrent = "AWSCURRENT"
+)
+
+var (
+	ErrInvalidMasterUserSecret = fmt.Errorf("invalid master user secret")
+)
+
+// SecretValueHash returns a hash of the supplied Secret value keyed by the
+// supplied key, typically the UID of the resource using the Secret. The hash
+// is recorded in the status of the resource to detect changes of the Secret
+// value. Keying the hash keeps the same value from hashing the same way for
+// different resources.
+func SecretValueHash(key string, value string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// MasterUserSecretPassword returns the master user password held by the
+// supplied value of a secret RDS manages in Secrets Manager, a JSON document
+// with the username and password of the master user.
+func MasterUserSecretPassword(secretString string) (string, error) {
+	var secret struct {
+		Password *string `json:"password"`
+	}
+	if err := json.Unmarshal([]byte(secretString), &secret); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidMasterUserSecret, err)
+	}
+	if secret.Password == nil {
+		return "", fmt.Errorf("%w: no password", ErrInvalidMasterUserSecret)
+	}
+	return *secret.Password, nil
+}
+
+// SecretStringPassword returns the password held by the supplied value of a
+// Secrets Manager secret: the password key of the value when it is a JSON
+// object with one, like the secrets RDS manages, and the value itself
+// otherwise.
+func SecretStringPassword(secretString string) string {
+	if password, err := MasterUserSecretPassword(secretString); err == nil {
+		return password
+	}
+	return secretString
+}
+
+// CurrentSecretVersionID returns the identifier of the current version of a
+// Secrets Manager secret, the one with the AWSCURRENT staging label in the
+// supplied versions returned by DescribeSecret, or "" when there is none.
+func CurrentSecretVersionID(versionIDsToStages map[string][]*string) string {
+	for id, stages := range versionIDsToStages {
+		for _, stage := range stages {
+			if stage != nil && *stage == SecretVersionStageCurrent {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// ValidateMasterUserSecret returns an ACK terminal error when the master user
+// password is set from more than one of the supplied Kubernetes Secret, the
+// supplied Secrets Manager secret ARN and the management in Secrets Manager
+// requested by the supplied manage flag, or when it is copied to the supplied
+// destination without being managed in Secrets Manager.
+func ValidateMasterUserSecret(
+	manage *bool,
+	password *ackv1alpha1.SecretKeyReference,
+	passwordSecretARN *string,
+	destination *svcapitypes.MasterUserSecretDestination,
+) error {
+	managed := manage != nil && *manage
+	if managed && password != nil {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: the master user password cannot be both managed in Secrets Manager and set from a Secret",
+			ErrInvalidMasterUserSecret,
+		))
+	}
+	if passwordSecretARN != nil && (managed || password != nil) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: the master user password cannot be both read from a Secrets Manager secret and set otherwise",
+			ErrInvalidMasterUserSecret,
+		))
+	}
+	if !managed && destination != nil {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: the master user password can only be copied to a Secret when it is managed in Secrets Manager",
+			ErrInvalidMasterUserSecret,
+		))
+	}
+	return nil
+}
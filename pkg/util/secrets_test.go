@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestSecretValueHash(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		value     string
+		otherKey  string
+		otherVal  string
+		wantEqual bool
+	}{
+		{"same key and value", "uid-1", "password", "uid-1", "password", true},
+		{"different value", "uid-1", "password", "uid-1", "passw0rd", false},
+		{"different key", "uid-1", "password", "uid-2", "password", false},
+		{"empty value", "uid-1", "", "uid-1", "password", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.SecretValueHash(tt.key, tt.value)
+			other := util.SecretValueHash(tt.otherKey, tt.otherVal)
+			if (got == other) != tt.wantEqual {
+				t.Errorf("SecretValueHash(%q, %q) == SecretValueHash(%q, %q) is %v, want %v",
+					tt.key, tt.value, tt.otherKey, tt.otherVal, got == other, tt.wantEqual)
+			}
+			if got == tt.value {
+				t.Errorf("SecretValueHash(%q, %q) returned the value", tt.key, tt.value)
+			}
+		})
+	}
+}
+
+func TestMasterUserSecretPassword(t *testing.T) {
+	tests := []struct {
+		name         string
+		secretString string
+		want         string
+		wantErr      bool
+	}{
+		{"username and password", `{"username":"admin","password":"s3cr3t"}`, "s3cr3t", false},
+		{"empty password", `{"username":"admin","password":""}`, "", false},
+		{"no password", `{"username":"admin"}`, "", true},
+		{"not JSON", "s3cr3t", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.MasterUserSecretPassword(tt.secretString)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidMasterUserSecret) {
+					t.Errorf("MasterUserSecretPassword() error = %v, want %v", err, util.ErrInvalidMasterUserSecret)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MasterUserSecretPassword() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MasterUserSecretPassword() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretStringPassword(t *testing.T) {
+	tests := []struct {
+		name         string
+		secretString string
+		want         string
+	}{
+		{"username and password", `{"username":"admin","password":"s3cr3t"}`, "s3cr3t"},
+		{"plain text", "s3cr3t", "s3cr3t"},
+		{"JSON without password", `{"username":"admin"}`, `{"username":"admin"}`},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.SecretStringPassword(tt.secretString); got != tt.want {
+				t.Errorf("SecretStringPassword(%q) = %q, want %q", tt.secretString, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrentSecretVersionID(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions map[string][]*string
+		want     string
+	}{
+		{
+			"current and previous versions",
+			map[string][]*string{
+				"v1": {aws.String("AWSPREVIOUS")},
+				"v2": {aws.String("AWSCURRENT"), aws.String("custom")},
+			},
+			"v2",
+		},
+		{"no current version", map[string][]*string{"v1": {aws.String("AWSPENDING")}}, ""},
+		{"no versions", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.CurrentSecretVersionID(tt.versions); got != tt.want {
+				t.Errorf("CurrentSecretVersionID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMasterUserSecret(t *testing.T) {
+	ref := &ackv1alpha1.SecretKeyReference{Key: "password"}
+	dest := &svcapitypes.MasterUserSecretDestination{Name: aws.String("db-password"), Key: aws.String("password")}
+	arn := aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-AbCdEf")
+	tests := []struct {
+		name        string
+		manage      *bool
+		password    *ackv1alpha1.SecretKeyReference
+		secretARN   *string
+		destination *svcapitypes.MasterUserSecretDestination
+		wantErr     bool
+	}{
+		{"nothing set", nil, nil, nil, nil, false},
+		{"password from a Secret", nil, ref, nil, nil, false},
+		{"password from a Secrets Manager secret", nil, nil, arn, nil, false},
+		{"managed", aws.Bool(true), nil, nil, nil, false},
+		{"managed and copied", aws.Bool(true), nil, nil, dest, false},
+		{"managed and set from a Secret", aws.Bool(true), ref, nil, nil, true},
+		{"managed and read from a Secrets Manager secret", aws.Bool(true), nil, arn, nil, true},
+		{"set from a Secret and a Secrets Manager secret", nil, ref, arn, nil, true},
+		{"read from a Secrets Manager secret with management turned off", aws.Bool(false), nil, arn, nil, false},
+		{"copied without being managed", nil, ref, nil, dest, true},
+		{"copied with management turned off", aws.Bool(false), nil, nil, dest, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateMasterUserSecret(tt.manage, tt.password, tt.secretARN, tt.destination)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidMasterUserSecret) {
+					t.Errorf("ValidateMasterUserSecret() error = %v, want %v", err, util.ErrInvalidMasterUserSecret)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateMasterUserSecret() unexpected error = %v", err)
+			}
+		})
+	}
+}
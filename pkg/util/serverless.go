@@ -0,0 +1,189 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// ServerlessV2MaxCapacity is the largest capacity, in Aurora capacity
+	// units (ACUs), of an Aurora Serverless v2 DB cluster
+	ServerlessV2MaxCapacity = 256
+	// ServerlessV2CapacityIncrement is the increment, in ACUs, of the
+	// capacities of an Aurora Serverless v2 DB cluster
+	ServerlessV2CapacityIncrement = 0.5
+
+	// EngineModeServerless is the engine mode of Aurora Serverless v1 DB
+	// clusters
+	EngineModeServerless = "serverless"
+)
+
+var (
+	ErrInvalidServerlessV2Scaling = fmt.Errorf("invalid Aurora Serverless v2 scaling configuration")
+	ErrInvalidServerlessV1Scaling = fmt.Errorf("invalid Aurora Serverless v1 scaling configuration")
+
+	// serverlessV1Capacities are the valid capacities, in ACUs, of the
+	// Aurora Serverless v1 DB clusters, by engine
+	serverlessV1Capacities = map[string][]int64{
+		"aurora-mysql":      {1, 2, 4, 8, 16, 32, 64, 128, 256},
+		"aurora-postgresql": {2, 4, 8, 16, 32, 64, 192, 384},
+	}
+	// serverlessV1TimeoutActions are the valid actions of an Aurora
+	// Serverless v1 DB cluster when a capacity change times out
+	serverlessV1TimeoutActions = []string{"ForceApplyCapacityChange", "RollbackCapacityChange"}
+
+	// serverlessV2ScaleToZeroPostgreSQLVersions are the first Aurora
+	// PostgreSQL engine versions, by major engine version, supporting a
+	// minimum capacity of 0 ACUs. The major engine versions after the last
+	// one all support it.
+	serverlessV2ScaleToZeroPostgreSQLVersions = map[string]string{
+		"13": "13.15",
+		"14": "14.12",
+		"15": "15.7",
+		"16": "16.3",
+	}
+)
+
+// serverlessV2ScaleToZeroMySQLVersion is the first Aurora MySQL version
+// supporting a minimum capacity of 0 ACUs
+const serverlessV2ScaleToZeroMySQLVersion = "3.08.0"
+
+// ServerlessV2ScaleToZeroSupported returns false when an Aurora Serverless v2
+// DB cluster with the supplied engine and engine version cannot scale to a
+// minimum capacity of 0 ACUs. An engine version made of only its major
+// version, resolved to its latest minor engine version, is supported when
+// any of its minor engine versions is.
+func ServerlessV2ScaleToZeroSupported(engine string, engineVersion string) bool {
+	major, _, _ := strings.Cut(engineVersion, ".")
+	switch engine {
+	case "aurora-postgresql":
+		first, ok := serverlessV2ScaleToZeroPostgreSQLVersions[major]
+		if !ok {
+			return CompareEngineVersions(major, "16") > 0
+		}
+		return major == engineVersion || CompareEngineVersions(engineVersion, first) >= 0
+	case "aurora-mysql":
+		// Aurora MySQL engine versions are like 8.0.mysql_aurora.3.08.0
+		_, auroraVersion, found := strings.Cut(engineVersion, ".mysql_aurora.")
+		if !found {
+			return major == "8" || CompareEngineVersions(major, "8") > 0
+		}
+		return CompareEngineVersions(auroraVersion, serverlessV2ScaleToZeroMySQLVersion) >= 0
+	}
+	return false
+}
+
+// ValidateServerlessV2Scaling returns an ACK terminal error when the supplied
+// minimum and maximum capacities of an Aurora Serverless v2 DB cluster with
+// the supplied engine and engine version are not valid: both must be set, in
+// increments of ServerlessV2CapacityIncrement, the maximum capacity between 1
+// and ServerlessV2MaxCapacity, the minimum capacity between 0 and the maximum
+// capacity, and 0 only on the engine versions supporting it.
+func ValidateServerlessV2Scaling(
+	engine string,
+	engineVersion *string,
+	minCapacity *float64,
+	maxCapacity *float64,
+) error {
+	if minCapacity == nil || maxCapacity == nil {
+		return serverlessV2ScalingError("both the minimum and maximum capacities must be set")
+	}
+	for _, capacity := range []float64{*minCapacity, *maxCapacity} {
+		if math.Mod(capacity, ServerlessV2CapacityIncrement) != 0 {
+			return serverlessV2ScalingError(
+				fmt.Sprintf("capacity %v is not a multiple of %v ACUs", capacity, ServerlessV2CapacityIncrement),
+			)
+		}
+	}
+	if *maxCapacity < 1 || *maxCapacity > ServerlessV2MaxCapacity {
+		return serverlessV2ScalingError(
+			fmt.Sprintf("maximum capacity %v is not between 1 and %d ACUs", *maxCapacity, ServerlessV2MaxCapacity),
+		)
+	}
+	if *minCapacity < 0 || *minCapacity > *maxCapacity {
+		return serverlessV2ScalingError(
+			fmt.Sprintf("minimum capacity %v is not between 0 and the maximum capacity", *minCapacity),
+		)
+	}
+	if *minCapacity == 0 && engineVersion != nil && !ServerlessV2ScaleToZeroSupported(engine, *engineVersion) {
+		return serverlessV2ScalingError(
+			fmt.Sprintf("%s %s cannot scale to 0 ACUs", engine, *engineVersion),
+		)
+	}
+	return nil
+}
+
+func serverlessV2ScalingError(msg string) error {
+	return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidServerlessV2Scaling, msg))
+}
+
+// ValidateServerlessV1Scaling returns an ACK terminal error when the supplied
+// scaling configuration of an Aurora Serverless v1 DB cluster with the
+// supplied engine and engine mode is not valid: it is only supported in the
+// serverless engine mode, its capacities must be valid capacities of the
+// engine, the minimum one not above the maximum one, the DB cluster can be
+// paused after 5 minutes to 1 day and capacity changes can time out after 1
+// to 10 minutes.
+func ValidateServerlessV1Scaling(
+	engine string,
+	engineMode *string,
+	config *svcapitypes.ScalingConfiguration,
+) error {
+	if config == nil {
+		return nil
+	}
+	if engineMode == nil || *engineMode != EngineModeServerless {
+		return serverlessV1ScalingError("only supported in the " + EngineModeServerless + " engine mode")
+	}
+	if capacities, ok := serverlessV1Capacities[engine]; ok {
+		for _, capacity := range []*int64{config.MinCapacity, config.MaxCapacity} {
+			if capacity != nil && !slices.Contains(capacities, *capacity) {
+				return serverlessV1ScalingError(
+					fmt.Sprintf("capacity %d is not one of %v ACUs for %s", *capacity, capacities, engine),
+				)
+			}
+		}
+	}
+	if config.MinCapacity != nil && config.MaxCapacity != nil && *config.MinCapacity > *config.MaxCapacity {
+		return serverlessV1ScalingError("the minimum capacity is above the maximum capacity")
+	}
+	if seconds := config.SecondsUntilAutoPause; seconds != nil && (*seconds < 300 || *seconds > 86400) {
+		return serverlessV1ScalingError(
+			fmt.Sprintf("%d seconds until auto-pause is not between 300 and 86400", *seconds),
+		)
+	}
+	if seconds := config.SecondsBeforeTimeout; seconds != nil && (*seconds < 60 || *seconds > 600) {
+		return serverlessV1ScalingError(
+			fmt.Sprintf("%d seconds before timeout is not between 60 and 600", *seconds),
+		)
+	}
+	if action := config.TimeoutAction; action != nil && !slices.Contains(serverlessV1TimeoutActions, *action) {
+		return serverlessV1ScalingError(
+			fmt.Sprintf("timeout action %q is not one of %v", *action, serverlessV1TimeoutActions),
+		)
+	}
+	return nil
+}
+
+func serverlessV1ScalingError(msg string) error {
+	return ackerr.NewTerminalError(fmt.Errorf("%w: %s", ErrInvalidServerlessV1Scaling, msg))
+}
@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestServerlessV2ScaleToZeroSupported(t *testing.T) {
+	tests := []struct {
+		engine        string
+		engineVersion string
+		want          bool
+	}{
+		{"aurora-postgresql", "16.3", true},
+		{"aurora-postgresql", "16.6", true},
+		{"aurora-postgresql", "16.1", false},
+		{"aurora-postgresql", "13.15", true},
+		{"aurora-postgresql", "13.9", false},
+		{"aurora-postgresql", "12.20", false},
+		{"aurora-postgresql", "17.4", true},
+		{"aurora-postgresql", "16", true},
+		{"aurora-mysql", "8.0.mysql_aurora.3.08.0", true},
+		{"aurora-mysql", "8.0.mysql_aurora.3.10.1", true},
+		{"aurora-mysql", "8.0.mysql_aurora.3.07.1", false},
+		{"aurora-mysql", "5.7.mysql_aurora.2.12.3", false},
+		{"aurora-mysql", "8.0", true},
+		{"mysql", "8.0.40", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.engine+" "+tt.engineVersion, func(t *testing.T) {
+			if got := util.ServerlessV2ScaleToZeroSupported(tt.engine, tt.engineVersion); got != tt.want {
+				t.Errorf("ServerlessV2ScaleToZeroSupported(%q, %q) = %v, want %v", tt.engine, tt.engineVersion, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateServerlessV2Scaling(t *testing.T) {
+	tests := []struct {
+		name          string
+		engineVersion *string
+		minCapacity   *float64
+		maxCapacity   *float64
+		wantErr       bool
+	}{
+		{"valid", aws.String("15.4"), aws.Float64(0.5), aws.Float64(16), false},
+		{"scale to zero", aws.String("16.3"), aws.Float64(0), aws.Float64(16), false},
+		{"scale to zero on the default engine version", nil, aws.Float64(0), aws.Float64(16), false},
+		{"scale to zero not supported", aws.String("15.4"), aws.Float64(0), aws.Float64(16), true},
+		{"no minimum capacity", aws.String("15.4"), nil, aws.Float64(16), true},
+		{"no maximum capacity", aws.String("15.4"), aws.Float64(0.5), nil, true},
+		{"not an increment", aws.String("15.4"), aws.Float64(0.75), aws.Float64(16), true},
+		{"maximum capacity too small", aws.String("15.4"), aws.Float64(0.5), aws.Float64(0.5), true},
+		{"maximum capacity too large", aws.String("15.4"), aws.Float64(0.5), aws.Float64(512), true},
+		{"minimum above maximum", aws.String("15.4"), aws.Float64(32), aws.Float64(16), true},
+		{"negative minimum", aws.String("15.4"), aws.Float64(-1), aws.Float64(16), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateServerlessV2Scaling("aurora-postgresql", tt.engineVersion, tt.minCapacity, tt.maxCapacity)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidServerlessV2Scaling) {
+					t.Errorf("ValidateServerlessV2Scaling() error = %v, want %v", err, util.ErrInvalidServerlessV2Scaling)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateServerlessV2Scaling() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateServerlessV1Scaling(t *testing.T) {
+	serverless := aws.String("serverless")
+	tests := []struct {
+		name       string
+		engine     string
+		engineMode *string
+		config     *svcapitypes.ScalingConfiguration
+		wantErr    bool
+	}{
+		{"no scaling configuration", "aurora-mysql", nil, nil, false},
+		{"valid", "aurora-mysql", serverless, &svcapitypes.ScalingConfiguration{
+			AutoPause:             aws.Bool(true),
+			MinCapacity:           aws.Int64(1),
+			MaxCapacity:           aws.Int64(16),
+			SecondsUntilAutoPause: aws.Int64(600),
+			SecondsBeforeTimeout:  aws.Int64(300),
+			TimeoutAction:         aws.String("RollbackCapacityChange"),
+		}, false},
+		{"valid for aurora-postgresql", "aurora-postgresql", serverless, &svcapitypes.ScalingConfiguration{
+			MinCapacity: aws.Int64(2),
+			MaxCapacity: aws.Int64(384),
+		}, false},
+		{"provisioned engine mode", "aurora-mysql", aws.String("provisioned"), &svcapitypes.ScalingConfiguration{}, true},
+		{"no engine mode", "aurora-mysql", nil, &svcapitypes.ScalingConfiguration{}, true},
+		{"invalid capacity", "aurora-postgresql", serverless, &svcapitypes.ScalingConfiguration{
+			MinCapacity: aws.Int64(1),
+		}, true},
+		{"minimum above maximum", "aurora-mysql", serverless, &svcapitypes.ScalingConfiguration{
+			MinCapacity: aws.Int64(32),
+			MaxCapacity: aws.Int64(8),
+		}, true},
+		{"auto-pause too soon", "aurora-mysql", serverless, &svcapitypes.ScalingConfiguration{
+			SecondsUntilAutoPause: aws.Int64(60),
+		}, true},
+		{"timeout too late", "aurora-mysql", serverless, &svcapitypes.ScalingConfiguration{
+			SecondsBeforeTimeout: aws.Int64(900),
+		}, true},
+		{"invalid timeout action", "aurora-mysql", serverless, &svcapitypes.ScalingConfiguration{
+			TimeoutAction: aws.String("Retry"),
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateServerlessV1Scaling(tt.engine, tt.engineMode, tt.config)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidServerlessV1Scaling) {
+					t.Errorf("ValidateServerlessV1Scaling() error = %v, want %v", err, util.ErrInvalidServerlessV1Scaling)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateServerlessV1Scaling() unexpected error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update
+
+var (
+	ErrNotOwned = fmt.Errorf("object not owned by the resource")
+)
+
+// kubeClient is used to maintain the Kubernetes Services pointed at the
+// endpoints of DB instances and DB clusters, their Service Binding and
+// connection Secrets, the ConfigMaps holding the RDS certificate bundle, the
+// member DBInstances of DB clusters and the finalizers of adopting resources.
+// It is nil (and no Kubernetes objects are maintained) until SetKubeClient is
+// called.
+var kubeClient client.Client
+
+// SetKubeClient sets the client used to maintain Kubernetes objects.
+func SetKubeClient(c client.Client) {
+	kubeClient = c
+}
+
+// NewExternalNameService returns a Kubernetes ExternalName Service with the
+// supplied namespace and name pointing at the supplied host, owned by the
+// supplied owner so that it is deleted along with it.
+func NewExternalNameService(
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	host string,
+) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: host,
+		},
+	}
+}
+
+// OwnedBy returns true if the supplied Kubernetes object is owned by the
+// object with the supplied UID.
+func OwnedBy(obj metav1.Object, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureExternalNameService creates the ExternalName Service with the supplied
+// namespace and name pointing at the supplied host, or points it at the host
+// when it already exists. It returns an ACK terminal error when the Service
+// exists but is not owned by the supplied owner, so that Services the
+// controller does not manage are left untouched.
+func EnsureExternalNameService(
+	ctx context.Context,
+	owner metav1.OwnerReference,
+	namespace string,
+	name string,
+	host string,
+) error {
+	if kubeClient == nil {
+		return nil
+	}
+	service := &corev1.Service{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, service)
+	if apierrors.IsNotFound(err) {
+		return kubeClient.Create(ctx, NewExternalNameService(owner, namespace, name, host))
+	}
+	if err != nil {
+		return err
+	}
+	if !OwnedBy(service, owner.UID) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: service %s/%s already exists", ErrNotOwned, namespace, name,
+		))
+	}
+	if service.Spec.Type == corev1.ServiceTypeExternalName && service.Spec.ExternalName == host {
+		return nil
+	}
+	service.Spec.Type = corev1.ServiceTypeExternalName
+	service.Spec.ExternalName = host
+	return kubeClient.Update(ctx, service)
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestNewExternalNameService(t *testing.T) {
+	owner := metav1.OwnerReference{Kind: "DBInstance", Name: "db", UID: types.UID("uid-1")}
+	host := "db.abcdefghijkl.us-west-2.rds.amazonaws.com"
+	got := util.NewExternalNameService(owner, "default", "db", host)
+	if got.Namespace != "default" || got.Name != "db" {
+		t.Errorf("NewExternalNameService() = %s/%s, want default/db", got.Namespace, got.Name)
+	}
+	if got.Spec.Type != corev1.ServiceTypeExternalName || got.Spec.ExternalName != host {
+		t.Errorf("NewExternalNameService() spec = %v %q, want ExternalName %q", got.Spec.Type, got.Spec.ExternalName, host)
+	}
+	if !util.OwnedBy(got, owner.UID) {
+		t.Errorf("NewExternalNameService() is not owned by %q", owner.UID)
+	}
+}
+
+func TestOwnedBy(t *testing.T) {
+	tests := []struct {
+		name   string
+		owners []metav1.OwnerReference
+		uid    types.UID
+		want   bool
+	}{
+		{"no owner", nil, "uid-1", false},
+		{"owned", []metav1.OwnerReference{{UID: "uid-1"}}, "uid-1", true},
+		{"owned among others", []metav1.OwnerReference{{UID: "uid-2"}, {UID: "uid-1"}}, "uid-1", true},
+		{"other owner", []metav1.OwnerReference{{UID: "uid-2"}}, "uid-1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{OwnerReferences: tt.owners}}
+			if got := util.OwnedBy(service, tt.uid); got != tt.want {
+				t.Errorf("OwnedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
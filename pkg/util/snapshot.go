@@ -0,0 +1,93 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+// SafetySnapshotIdentifier returns the identifier of the safety snapshot of
+// the DB instance or DB cluster with the supplied identifier taken at the
+// supplied time, before a modification that is hard to roll back.
+func SafetySnapshotIdentifier(identifier string, now time.Time) string {
+	return identifier + "-safety-" + now.UTC().Format("20060102150405")
+}
+
+// MajorEngineVersionUpgrade returns true if upgrading a database of the
+// supplied engine from the latest engine version to the desired one changes
+// its major engine version. The major version of MySQL and MariaDB engines is
+// made of the first two components of the engine version, like 8.0, as is the
+// one of PostgreSQL engines before version 10, like 9.6. The major version of
+// the other engines is the first component of the engine version.
+func MajorEngineVersionUpgrade(engine string, latest string, desired string) bool {
+	return majorEngineVersion(engine, latest) != majorEngineVersion(engine, desired)
+}
+
+// majorEngineVersion returns the major version of the supplied engine version
+// of the supplied engine.
+func majorEngineVersion(engine string, version string) string {
+	components := strings.SplitN(version, ".", 3)
+	if len(components) < 2 {
+		return version
+	}
+	switch {
+	case strings.Contains(engine, "mysql"), engine == "mariadb":
+		return components[0] + "." + components[1]
+	case strings.Contains(engine, "postgres") && len(components[0]) < 2:
+		return components[0] + "." + components[1]
+	}
+	return components[0]
+}
+
+const (
+	// SnapshotAttributeRestore is the name of the manual snapshot attribute
+	// listing the AWS accounts allowed to copy or restore the snapshot
+	SnapshotAttributeRestore = "restore"
+	// SnapshotSharedWithAll is the value of the restore attribute of the
+	// public manual snapshots, which any AWS account can copy or restore
+	SnapshotSharedWithAll = "all"
+)
+
+var ErrInvalidSharedAccountID = fmt.Errorf("invalid shared account ID")
+
+// accountIDRegexp matches AWS account IDs
+var accountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
+// ValidateSharedAccountIDs returns an ACK terminal error listing the supplied
+// values of the restore attribute of a manual snapshot that are neither AWS
+// account IDs nor SnapshotSharedWithAll.
+func ValidateSharedAccountIDs(ids []*string) error {
+	var invalid []string
+	for _, id := range ids {
+		if id == nil {
+			continue
+		}
+		if *id != SnapshotSharedWithAll && !accountIDRegexp.MatchString(*id) {
+			invalid = append(invalid, *id)
+		}
+	}
+	if len(invalid) > 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: %s, expected 12 digit AWS account IDs or %q",
+			ErrInvalidSharedAccountID, strings.Join(invalid, ", "),
+			SnapshotSharedWithAll,
+		))
+	}
+	return nil
+}
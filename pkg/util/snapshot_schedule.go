@@ -0,0 +1,183 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidSnapshotSchedule = fmt.Errorf("invalid snapshot schedule")
+)
+
+// ScheduledSnapshot is a snapshot resource taken by a snapshot schedule
+type ScheduledSnapshot struct {
+	Name         string
+	CreationTime time.Time
+}
+
+// ValidateSnapshotSchedule returns an ACK terminal error when the supplied
+// snapshot schedule cannot be evaluated or does not reference exactly one DB
+// instance or DB cluster.
+func ValidateSnapshotSchedule(spec *svcapitypes.SnapshotScheduleSpec) error {
+	if _, _, err := LoadCronSchedule(spec.Schedule, spec.TimeZone); err != nil {
+		return newErrInvalidSnapshotSchedule(err.Error())
+	}
+	if (spec.DBInstanceRef == nil) == (spec.DBClusterRef == nil) {
+		return newErrInvalidSnapshotSchedule("exactly one of dbInstanceRef and dbClusterRef is required")
+	}
+	if spec.DBInstanceRef != nil && (spec.DBInstanceRef.From == nil || spec.DBInstanceRef.From.Name == nil) {
+		return newErrInvalidSnapshotSchedule("dbInstanceRef requires the name of a DBInstance")
+	}
+	if spec.DBClusterRef != nil && (spec.DBClusterRef.From == nil || spec.DBClusterRef.From.Name == nil) {
+		return newErrInvalidSnapshotSchedule("dbClusterRef requires the name of a DBCluster")
+	}
+	if r := spec.Retention; r != nil {
+		for _, rule := range []struct {
+			field string
+			n     *int64
+		}{
+			{"count", r.Count},
+			{"daily", r.Daily},
+			{"weekly", r.Weekly},
+			{"monthly", r.Monthly},
+		} {
+			if rule.n != nil && *rule.n < 1 {
+				return newErrInvalidSnapshotSchedule(fmt.Sprintf("retention %s must be at least 1", rule.field))
+			}
+		}
+		if r.MaxAge != nil && r.MaxAge.Duration <= 0 {
+			return newErrInvalidSnapshotSchedule("retention maxAge must be positive")
+		}
+	}
+	return nil
+}
+
+// SnapshotScheduleTimes returns the most recent time of the supplied snapshot
+// schedule after last and not after now, which is nil when no snapshot is
+// due, and the next time of the schedule after now.
+func SnapshotScheduleTimes(
+	spec *svcapitypes.SnapshotScheduleSpec,
+	last time.Time,
+	now time.Time,
+) (*time.Time, time.Time, error) {
+	schedule, loc, err := LoadCronSchedule(spec.Schedule, spec.TimeZone)
+	if err != nil {
+		return nil, time.Time{}, newErrInvalidSnapshotSchedule(err.Error())
+	}
+	due, next := schedule.Times(loc, last, now)
+	return due, next, nil
+}
+
+// SnapshotScheduleLocation returns the time zone the supplied snapshot
+// schedule is evaluated in.
+func SnapshotScheduleLocation(spec *svcapitypes.SnapshotScheduleSpec) (*time.Location, error) {
+	_, loc, err := LoadCronSchedule(spec.Schedule, spec.TimeZone)
+	if err != nil {
+		return nil, newErrInvalidSnapshotSchedule(err.Error())
+	}
+	return loc, nil
+}
+
+// ExpiredSnapshots returns the names of the supplied scheduled snapshots that
+// are past the supplied retention at the supplied time, oldest first, and the
+// number of snapshots kept by each rule of the retention. A snapshot is
+// expired when it is older than the maximum age of the retention, or when
+// none of its count, daily, weekly and monthly rules keeps it. Days, weeks and
+// months are those of the supplied time zone.
+func ExpiredSnapshots(
+	snapshots []ScheduledSnapshot,
+	retention *svcapitypes.SnapshotRetention,
+	loc *time.Location,
+	now time.Time,
+) ([]string, *svcapitypes.SnapshotRetentionCounts) {
+	if retention == nil {
+		return nil, nil
+	}
+	sorted := make([]ScheduledSnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreationTime.After(sorted[j].CreationTime)
+	})
+	kept := make([]bool, len(sorted))
+	counts := &svcapitypes.SnapshotRetentionCounts{
+		// Every snapshot is a period of its own for the count rule
+		Count: keepPeriods(sorted, kept, retention.Count, func(s ScheduledSnapshot) string {
+			return s.Name
+		}),
+		Daily: keepPeriods(sorted, kept, retention.Daily, func(s ScheduledSnapshot) string {
+			return s.CreationTime.In(loc).Format("2006-01-02")
+		}),
+		Weekly: keepPeriods(sorted, kept, retention.Weekly, func(s ScheduledSnapshot) string {
+			year, week := s.CreationTime.In(loc).ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}),
+		Monthly: keepPeriods(sorted, kept, retention.Monthly, func(s ScheduledSnapshot) string {
+			return s.CreationTime.In(loc).Format("2006-01")
+		}),
+	}
+	keepAll := retention.Count == nil && retention.Daily == nil &&
+		retention.Weekly == nil && retention.Monthly == nil
+	var expired []string
+	for i := len(sorted) - 1; i >= 0; i-- {
+		tooOld := retention.MaxAge != nil &&
+			now.Sub(sorted[i].CreationTime) > retention.MaxAge.Duration
+		if tooOld || !(keepAll || kept[i]) {
+			expired = append(expired, sorted[i].Name)
+		}
+	}
+	return expired, counts
+}
+
+// keepPeriods marks as kept the most recent of the supplied snapshots, sorted
+// newest first, of each of the supplied number of most recent periods, whose
+// keys period returns, and returns the number of snapshots it marked.
+// It returns nil when the number of periods is unset.
+func keepPeriods(
+	sorted []ScheduledSnapshot,
+	kept []bool,
+	periods *int64,
+	period func(ScheduledSnapshot) string,
+) *int64 {
+	if periods == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for i, s := range sorted {
+		if int64(len(seen)) >= *periods {
+			break
+		}
+		key := period(s)
+		if !seen[key] {
+			seen[key] = true
+			kept[i] = true
+		}
+	}
+	n := int64(len(seen))
+	return &n
+}
+
+// newErrInvalidSnapshotSchedule generates an ACK terminal error about a
+// snapshot schedule that cannot be evaluated
+func newErrInvalidSnapshotSchedule(msg string) error {
+	return ackerr.NewTerminalError(
+		fmt.Errorf("%w: %s", ErrInvalidSnapshotSchedule, msg),
+	)
+}
@@ -0,0 +1,313 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func dbInstanceRef(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+func TestValidateSnapshotSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    svcapitypes.SnapshotScheduleSpec
+		wantErr bool
+	}{
+		{
+			name: "DB instance",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: dbInstanceRef("db"),
+				Retention: &svcapitypes.SnapshotRetention{
+					Count:  aws.Int64(7),
+					MaxAge: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				},
+			},
+		},
+		{
+			name: "DB cluster",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:     aws.String("0 3 * * *"),
+				TimeZone:     aws.String("Europe/Paris"),
+				DBClusterRef: dbInstanceRef("cluster"),
+			},
+		},
+		{
+			name:    "no target",
+			spec:    svcapitypes.SnapshotScheduleSpec{Schedule: aws.String("0 3 * * *")},
+			wantErr: true,
+		},
+		{
+			name: "both targets",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: dbInstanceRef("db"),
+				DBClusterRef:  dbInstanceRef("cluster"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "target without name",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: &ackv1alpha1.AWSResourceReferenceWrapper{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cron expression",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("daily"),
+				DBInstanceRef: dbInstanceRef("db"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid time zone",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				TimeZone:      aws.String("Mars/Olympus"),
+				DBInstanceRef: dbInstanceRef("db"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no snapshot kept",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: dbInstanceRef("db"),
+				Retention:     &svcapitypes.SnapshotRetention{Count: aws.Int64(0)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "daily, weekly and monthly",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: dbInstanceRef("db"),
+				Retention: &svcapitypes.SnapshotRetention{
+					Daily:   aws.Int64(7),
+					Weekly:  aws.Int64(4),
+					Monthly: aws.Int64(12),
+				},
+			},
+		},
+		{
+			name: "no week kept",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				Schedule:      aws.String("0 3 * * *"),
+				DBInstanceRef: dbInstanceRef("db"),
+				Retention:     &svcapitypes.SnapshotRetention{Weekly: aws.Int64(0)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateSnapshotSchedule(&tt.spec)
+			if tt.wantErr != errors.Is(err, util.ErrInvalidSnapshotSchedule) {
+				t.Errorf("ValidateSnapshotSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSnapshotScheduleTimes(t *testing.T) {
+	daily := &svcapitypes.SnapshotScheduleSpec{Schedule: aws.String("0 3 * * *")}
+	threeAM := time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		spec     *svcapitypes.SnapshotScheduleSpec
+		last     time.Time
+		now      time.Time
+		wantDue  *time.Time
+		wantNext time.Time
+	}{
+		{
+			name:     "not due yet",
+			spec:     daily,
+			last:     threeAM.Add(-2 * time.Hour),
+			now:      threeAM.Add(-time.Hour),
+			wantNext: threeAM,
+		},
+		{
+			name:     "due",
+			spec:     daily,
+			last:     threeAM.Add(-2 * time.Hour),
+			now:      threeAM.Add(5 * time.Minute),
+			wantDue:  &threeAM,
+			wantNext: threeAM.AddDate(0, 0, 1),
+		},
+		{
+			name:     "already taken",
+			spec:     daily,
+			last:     threeAM,
+			now:      threeAM.Add(5 * time.Minute),
+			wantNext: threeAM.AddDate(0, 0, 1),
+		},
+		{
+			name:     "missed times collapse into the most recent one",
+			spec:     daily,
+			last:     threeAM.AddDate(0, 0, -3),
+			now:      threeAM.Add(time.Hour),
+			wantDue:  &threeAM,
+			wantNext: threeAM.AddDate(0, 0, 1),
+		},
+		{
+			name: "time zone",
+			spec: &svcapitypes.SnapshotScheduleSpec{
+				Schedule: aws.String("0 4 * * *"),
+				TimeZone: aws.String("Europe/Paris"),
+			},
+			last:     threeAM.Add(-2 * time.Hour),
+			now:      threeAM.Add(5 * time.Minute),
+			wantDue:  &threeAM,
+			wantNext: threeAM.AddDate(0, 0, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due, next, err := util.SnapshotScheduleTimes(tt.spec, tt.last, tt.now)
+			if err != nil {
+				t.Fatalf("SnapshotScheduleTimes() unexpected error = %v", err)
+			}
+			if (due == nil) != (tt.wantDue == nil) || (due != nil && !due.Equal(*tt.wantDue)) {
+				t.Errorf("SnapshotScheduleTimes() due = %v, want %v", due, tt.wantDue)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("SnapshotScheduleTimes() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestExpiredSnapshots(t *testing.T) {
+	now := time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	snapshots := []util.ScheduledSnapshot{
+		{Name: "two-days", CreationTime: now.Add(-2 * day)},
+		{Name: "today", CreationTime: now},
+		{Name: "ten-days", CreationTime: now.Add(-10 * day)},
+		{Name: "yesterday", CreationTime: now.Add(-day)},
+	}
+	tests := []struct {
+		name       string
+		retention  *svcapitypes.SnapshotRetention
+		want       []string
+		wantCounts *svcapitypes.SnapshotRetentionCounts
+	}{
+		{"no retention", nil, nil, nil},
+		{"unlimited", &svcapitypes.SnapshotRetention{}, nil, &svcapitypes.SnapshotRetentionCounts{}},
+		{
+			"count",
+			&svcapitypes.SnapshotRetention{Count: aws.Int64(2)},
+			[]string{"ten-days", "two-days"},
+			&svcapitypes.SnapshotRetentionCounts{Count: aws.Int64(2)},
+		},
+		{
+			"max age",
+			&svcapitypes.SnapshotRetention{MaxAge: &metav1.Duration{Duration: 7 * day}},
+			[]string{"ten-days"},
+			&svcapitypes.SnapshotRetentionCounts{},
+		},
+		{
+			"count and max age",
+			&svcapitypes.SnapshotRetention{
+				Count:  aws.Int64(3),
+				MaxAge: &metav1.Duration{Duration: 36 * time.Hour},
+			},
+			[]string{"ten-days", "two-days"},
+			&svcapitypes.SnapshotRetentionCounts{Count: aws.Int64(3)},
+		},
+		{
+			// Monday the 15th starts a new ISO week, the previous one holds
+			// the Saturday and Sunday snapshots
+			"weekly",
+			&svcapitypes.SnapshotRetention{Weekly: aws.Int64(2)},
+			[]string{"ten-days", "two-days"},
+			&svcapitypes.SnapshotRetentionCounts{Weekly: aws.Int64(2)},
+		},
+		{
+			"daily and monthly",
+			&svcapitypes.SnapshotRetention{
+				Daily:   aws.Int64(1),
+				Monthly: aws.Int64(2),
+			},
+			[]string{"ten-days", "two-days", "yesterday"},
+			&svcapitypes.SnapshotRetentionCounts{Daily: aws.Int64(1), Monthly: aws.Int64(1)},
+		},
+		{
+			"more periods than snapshots",
+			&svcapitypes.SnapshotRetention{Daily: aws.Int64(30)},
+			nil,
+			&svcapitypes.SnapshotRetentionCounts{Daily: aws.Int64(4)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotCounts := util.ExpiredSnapshots(snapshots, tt.retention, time.UTC, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpiredSnapshots() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotCounts, tt.wantCounts) {
+				t.Errorf("ExpiredSnapshots() counts = %+v, want %+v", gotCounts, tt.wantCounts)
+			}
+		})
+	}
+}
+
+func TestExpiredSnapshotsTimeZone(t *testing.T) {
+	// 23:30 UTC on the 14th is already the 15th in Paris
+	late := time.Date(2024, time.January, 14, 23, 30, 0, 0, time.UTC)
+	snapshots := []util.ScheduledSnapshot{
+		{Name: "late", CreationTime: late},
+		{Name: "early", CreationTime: late.Add(-time.Hour)},
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	retention := &svcapitypes.SnapshotRetention{Daily: aws.Int64(2)}
+	tests := []struct {
+		name string
+		loc  *time.Location
+		want []string
+	}{
+		{"UTC", time.UTC, []string{"early"}},
+		{"Paris", paris, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := util.ExpiredSnapshots(snapshots, retention, tt.loc, late)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpiredSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
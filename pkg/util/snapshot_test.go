@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestSafetySnapshotIdentifier(t *testing.T) {
+	now := time.Date(2024, 3, 5, 7, 9, 11, 0, time.FixedZone("CET", 3600))
+	want := "my-db-safety-20240305060911"
+	if got := util.SafetySnapshotIdentifier("my-db", now); got != want {
+		t.Errorf("SafetySnapshotIdentifier() = %q, want %q", got, want)
+	}
+}
+
+func TestMajorEngineVersionUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		engine  string
+		latest  string
+		desired string
+		want    bool
+	}{
+		{"postgres minor", "postgres", "14.5", "14.7", false},
+		{"postgres major", "postgres", "14.7", "15.2", true},
+		{"postgres 9 minor", "postgres", "9.6.22", "9.6.24", false},
+		{"postgres 9 major", "postgres", "9.5.25", "9.6.24", true},
+		{"aurora postgresql major", "aurora-postgresql", "13.9", "14.6", true},
+		{"mysql minor", "mysql", "8.0.32", "8.0.35", false},
+		{"mysql major", "mysql", "5.7.44", "8.0.35", true},
+		{"mariadb major", "mariadb", "10.6.14", "10.11.5", true},
+		{"aurora mysql minor", "aurora-mysql", "8.0.mysql_aurora.3.04.0", "8.0.mysql_aurora.3.05.2", false},
+		{"aurora mysql major", "aurora-mysql", "5.7.mysql_aurora.2.11.2", "8.0.mysql_aurora.3.04.0", true},
+		{"oracle minor", "oracle-ee", "19.0.0.0.ru-2023-04.rur-2023-04.r1", "19.0.0.0.ru-2023-07.rur-2023-07.r1", false},
+		{"oracle major", "oracle-ee", "19.0.0.0.ru-2023-07.rur-2023-07.r1", "21.0.0.0.ru-2023-07.rur-2023-07.r1", true},
+		{"sqlserver major", "sqlserver-se", "15.00.4316.3.v1", "16.00.4085.2.v1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.MajorEngineVersionUpgrade(tt.engine, tt.latest, tt.desired); got != tt.want {
+				t.Errorf("MajorEngineVersionUpgrade() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSharedAccountIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []*string
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"accounts", aws.StringSlice([]string{"123456789012", "210987654321"}), false},
+		{"public", aws.StringSlice([]string{"all"}), false},
+		{"too short", aws.StringSlice([]string{"12345678901"}), true},
+		{"not digits", aws.StringSlice([]string{"123456789012", "my-account"}), true},
+		{"upper case all", aws.StringSlice([]string{"ALL"}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateSharedAccountIDs(tt.ids)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidSharedAccountID) {
+					t.Errorf("ValidateSharedAccountIDs() error = %v, want %v", err, util.ErrInvalidSharedAccountID)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateSharedAccountIDs() unexpected error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// StorageTypeAurora is the Aurora Standard storage type, billing the I/O
+	// operations of the DB cluster. It is the default storage type of Aurora
+	// DB clusters.
+	StorageTypeAurora = "aurora"
+	// StorageTypeAuroraIOOptimized is the Aurora I/O-Optimized storage type,
+	// including the I/O operations of the DB cluster in its price. An Aurora
+	// DB cluster can only switch to it once every 30 days.
+	StorageTypeAuroraIOOptimized = "aurora-iopt1"
+)
+
+var (
+	ErrInvalidAuroraStorageType = fmt.Errorf("invalid Aurora storage type")
+
+	// auroraStorageTypes are the storage types of the Aurora DB clusters
+	auroraStorageTypes = []string{StorageTypeAurora, StorageTypeAuroraIOOptimized}
+)
+
+// ValidateAuroraStorageType returns an ACK terminal error when the supplied
+// storage type of a DB cluster with the supplied engine is not one of the
+// Aurora storage types. The storage types of the other DB clusters are left
+// to ValidateMultiAZCluster.
+func ValidateAuroraStorageType(engine string, storageType *string) error {
+	if !strings.HasPrefix(engine, "aurora") || storageType == nil || *storageType == "" {
+		return nil
+	}
+	if slices.Contains(auroraStorageTypes, *storageType) {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w %q: expected one of %q", ErrInvalidAuroraStorageType, *storageType, auroraStorageTypes,
+	))
+}
+
+// IOOptimizedSwitchWait returns how long an Aurora DB cluster has to wait at
+// the supplied time before switching to the Aurora I/O-Optimized storage
+// type, given the next time it is allowed to, or zero when it can switch
+// right away.
+func IOOptimizedSwitchWait(nextAllowed *metav1.Time, now time.Time) time.Duration {
+	if nextAllowed == nil || !nextAllowed.After(now) {
+		return 0
+	}
+	return nextAllowed.Sub(now)
+}
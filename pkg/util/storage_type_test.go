@@ -0,0 +1,73 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateAuroraStorageType(t *testing.T) {
+	tests := []struct {
+		engine      string
+		storageType *string
+		wantErr     bool
+	}{
+		{"aurora-postgresql", nil, false},
+		{"aurora-postgresql", aws.String(""), false},
+		{"aurora-postgresql", aws.String("aurora"), false},
+		{"aurora-mysql", aws.String("aurora-iopt1"), false},
+		{"aurora-mysql", aws.String("gp3"), true},
+		{"aurora-postgresql", aws.String("aurora-iopt2"), true},
+		{"postgres", aws.String("io1"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.engine+"/"+aws.StringValue(tt.storageType), func(t *testing.T) {
+			err := util.ValidateAuroraStorageType(tt.engine, tt.storageType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateAuroraStorageType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, util.ErrInvalidAuroraStorageType) {
+				t.Errorf("ValidateAuroraStorageType() error = %v, want ErrInvalidAuroraStorageType", err)
+			}
+		})
+	}
+}
+
+func TestIOOptimizedSwitchWait(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		nextAllowed *metav1.Time
+		want        time.Duration
+	}{
+		{"never switched", nil, 0},
+		{"allowed in the past", &metav1.Time{Time: now.Add(-time.Hour)}, 0},
+		{"allowed now", &metav1.Time{Time: now}, 0},
+		{"allowed later", &metav1.Time{Time: now.Add(72 * time.Hour)}, 72 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.IOOptimizedSwitchWait(tt.nextAllowed, now); got != tt.want {
+				t.Errorf("IOOptimizedSwitchWait() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
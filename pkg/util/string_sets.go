@@ -0,0 +1,72 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+// EqualStringSets returns true if the supplied string slices hold the same
+// strings, regardless of their order.
+func EqualStringSets(a []*string, b []*string) bool {
+	as := map[string]bool{}
+	for _, s := range a {
+		if s != nil {
+			as[*s] = true
+		}
+	}
+	bs := map[string]bool{}
+	for _, s := range b {
+		if s != nil {
+			bs[*s] = true
+		}
+	}
+	if len(as) != len(bs) {
+		return false
+	}
+	for s := range as {
+		if !bs[s] {
+			return false
+		}
+	}
+	return true
+}
+
+// StringSetsDelta returns the supplied desired strings missing from the
+// supplied latest strings, and the latest strings missing from the desired
+// strings, regardless of their order.
+func StringSetsDelta(
+	desired []*string,
+	latest []*string,
+) (toAdd []*string, toRemove []*string) {
+	desiredSet := map[string]bool{}
+	for _, s := range desired {
+		if s != nil {
+			desiredSet[*s] = true
+		}
+	}
+	latestSet := map[string]bool{}
+	for _, s := range latest {
+		if s != nil {
+			latestSet[*s] = true
+		}
+	}
+	for _, s := range desired {
+		if s != nil && !latestSet[*s] {
+			toAdd = append(toAdd, s)
+		}
+	}
+	for _, s := range latest {
+		if s != nil && !desiredSet[*s] {
+			toRemove = append(toRemove, s)
+		}
+	}
+	return toAdd, toRemove
+}
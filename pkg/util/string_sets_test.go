@@ -0,0 +1,73 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestEqualStringSets(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{"no strings", nil, nil, true},
+		{"same order", []string{"a", "b"}, []string{"a", "b"}, true},
+		{"different order", []string{"a", "b"}, []string{"b", "a"}, true},
+		{"duplicates", []string{"a", "a"}, []string{"a"}, true},
+		{"missing string", []string{"a", "b"}, []string{"a"}, false},
+		{"different strings", []string{"a"}, []string{"b"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.EqualStringSets(aws.StringSlice(tt.a), aws.StringSlice(tt.b)); got != tt.want {
+				t.Errorf("EqualStringSets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringSetsDelta(t *testing.T) {
+	tests := []struct {
+		name       string
+		desired    []string
+		latest     []string
+		wantAdd    []string
+		wantRemove []string
+	}{
+		{"no strings", nil, nil, nil, nil},
+		{"same strings", []string{"a", "b"}, []string{"b", "a"}, nil, nil},
+		{"added string", []string{"a", "b"}, []string{"a"}, []string{"b"}, nil},
+		{"removed string", []string{"a"}, []string{"a", "b"}, nil, []string{"b"}},
+		{"replaced strings", []string{"c"}, []string{"a", "b"}, []string{"c"}, []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := util.StringSetsDelta(aws.StringSlice(tt.desired), aws.StringSlice(tt.latest))
+			if got := aws.StringValueSlice(toAdd); !slices.Equal(got, tt.wantAdd) {
+				t.Errorf("StringSetsDelta() toAdd = %v, want %v", got, tt.wantAdd)
+			}
+			if got := aws.StringValueSlice(toRemove); !slices.Equal(got, tt.wantRemove) {
+				t.Errorf("StringSetsDelta() toRemove = %v, want %v", got, tt.wantRemove)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+var (
+	ErrInvalidWindow = fmt.Errorf("invalid window")
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+	// minWindowMinutes is the minimum duration of the backup and maintenance
+	// windows accepted by RDS
+	minWindowMinutes = 30
+)
+
+var (
+	backupWindowRegexp      = regexp.MustCompile(`^(\d{1,2}):(\d{2})-(\d{1,2}):(\d{2})$`)
+	maintenanceWindowRegexp = regexp.MustCompile(`^([a-z]+):(\d{1,2}):(\d{2})-([a-z]+):(\d{1,2}):(\d{2})$`)
+	// windowDays are the days of the week, starting on Sunday, in the
+	// canonical form RDS uses in maintenance windows
+	windowDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+	// windowDayAliases are the other accepted names of the days of the week
+	windowDayAliases = map[string]string{
+		"sunday":    "sun",
+		"monday":    "mon",
+		"tues":      "tue",
+		"tuesday":   "tue",
+		"wednesday": "wed",
+		"thur":      "thu",
+		"thurs":     "thu",
+		"thursday":  "thu",
+		"friday":    "fri",
+		"saturday":  "sat",
+	}
+)
+
+// NormalizeBackupWindow returns the supplied daily backup window, like
+// 3:00-03:30, in the canonical hh24:mi-hh24:mi form RDS returns, like
+// 03:00-03:30, or an ACK terminal error when it is not a valid backup window.
+func NormalizeBackupWindow(window string) (string, error) {
+	start, end, err := parseBackupWindow(window)
+	if err != nil {
+		return "", err
+	}
+	return formatTime(start) + "-" + formatTime(end), nil
+}
+
+// NormalizeMaintenanceWindow returns the supplied weekly maintenance window,
+// like Mon:3:00-MONDAY:03:30, in the canonical ddd:hh24:mi-ddd:hh24:mi form
+// RDS returns, like mon:03:00-mon:03:30, or an ACK terminal error when it is
+// not a valid maintenance window.
+func NormalizeMaintenanceWindow(window string) (string, error) {
+	start, end, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return "", err
+	}
+	return formatDayTime(start) + "-" + formatDayTime(end), nil
+}
+
+// ValidateWindows returns an ACK terminal error when the supplied backup or
+// maintenance windows are not valid, or when they overlap. Either of them may
+// be nil.
+func ValidateWindows(backupWindow *string, maintenanceWindow *string) error {
+	var backupStart, backupEnd, maintenanceStart, maintenanceEnd int
+	var err error
+	if backupWindow != nil {
+		if backupStart, backupEnd, err = parseBackupWindow(*backupWindow); err != nil {
+			return err
+		}
+	}
+	if maintenanceWindow != nil {
+		if maintenanceStart, maintenanceEnd, err = parseMaintenanceWindow(*maintenanceWindow); err != nil {
+			return err
+		}
+	}
+	if backupWindow == nil || maintenanceWindow == nil {
+		return nil
+	}
+	backupDuration := windowDuration(backupStart, backupEnd, minutesPerDay)
+	maintenanceDuration := windowDuration(maintenanceStart, maintenanceEnd, minutesPerWeek)
+	for day := 0; day < 7; day++ {
+		if windowsOverlap(
+			day*minutesPerDay+backupStart, backupDuration,
+			maintenanceStart, maintenanceDuration,
+		) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%w: backup window %s overlaps maintenance window %s",
+				ErrInvalidWindow, *backupWindow, *maintenanceWindow,
+			))
+		}
+	}
+	return nil
+}
+
+// parseBackupWindow returns the start and end, in minutes of the day, of the
+// supplied daily backup window.
+func parseBackupWindow(window string) (int, int, error) {
+	m := backupWindowRegexp.FindStringSubmatch(strings.TrimSpace(window))
+	if m == nil {
+		return 0, 0, invalidWindow("backup window %q must be in the hh24:mi-hh24:mi format", window)
+	}
+	start, startOK := parseTime(m[1], m[2])
+	end, endOK := parseTime(m[3], m[4])
+	if !startOK || !endOK {
+		return 0, 0, invalidWindow("backup window %q is not made of valid times", window)
+	}
+	if windowDuration(start, end, minutesPerDay) < minWindowMinutes {
+		return 0, 0, invalidWindow("backup window %q must be at least %d minutes", window, minWindowMinutes)
+	}
+	return start, end, nil
+}
+
+// parseMaintenanceWindow returns the start and end, in minutes of the week
+// starting on Sunday, of the supplied weekly maintenance window.
+func parseMaintenanceWindow(window string) (int, int, error) {
+	m := maintenanceWindowRegexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(window)))
+	if m == nil {
+		return 0, 0, invalidWindow("maintenance window %q must be in the ddd:hh24:mi-ddd:hh24:mi format", window)
+	}
+	startDay, startDayOK := parseDay(m[1])
+	endDay, endDayOK := parseDay(m[4])
+	start, startOK := parseTime(m[2], m[3])
+	end, endOK := parseTime(m[5], m[6])
+	if !startDayOK || !endDayOK || !startOK || !endOK {
+		return 0, 0, invalidWindow("maintenance window %q is not made of valid days and times", window)
+	}
+	start += startDay * minutesPerDay
+	end += endDay * minutesPerDay
+	if windowDuration(start, end, minutesPerWeek) < minWindowMinutes {
+		return 0, 0, invalidWindow("maintenance window %q must be at least %d minutes", window, minWindowMinutes)
+	}
+	return start, end, nil
+}
+
+// parseDay returns the index, starting on Sunday, of the supplied lower case
+// day of the week.
+func parseDay(day string) (int, bool) {
+	if alias, ok := windowDayAliases[day]; ok {
+		day = alias
+	}
+	for i, d := range windowDays {
+		if d == day {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// parseTime returns the minute of the day of the supplied hours and minutes.
+func parseTime(hours string, minutes string) (int, bool) {
+	h, hErr := strconv.Atoi(hours)
+	m, mErr := strconv.Atoi(minutes)
+	if hErr != nil || mErr != nil || h > 23 || m > 59 {
+		return 0, false
+	}
+	return h*60 + m, true
+}
+
+// formatTime returns the supplied minute of the day in the hh24:mi format.
+func formatTime(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// formatDayTime returns the supplied minute of the week in the ddd:hh24:mi
+// format.
+func formatDayTime(minute int) string {
+	return windowDays[minute/minutesPerDay] + ":" + formatTime(minute%minutesPerDay)
+}
+
+// windowDuration returns the duration, in minutes, of the window with the
+// supplied start and end on a cycle of the supplied number of minutes. A
+// window ending before its start wraps around the end of the cycle.
+func windowDuration(start int, end int, cycle int) int {
+	return ((end-start)%cycle + cycle) % cycle
+}
+
+// windowsOverlap returns true if the windows with the supplied starts and
+// durations, in minutes of the week, overlap.
+func windowsOverlap(start1 int, duration1 int, start2 int, duration2 int) bool {
+	return windowDuration(start1, start2, minutesPerWeek) < duration1 ||
+		windowDuration(start2, start1, minutesPerWeek) < duration2
+}
+
+// invalidWindow returns an ACK terminal error wrapping ErrInvalidWindow with
+// the supplied formatted message.
+func invalidWindow(format string, args ...interface{}) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%w: %s", ErrInvalidWindow, fmt.Sprintf(format, args...),
+	))
+}
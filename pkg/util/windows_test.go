@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestNormalizeBackupWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  string
+		want    string
+		wantErr bool
+	}{
+		{"canonical", "03:00-03:30", "03:00-03:30", false},
+		{"single digit hour", "3:00-4:00", "03:00-04:00", false},
+		{"around midnight", "23:45-00:15", "23:45-00:15", false},
+		{"too short", "03:00-03:15", "", true},
+		{"invalid time", "24:00-01:00", "", true},
+		{"with days", "mon:03:00-mon:03:30", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.NormalizeBackupWindow(tt.window)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidWindow) {
+					t.Errorf("NormalizeBackupWindow() error = %v, want %v", err, util.ErrInvalidWindow)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeBackupWindow() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeBackupWindow() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  string
+		want    string
+		wantErr bool
+	}{
+		{"canonical", "sun:05:00-sun:06:00", "sun:05:00-sun:06:00", false},
+		{"casing", "Sun:05:00-SUN:06:00", "sun:05:00-sun:06:00", false},
+		{"full day names", "Tuesday:5:00-tuesday:6:00", "tue:05:00-tue:06:00", false},
+		{"across days", "sat:23:30-sun:00:30", "sat:23:30-sun:00:30", false},
+		{"unknown day", "funday:05:00-funday:06:00", "", true},
+		{"too short", "sun:05:00-sun:05:10", "", true},
+		{"without days", "05:00-06:00", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.NormalizeMaintenanceWindow(tt.window)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidWindow) {
+					t.Errorf("NormalizeMaintenanceWindow() error = %v, want %v", err, util.ErrInvalidWindow)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeMaintenanceWindow() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeMaintenanceWindow() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateWindows(t *testing.T) {
+	tests := []struct {
+		name        string
+		backup      *string
+		maintenance *string
+		wantErr     bool
+	}{
+		{"unset", nil, nil, false},
+		{"backup only", aws.String("03:00-03:30"), nil, false},
+		{"disjoint", aws.String("03:00-03:30"), aws.String("sun:05:00-sun:06:00"), false},
+		{"adjacent", aws.String("03:00-03:30"), aws.String("sun:03:30-sun:04:00"), false},
+		{"overlapping", aws.String("03:00-04:00"), aws.String("wed:03:30-wed:04:30"), true},
+		{"overlapping around midnight", aws.String("23:30-00:30"), aws.String("sat:23:45-sun:00:15"), true},
+		{"overlapping a day boundary", aws.String("00:00-00:30"), aws.String("mon:23:00-tue:01:00"), true},
+		{"invalid maintenance window", aws.String("03:00-03:30"), aws.String("sunday"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateWindows(tt.backup, tt.maintenance)
+			if tt.wantErr {
+				if !errors.Is(err, util.ErrInvalidWindow) {
+					t.Errorf("ValidateWindows() error = %v, want %v", err, util.ErrInvalidWindow)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ValidateWindows() unexpected error = %v", err)
+			}
+		})
+	}
+}
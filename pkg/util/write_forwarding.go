@@ -0,0 +1,78 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+var (
+	ErrInvalidWriteForwarding = fmt.Errorf("invalid write forwarding")
+)
+
+// ValidateWriteForwarding returns an ACK terminal error when the supplied Spec
+// of a DB cluster turns global write forwarding on outside of a global
+// database, or local write forwarding on for another engine than Aurora
+// MySQL.
+func ValidateWriteForwarding(spec *svcapitypes.DBClusterSpec) error {
+	if spec.EnableGlobalWriteForwarding != nil && *spec.EnableGlobalWriteForwarding &&
+		(spec.GlobalClusterIdentifier == nil || *spec.GlobalClusterIdentifier == "") {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: enableGlobalWriteForwarding requires the DB cluster to be a secondary cluster of a global database",
+			ErrInvalidWriteForwarding,
+		))
+	}
+	if spec.EnableLocalWriteForwarding != nil && *spec.EnableLocalWriteForwarding &&
+		(spec.Engine == nil || *spec.Engine != "aurora-mysql") {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"%w: enableLocalWriteForwarding is only supported by Aurora MySQL",
+			ErrInvalidWriteForwarding,
+		))
+	}
+	return nil
+}
+
+// WriteForwardingEnabled returns true if the supplied global or local write
+// forwarding status of a DB cluster is enabled or on its way to be.
+func WriteForwardingEnabled(status *string) bool {
+	if status == nil {
+		return false
+	}
+	switch *status {
+	case string(svcapitypes.WriteForwardingStatus_enabled),
+		string(svcapitypes.WriteForwardingStatus_enabling),
+		string(svcapitypes.LocalWriteForwardingStatus_requested):
+		return true
+	}
+	return false
+}
+
+// WriteForwardingChanging returns true if the supplied global or local write
+// forwarding status of a DB cluster is being turned on or off.
+func WriteForwardingChanging(status *string) bool {
+	if status == nil {
+		return false
+	}
+	switch *status {
+	case string(svcapitypes.WriteForwardingStatus_enabling),
+		string(svcapitypes.WriteForwardingStatus_disabling),
+		string(svcapitypes.LocalWriteForwardingStatus_requested):
+		return true
+	}
+	return false
+}
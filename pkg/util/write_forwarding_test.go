@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestValidateWriteForwarding(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *svcapitypes.DBClusterSpec
+		wantErr bool
+	}{
+		{"unset", &svcapitypes.DBClusterSpec{Engine: aws.String("aurora-postgresql")}, false},
+		{"global on secondary cluster", &svcapitypes.DBClusterSpec{
+			Engine:                      aws.String("aurora-postgresql"),
+			GlobalClusterIdentifier:     aws.String("global"),
+			EnableGlobalWriteForwarding: aws.Bool(true),
+		}, false},
+		{"global outside global database", &svcapitypes.DBClusterSpec{
+			Engine:                      aws.String("aurora-mysql"),
+			EnableGlobalWriteForwarding: aws.Bool(true),
+		}, true},
+		{"global off outside global database", &svcapitypes.DBClusterSpec{
+			Engine:                      aws.String("aurora-mysql"),
+			EnableGlobalWriteForwarding: aws.Bool(false),
+		}, false},
+		{"local on aurora mysql", &svcapitypes.DBClusterSpec{
+			Engine:                     aws.String("aurora-mysql"),
+			EnableLocalWriteForwarding: aws.Bool(true),
+		}, false},
+		{"local on aurora postgresql", &svcapitypes.DBClusterSpec{
+			Engine:                     aws.String("aurora-postgresql"),
+			EnableLocalWriteForwarding: aws.Bool(true),
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateWriteForwarding(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateWriteForwarding() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, util.ErrInvalidWriteForwarding) {
+				t.Errorf("ValidateWriteForwarding() error = %v, want ErrInvalidWriteForwarding", err)
+			}
+		})
+	}
+}
+
+func TestWriteForwardingStatus(t *testing.T) {
+	tests := []struct {
+		status      *string
+		wantEnabled bool
+		wantChange  bool
+	}{
+		{nil, false, false},
+		{aws.String("enabled"), true, false},
+		{aws.String("enabling"), true, true},
+		{aws.String("requested"), true, true},
+		{aws.String("disabling"), false, true},
+		{aws.String("disabled"), false, false},
+		{aws.String("unknown"), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(aws.StringValue(tt.status), func(t *testing.T) {
+			if got := util.WriteForwardingEnabled(tt.status); got != tt.wantEnabled {
+				t.Errorf("WriteForwardingEnabled() = %v, want %v", got, tt.wantEnabled)
+			}
+			if got := util.WriteForwardingChanging(tt.status); got != tt.wantChange {
+				t.Errorf("WriteForwardingChanging() = %v, want %v", got, tt.wantChange)
+			}
+		})
+	}
+}
@@ -0,0 +1 @@
+	compareSwitchover(delta, a, b)
@@ -0,0 +1,5 @@
+	// The resources of the green environment cannot be deleted once they were
+	// switched over, since they are the production databases.
+	if deploymentSwitchedOver(r) {
+		input.DeleteTarget = nil
+	}
@@ -0,0 +1,3 @@
+	if deploymentDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
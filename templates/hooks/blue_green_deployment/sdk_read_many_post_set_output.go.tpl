@@ -0,0 +1,12 @@
+	setSwitchoverProgress(&resource{ko})
+	if deploymentHasTerminalStatus(&resource{ko}) {
+		msg := "Blue/green deployment is in '" + *ko.Status.Status + "' status"
+		if ko.Status.StatusDetails != nil {
+			msg += ": " + *ko.Status.StatusDetails
+		}
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &msg, nil)
+	} else if !deploymentSynced(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
@@ -0,0 +1 @@
+	compareDescription(delta, a, b)
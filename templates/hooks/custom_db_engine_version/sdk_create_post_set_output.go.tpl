@@ -0,0 +1,13 @@
+	if resp.DBEngineVersionArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBEngineVersionArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	ko.Spec.Description = resp.DBEngineVersionDescription
+	// We expect the custom engine version to be in 'creating' status since we
+	// just issued the call to create it, but it doesn't hurt to check here.
+	if engineVersionCreating(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
@@ -0,0 +1,3 @@
+	if engineVersionDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
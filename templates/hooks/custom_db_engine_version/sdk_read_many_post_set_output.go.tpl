@@ -0,0 +1,24 @@
+	// The engine and the engine version identify a single custom engine
+	// version, the one merged in above.
+	version := resp.DBEngineVersions[0]
+	if version.DBEngineVersionArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*version.DBEngineVersionArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	ko.Spec.Description = version.DBEngineVersionDescription
+	if engineVersionHasTerminalStatus(&resource{ko}) {
+		msg := "Custom engine version is in '" + *ko.Status.Status + "' status"
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &msg, nil)
+	} else if !engineVersionModifiable(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
+	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
+		tags, err := rm.getTags(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
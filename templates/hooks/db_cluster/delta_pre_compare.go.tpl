@@ -1,2 +1,16 @@
     compareTags(delta, a, b)
     compareSecretReferenceChanges(delta, a, b)
+    compareMasterUserPasswordHash(delta, a, b)
+    compareMasterUserSecretRotation(delta, a, b)
+    compareActivityStream(delta, a, b)
+    compareSchedule(delta, a, b)
+    compareApplyPendingMaintenanceAction(delta, a, b)
+    compareBacktrackTo(delta, a, b)
+    compareFailover(delta, a, b)
+    comparePromoteReadReplica(delta, a, b)
+    compareGlobalClusterMembership(delta, a, b)
+    compareClusterInstances(delta, a, b)
+    reconcileEngineVersion(a, b)
+    reconcileWindows(a, b)
+    reconcileNetworkType(a, b)
+    reconcileScalingConfiguration(a, b)
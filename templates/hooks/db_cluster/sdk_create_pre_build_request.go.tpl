@@ -1,5 +1,57 @@
+    if err = validateDeletion(desired); err != nil {
+        return nil, err
+    }
+    if err = validateWindows(desired); err != nil {
+        return nil, err
+    }
+    if err = validateMasterUserSecret(desired); err != nil {
+        return nil, err
+    }
+    if err = validateMasterUserSecretRotation(desired); err != nil {
+        return nil, err
+    }
+    if err = validateActivityStream(desired); err != nil {
+        return nil, err
+    }
+    if err = validateServerlessV2Scaling(desired); err != nil {
+        return nil, err
+    }
+    if err = validateServerlessV1Scaling(desired); err != nil {
+        return nil, err
+    }
+    if err = validateBacktrackWindow(desired); err != nil {
+        return nil, err
+    }
+    if err = validateMultiAZCluster(desired); err != nil {
+        return nil, err
+    }
+    if err = validateStorageType(desired); err != nil {
+        return nil, err
+    }
+    if err = validateWriteForwarding(desired); err != nil {
+        return nil, err
+    }
+    if err = validateHTTPEndpoint(desired); err != nil {
+        return nil, err
+    }
+    if err = rm.validateNetworkType(ctx, desired, nil); err != nil {
+        return nil, err
+    }
+    if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+        return nil, err
+    }
     // if request has SnapshotIdentifier spec, create request will call RestoreDBClusterFromSnapshotWithContext
     // instead of normal create api
     if desired.ko.Spec.SnapshotIdentifier != nil {
         return rm.restoreDbClusterFromSnapshot(ctx, desired)
     }
+    // if request has RestoreFrom spec, create request will call RestoreDBClusterToPointInTimeWithContext
+    // instead of normal create api
+    if desired.ko.Spec.RestoreFrom != nil {
+        return rm.restoreDBClusterFromCluster(ctx, desired)
+    }
+    // if request has RestoreToPointInTime spec, create request will call RestoreDBClusterToPointInTimeWithContext
+    // instead of normal create api
+    if desired.ko.Spec.RestoreToPointInTime != nil {
+        return rm.restoreDBClusterToPointInTime(ctx, desired)
+    }
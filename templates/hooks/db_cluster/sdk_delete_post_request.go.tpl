@@ -0,0 +1,3 @@
+	if err == nil {
+		rm.recordFinalSnapshot(r, input)
+	}
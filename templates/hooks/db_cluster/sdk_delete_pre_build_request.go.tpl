@@ -1,3 +1,9 @@
 	if clusterDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.checkDeletionProtection(ctx, r); err != nil {
+		return r, err
+	}
+	if err = rm.deleteClusterInstances(ctx, r); err != nil {
+		return r, err
+	}
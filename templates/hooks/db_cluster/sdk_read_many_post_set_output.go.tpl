@@ -5,11 +5,57 @@
             return nil, err
         }
         ko.Spec.Tags = tags
+        pendingActions, err := rm.getPendingMaintenanceActions(ctx, *resourceARN)
+        if err != nil {
+            return nil, err
+        }
+        ko.Status.PendingMaintenanceActions = pendingActions
+	}
+	rm.setMasterUserPasswordHash(ctx, &resource{ko})
+	rm.setServerlessV2Capacity(ctx, &resource{ko})
+	rm.setBacktrackProgress(ctx, &resource{ko})
+	setFailoverProgress(&resource{ko})
+	rm.setGlobalClusterMembership(ctx, &resource{ko})
+	if err = rm.setMasterUserSecretRotation(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	setActivityStream(r, &resource{ko})
+	if err = rm.copyMasterUserSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeConnectionSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeServiceBindingSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
 	}
-	if !clusterAvailable(&resource{ko}) {
+	if err = rm.syncCABundleConfigMap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncEndpointServices(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.setClusterInstancesSynced(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if !clusterAvailable(&resource{ko}) && !clusterStoppedAsScheduled(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if writeForwardingChanging(&resource{ko}) {
+		msg := "DB cluster write forwarding is being turned on or off"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if activityStreamChanging(&resource{ko}) {
+		msg := "DB cluster activity stream is being started or stopped"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if multiAZClusterModifying(&resource{ko}) {
+		msg := "Multi-AZ DB cluster storage modification is pending"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	} else if clusterPaused(&resource{ko}) {
+		// A paused Aurora Serverless v1 DB cluster is resumed on the next
+		// connection to it, so it is synced
+		msg := "Aurora Serverless v1 DB cluster is paused"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, &msg, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
@@ -37,4 +83,21 @@
 		ko.Spec.EnableIAMDatabaseAuthentication = ko.Status.IAMDatabaseAuthenticationEnabled
 	}
 
+	if r.ko.Spec.EnableGlobalWriteForwarding != nil {
+		// If the desired resource turns global write forwarding explicitly on
+		// or off then update the spec of the latest resource with the
+		// requested state.
+		ko.Spec.EnableGlobalWriteForwarding = aws.Bool(aws.BoolValue(ko.Status.GlobalWriteForwardingRequested))
+	}
+	if r.ko.Spec.EnableLocalWriteForwarding != nil {
+		// If the desired resource turns local write forwarding explicitly on
+		// or off then update the spec of the latest resource with its state.
+		ko.Spec.EnableLocalWriteForwarding = aws.Bool(localWriteForwardingEnabled(&resource{ko}))
+	}
+	if r.ko.Spec.EnableHTTPEndpoint != nil {
+		// If the desired resource turns the Data API explicitly on or off then
+		// update the spec of the latest resource with its effective state.
+		ko.Spec.EnableHTTPEndpoint = aws.Bool(aws.BoolValue(ko.Status.HTTPEndpointEnabled))
+	}
+
 	ko.Spec.EnableCloudwatchLogsExports = ko.Status.EnabledCloudwatchLogsExports 
@@ -0,0 +1,3 @@
+	if err = validateCustomEndpoint(desired); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,3 @@
+	if endpointDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
@@ -0,0 +1,8 @@
+	// The type of a custom endpoint is returned as CustomEndpointType, its
+	// EndpointType is always CUSTOM.
+	ko.Spec.EndpointType = ko.Status.CustomEndpointType
+	if !endpointAvailable(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
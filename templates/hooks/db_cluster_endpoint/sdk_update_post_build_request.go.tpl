@@ -0,0 +1 @@
+	clearRemovedMembers(input, delta)
@@ -0,0 +1,13 @@
+	if err = validateCustomEndpoint(desired); err != nil {
+		return nil, err
+	}
+	if endpointDeleting(latest) {
+		msg := "DB cluster endpoint is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if !endpointAvailable(latest) {
+		msg := "DB cluster endpoint cannot be modified while in '" + aws.StringValue(latest.ko.Status.Status) + "' status"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilCanModify(latest)
+	}
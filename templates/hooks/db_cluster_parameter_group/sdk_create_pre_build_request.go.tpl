@@ -0,0 +1,5 @@
+	// Reject unknown or unmodifiable parameter overrides before creating the
+	// parameter group, rather than after the group has been created.
+	if err = rm.validateParameters(ctx, desired); err != nil {
+		return nil, err
+	}
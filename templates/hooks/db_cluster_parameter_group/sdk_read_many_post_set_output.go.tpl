@@ -8,10 +8,13 @@
     }
     if ko.Spec.Name != nil {
         groupName := ko.Spec.Name
-        params, paramStatuses, err := rm.getParameters(ctx, groupName)
+        params, paramStatuses, paramSources, err := rm.getParameters(ctx, groupName)
         if err != nil {
             return nil, err
         }
         ko.Spec.ParameterOverrides = params
         ko.Status.ParameterOverrideStatuses = paramStatuses
+        ko.Status.ParameterSources = paramSources
+        setParameterDrift(ko)
+        rm.checkInstanceParameterGroupConsistency(ctx, ko)
     }
@@ -0,0 +1,9 @@
+	if err = populateAdoptionFields(r); err != nil {
+		return nil, err
+	}
+	if err = markAdoptionManaged(ctx, r); err != nil {
+		return nil, err
+	}
+	if err = validateDriftPolicy(r); err != nil {
+		return nil, err
+	}
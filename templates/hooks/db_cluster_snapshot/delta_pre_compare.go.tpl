@@ -0,0 +1 @@
+	compareSharedAccountIDs(delta, a, b)
@@ -0,0 +1,3 @@
+	if snapshotDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
@@ -1,3 +1,9 @@
+	// The Spec of a resource adopting a DB instance is replaced by the
+	// configuration of the DB instance rather than compared to it
+	if adoptionRequested(a) {
+		delta.Add("Spec.Adoption", true, false)
+		return delta
+	}
 	// Do not consider any of the following fields for delta if they are missing in
 	// desired(a) but are present in latest(b) because each of these fields is
 	// late-initialized
@@ -28,11 +34,50 @@
 		b.ko.Spec.PerformanceInsightsKMSKeyID != nil {
 		a.ko.Spec.PerformanceInsightsKMSKeyID = b.ko.Spec.PerformanceInsightsKMSKeyID
 	}
+	if a.ko.Spec.CACertificateIdentifier == nil &&
+		b.ko.Spec.CACertificateIdentifier != nil {
+		a.ko.Spec.CACertificateIdentifier = b.ko.Spec.CACertificateIdentifier
+	}
+	if a.ko.Spec.CharacterSetName == nil &&
+		b.ko.Spec.CharacterSetName != nil {
+		a.ko.Spec.CharacterSetName = b.ko.Spec.CharacterSetName
+	}
+	if a.ko.Spec.NcharCharacterSetName == nil &&
+		b.ko.Spec.NcharCharacterSetName != nil {
+		a.ko.Spec.NcharCharacterSetName = b.ko.Spec.NcharCharacterSetName
+	}
+	if a.ko.Spec.DBSystemID == nil &&
+		b.ko.Spec.DBSystemID != nil {
+		a.ko.Spec.DBSystemID = b.ko.Spec.DBSystemID
+	}
 
 	// RDS will choose preferred engine minor version if only
 	// engine major version is provided and controler should not
 	// treat them as different, such as spec has 14, status has 14.1
 	// controller should treat them as same
 	reconcileEngineVersion(a, b)
+	// RDS returns the preferred backup and maintenance windows in their
+	// canonical form
+	reconcileWindows(a, b)
+	// RDS grows the allocated storage on its own when storage autoscaling is
+	// enabled, controller should not attempt to shrink it back
+	reconcileAllocatedStorage(a, b)
+	// RDS returns the IOPS and storage throughput of gp3 storage even when
+	// they are not specified
+	reconcileGP3Storage(a, b)
+	// RDS defaults the promotion tier of the DB instances of an Aurora DB
+	// cluster
+	reconcilePromotionTier(a, b)
     compareTags(delta, a, b)
 	compareSecretReferenceChanges(delta, a, b)
+	compareMasterUserPasswordHash(delta, a, b)
+	compareMasterUserSecretRotation(delta, a, b)
+	compareActivityStream(delta, a, b)
+	compareAutomatedBackupsReplication(delta, a, b)
+	compareDesiredState(delta, a, b)
+	compareReboot(delta, a, b)
+	comparePromoteReadReplica(delta, a, b)
+	compareSwitchoverReadReplica(delta, a, b)
+	compareApplyPendingMaintenanceAction(delta, a, b)
+	compareRotateCACertificate(delta, a, b)
+	comparePauseAutomation(delta, a, b)
@@ -0,0 +1,14 @@
+	// An engine version made of only its first components, like 15, is
+	// resolved to the latest minor engine version
+	if input.EngineVersion, err = rm.resolveEngineVersion(ctx, input.Engine, input.EngineVersion); err != nil {
+		return nil, err
+	}
+	// The master user password of the Secrets Manager secret set in
+	// Spec.MasterUserPasswordSecretARN is read when creating the DB instance
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
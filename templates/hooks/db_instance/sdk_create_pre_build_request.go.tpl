@@ -1,8 +1,63 @@
+    if err = validateCreation(desired); err != nil {
+        return nil, err
+    }
+    if err = validateGP3Storage(desired); err != nil {
+        return nil, err
+    }
+    if err = validatePromotionTier(desired); err != nil {
+        return nil, err
+    }
+    if err = validateCharacterSets(desired); err != nil {
+        return nil, err
+    }
+    if err = validateCustomDBInstance(desired); err != nil {
+        return nil, err
+    }
+    if err = validateDeletion(desired); err != nil {
+        return nil, err
+    }
+    if err = validateWindows(desired); err != nil {
+        return nil, err
+    }
+    if err = validateMasterUserSecret(desired); err != nil {
+        return nil, err
+    }
+    if err = validateMasterUserSecretRotation(desired); err != nil {
+        return nil, err
+    }
+    if err = validateActivityStream(desired); err != nil {
+        return nil, err
+    }
+    if err = rm.validateAutomatedBackupsReplication(desired); err != nil {
+        return nil, err
+    }
+    if err = validateDomain(desired); err != nil {
+        return nil, err
+    }
+    if err = rm.validateDedicatedLogVolume(ctx, desired); err != nil {
+        return nil, err
+    }
+    if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+        return nil, err
+    }
+    if err = rm.validateNetworkType(ctx, desired, nil); err != nil {
+        return nil, err
+    }
     // if request has DBSnapshotIdentifier spec, create request will call RestoreDBInstanceFromDBSnapshotWithContext
     // instead of normal create api
     if desired.ko.Spec.DBSnapshotIdentifier != nil {
         return rm.restoreDbInstanceFromDbSnapshot(ctx, desired)
     }
+    // if request has RestoreToPointInTime spec, create request will call RestoreDBInstanceToPointInTimeWithContext
+    // instead of normal create api
+    if desired.ko.Spec.RestoreToPointInTime != nil {
+        return rm.restoreDBInstanceToPointInTime(ctx, desired)
+    }
+    // if request has S3Restore spec, create request will call RestoreDBInstanceFromS3WithContext
+    // instead of normal create api
+    if desired.ko.Spec.S3Restore != nil {
+        return rm.restoreDBInstanceFromS3(ctx, desired)
+    }
     // if request has SourceDBInstanceIdentifier spec, create request will call CreateDBInstanceReadReplicaWithContext
     // instead of normal create api
     if desired.ko.Spec.SourceDBInstanceIdentifier != nil {
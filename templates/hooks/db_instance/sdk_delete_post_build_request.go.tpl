@@ -0,0 +1,3 @@
+	if err = setFinalSnapshot(r, input); err != nil {
+		return nil, err
+	}
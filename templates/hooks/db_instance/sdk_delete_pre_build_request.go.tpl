@@ -1,3 +1,6 @@
 	if instanceDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.checkDeletionProtection(ctx, r); err != nil {
+		return r, err
+	}
@@ -2,7 +2,7 @@
 {{ $SDKAPI := .SDKAPI }}
 
 {{/* Maintain operations here */}}
-{{ range $operationName := Each "RestoreDBInstanceFromDBSnapshot" "CreateDBInstanceReadReplica" }}
+{{ range $operationName := Each "RestoreDBInstanceFromDBSnapshot" "CreateDBInstanceReadReplica" "RestoreDBInstanceToPointInTime" "RestoreDBInstanceFromS3" }}
 
 {{- $operation := (index $SDKAPI.API.Operations $operationName)}}
 
@@ -14,7 +14,7 @@
 
 
 {{/* Some operations have custom structure */}}
-{{- if (eq $operationName "RestoreDBInstanceFromDBSnapshot") }}
+{{- if or (eq $operationName "RestoreDBInstanceFromDBSnapshot") (eq $operationName "RestoreDBInstanceToPointInTime") }}
 
 // new{{ $inputShapeName }} returns a {{ $inputShapeName }} object 
 // with each the field set by the corresponding configuration's fields.
@@ -28,6 +28,22 @@ func (rm *resourceManager) new{{ $inputShapeName }}(
 }
 {{ end }}
 
+{{/* RestoreDBInstanceFromS3 takes the master user password from a secret */}}
+{{- if (eq $operationName "RestoreDBInstanceFromS3") }}
+
+// new{{ $inputShapeName }} returns a {{ $inputShapeName }} object 
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) new{{ $inputShapeName }}(
+    ctx context.Context,
+    r *resource,
+) (*svcsdk.{{ $inputShapeName }}, error) {
+    res := &svcsdk.{{ $inputShapeName }}{}
+
+{{ GoCodeSetSDKForStruct $CRD "" "res" $inputRef "" "r.ko.Spec" 1 }}
+    return res, nil
+}
+{{ end }}
+
 // setResourceFrom{{ $outputShapeName }} sets a resource {{ $outputShapeName }} type
 // given the SDK type.
 func (rm *resourceManager) setResourceFrom{{ $outputShapeName }}(
@@ -1,3 +1,22 @@
+	// The Spec is reset to the pending values below, report the progress of
+	// a major engine version upgrade first.
+	setMajorVersionUpgradeProgress(r, &resource{ko})
+	// Spec.AllocatedStorage is reset to the pending value below, record the
+	// storage currently allocated to the DB instance first.
+	if ko.Spec.AllocatedStorage != nil {
+		allocatedStorage := *ko.Spec.AllocatedStorage
+		ko.Status.CurrentAllocatedStorage = &allocatedStorage
+	} else {
+		ko.Status.CurrentAllocatedStorage = nil
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil && r.ko.Spec.DBClusterIdentifier == nil {
+		// If the desired resource has IAM authentication explicitly enabled or
+		// disabled then update the spec of the latest resource with the value
+		// from the status. The IAM authentication of the DB instances of a DB
+		// cluster is managed by the DB cluster.
+		ko.Spec.EnableIAMDatabaseAuthentication = ko.Status.IAMDatabaseAuthenticationEnabled
+	}
+	setDomainFromMembership(r, &resource{ko})
 	// DescribeDBInstances returns an array of DBInstance structs that contains
 	// the *previously set* values for various mutable fields. This is
 	// problematic because it causes a "flopping" behaviour when the user has
@@ -38,17 +57,18 @@
 		if pmv.DBSubnetGroupName != nil {
 			ko.Spec.DBSubnetGroupName = pmv.DBSubnetGroupName
 		}
+		if pmv.DedicatedLogVolume != nil {
+			ko.Spec.DedicatedLogVolume = pmv.DedicatedLogVolume
+		}
 		if pmv.EngineVersion != nil {
 			ko.Spec.EngineVersion = pmv.EngineVersion
 		}
-        // NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.IAMDatabaseAuthenticationEnabled != nil {
-		//	ko.Spec.IAMDatabaseAuthenticationEnabled = pmv.IAMDatabaseAuthenticationEnabled
-		//}
-        // NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.Iops != nil {
-		//	ko.Spec.IOPS = pmv.Iops
-		//}
+		if pmv.IAMDatabaseAuthenticationEnabled != nil {
+			ko.Spec.EnableIAMDatabaseAuthentication = pmv.IAMDatabaseAuthenticationEnabled
+		}
+		if pmv.IOPS != nil {
+			ko.Spec.IOPS = pmv.IOPS
+		}
 		if pmv.LicenseModel != nil {
 			ko.Spec.LicenseModel = pmv.LicenseModel
 		}
@@ -89,11 +109,47 @@
 			return nil, err
 		}
 		ko.Spec.Tags = tags
+		pendingActions, err := rm.getPendingMaintenanceActions(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.PendingMaintenanceActions = pendingActions
+	}
+	setSwitchoverProgress(&resource{ko})
+	setMultiAZConversionProgress(&resource{ko})
+	setCACertificateExpiry(&resource{ko})
+	setPublicAccessProgress(r, &resource{ko})
+	setPortChangeProgress(&resource{ko})
+	rm.setMasterUserPasswordHash(ctx, &resource{ko})
+	if err = rm.setMasterUserSecretRotation(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	setActivityStream(r, &resource{ko})
+	if err = rm.setAutomatedBackupsReplication(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.copyMasterUserSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeConnectionSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.writeServiceBindingSecret(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncCABundleConfigMap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err = rm.syncEndpointService(ctx, &resource{ko}); err != nil {
+		return nil, err
 	}
-	if !instanceAvailable(&resource{ko}) {
+	if !instanceAvailable(&resource{ko}) && !instanceStoppedAsDesired(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if activityStreamChanging(&resource{ko}) {
+		msg := "DB instance activity stream is being started or stopped"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
 	}
 	if len(r.ko.Spec.VPCSecurityGroupIDs) > 0 {
 		// If the desired resource has security groups specified then update the spec of the latest resource with the
@@ -24,6 +24,60 @@
         if !delta.DifferentAt("Spec.NetworkType") {
                 input.NetworkType = nil
         }
+	// The master user password of the Secrets Manager secret set in
+	// Spec.MasterUserPasswordSecretARN is only read when it changed
+	if desired.ko.Spec.MasterUserPasswordSecretARN != nil && delta.DifferentAt("Spec.MasterUserPassword") {
+		password, err := rm.getMasterUserPasswordFromSecretARN(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.SetMasterUserPassword(password)
+	}
+	// RDS only accepts the KMS key of the master user secret along with
+	// turning on the management of the master user password in Secrets
+	// Manager. So, if neither of them changed, exclude them from
+	// ModifyDBInstanceRequest
+	if !delta.DifferentAt("Spec.ManageMasterUserPassword") &&
+		!delta.DifferentAt("Spec.MasterUserSecretKMSKeyID") {
+		input.ManageMasterUserPassword = nil
+		input.MasterUserSecretKmsKeyId = nil
+	}
+
+	// The domain of the DB instance only needs to be part of a
+	// ModifyDBInstanceRequest that changes it
+	if !domainChanged(delta) {
+		input.Domain = nil
+		input.DomainIAMRoleName = nil
+		input.DomainFqdn = nil
+		input.DomainOu = nil
+		input.DomainAuthSecretArn = nil
+		input.DomainDnsIps = nil
+	}
+
+	// Modifications deferred to the maintenance window are requested on their
+	// own, see deferModifications, so exclude them from the
+	// ModifyDBInstanceRequest applied immediately
+	excludeDeferredModifications(desired, input)
+
+	// Switch the DB instance to the default DB parameter group of the family
+	// of the target engine version when upgrading its major engine version,
+	// unless the DB parameter group is set in the Spec
+	if group := majorVersionUpgradeParameterGroup(desired, upgradeFamily); group != nil && input.EngineVersion != nil {
+		input.DBParameterGroupName = group
+	}
+
+	// RDS returns the gp3 baseline IOPS and storage throughput as set, but
+	// rejects a ModifyDBInstanceRequest that contains them below the
+	// engine-specific minimum allocated storage. So, if neither of them nor
+	// the storage they apply to changed, exclude them from
+	// ModifyDBInstanceRequest
+	if !delta.DifferentAt("Spec.IOPS") &&
+		!delta.DifferentAt("Spec.StorageThroughput") &&
+		!delta.DifferentAt("Spec.StorageType") &&
+		!delta.DifferentAt("Spec.AllocatedStorage") {
+		input.Iops = nil
+		input.StorageThroughput = nil
+	}
 
         // For dbInstance inside dbCluster, it's either aurora or 
         // multi-az cluster case, in either case, the below params
@@ -35,4 +89,5 @@
                 input.BackupRetentionPeriod = nil
                 input.PreferredBackupWindow = nil
                 input.DeletionProtection = nil
+                input.EnableIAMDatabaseAuthentication = nil
         }
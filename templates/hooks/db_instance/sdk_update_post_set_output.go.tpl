@@ -35,13 +35,15 @@
 		if pmv.DBSubnetGroupName != nil {
 			ko.Spec.DBSubnetGroupName = pmv.DBSubnetGroupName
 		}
+		if pmv.DedicatedLogVolume != nil {
+			ko.Spec.DedicatedLogVolume = pmv.DedicatedLogVolume
+		}
 		if pmv.EngineVersion != nil {
 			ko.Spec.EngineVersion = pmv.EngineVersion
 		}
-        // NOTE(jaypipes): Handle when aws-sdk-go update
-		//if pmv.IAMDatabaseAuthenticationEnabled != nil {
-		//	ko.Spec.IAMDatabaseAuthenticationEnabled = pmv.IAMDatabaseAuthenticationEnabled
-		//}
+		if pmv.IAMDatabaseAuthenticationEnabled != nil {
+			ko.Spec.EnableIAMDatabaseAuthentication = pmv.IAMDatabaseAuthenticationEnabled
+		}
 		if pmv.Iops != nil {
 			ko.Spec.IOPS = pmv.Iops
 		}
@@ -86,6 +88,26 @@
 		// resource.
 		r := &resource{ko}
 		setLastAppliedSecretReferenceAnnotation(r)
+		// The new value of the Secret referenced by Spec.MasterUserPassword
+		// has been applied
+		ko.Status.MasterUserPasswordHash = latest.ko.Status.MasterUserPasswordHash
+		ko.Status.MasterUserPasswordSecretVersionID = latest.ko.Status.MasterUserPasswordSecretVersionID
+		if delta.DifferentAt("Spec.MultiAZ") && !multiAZConversionDeferred(desired) {
+			setMultiAZConversionStarted(r)
+		}
+		// ModifyDBInstance returns the previous public accessibility, which is
+		// not part of the pending modified values
+		if delta.DifferentAt("Spec.PubliclyAccessible") {
+			ko.Spec.PubliclyAccessible = desired.ko.Spec.PubliclyAccessible
+			setPublicAccessStarted(r)
+		}
+		if delta.DifferentAt("Spec.Port") {
+			setPortChangeStarted(r)
+		}
+		setSafetySnapshotCompleted(r)
+		if upgradeFamily != "" && input.EngineVersion != nil {
+			setMajorVersionUpgradeStarted(r, false)
+		}
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(r, corev1.ConditionFalse, nil, nil)
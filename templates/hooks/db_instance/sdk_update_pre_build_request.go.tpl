@@ -1,3 +1,6 @@
+	if delta.DifferentAt("Spec.Adoption") {
+		return adoptDBInstance(latest), nil
+	}
 	if instanceDeleting(latest) {
 		msg := "DB instance is currently being deleted"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
@@ -8,6 +11,44 @@
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if _, err = desiredState(desired); err != nil {
+		return nil, err
+	}
+	if err = validateGP3Storage(desired); err != nil {
+		return nil, err
+	}
+	if err = validatePromotionTier(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDeletion(desired); err != nil {
+		return nil, err
+	}
+	if err = validateWindows(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecret(desired); err != nil {
+		return nil, err
+	}
+	if err = validateMasterUserSecretRotation(desired); err != nil {
+		return nil, err
+	}
+	if err = validateActivityStream(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.validateAutomatedBackupsReplication(desired); err != nil {
+		return nil, err
+	}
+	if err = validateDomain(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.DesiredState") && instanceStopped(latest) {
+		if *latest.ko.Status.DBInstanceStatus == StatusStopping {
+			msg := "DB instance cannot be started while in '" + StatusStopping + "' status"
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+			return desired, requeueWaitWhileStopping
+		}
+		return rm.startDBInstance(ctx, desired)
+	}
 	if instanceHasTerminalStatus(latest) {
 		msg := "DB instance is in '"+*latest.ko.Status.DBInstanceStatus+"' status"
 		ackcondition.SetTerminal(desired, corev1.ConditionTrue, &msg, nil)
@@ -19,8 +60,104 @@
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
 	}
+	if multiAZConversionInProgress(latest) {
+		msg := "DB instance cannot be modified until its Multi-AZ conversion completes"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileConvertingMultiAZ
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	// The rotation schedule of the master user secret is applied through
+	// Secrets Manager
+	if delta.DifferentAt("Spec.MasterUserSecretRotation") {
+		if err = rm.syncMasterUserSecretRotation(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		if masterUserPasswordRotationRequested(desired) {
+			return rm.rotateMasterUserPassword(ctx, desired)
+		}
+		if !delta.DifferentExcept("Spec.MasterUserSecretRotation", "Spec.Tags") {
+			return desired, nil
+		}
+	}
+	if delta.DifferentAt("Spec.ActivityStream") {
+		return rm.syncActivityStream(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.AutomatedBackupsReplication") {
+		return rm.syncAutomatedBackupsReplication(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.PauseAutomation") {
+		return rm.changeAutomationMode(ctx, desired)
+	}
+	if delta.DifferentAt("Spec.ApplyPendingMaintenanceAction") {
+		return rm.applyPendingMaintenanceAction(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.RotateCACertificate") {
+		return rm.rotateCACertificate(ctx, desired, latest)
+	}
+	// Promote the read replica before any other modification, since some of
+	// them, like enabling automated backups on some engines, only apply to a
+	// standalone DB instance.
+	if delta.DifferentAt("Spec.PromoteReadReplica") {
+		return rm.promoteReadReplica(ctx, desired, latest)
+	}
+	if delta.DifferentAt("Spec.SwitchoverReadReplica") {
+		return rm.switchoverReadReplica(ctx, desired, latest)
+	}
+	if err = rm.validateModifications(ctx, desired, latest, delta); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.DedicatedLogVolume") || delta.DifferentAt("Spec.DBInstanceClass") ||
+		delta.DifferentAt("Spec.EngineVersion") || delta.DifferentAt("Spec.StorageType") {
+		if err = rm.validateDedicatedLogVolume(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.EnableIAMDatabaseAuthentication") || delta.DifferentAt("Spec.DBInstanceClass") ||
+		delta.DifferentAt("Spec.EngineVersion") {
+		if err = rm.validateIAMDatabaseAuthentication(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.NetworkType") || delta.DifferentAt("Spec.DBSubnetGroupName") {
+		if err = rm.validateNetworkType(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.PubliclyAccessible") &&
+		desired.ko.Spec.PubliclyAccessible != nil && *desired.ko.Spec.PubliclyAccessible {
+		if err = rm.validatePublicAccess(ctx, latest); err != nil {
+			return nil, err
+		}
+	}
+	upgradeFamily, err := rm.validateEngineVersionUpgrade(ctx, desired, latest, delta)
+	if err != nil {
+		return nil, err
+	}
+	if safetySnapshotRequired(desired, latest, delta) {
+		if err = rm.syncSafetySnapshot(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
+	// Modifications deferred to the maintenance window are requested on their
+	// own, once every other modification has been applied, since RDS applies
+	// pending modifications along with the ones applied immediately.
+	if modificationsDeferred(desired, delta) {
+		return rm.deferModifications(ctx, desired, delta, upgradeFamily)
+	}
+	// Reboot the DB instance once every other modification has been applied,
+	// so that the reboot also applies the modifications pending a reboot. A
+	// requested stop happens after the reboot.
+	if delta.DifferentAt("Spec.Reboot") &&
+		!delta.DifferentExcept("Spec.Reboot", "Spec.Tags", "Spec.DesiredState") {
+		return rm.rebootDBInstance(ctx, desired)
+	}
+	// Stop the DB instance once every other modification has been applied,
+	// since a stopped DB instance cannot be modified.
+	if delta.DifferentAt("Spec.DesiredState") &&
+		!delta.DifferentExcept("Spec.DesiredState", "Spec.Tags") {
+		return rm.stopDBInstance(ctx, desired)
+	}
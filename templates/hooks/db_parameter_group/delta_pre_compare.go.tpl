@@ -1 +1,2 @@
     compareTags(delta, a, b)
+    compareParameterOverrides(delta, a, b)
@@ -1,3 +1,5 @@
-	if err = rm.syncParameters(ctx, desired, nil); err != nil {
+	if err = rm.syncParameters(ctx, &resource{ko}, nil); err != nil {
 		return nil, err
 	}
+	// A new parameter group has all its parameters set to their defaults.
+	setLastResetAllParameters(&resource{ko})
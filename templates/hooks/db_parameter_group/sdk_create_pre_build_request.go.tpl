@@ -0,0 +1,13 @@
+	if err = rm.resolveFamily(ctx, desired); err != nil {
+		return nil, err
+	}
+	// Reject unknown or unmodifiable parameter overrides before creating the
+	// parameter group, rather than after the group has been created.
+	if err = rm.validateParameters(ctx, desired); err != nil {
+		return nil, err
+	}
+	// Seed the parameter group with the parameters of the source parameter
+	// group instead of creating an empty parameter group.
+	if desired.ko.Spec.SourceParameterGroupName != nil {
+		return rm.copyParameterGroup(ctx, desired)
+	}
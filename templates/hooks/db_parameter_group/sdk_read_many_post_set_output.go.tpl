@@ -8,10 +8,21 @@
 	}
 	if ko.Spec.Name != nil {
 		groupName := ko.Spec.Name
-		params, paramStatuses, err := rm.getParameters(ctx, groupName)
+		params, paramStatuses, paramSources, err := rm.getParameters(ctx, groupName)
 		if err != nil {
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
 		ko.Status.ParameterOverrideStatuses = paramStatuses
+		ko.Status.ParameterSources = paramSources
+		setParameterDrift(ko)
+		rm.checkClusterParameterGroupConsistency(ctx, ko)
+		// The base parameter group's parameters are read on every
+		// reconciliation so that changes to the base are layered onto this
+		// parameter group too.
+		baseParams, err := rm.baseParameters(ctx, &resource{ko})
+		if err != nil {
+			return nil, err
+		}
+		ko.Status.BaseParameterOverrides = baseParams
 	}
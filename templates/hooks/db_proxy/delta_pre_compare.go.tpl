@@ -0,0 +1 @@
+	compareAuthSecrets(delta, a, b)
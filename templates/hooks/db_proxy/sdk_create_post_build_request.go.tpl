@@ -0,0 +1,3 @@
+	// Spec.AuthSecrets are sent as auth settings of the Secrets Manager secrets
+	// managed for them, along with the ones of Spec.Auth.
+	input.Auth = append(input.Auth, managedSDKAuth(desired)...)
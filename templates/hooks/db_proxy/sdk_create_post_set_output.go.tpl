@@ -1,3 +1,4 @@
+	setUnmanagedAuth(&resource{ko})
 	// We expect the DB proxy to be in 'creating' status since we just
 	// issued the call to create it, but I suppose it doesn't hurt to check
 	// here.
@@ -0,0 +1,6 @@
+	if err = validateAuth(desired); err != nil {
+		return nil, err
+	}
+	if err = rm.syncAuthSecrets(ctx, desired); err != nil {
+		return nil, err
+	}
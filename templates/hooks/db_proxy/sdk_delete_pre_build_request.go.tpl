@@ -1,3 +1,6 @@
 	if proxyDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err = rm.deleteAuthSecrets(ctx, r); err != nil {
+		return nil, err
+	}
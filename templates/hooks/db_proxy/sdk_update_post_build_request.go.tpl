@@ -0,0 +1,8 @@
+	// ModifyDBProxy names the VPC security groups SecurityGroups, so the
+	// generated code does not set them.
+	if delta.DifferentAt("Spec.VPCSecurityGroupIDs") {
+		input.SetSecurityGroups(desired.ko.Spec.VPCSecurityGroupIDs)
+	}
+	// Spec.AuthSecrets are sent as auth settings of the Secrets Manager secrets
+	// managed for them, along with the ones of Spec.Auth.
+	input.Auth = append(input.Auth, managedSDKAuth(desired)...)
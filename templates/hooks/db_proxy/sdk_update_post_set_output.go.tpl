@@ -1,3 +1,4 @@
+	setUnmanagedAuth(&resource{ko})
     // When ModifyDBProxy API is successful, it asynchronously
 	// updates the DBProxyStatus. Requeue to find the current
 	// DBProxy status and set Synced condition accordingly
@@ -18,4 +18,20 @@
 		msg := "DB proxy cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
 		return desired, requeueWaitUntilCanModify(latest)
-	}
\ No newline at end of file
+	}
+	if err = validateAuth(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.AuthSecrets") {
+		if err = rm.syncAuthSecrets(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
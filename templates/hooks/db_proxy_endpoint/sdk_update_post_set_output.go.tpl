@@ -0,0 +1,8 @@
+	// ModifyDBProxyEndpoint updates the DB proxy endpoint asynchronously.
+	// Requeue to find the current status and set the Synced condition
+	// accordingly.
+	if err == nil {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
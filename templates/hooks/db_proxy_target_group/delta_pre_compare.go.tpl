@@ -0,0 +1 @@
+	compareConnectionPoolConfig(delta, a, b)
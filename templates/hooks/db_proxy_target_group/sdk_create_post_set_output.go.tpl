@@ -0,0 +1,6 @@
+	if err = rm.syncTargets(ctx, &resource{ko}, nil); err != nil {
+		return nil, err
+	}
+	// The targets are registered asynchronously. Requeue to find their
+	// health and set the Synced condition accordingly.
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
@@ -0,0 +1,5 @@
+	// The target group itself is deleted along with its DB proxy, only its
+	// targets are deregistered.
+	if len(r.ko.Spec.DBClusterIdentifiers) == 0 && len(r.ko.Spec.DBInstanceIdentifiers) == 0 {
+		return r, nil
+	}
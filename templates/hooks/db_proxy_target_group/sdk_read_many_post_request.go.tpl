@@ -0,0 +1,4 @@
+	// The target groups of a DB proxy are deleted along with the DB proxy
+	if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "DBProxyNotFoundFault" {
+		return nil, ackerr.NotFound
+	}
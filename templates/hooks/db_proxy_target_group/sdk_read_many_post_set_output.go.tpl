@@ -0,0 +1,8 @@
+	if err = rm.setTargets(ctx, ko); err != nil {
+		return nil, err
+	}
+	if !targetGroupSynced(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
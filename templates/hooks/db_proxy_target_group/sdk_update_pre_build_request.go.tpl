@@ -0,0 +1,11 @@
+	if delta.DifferentAt("Spec.DBClusterIdentifiers") || delta.DifferentAt("Spec.DBInstanceIdentifiers") {
+		if err = rm.syncTargets(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// The targets are registered asynchronously. Requeue to find their
+		// health and set the Synced condition accordingly.
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, nil, nil)
+	}
+	if !delta.DifferentAt("Spec.ConnectionPoolConfig") {
+		return desired, nil
+	}
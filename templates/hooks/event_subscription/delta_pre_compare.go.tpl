@@ -0,0 +1,2 @@
+	compareEventCategories(delta, a, b)
+	compareSourceIDs(delta, a, b)
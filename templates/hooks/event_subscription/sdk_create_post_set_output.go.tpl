@@ -0,0 +1,8 @@
+	// We expect the event subscription to be in 'creating' status since we
+	// just issued the call to create it, but it doesn't hurt to check here.
+	if subscriptionCreating(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
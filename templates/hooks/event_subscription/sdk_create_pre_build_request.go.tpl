@@ -0,0 +1,3 @@
+	if err = rm.validateEventSubscription(ctx, desired); err != nil {
+		return nil, err
+	}
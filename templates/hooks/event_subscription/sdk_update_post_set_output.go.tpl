@@ -0,0 +1,11 @@
+	if err = rm.addSourceIDs(ctx, &resource{ko}, latest); err != nil {
+		return nil, err
+	}
+	// ModifyEventSubscription updates the event subscription asynchronously.
+	// Requeue to find the current status and set the Synced condition
+	// accordingly.
+	if err == nil {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
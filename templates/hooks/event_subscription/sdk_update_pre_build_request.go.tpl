@@ -0,0 +1,29 @@
+	if subscriptionDeleting(latest) {
+		msg := "Event subscription is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if !subscriptionModifiable(latest) {
+		msg := "Event subscription cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilCanModify(latest)
+	}
+	if err = rm.validateEventSubscription(ctx, desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.SourceIDs") {
+		if err = rm.removeSourceIDs(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags", "Spec.SourceIDs") {
+		if err = rm.addSourceIDs(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		return desired, nil
+	}
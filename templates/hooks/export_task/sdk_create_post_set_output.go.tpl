@@ -0,0 +1,8 @@
+	// We expect the export task to be in 'STARTING' status since we just
+	// issued the call to start it, but it doesn't hurt to check here.
+	if !exportFinished(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
@@ -0,0 +1,4 @@
+	if !exportCancelable(r) {
+		// There is nothing to cancel, the exported data is kept in Amazon S3
+		return nil, nil
+	}
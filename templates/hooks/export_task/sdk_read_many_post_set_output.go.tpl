@@ -0,0 +1,11 @@
+	if exportFailed(&resource{ko}) {
+		msg := "Export task is in '" + *ko.Status.Status + "' status"
+		if ko.Status.FailureCause != nil {
+			msg += ": " + *ko.Status.FailureCause
+		}
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &msg, nil)
+	} else if !exportFinished(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
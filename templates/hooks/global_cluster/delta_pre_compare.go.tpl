@@ -0,0 +1 @@
+	compareFailover(delta, a, b)
@@ -0,0 +1,16 @@
+	if globalClusterDeleting(latest) {
+		msg := "Global cluster is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if !globalClusterSynced(latest) {
+		msg := "Global cluster cannot be modified while in '" + aws.StringValue(latest.ko.Status.Status) + "' status"
+		if latest.ko.Status.FailoverState != nil {
+			msg = "Global cluster cannot be modified while it is being failed over"
+		}
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilCanModify(latest)
+	}
+	if delta.DifferentAt("Spec.Failover") {
+		return rm.failoverGlobalCluster(ctx, desired, latest)
+	}
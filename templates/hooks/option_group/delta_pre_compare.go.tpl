@@ -0,0 +1 @@
+	compareOptions(delta, a, b)
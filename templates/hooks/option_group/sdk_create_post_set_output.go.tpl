@@ -0,0 +1,5 @@
+	// A new option group has no options, the options of the Spec are added
+	// once the option group is created.
+	if err = rm.syncOptions(ctx, &resource{ko}, nil); err != nil {
+		return nil, err
+	}
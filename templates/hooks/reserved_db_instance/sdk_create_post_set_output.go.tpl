@@ -0,0 +1,8 @@
+	// We expect the reserved DB instance to be in 'payment-pending' state
+	// since we just purchased it, but it doesn't hurt to check here.
+	if reservationPaymentPending(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
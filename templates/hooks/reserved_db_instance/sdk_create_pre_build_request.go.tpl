@@ -0,0 +1,7 @@
+	if desired.ko.Spec.ReservedDBInstancesOfferingID == nil {
+		offeringID, err := rm.findReservedDBInstancesOffering(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		desired.ko.Spec.ReservedDBInstancesOfferingID = offeringID
+	}
@@ -0,0 +1 @@
+	compareMasterUserPasswordHash(delta, a, b)
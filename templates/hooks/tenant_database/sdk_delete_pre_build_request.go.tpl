@@ -0,0 +1,3 @@
+	if tenantDatabaseDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
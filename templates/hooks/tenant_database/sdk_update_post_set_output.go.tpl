@@ -0,0 +1,7 @@
+	// The new value of the Secret referenced by Spec.MasterUserPassword has
+	// been applied
+	ko.Status.MasterUserPasswordHash = latest.ko.Status.MasterUserPasswordHash
+	// ModifyTenantDatabase asynchronously updates the status of the tenant
+	// database. Setting resource synced condition to false will trigger a
+	// requeue of the resource.
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)